@@ -1,7 +1,7 @@
 package database
 
 import (
-	_ "embed"
+	"embed"
 	"fmt"
 
 	"github.com/wowsims/mop/sim/core/proto"
@@ -14,6 +14,12 @@ var dbBytes []byte
 //go:embed leftover_db.bin
 var leftoverBytes []byte
 
+// Translated spell and item names, one <locale>.json file per locale. Generated
+// with -gen=locale-names.
+//
+//go:embed locales/*.json
+var LocaleFiles embed.FS
+
 func Load() *proto.UIDatabase {
 	// 1) Unmarshal the “main” DB
 	db := &proto.UIDatabase{}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"os"
@@ -14,6 +15,7 @@ import (
 var (
 	infile  string
 	outfile string
+	format  string
 	verbose bool
 )
 
@@ -26,11 +28,16 @@ var simCmd = &cobra.Command{
 func init() {
 	simCmd.Flags().StringVar(&infile, "infile", "input.json", "location of input file (RaidSimRequest in protojson format)")
 	simCmd.Flags().StringVar(&outfile, "outfile", "", "location of output file, defaults to stdout")
+	simCmd.Flags().StringVar(&format, "format", "proto", "output format, either proto (RaidSimResult in protojson format) or export (stable format described in schemas/result_export.schema.json)")
 	simCmd.Flags().BoolVar(&verbose, "verbose", false, "print information during runtime")
 	simCmd.MarkFlagRequired("infile")
 }
 
 func simMain(cmd *cobra.Command, args []string) {
+	if format != "proto" && format != "export" {
+		log.Fatalf("unknown output format %q", format)
+	}
+
 	data, err := os.ReadFile(infile)
 	if err != nil {
 		log.Fatalf("failed to load input json file %q: %v", infile, err)
@@ -56,7 +63,11 @@ func simMain(cmd *cobra.Command, args []string) {
 		}
 	}
 
-	output, err = protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(finalResult)
+	if format == "export" {
+		output, err = json.MarshalIndent(core.ExportRaidSimResult(finalResult), "", "  ")
+	} else {
+		output, err = protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(finalResult)
+	}
 	if err != nil {
 		log.Fatalf("failed to marshal final results: %s", err)
 	}
@@ -171,7 +171,7 @@ func init() {
 	}
 
 	newDancingSteelEnchant("Dancing Steel", 4444, 118333, 118334, 118335)
-	newDancingSteelEnchant("Bloddy Dancing Steel", 5125, 142531, 142530, 142530)
+	newDancingSteelEnchant("Bloody Dancing Steel", 5125, 142531, 142530, 142530)
 
 	// Permanently enchants a melee weapon to make your damaging melee strikes sometimes activate a Mogu protection
 	// spell, absorbing up to 8000 damage.
@@ -320,13 +320,10 @@ func init() {
 						Timer:    character.NewTimer(),
 						Duration: time.Minute,
 					},
-					SharedCD: core.Cooldown{
-						Timer:    character.GetOffensiveTrinketCD(),
-						Duration: 10 * time.Second,
-					},
 				},
 			})
 	})
+	core.RegisterTinkerOnUse(4898, core.ActionID{SpellID: 126734})
 
 	// Phase Fingers
 	core.NewEnchantEffect(4697, func(agent core.Agent, _ proto.ItemLevelState) {
@@ -346,13 +343,10 @@ func init() {
 						Timer:    character.NewTimer(),
 						Duration: time.Minute,
 					},
-					SharedCD: core.Cooldown{
-						Timer:    character.GetDefensiveTrinketCD(),
-						Duration: 10 * time.Second,
-					},
 				},
 			})
 	})
+	core.RegisterTinkerOnUse(4697, core.ActionID{SpellID: 108788})
 
 	// Nitro Boosts
 	core.NewEnchantEffect(4223, func(agent core.Agent, _ proto.ItemLevelState) {
@@ -395,9 +389,20 @@ func init() {
 			Spell: activationSpell,
 			Type:  core.CooldownTypeDPS,
 
-			ShouldActivate: func(_ *core.Simulation, character *core.Character) bool {
-				return character.DistanceFromTarget > core.MaxMeleeRange
+			// Only worth the 3 minute cooldown when closing the distance to the
+			// target or when the forced movement outlasts the boost.
+			ShouldActivate: func(sim *core.Simulation, character *core.Character) bool {
+				return (character.DistanceFromTarget > core.MaxMeleeRange) || (character.RemainingTravelTime(sim) >= buffAura.Duration)
 			},
 		})
 	})
+	core.RegisterTinkerOnUse(4223, core.ActionID{SpellID: 55004})
+
+	// Boot enchants with a minor run speed increase
+	for _, effectID := range []int32{911, 4104, 4105, 4428, 4429} {
+		core.NewEnchantEffect(effectID, func(agent core.Agent, _ proto.ItemLevelState) {
+			character := agent.GetCharacter()
+			character.NewPassiveMovementSpeedAura("Minor Run Speed", core.ActionID{SpellID: 13889}, 0.08)
+		})
+	}
 }
@@ -31,7 +31,6 @@ func init() {
 	// (Approximately [19.27 + Haste] procs per minute)
 	core.NewItemEffect(95346, func(agent core.Agent, _ proto.ItemLevelState) {
 		character := agent.GetCharacter()
-		var target *core.Unit
 
 		isHunter := character.Class == proto.Class_ClassHunter
 		flags := core.SpellFlagNoOnCastComplete
@@ -61,17 +60,15 @@ func init() {
 			},
 		})
 
-		capacitanceAura := character.RegisterAura(core.Aura{
-			Label:     "Capacitance",
-			ActionID:  core.ActionID{SpellID: 137596},
-			Duration:  time.Minute * 1,
-			MaxStacks: 5,
-			OnStacksChange: func(aura *core.Aura, sim *core.Simulation, oldStacks int32, newStacks int32) {
-				if newStacks == aura.MaxStacks {
-					lightningStrike.Cast(sim, target)
-					aura.SetStacks(sim, 0)
-				}
+		capacitor := character.NewCapacitor(core.CapacitorConfig{
+			Aura: core.Aura{
+				Label:     "Capacitance",
+				ActionID:  core.ActionID{SpellID: 137596},
+				Duration:  time.Minute * 1,
+				MaxStacks: 5,
 			},
+			Discharge: lightningStrike,
+			Targeting: core.CapacitorTargetLastCharge,
 		})
 
 		character.MakeProcTriggerAura(core.ProcTrigger{
@@ -105,9 +102,7 @@ func init() {
 				WithSpecMod(0.0869999975, proto.Spec_SpecWindwalkerMonk),
 			),
 			Handler: func(sim *core.Simulation, spell *core.Spell, result *core.SpellResult) {
-				target = result.Target
-				capacitanceAura.Activate(sim)
-				capacitanceAura.AddStack(sim)
+				capacitor.AddCharge(sim, result.Target)
 			},
 		})
 	})
@@ -207,6 +207,7 @@ func factory_StatBonusEffect(config ProcStatBonusEffect, extraSpell func(agent c
 		if proc.IcdMs != 0 {
 			procAura.Icd = triggerAura.Icd
 		}
+		procAura.Dpm = triggerAura.Dpm
 		if isEnchant {
 			character.ItemSwap.RegisterEnchantProcWithSlots(effectID, triggerAura, eligibleSlots)
 		} else {
@@ -479,6 +480,7 @@ func NewStackingStatBonusEffect(config StackingStatBonusEffect) {
 		})
 
 		procAura.Icd = triggerAura.Icd
+		procAura.Dpm = triggerAura.Dpm
 		character.AddStatProcBuff(config.ItemID, procAura, false, eligibleSlotsForItem)
 		character.ItemSwap.RegisterProcWithSlots(config.ItemID, triggerAura, eligibleSlotsForItem)
 	})
@@ -0,0 +1,98 @@
+package core
+
+import (
+	"time"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+// Tracks how well a tank keeps their active mitigation up, from the auras
+// marked with ActiveMitigation. Overlapping auras count once.
+type activeMitigationMetrics struct {
+	// Values for the current iteration.
+	numActive   int32
+	activeSince time.Duration
+	uptime      time.Duration
+	damageTaken float64
+	unmitigated float64
+
+	// Aggregate values, updated after each iteration.
+	uptimeSum      float64
+	damageTakenSum float64
+	unmitigatedSum float64
+}
+
+// Marks an aura as one of its unit's active mitigation effects, like Shield
+// Block or Guard. Metrics of tanking units then report how much of the
+// encounter those are up for, and how much damage is taken without them.
+func ActiveMitigation(aura *Aura) *Aura {
+	if aura.Unit.Metrics.activeMitigation == nil {
+		aura.Unit.Metrics.activeMitigation = &activeMitigationMetrics{}
+	}
+	am := aura.Unit.Metrics.activeMitigation
+
+	aura.ApplyOnGain(func(_ *Aura, sim *Simulation) {
+		am.gain(sim)
+	})
+	aura.ApplyOnExpire(func(_ *Aura, sim *Simulation) {
+		am.expire(sim)
+	})
+
+	return aura
+}
+
+func (am *activeMitigationMetrics) gain(sim *Simulation) {
+	if am.numActive == 0 {
+		am.activeSince = max(0, sim.CurrentTime)
+	}
+	am.numActive++
+}
+
+func (am *activeMitigationMetrics) expire(sim *Simulation) {
+	// Auras still up at the end of an iteration expire after the metrics are
+	// reset.
+	if am.numActive == 0 {
+		return
+	}
+	am.numActive--
+	if am.numActive == 0 {
+		am.uptime += max(0, sim.CurrentTime-am.activeSince)
+	}
+}
+
+func (am *activeMitigationMetrics) addDamageTaken(amount float64) {
+	am.damageTaken += amount
+	if am.numActive == 0 {
+		am.unmitigated += amount
+	}
+}
+
+func (am *activeMitigationMetrics) reset() {
+	am.numActive = 0
+	am.activeSince = 0
+	am.uptime = 0
+	am.damageTaken = 0
+	am.unmitigated = 0
+}
+
+func (am *activeMitigationMetrics) doneIteration(sim *Simulation) {
+	uptime := am.uptime
+	if am.numActive > 0 {
+		uptime += max(0, sim.CurrentTime-am.activeSince)
+	}
+
+	am.uptimeSum += uptime.Seconds() / sim.Duration.Seconds()
+	am.damageTakenSum += am.damageTaken
+	am.unmitigatedSum += am.unmitigated
+}
+
+func (am *activeMitigationMetrics) toProto(numIterations float64) *proto.ActiveMitigationMetrics {
+	result := &proto.ActiveMitigationMetrics{
+		UptimeAvg:      am.uptimeSum / numIterations,
+		DamageTakenAvg: am.damageTakenSum / numIterations,
+	}
+	if am.damageTakenSum > 0 {
+		result.UnmitigatedDamageTaken = am.unmitigatedSum / am.damageTakenSum
+	}
+	return result
+}
@@ -0,0 +1,48 @@
+package core
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestActiveMitigationMetrics(t *testing.T) {
+	sim := &Simulation{Duration: time.Second * 20}
+	am := &activeMitigationMetrics{}
+
+	// Gained before the pull, and overlapped by a second aura.
+	sim.CurrentTime = -time.Second
+	am.gain(sim)
+	sim.CurrentTime = time.Second * 2
+	am.addDamageTaken(1000)
+	am.gain(sim)
+	sim.CurrentTime = time.Second * 4
+	am.expire(sim)
+	sim.CurrentTime = time.Second * 5
+	am.expire(sim)
+
+	sim.CurrentTime = time.Second * 10
+	am.addDamageTaken(3000)
+
+	// Still up at the end of the iteration.
+	sim.CurrentTime = time.Second * 15
+	am.gain(sim)
+	sim.CurrentTime = sim.Duration
+	am.doneIteration(sim)
+
+	result := am.toProto(1)
+	if math.Abs(result.UptimeAvg-0.5) > 1e-9 {
+		t.Fatalf("Expected an uptime of 50%%, found %f", result.UptimeAvg)
+	}
+	if math.Abs(result.UnmitigatedDamageTaken-0.75) > 1e-9 {
+		t.Fatalf("Expected 75%% of damage taken unmitigated, found %f", result.UnmitigatedDamageTaken)
+	}
+
+	// Expiring once the metrics are reset doesn't count against the next
+	// iteration.
+	am.reset()
+	am.expire(sim)
+	if am.numActive != 0 || am.uptime != 0 {
+		t.Fatalf("Expected no active mitigation after reset, found %d active for %s", am.numActive, am.uptime)
+	}
+}
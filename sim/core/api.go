@@ -2,6 +2,10 @@
 package core
 
 import (
+	"cmp"
+	"io"
+	"runtime/debug"
+
 	"github.com/wowsims/mop/sim/core/proto"
 	"github.com/wowsims/mop/sim/core/simsignals"
 )
@@ -23,6 +27,70 @@ func ComputeStats(csr *proto.ComputeStatsRequest) *proto.ComputeStatsResult {
 	}
 }
 
+/**
+ * Returns the fully buffed character sheet of each player, without running any iterations
+ */
+func ComputeCharacterSheet(request *proto.ComputeCharacterSheetRequest) (result *proto.ComputeCharacterSheetResult) {
+	if request.Raid == nil {
+		return &proto.ComputeCharacterSheetResult{Error: &proto.ErrorOutcome{Message: "No raid to compute character sheets for"}}
+	}
+
+	encounter := request.Encounter
+	if encounter == nil {
+		encounter = &proto.Encounter{}
+	}
+
+	// Invalid players panic while their characters are constructed, so report
+	// that as an error instead of taking down the caller.
+	defer func() {
+		if err := recover(); err != nil {
+			errStr := ""
+			switch errt := err.(type) {
+			case string:
+				errStr = errt
+			case error:
+				errStr = errt.Error()
+			}
+
+			errStr += "\nStack Trace:\n" + string(debug.Stack())
+			result = &proto.ComputeCharacterSheetResult{
+				Error: &proto.ErrorOutcome{Message: errStr},
+			}
+		}
+	}()
+
+	env, _, _ := NewEnvironment(request.Raid, encounter, false)
+
+	result = &proto.ComputeCharacterSheetResult{}
+	for _, party := range env.Raid.Parties {
+		for _, player := range party.Players {
+			if _, isDummy := player.(*TargetDummy); isDummy {
+				continue
+			}
+			result.Players = append(result.Players, player.GetCharacter().GetCharacterSheet())
+		}
+	}
+
+	return result
+}
+
+/**
+ * Returns the combat table of each player against each target, as built by the encounter's outcome model
+ */
+func ComputeOutcomeTables(request *proto.ComputeOutcomeTablesRequest) *proto.ComputeOutcomeTablesResult {
+	encounter := request.Encounter
+	if encounter == nil {
+		encounter = &proto.Encounter{}
+	}
+
+	env, _, _ := NewEnvironment(request.Raid, encounter, false)
+
+	return &proto.ComputeOutcomeTablesResult{
+		OutcomeModel: cmp.Or(encounter.OutcomeModel, DefaultOutcomeModelName),
+		Tables:       env.GetOutcomeTables(),
+	}
+}
+
 /**
  * Returns stat weights and EP values, with standard deviations, for all stats.
  */
@@ -60,6 +128,69 @@ func StatWeightCompute(request *proto.StatWeightsCalcRequest) *proto.StatWeights
 	return computeStatWeights(request)
 }
 
+/**
+ * Sims a single replacement item against the equipped one, and returns the delta.
+ */
+func ComputeGearDelta(request *proto.GearDeltaRequest) *proto.GearDeltaResult {
+	return runGearDelta(request, simsignals.CreateSignals())
+}
+
+/**
+ * Sims each obtainable item as a replacement, and ranks the upgrades by DPS per valor/bonus roll.
+ */
+func ComputeUpgradeAdvice(request *proto.UpgradeAdvisorRequest) *proto.UpgradeAdvisorResult {
+	return runUpgradeAdvisor(request, simsignals.CreateSignals())
+}
+
+/**
+ * Sims each viable flask/food/potion alternative and returns the delta against the current consumes.
+ */
+func CompareConsumables(request *proto.ConsumableComparisonRequest) *proto.ConsumableComparisonResult {
+	return runConsumableComparison(request, simsignals.CreateSignals())
+}
+
+/**
+ * Sims the raid without each of its raid buffs and debuffs (and optionally each of its classes), and ranks them by raid DPS lost.
+ */
+func ComputeRaidBuffContributions(request *proto.RaidBuffContributionRequest) *proto.RaidBuffContributionResult {
+	return runRaidBuffContributions(request, simsignals.CreateSignals())
+}
+
+/**
+ * Sims the same character with each of the given rotations, and ranks them.
+ */
+func CompareRotations(request *proto.RotationComparisonRequest) *proto.RotationComparisonResult {
+	return runRotationComparison(request, simsignals.CreateSignals())
+}
+
+/**
+ * Sims the character on a grid of bonus stats, and reports DPS normalized by main stat and power.
+ */
+func ComputeStatScaling(request *proto.StatScalingRequest) *proto.StatScalingResult {
+	return runStatScaling(request, simsignals.CreateSignals())
+}
+
+/**
+ * Lists the gear set and APL presets shipped with each spec UI.
+ */
+func ListPresets(request *proto.ListPresetsRequest) *proto.ListPresetsResult {
+	return listPresets(request)
+}
+
+/**
+ * Returns the item restrictions of a spec, and which of the given items (or of all items) it can equip.
+ */
+func GetItemFilterRules(request *proto.ItemFilterRequest) *proto.ItemFilterResult {
+	return getItemFilter(request)
+}
+
+/**
+ * Decodes a talents string into the named talents of a class, or returns why it is invalid.
+ */
+func DecodeTalents(request *proto.DecodeTalentsRequest) *proto.DecodeTalentsResult {
+	return decodeTalents(request)
+}
+
 /**
  * Runs multiple iterations of the sim with a full raid.
  */
@@ -67,6 +198,15 @@ func RunRaidSim(request *proto.RaidSimRequest) *proto.RaidSimResult {
 	return RunSim(request, nil, simsignals.CreateSignals())
 }
 
+/**
+ * Runs the raid sim, streaming the logs of the first iteration to w as a
+ * compact event log as they are written rather than holding them in memory.
+ */
+func StreamRaidSimLogs(request *proto.RaidSimRequest, w io.Writer) *proto.RaidSimResult {
+	request.SimOptions.DebugFirstIteration = true
+	return runSim(request, nil, false, simsignals.CreateSignals(), w)
+}
+
 func RunRaidSimAsync(request *proto.RaidSimRequest, progress chan *proto.ProgressMetrics, requestId string) {
 	signals, err := simsignals.RegisterWithId(requestId)
 	if err != nil {
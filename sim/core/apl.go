@@ -2,6 +2,7 @@ package core
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/wowsims/mop/sim/core/proto"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -45,6 +46,11 @@ type APLRotation struct {
 	prepullIdxMap      []int
 	priorityListIdxMap []int
 	groupListIdxMap    [][]int
+
+	// Only set when SimOptions.trace_rotation is enabled.
+	trace *aplTrace
+
+	waits aplWaitMetrics
 }
 
 type APLGroup struct {
@@ -223,6 +229,13 @@ func (unit *Unit) newAPLRotation(config *proto.APLRotation) *APLRotation {
 		// Duplicate any other groups referenced by this group's actions.
 		for _, action := range group.actions {
 			if groupReferenceAction, ok := action.impl.(*APLActionGroupReference); ok {
+				// Recursive references would be duplicated forever.
+				if groupConfigReaches(groupsConfig, groupReferenceAction.groupName, group.name, make(map[string]bool)) {
+					groupReferenceAction.recursive = true
+					groupReferenceAction.matched = true
+					continue
+				}
+
 				for _, groupConfig := range groupsConfig {
 					if (groupReferenceAction.groupName == groupConfig.Name) && !groupReferenceAction.matched {
 						groupsConfig = append(groupsConfig, groupConfig)
@@ -384,6 +397,10 @@ func (rot *APLRotation) reset(sim *Simulation) {
 	rot.inLoop = false
 	rot.interruptChannelIf = nil
 	rot.allowChannelRecastOnInterrupt = false
+	if rot.trace != nil {
+		rot.trace.reset()
+	}
+	rot.waits.reset()
 	for _, action := range rot.allAPLActions() {
 		action.impl.Reset(sim)
 	}
@@ -409,6 +426,15 @@ func (apl *APLRotation) DoNextAction(sim *Simulation) {
 		return
 	}
 
+	// A spell picked early through the spell queue goes off before anything
+	// else, so hold off until right after it does.
+	if (apl.unit.SpellQueueWindow > 0) && apl.unit.QueuedSpell.isPending() {
+		apl.unit.SetRotationTimer(sim, apl.unit.QueuedSpell.queueAction.NextActionAt+time.Duration(1))
+		return
+	}
+
+	apl.waits.stop(sim)
+
 	i := 0
 	apl.inLoop = true
 
@@ -426,12 +452,21 @@ func (apl *APLRotation) DoNextAction(sim *Simulation) {
 		apl.unit.Log(sim, "No available actions!")
 	}
 
+	if reason := apl.getWaitReason(sim, i > 0); reason != aplWaitNone {
+		apl.waits.start(sim, apl.unit, reason)
+	}
+
 	// Schedule the next rotation evaluation based on either the GCD or reaction time
 	if apl.unit.RotationTimer.IsReady(sim) {
 		nextEvaluation := sim.CurrentTime + apl.unit.ReactionTime
 
 		if !apl.unit.Moving {
-			nextEvaluation = max(nextEvaluation, apl.unit.NextGCDAt())
+			nextEvaluation = max(nextEvaluation, apl.unit.NextGCDAt()-apl.unit.SpellQueueWindow)
+		}
+
+		// Continue right after a queued spell goes off, same as sequences do.
+		if (apl.unit.SpellQueueWindow > 0) && apl.unit.QueuedSpell.isPending() {
+			nextEvaluation = apl.unit.QueuedSpell.queueAction.NextActionAt + time.Duration(1)
 		}
 
 		apl.unit.WaitUntil(sim, nextEvaluation)
@@ -443,6 +478,10 @@ func (apl *APLRotation) getNextAction(sim *Simulation) *APLAction {
 		return apl.controllingActions[len(apl.controllingActions)-1].GetNextAction(sim)
 	}
 
+	if apl.trace.isRecording() {
+		return apl.trace.getNextAction(sim, apl.priorityList)
+	}
+
 	for _, action := range apl.priorityList {
 		if action.IsReady(sim) {
 			return action
@@ -491,6 +530,32 @@ func APLRotationFromJsonString(jsonString string) *proto.APLRotation {
 	return apl
 }
 
+// Whether the group named from references the group named to, directly or
+// through other groups.
+func groupConfigReaches(groupsConfig []*proto.APLGroup, from string, to string, visited map[string]bool) bool {
+	if from == to {
+		return true
+	}
+	if visited[from] {
+		return false
+	}
+	visited[from] = true
+
+	for _, groupConfig := range groupsConfig {
+		if groupConfig.Name != from {
+			continue
+		}
+		for _, aplItem := range groupConfig.Actions {
+			if groupReference := aplItem.GetAction().GetGroupReference(); !aplItem.Hide && groupReference != nil {
+				if groupConfigReaches(groupsConfig, groupReference.GroupName, to, visited) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
 // Add newAPLActionWithGroupVars to propagate groupVars to action condition and impl
 func (rot *APLRotation) newAPLActionWithGroupVars(config *proto.APLAction, groupVars map[string]*proto.APLValue) *APLAction {
 	if config == nil {
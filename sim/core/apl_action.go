@@ -170,6 +170,8 @@ func (rot *APLRotation) newAPLActionImpl(config *proto.APLAction) APLActionImpl
 	// Casting
 	case *proto.APLAction_CastSpell:
 		return rot.newActionCastSpell(config.GetCastSpell())
+	case *proto.APLAction_UseItemInSlot:
+		return rot.newActionUseItemInSlot(config.GetUseItemInSlot())
 	case *proto.APLAction_CancelSpellCast:
 		return rot.newActionCancelSpellCast(config.GetCancelSpellCast())
 	case *proto.APLAction_CastFriendlySpell:
@@ -192,6 +194,8 @@ func (rot *APLRotation) newAPLActionImpl(config *proto.APLAction) APLActionImpl
 		return rot.newActionWait(config.GetWait())
 	case *proto.APLAction_WaitUntil:
 		return rot.newActionWaitUntil(config.GetWaitUntil())
+	case *proto.APLAction_PoolResource:
+		return rot.newActionPoolResource(config.GetPoolResource())
 	case *proto.APLAction_Schedule:
 		return rot.newActionSchedule(config.GetSchedule())
 
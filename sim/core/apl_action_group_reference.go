@@ -12,6 +12,7 @@ type APLActionGroupReference struct {
 	variables map[string]*proto.APLValue
 	group     *APLGroup
 	matched   bool
+	recursive bool
 }
 
 func (rot *APLRotation) newActionGroupReference(config *proto.APLActionGroupReference) APLActionImpl {
@@ -75,6 +76,11 @@ func (action *APLActionGroupReference) Finalize(rot *APLRotation) {
 		return
 	}
 
+	if action.recursive {
+		rot.ValidationMessage(proto.LogLevel_Error, "Group '%s' cannot be referenced from within itself", action.groupName)
+		return
+	}
+
 	// Find the referenced group
 	for _, group := range rot.groups {
 		if (group.name == action.groupName) && ((group.referencedBy == nil) || (group.referencedBy == action)) {
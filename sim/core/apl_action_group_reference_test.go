@@ -0,0 +1,46 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestAPLGroupReferences(t *testing.T) {
+	groupReference := func(name string) *proto.APLListItem {
+		return &proto.APLListItem{Action: &proto.APLAction{Action: &proto.APLAction_GroupReference{
+			GroupReference: &proto.APLActionGroupReference{GroupName: name},
+		}}}
+	}
+
+	rotation := newFillerRotation()
+	rotation.Groups = append(rotation.Groups,
+		&proto.APLGroup{Name: "shared", Actions: rotation.PriorityList},
+		&proto.APLGroup{Name: "wrapper", Actions: []*proto.APLListItem{groupReference("shared")}},
+		&proto.APLGroup{Name: "recursive", Actions: []*proto.APLListItem{groupReference("recursive")}},
+	)
+	rotation.PriorityList = []*proto.APLListItem{groupReference("recursive"), groupReference("wrapper"), groupReference("shared")}
+
+	raid := SinglePlayerRaidProto(newRotationTestPlayer(rotation), &proto.PartyBuffs{}, &proto.RaidBuffs{}, &proto.Debuffs{})
+	stats := ComputeStats(&proto.ComputeStatsRequest{Raid: raid, Encounter: MakeSingleTargetEncounter(0)})
+	rotationStats := stats.RaidStats.Parties[0].Players[0].RotationStats
+	if len(rotationStats.PriorityList[0].Validations) == 0 {
+		t.Fatalf("Expected an error for the recursive group reference")
+	}
+	for _, actionStats := range rotationStats.PriorityList[1:] {
+		for _, validation := range actionStats.Validations {
+			if validation.LogLevel == proto.LogLevel_Error {
+				t.Fatalf("Expected the shared group to be referenced from multiple places, got %v", validation)
+			}
+		}
+	}
+
+	result := RunRaidSim(&proto.RaidSimRequest{
+		Raid:       raid,
+		Encounter:  MakeSingleTargetEncounter(0),
+		SimOptions: &proto.SimOptions{Iterations: 1, IsTest: true, RandomSeed: 101},
+	})
+	if result.Error != nil || result.RaidMetrics.Dps.Avg <= 0 {
+		t.Fatalf("Expected the shared group to deal damage, got %v", result.Error)
+	}
+}
@@ -2,6 +2,7 @@ package core
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/wowsims/mop/sim/core/proto"
 	"github.com/wowsims/mop/sim/core/stats"
@@ -28,15 +29,36 @@ func (rot *APLRotation) newActionCastSpell(config *proto.APLActionCastSpell) APL
 	}
 }
 func (action *APLActionCastSpell) IsReady(sim *Simulation) bool {
-	return action.spell.CanCastOrQueue(sim, action.target.Get()) && (!action.spell.Flags.Matches(SpellFlagMCD) || action.spell.Flags.Matches(SpellFlagReactive) || action.spell.Unit.GCD.IsReady(sim) || action.spell.Unit.Rotation.inSequence)
+	spell := action.spell.Substituted()
+	return spell.CanCastOrQueue(sim, action.target.Get()) && (!spell.Flags.Matches(SpellFlagMCD) || spell.Flags.Matches(SpellFlagReactive) || spell.Unit.GCD.TimeToReady(sim) <= spell.Unit.SpellQueueWindow || spell.Unit.Rotation.inSequence)
 }
 func (action *APLActionCastSpell) Execute(sim *Simulation) {
-	action.spell.CastOrQueue(sim, action.target.Get())
+	action.spell.Substituted().CastOrQueue(sim, action.target.Get())
 }
 func (action *APLActionCastSpell) String() string {
 	return fmt.Sprintf("Cast Spell(%s)", action.spell.ActionID)
 }
 
+// Resolves to casting the on-use spell of the item equipped in the slot, so
+// it is otherwise treated exactly like a Cast Spell action.
+func (rot *APLRotation) newActionUseItemInSlot(config *proto.APLActionUseItemInSlot) APLActionImpl {
+	agent := rot.unit.Env.GetAgentFromUnit(rot.unit)
+	if agent == nil {
+		return nil
+	}
+
+	spell := agent.GetCharacter().GetOnUseSpellForSlot(config.ItemSlot)
+	if spell == nil {
+		rot.ValidationMessage(proto.LogLevel_Warning, "%s has no on-use item equipped in %s", rot.unit.Label, config.ItemSlot)
+		return nil
+	}
+
+	return rot.newActionCastSpell(&proto.APLActionCastSpell{
+		SpellId: spell.ActionID.ToProto(),
+		Target:  config.Target,
+	})
+}
+
 type APLActionCancelSpellCast struct {
 	defaultAPLActionImpl
 	unit *Unit
@@ -61,6 +83,10 @@ type APLActionCastFriendlySpell struct {
 	defaultAPLActionImpl
 	spell  *Spell
 	target UnitReference
+
+	smartTarget     proto.HealTargetStrategy
+	smartTargetUnit *Unit
+	smartTargetAt   time.Duration
 }
 
 func (rot *APLRotation) newActionCastFriendlySpell(config *proto.APLActionCastFriendlySpell) APLActionImpl {
@@ -69,19 +95,42 @@ func (rot *APLRotation) newActionCastFriendlySpell(config *proto.APLActionCastFr
 		return nil
 	}
 	target := rot.GetTargetUnit(config.Target)
-	if target.Get() == nil {
+	if (config.SmartTarget == proto.HealTargetStrategy_HealTargetNone) && (target.Get() == nil) {
 		return nil
 	}
 	return &APLActionCastFriendlySpell{
-		spell:  spell,
-		target: target,
+		spell:         spell,
+		target:        target,
+		smartTarget:   config.SmartTarget,
+		smartTargetAt: -NeverExpires,
 	}
 }
+func (action *APLActionCastFriendlySpell) Reset(*Simulation) {
+	action.smartTargetUnit = nil
+	action.smartTargetAt = -NeverExpires
+}
+
+// Smart targets are picked at most once per timestep, so that IsReady and
+// Execute agree on the target.
+func (action *APLActionCastFriendlySpell) getTarget(sim *Simulation) *Unit {
+	if action.smartTarget == proto.HealTargetStrategy_HealTargetNone {
+		return action.target.Get()
+	}
+	if action.smartTargetAt != sim.CurrentTime {
+		action.smartTargetUnit = action.spell.Unit.SmartHealTarget(sim, action.smartTarget)
+		action.smartTargetAt = sim.CurrentTime
+	}
+	return action.smartTargetUnit
+}
 func (action *APLActionCastFriendlySpell) IsReady(sim *Simulation) bool {
-	return action.spell.CanCastOrQueue(sim, action.target.Get()) && (!action.spell.Flags.Matches(SpellFlagMCD) || action.spell.Flags.Matches(SpellFlagReactive) || action.spell.Unit.GCD.IsReady(sim) || action.spell.Unit.Rotation.inSequence)
+	target := action.getTarget(sim)
+	if target == nil {
+		return false
+	}
+	return action.spell.CanCastOrQueue(sim, target) && (!action.spell.Flags.Matches(SpellFlagMCD) || action.spell.Flags.Matches(SpellFlagReactive) || action.spell.Unit.GCD.TimeToReady(sim) <= action.spell.Unit.SpellQueueWindow || action.spell.Unit.Rotation.inSequence)
 }
 func (action *APLActionCastFriendlySpell) Execute(sim *Simulation) {
-	action.spell.CastOrQueue(sim, action.target.Get())
+	action.spell.CastOrQueue(sim, action.getTarget(sim))
 }
 func (action *APLActionCastFriendlySpell) String() string {
 	return fmt.Sprintf("Cast Friendly Spell(%s)", action.spell.ActionID)
@@ -196,10 +245,27 @@ func (action *APLActionMultidot) IsReady(sim *Simulation) bool {
 			}
 		}
 	} else {
-		for i := int32(0); i < action.maxDots; i++ {
-			target := sim.Encounter.AllTargetUnits[i]
+		// Cycle through the enabled targets, keeping at most maxDots dots up.
+		activeDots := int32(0)
+		for _, target := range sim.Encounter.AllTargetUnits {
+			if target.IsEnabled() && action.spell.Dot(target).IsActive() {
+				activeDots++
+			}
+		}
+
+		for _, target := range sim.Encounter.AllTargetUnits {
+			if !target.IsEnabled() {
+				continue
+			}
 			dot := action.spell.Dot(target)
-			if (!dot.IsActive() || dot.RemainingDuration(sim) < maxOverlap) && action.spell.CanCastOrQueue(sim, target) {
+			if dot.IsActive() {
+				if dot.RemainingDuration(sim) >= maxOverlap {
+					continue
+				}
+			} else if activeDots >= action.maxDots {
+				continue
+			}
+			if action.spell.CanCastOrQueue(sim, target) {
 				action.nextTarget = target
 				return true
 			}
@@ -505,7 +571,7 @@ func (action *APLActionAutocastOtherCooldowns) IsReady(sim *Simulation) bool {
 	// true even for MCDs which do not require the GCD. The one exception to this rule
 	// is Engineering explosives, which should instead be cast right *after* incurring
 	// a GCD, since they are off-GCD but have small cast times.
-	return (action.nextReadyMCD != nil) && ((action.character.GCD.IsReady(sim) != action.nextReadyMCD.Type.Matches(CooldownTypeExplosive)) || action.nextReadyMCD.Spell.Flags.Matches(SpellFlagReactive))
+	return (action.nextReadyMCD != nil) && (((action.character.GCD.TimeToReady(sim) <= action.character.SpellQueueWindow) != action.nextReadyMCD.Type.Matches(CooldownTypeExplosive)) || action.nextReadyMCD.Spell.Flags.Matches(SpellFlagReactive))
 }
 func (action *APLActionAutocastOtherCooldowns) Execute(sim *Simulation) {
 	action.nextReadyMCD.tryActivateHelper(sim, action.character)
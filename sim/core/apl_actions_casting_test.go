@@ -0,0 +1,46 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestMultidotSkipsInactiveTargets(t *testing.T) {
+	rotation := &proto.APLRotation{PriorityList: []*proto.APLListItem{{Action: &proto.APLAction{Action: &proto.APLAction_Multidot{
+		Multidot: &proto.APLActionMultidot{
+			SpellId: fakeRotationDotID.ToProto(),
+			MaxDots: 2,
+			MaxOverlap: &proto.APLValue{Value: &proto.APLValue_Const{
+				Const: &proto.APLValueConst{Val: "2s"},
+			}},
+		},
+	}}}}}
+
+	disabledTarget := FreshDefaultTargetConfig()
+	disabledTarget.DisabledAtStart = true
+	encounter := MakeSingleTargetEncounter(0)
+	encounter.Targets = []*proto.Target{NewDefaultTarget(), disabledTarget, NewDefaultTarget()}
+
+	result := RunRaidSim(&proto.RaidSimRequest{
+		Raid:       SinglePlayerRaidProto(newRotationTestPlayer(rotation), &proto.PartyBuffs{}, &proto.RaidBuffs{}, &proto.Debuffs{}),
+		Encounter:  encounter,
+		SimOptions: &proto.SimOptions{Iterations: 1, IsTest: true, RandomSeed: 101},
+	})
+	if result.Error != nil {
+		t.Fatalf("Sim failed: %s", result.Error.Message)
+	}
+
+	damageByTarget := make(map[int32]float64)
+	for _, action := range result.RaidMetrics.Parties[0].Players[0].Actions {
+		if ProtoToActionID(action.Id) != fakeRotationDotID {
+			continue
+		}
+		for _, target := range action.Targets {
+			damageByTarget[target.UnitIndex] += target.Damage
+		}
+	}
+	if damageByTarget[0] <= 0 || damageByTarget[1] != 0 || damageByTarget[2] <= 0 {
+		t.Fatalf("Expected the DoT on the first and third targets only, got %v", damageByTarget)
+	}
+}
@@ -114,9 +114,13 @@ func (action *APLActionResetSequence) String() string {
 
 type APLActionStrictSequence struct {
 	defaultAPLActionImpl
-	unit       *Unit
-	subactions []*APLAction
-	curIdx     int
+	unit           *Unit
+	name           string
+	subactions     []*APLAction
+	resetCondition APLValue
+	resetTimer     APLValue
+	curIdx         int
+	startedAt      time.Duration
 
 	subactionSpells []*Spell
 }
@@ -131,13 +135,19 @@ func (rot *APLRotation) newActionStrictSequence(config *proto.APLActionStrictSeq
 	}
 
 	return &APLActionStrictSequence{
-		unit:       rot.unit,
-		subactions: subactions,
+		unit:           rot.unit,
+		name:           config.Name,
+		subactions:     subactions,
+		resetCondition: rot.coerceTo(rot.newAPLValue(config.ResetCondition), proto.APLValueType_ValueTypeBool),
+		resetTimer:     rot.coerceTo(rot.newAPLValue(config.ResetTimer), proto.APLValueType_ValueTypeDuration),
 	}
 }
 func (action *APLActionStrictSequence) GetInnerActions() []*APLAction {
 	return Flatten(MapSlice(action.subactions, func(action *APLAction) []*APLAction { return action.GetAllActions() }))
 }
+func (action *APLActionStrictSequence) GetAPLValues() []APLValue {
+	return []APLValue{action.resetCondition, action.resetTimer}
+}
 func (action *APLActionStrictSequence) Finalize(rot *APLRotation) {
 	for _, subaction := range action.subactions {
 		subaction.impl.Finalize(rot)
@@ -156,11 +166,11 @@ func (action *APLActionStrictSequence) Reset(*Simulation) {
 func (action *APLActionStrictSequence) IsReady(sim *Simulation) bool {
 	action.unit.Rotation.inSequence = true
 
-	if (action.unit.GCD.TimeToReady(sim) > MaxSpellQueueWindow) && (len(action.subactionSpells) > 0) {
+	if (action.unit.GCD.TimeToReady(sim) > action.unit.spellQueueLeniency()) && (len(action.subactionSpells) > 0) {
 		action.unit.Rotation.inSequence = false
 		return false
 	}
-	if !action.subactions[0].IsReady(sim) {
+	if !action.subactions[0].IsReady(sim) || (action.resetCondition != nil && action.resetCondition.GetBool(sim)) {
 		action.unit.Rotation.inSequence = false
 		return false
 	}
@@ -174,8 +184,20 @@ func (action *APLActionStrictSequence) IsReady(sim *Simulation) bool {
 	return true
 }
 func (action *APLActionStrictSequence) Execute(sim *Simulation) {
+	action.startedAt = sim.CurrentTime
 	action.unit.Rotation.pushControllingAction(action)
 }
+
+// Whether the sequence should be aborted before its next sub-action.
+func (action *APLActionStrictSequence) shouldReset(sim *Simulation) bool {
+	if action.curIdx == 0 {
+		return false
+	}
+	if action.resetCondition != nil && action.resetCondition.GetBool(sim) {
+		return true
+	}
+	return action.resetTimer != nil && sim.CurrentTime-action.startedAt > action.resetTimer.GetDuration(sim)
+}
 func (action *APLActionStrictSequence) relinquishControl() {
 	action.curIdx = 0
 	action.unit.Rotation.inSequence = false
@@ -188,10 +210,24 @@ func (action *APLActionStrictSequence) advanceSequence() {
 	}
 }
 func (action *APLActionStrictSequence) GetNextAction(sim *Simulation) *APLAction {
+	// The previous subaction was queued up, so wait for it to go off.
+	if (action.unit.SpellQueueWindow > 0) && action.unit.QueuedSpell.isPending() {
+		return nil
+	}
+
+	if action.shouldReset(sim) {
+		action.relinquishControl()
+		return action.unit.Rotation.getNextAction(sim)
+	}
+
 	if action.subactions[action.curIdx].IsReady(sim) {
 		nextAction := action.subactions[action.curIdx]
+		readyAt := action.unit.NextGCDAt()
+		if action.unit.SpellQueueWindow > 0 {
+			readyAt = max(readyAt, action.unit.Hardcast.Expires)
+		}
 
-		if action.unit.GCD.IsReady(sim) {
+		if readyAt <= sim.CurrentTime {
 			action.advanceSequence()
 		} else if _, ok := nextAction.impl.(*APLActionWait); ok {
 			action.advanceSequence()
@@ -199,7 +235,7 @@ func (action *APLActionStrictSequence) GetNextAction(sim *Simulation) *APLAction
 			action.advanceSequence()
 		} else {
 			pa := sim.GetConsumedPendingActionFromPool()
-			pa.NextActionAt = action.unit.NextGCDAt()
+			pa.NextActionAt = readyAt
 			pa.Priority = ActionPriorityPrePull
 
 			pa.OnAction = func(_ *Simulation) {
@@ -213,7 +249,7 @@ func (action *APLActionStrictSequence) GetNextAction(sim *Simulation) *APLAction
 		}
 
 		return nextAction
-	} else if action.unit.GCD.TimeToReady(sim) <= MaxSpellQueueWindow {
+	} else if action.unit.GCD.TimeToReady(sim) <= action.unit.spellQueueLeniency() {
 		// If the GCD is ready when the next subaction isn't, it means the sequence is bad
 		// so reset and exit the sequence.
 		action.relinquishControl()
@@ -224,5 +260,12 @@ func (action *APLActionStrictSequence) GetNextAction(sim *Simulation) *APLAction
 	}
 }
 func (action *APLActionStrictSequence) String() string {
-	return "Strict Sequence(" + strings.Join(MapSlice(action.subactions, func(subaction *APLAction) string { return fmt.Sprintf("(%s)", subaction) }), "+") + ")"
+	str := "Strict Sequence(" + strings.Join(MapSlice(action.subactions, func(subaction *APLAction) string { return fmt.Sprintf("(%s)", subaction) }), "+")
+	if action.resetCondition != nil {
+		str += fmt.Sprintf(", resetIf=%s", action.resetCondition)
+	}
+	if action.resetTimer != nil {
+		str += fmt.Sprintf(", resetAfter=%s", action.resetTimer)
+	}
+	return str + ")"
 }
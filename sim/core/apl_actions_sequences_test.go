@@ -0,0 +1,48 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestStrictSequenceReset(t *testing.T) {
+	sim := &Simulation{}
+	rot := &APLRotation{
+		unit: &Unit{},
+	}
+
+	sequence := &APLActionStrictSequence{
+		name:       "Opener",
+		subactions: make([]*APLAction, 3),
+		resetTimer: rot.newValueConst(&proto.APLValueConst{Val: "5s"}, &proto.UUID{Value: ""}),
+	}
+	position := &APLValueSequencePosition{name: "Opener", curIdx: &sequence.curIdx}
+
+	sim.CurrentTime = time.Second * 10
+	sequence.startedAt = sim.CurrentTime
+	if sequence.shouldReset(sim) {
+		t.Fatalf("Expected a sequence not to reset before its first sub-action")
+	}
+
+	sequence.curIdx = 2
+	if pos := position.GetInt(sim); pos != 2 {
+		t.Fatalf("Expected sequence position 2, found %d", pos)
+	}
+
+	sim.CurrentTime = time.Second * 15
+	if sequence.shouldReset(sim) {
+		t.Fatalf("Expected the sequence not to reset within its timer")
+	}
+	sim.CurrentTime = time.Second * 16
+	if !sequence.shouldReset(sim) {
+		t.Fatalf("Expected the sequence to reset once its timer ran out")
+	}
+
+	sim.CurrentTime = time.Second * 11
+	sequence.resetCondition = rot.newValueConst(&proto.APLValueConst{Val: "true"}, &proto.UUID{Value: ""})
+	if !sequence.shouldReset(sim) {
+		t.Fatalf("Expected the sequence to reset when its reset condition is true")
+	}
+}
@@ -96,6 +96,99 @@ func (action *APLActionWaitUntil) String() string {
 	return fmt.Sprintf("WaitUntil(%s)", action.condition)
 }
 
+type APLActionPoolResource struct {
+	defaultAPLActionImpl
+	unit         *Unit
+	targetAmount APLValue
+	capMargin    APLValue
+
+	resourceName  string
+	currentAmount func() float64
+	maximumAmount func() float64
+	timeToTarget  func(float64) time.Duration
+	poolingTarget float64
+}
+
+func (rot *APLRotation) newActionPoolResource(config *proto.APLActionPoolResource) APLActionImpl {
+	unit := rot.unit
+	targetAmount := rot.coerceTo(rot.newAPLValue(config.TargetAmount), proto.APLValueType_ValueTypeFloat)
+	if targetAmount == nil {
+		return nil
+	}
+	capMargin := rot.coerceTo(rot.newAPLValue(config.CapMargin), proto.APLValueType_ValueTypeFloat)
+
+	action := &APLActionPoolResource{
+		unit:         unit,
+		targetAmount: targetAmount,
+		capMargin:    capMargin,
+	}
+
+	// Rage has no passive regen, so it is polled instead of waited on.
+	if unit.HasEnergyBar() {
+		action.resourceName = "Energy"
+		action.currentAmount = unit.CurrentEnergy
+		action.maximumAmount = unit.MaximumEnergy
+		action.timeToTarget = unit.TimeToTargetEnergy
+	} else if unit.HasFocusBar() {
+		action.resourceName = "Focus"
+		action.currentAmount = unit.CurrentFocus
+		action.maximumAmount = unit.MaximumFocus
+		action.timeToTarget = unit.TimeToTargetFocus
+	} else if unit.HasRageBar() {
+		action.resourceName = "Rage"
+		action.currentAmount = unit.CurrentRage
+		action.maximumAmount = unit.MaximumRage
+	} else {
+		rot.ValidationMessage(proto.LogLevel_Warning, "%s does not use Energy, Focus or Rage", unit.Label)
+		return nil
+	}
+
+	return action
+}
+func (action *APLActionPoolResource) GetAPLValues() []APLValue {
+	return []APLValue{action.targetAmount, action.capMargin}
+}
+
+// The amount to pool up to, capped so the resource is never overcapped.
+func (action *APLActionPoolResource) getPoolingTarget(sim *Simulation) float64 {
+	capMargin := 0.0
+	if action.capMargin != nil {
+		capMargin = max(0, action.capMargin.GetFloat(sim))
+	}
+	return min(action.targetAmount.GetFloat(sim), action.maximumAmount()-capMargin)
+}
+
+func (action *APLActionPoolResource) IsReady(sim *Simulation) bool {
+	return action.currentAmount() < action.getPoolingTarget(sim)
+}
+
+func (action *APLActionPoolResource) Execute(sim *Simulation) {
+	action.unit.Rotation.pushControllingAction(action)
+	action.poolingTarget = action.getPoolingTarget(sim)
+	action.waitForResource(sim)
+}
+
+func (action *APLActionPoolResource) waitForResource(sim *Simulation) {
+	if action.timeToTarget != nil {
+		action.unit.WaitUntil(sim, sim.CurrentTime+action.timeToTarget(action.poolingTarget))
+	}
+}
+
+func (action *APLActionPoolResource) GetNextAction(sim *Simulation) *APLAction {
+	if action.currentAmount() >= action.poolingTarget {
+		action.unit.Rotation.popControllingAction(action)
+		return action.unit.Rotation.getNextAction(sim)
+	} else {
+		// Regen rate may have changed while waiting, so re-estimate the wait.
+		action.waitForResource(sim)
+		return nil
+	}
+}
+
+func (action *APLActionPoolResource) String() string {
+	return fmt.Sprintf("PoolResource(%s, %s, margin=%s)", action.resourceName, action.targetAmount, action.capMargin)
+}
+
 type APLActionSchedule struct {
 	defaultAPLActionImpl
 	innerAction *APLAction
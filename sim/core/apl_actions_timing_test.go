@@ -0,0 +1,47 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestActionPoolResource(t *testing.T) {
+	sim := &Simulation{}
+	unit := &Unit{}
+	unit.energyBar = energyBar{
+		unit:                  unit,
+		currentEnergy:         50,
+		maxEnergy:             100,
+		energyRegenMultiplier: 1,
+		hasteRatingMultiplier: 1,
+	}
+	rot := &APLRotation{
+		unit: unit,
+	}
+
+	action := &APLActionPoolResource{
+		unit:          unit,
+		targetAmount:  rot.newValueConst(&proto.APLValueConst{Val: "80"}, &proto.UUID{Value: ""}),
+		currentAmount: unit.CurrentEnergy,
+		maximumAmount: unit.MaximumEnergy,
+		timeToTarget:  unit.TimeToTargetEnergy,
+	}
+	if !action.IsReady(sim) {
+		t.Fatalf("Expected pooling to be ready below the target")
+	}
+	if target := action.getPoolingTarget(sim); target != 80 {
+		t.Fatalf("Expected pooling target of 80, found %f", target)
+	}
+
+	action.targetAmount = rot.newValueConst(&proto.APLValueConst{Val: "120"}, &proto.UUID{Value: ""})
+	action.capMargin = rot.newValueConst(&proto.APLValueConst{Val: "10"}, &proto.UUID{Value: ""})
+	if target := action.getPoolingTarget(sim); target != 90 {
+		t.Fatalf("Expected pooling target to stop short of the cap, found %f", target)
+	}
+
+	unit.energyBar.currentEnergy = 95
+	if action.IsReady(sim) {
+		t.Fatalf("Expected pooling not to be ready within the cap margin")
+	}
+}
@@ -0,0 +1,108 @@
+package core
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+// Generates a baseline APL out of the character's registered spells, for specs
+// without a hand-written rotation or as a reference to compare rotations against.
+// Must be called once the character is finalized, so all spells are registered.
+//
+// The priority list is, from top to bottom:
+//   - Major cooldowns, through autocastOtherCooldowns.
+//   - Self buffs, recast when they are not active.
+//   - DoTs, refreshed once less than a tick remains.
+//   - Everything else, by estimated damage per execute time.
+func (character *Character) GenerateDefaultAPLRotation() *proto.APLRotation {
+	var buffSpells, dotSpells, damageSpells []*Spell
+	for _, spell := range character.Spellbook {
+		if !isAPLGeneratorCandidate(spell) {
+			continue
+		}
+
+		if spell.RelatedSelfBuff != nil {
+			buffSpells = append(buffSpells, spell)
+		} else if dot := spell.CurDot(); dot != nil && !dot.isChanneled {
+			dotSpells = append(dotSpells, spell)
+		} else if spell.DamageMultiplier != 0 {
+			damageSpells = append(damageSpells, spell)
+		}
+	}
+
+	slices.SortStableFunc(damageSpells, func(a, b *Spell) int {
+		return cmp.Or(
+			cmp.Compare(aplGeneratorDamagePerExecuteTime(b), aplGeneratorDamagePerExecuteTime(a)),
+			cmp.Compare(b.CD.Duration, a.CD.Duration),
+		)
+	})
+
+	rotation := &proto.APLRotation{
+		Type: proto.APLRotation_TypeAPL,
+		PriorityList: []*proto.APLListItem{
+			{Action: &proto.APLAction{Action: &proto.APLAction_AutocastOtherCooldowns{AutocastOtherCooldowns: &proto.APLActionAutocastOtherCooldowns{}}}},
+		},
+	}
+
+	for _, spell := range buffSpells {
+		rotation.PriorityList = append(rotation.PriorityList, aplGeneratorCast(spell, &proto.APLValue{
+			Value: &proto.APLValue_AuraIsInactive{AuraIsInactive: &proto.APLValueAuraIsInactive{
+				AuraId: spell.RelatedSelfBuff.ActionID.ToProto(),
+			}},
+		}))
+	}
+
+	for _, spell := range dotSpells {
+		spellID := spell.ActionID.ToProto()
+		rotation.PriorityList = append(rotation.PriorityList, aplGeneratorCast(spell, &proto.APLValue{
+			Value: &proto.APLValue_Cmp{Cmp: &proto.APLValueCompare{
+				Op: proto.APLValueCompare_OpLt,
+				Lhs: &proto.APLValue{Value: &proto.APLValue_DotRemainingTime{DotRemainingTime: &proto.APLValueDotRemainingTime{
+					SpellId: spellID,
+				}}},
+				Rhs: &proto.APLValue{Value: &proto.APLValue_DotTickFrequency{DotTickFrequency: &proto.APLValueDotTickFrequency{
+					SpellId: spellID,
+				}}},
+			}},
+		}))
+	}
+
+	for _, spell := range damageSpells {
+		rotation.PriorityList = append(rotation.PriorityList, aplGeneratorCast(spell, nil))
+	}
+
+	return rotation
+}
+
+func isAPLGeneratorCandidate(spell *Spell) bool {
+	return spell.Flags.Matches(SpellFlagAPL) &&
+		!spell.Flags.Matches(SpellFlagMCD|SpellFlagPrepullOnly|SpellFlagPassiveSpell|SpellFlagHelpful) &&
+		spell.ActionID.SpellID != 0
+}
+
+func aplGeneratorCast(spell *Spell, condition *proto.APLValue) *proto.APLListItem {
+	return &proto.APLListItem{
+		Action: &proto.APLAction{
+			Condition: condition,
+			Action: &proto.APLAction_CastSpell{CastSpell: &proto.APLActionCastSpell{
+				SpellId: spell.ActionID.ToProto(),
+			}},
+		},
+	}
+}
+
+// Damage per execute time from the static spell config, i.e. the spell power
+// coefficient over the time the cast locks the character out. Spells without a
+// coefficient score 0, and are ordered by cooldown instead, as spells with a
+// longer cooldown usually hit harder.
+func aplGeneratorDamagePerExecuteTime(spell *Spell) float64 {
+	executeTime := max(spell.DefaultCast.EffectiveTime(), time.Second)
+	if dot := spell.CurDot(); dot != nil && dot.isChanneled {
+		executeTime = max(executeTime, dot.BaseDuration())
+		return (spell.BonusCoefficient + dot.BonusCoefficient*float64(dot.BaseTickCount)) * spell.DamageMultiplier / executeTime.Seconds()
+	}
+	return spell.BonusCoefficient * spell.DamageMultiplier / executeTime.Seconds()
+}
@@ -0,0 +1,39 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestGenerateDefaultAPLRotation(t *testing.T) {
+	player := newRotationTestPlayer(&proto.APLRotation{Type: proto.APLRotation_TypeAuto})
+	raid := SinglePlayerRaidProto(player, &proto.PartyBuffs{}, &proto.RaidBuffs{}, &proto.Debuffs{})
+	encounter := MakeSingleTargetEncounter(0)
+
+	env, _, _ := NewEnvironment(raid, encounter, false)
+	rotation := env.Raid.Parties[0].Players[0].GetCharacter().GenerateDefaultAPLRotation()
+
+	// DoTs should only be refreshed once they are about to fall off.
+	foundDot := false
+	for _, item := range rotation.PriorityList {
+		if castSpell := item.Action.GetCastSpell(); castSpell != nil && ProtoToActionID(castSpell.SpellId) == fakeRotationDotID {
+			foundDot = item.Action.Condition.GetCmp() != nil
+		}
+	}
+	if !foundDot {
+		t.Fatalf("Expected the generated rotation to maintain the DoT")
+	}
+
+	result := RunRaidSim(&proto.RaidSimRequest{
+		Raid:       raid,
+		Encounter:  encounter,
+		SimOptions: &proto.SimOptions{Iterations: 10, IsTest: true, RandomSeed: 101},
+	})
+	if result.Error != nil {
+		t.Fatalf("Sim failed: %s", result.Error.Message)
+	}
+	if result.RaidMetrics.Dps.Avg <= 0 {
+		t.Fatalf("Expected the generated rotation to deal damage")
+	}
+}
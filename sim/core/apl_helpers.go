@@ -122,6 +122,10 @@ func NewIcdAuraReference(sourceUnit UnitReference, auraId *proto.ActionID) AuraR
 	return newAuraReferenceHelper(sourceUnit, auraId, func(unit *Unit, actionID ActionID) *Aura { return unit.GetIcdAuraByID(actionID) })
 }
 
+func NewRppmAuraReference(sourceUnit UnitReference, auraId *proto.ActionID) AuraReference {
+	return newAuraReferenceHelper(sourceUnit, auraId, func(unit *Unit, actionID ActionID) *Aura { return unit.GetRppmAuraByID(actionID) })
+}
+
 type DotReference struct {
 	fixedDot *Dot
 
@@ -190,6 +194,19 @@ func (rot *APLRotation) GetAPLICDAura(sourceUnit UnitReference, auraId *proto.Ac
 	return aura
 }
 
+func (rot *APLRotation) GetAPLRppmAura(sourceUnit UnitReference, auraId *proto.ActionID) AuraReference {
+	resolvedSourceUnit := sourceUnit.Get()
+	if resolvedSourceUnit == nil {
+		return AuraReference{}
+	}
+
+	aura := NewRppmAuraReference(sourceUnit, auraId)
+	if aura.Get() == nil {
+		rot.ValidationMessage(proto.LogLevel_Warning, "No RPPM aura found on %s for: %s", resolvedSourceUnit.Label, ProtoToActionID(auraId))
+	}
+	return aura
+}
+
 func (rot *APLRotation) GetAPLItemProcAuras(statTypesToMatch []stats.Stat, minIcd time.Duration, warnIfNoneFound bool, uuid *proto.UUID) []*StatBuffAura {
 	unit := rot.unit
 	character := unit.Env.Raid.GetPlayerFromUnit(unit).GetCharacter()
@@ -0,0 +1,94 @@
+package core
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+// Evaluations recorded per player, to keep traces of long fights manageable.
+const aplTraceMaxEvaluations = 5000
+
+// Records why each action in the priority list was or wasn't executed, in the
+// first iteration only.
+type aplTrace struct {
+	iteration   int
+	evaluations []*proto.APLTraceEvaluation
+}
+
+// Starts tracing the character's rotation. Called once the environment is
+// finalized, so the rotation exists.
+func (character *Character) enableRotationTrace() {
+	if character.Rotation != nil && character.Rotation.trace == nil {
+		character.Rotation.trace = &aplTrace{}
+	}
+}
+
+func (trace *aplTrace) reset() {
+	trace.iteration++
+}
+
+func (trace *aplTrace) isRecording() bool {
+	return trace != nil && trace.iteration == 1 && len(trace.evaluations) < aplTraceMaxEvaluations
+}
+
+// Same as the untraced search for the next action, but records each action it
+// considers along the way.
+func (trace *aplTrace) getNextAction(sim *Simulation, priorityList []*APLAction) *APLAction {
+	evaluation := &proto.APLTraceEvaluation{Timestamp: sim.CurrentTime.Seconds()}
+	trace.evaluations = append(trace.evaluations, evaluation)
+
+	for _, action := range priorityList {
+		traceAction := &proto.APLTraceAction{Action: fmt.Sprintf("%s", action.impl)}
+		evaluation.Actions = append(evaluation.Actions, traceAction)
+
+		if action.condition != nil && !action.condition.GetBool(sim) {
+			failedClause := aplFailedClause(sim, action.condition)
+			traceAction.ConditionFailed = true
+			traceAction.FailedClause = failedClause.String()
+			traceAction.Values = append(traceAction.Values, &proto.APLTraceValue{Expression: failedClause.String(), Value: aplValueString(sim, failedClause)})
+			for _, inner := range failedClause.GetInnerValues() {
+				traceAction.Values = append(traceAction.Values, &proto.APLTraceValue{Expression: inner.String(), Value: aplValueString(sim, inner)})
+			}
+			continue
+		}
+		if action.impl.IsReady(sim) {
+			traceAction.Executed = true
+			return action
+		}
+	}
+	return nil
+}
+
+// Returns the innermost clause of a failed condition that made it fail.
+func aplFailedClause(sim *Simulation, condition APLValue) APLValue {
+	if and, ok := condition.(*APLValueAnd); ok {
+		for _, inner := range and.vals {
+			if !inner.GetBool(sim) {
+				return aplFailedClause(sim, inner)
+			}
+		}
+	}
+	return condition
+}
+
+func aplValueString(sim *Simulation, value APLValue) string {
+	switch value.Type() {
+	case proto.APLValueType_ValueTypeBool:
+		return strconv.FormatBool(value.GetBool(sim))
+	case proto.APLValueType_ValueTypeInt:
+		return strconv.Itoa(int(value.GetInt(sim)))
+	case proto.APLValueType_ValueTypeFloat:
+		return strconv.FormatFloat(value.GetFloat(sim), 'f', 2, 64)
+	case proto.APLValueType_ValueTypeDuration:
+		return value.GetDuration(sim).String()
+	case proto.APLValueType_ValueTypeString:
+		return value.GetString(sim)
+	}
+	return ""
+}
+
+func (trace *aplTrace) toProto() []*proto.APLTraceEvaluation {
+	return trace.evaluations
+}
@@ -0,0 +1,67 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+type testAPLAction struct {
+	defaultAPLActionImpl
+	name  string
+	ready bool
+}
+
+func (action *testAPLAction) IsReady(*Simulation) bool { return action.ready }
+func (action *testAPLAction) Execute(*Simulation)      {}
+func (action *testAPLAction) String() string           { return action.name }
+
+func TestAPLTrace(t *testing.T) {
+	sim := &Simulation{}
+	rot := &APLRotation{
+		unit:            &Unit{},
+		uuidValidations: map[*proto.UUID][]*proto.APLValidation{},
+	}
+	newConst := func(val string) APLValue {
+		return rot.newValueConst(&proto.APLValueConst{Val: val}, &proto.UUID{})
+	}
+
+	failedClause := &APLValueCompare{op: proto.APLValueCompare_OpLt, lhs: newConst("5"), rhs: newConst("3")}
+	priorityList := []*APLAction{
+		{
+			condition: &APLValueAnd{vals: []APLValue{newConst("true"), failedClause}},
+			impl:      &testAPLAction{name: "Conditional", ready: true},
+		},
+		{impl: &testAPLAction{name: "On Cooldown", ready: false}},
+		{impl: &testAPLAction{name: "Filler", ready: true}},
+		{impl: &testAPLAction{name: "Unreached", ready: true}},
+	}
+
+	trace := &aplTrace{}
+	trace.reset()
+	if !trace.isRecording() {
+		t.Fatalf("Expected the first iteration to be traced")
+	}
+
+	if next := trace.getNextAction(sim, priorityList); next != priorityList[2] {
+		t.Fatalf("Expected the filler to be chosen, found %s", next)
+	}
+	actions := trace.evaluations[0].Actions
+	if len(actions) != 3 {
+		t.Fatalf("Expected 3 actions to be considered, found %d", len(actions))
+	}
+	if !actions[0].ConditionFailed || actions[0].FailedClause != failedClause.String() || len(actions[0].Values) != 3 || actions[0].Values[1].Value != "5" {
+		t.Fatalf("Expected the comparison to be reported as the failed clause, found %v", actions[0])
+	}
+	if actions[1].ConditionFailed || actions[1].Executed {
+		t.Fatalf("Expected the action on cooldown to be reported as not ready, found %v", actions[1])
+	}
+	if !actions[2].Executed {
+		t.Fatalf("Expected the filler to be reported as executed")
+	}
+
+	trace.reset()
+	if trace.isRecording() {
+		t.Fatalf("Expected only the first iteration to be traced")
+	}
+}
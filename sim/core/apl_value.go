@@ -100,6 +100,10 @@ func (rot *APLRotation) newAPLValueWithContext(config *proto.APLValue, groupVari
 		value = rot.newValueIsExecutePhase(config.GetIsExecutePhase(), config.Uuid)
 	case *proto.APLValue_NumberTargets:
 		value = rot.newValueNumberTargets(config.GetNumberTargets(), config.Uuid)
+	case *proto.APLValue_NumberTargetsInRange:
+		value = rot.newValueNumberTargetsInRange(config.GetNumberTargetsInRange(), config.Uuid)
+	case *proto.APLValue_TimeUntilEncounterEvent:
+		value = rot.newValueTimeUntilEncounterEvent(config.GetTimeUntilEncounterEvent(), config.Uuid)
 
 	// Boss
 	case *proto.APLValue_BossSpellIsCasting:
@@ -108,6 +112,12 @@ func (rot *APLRotation) newAPLValueWithContext(config *proto.APLValue, groupVari
 		value = rot.newValueBossSpellTimeToReady(config.GetBossSpellTimeToReady(), config.Uuid)
 	case *proto.APLValue_BossCurrentTarget:
 		value = rot.newValueBossCurrentTarget(config.GetBossCurrentTarget(), config.Uuid)
+	case *proto.APLValue_BossDamageReduced:
+		value = rot.newValueBossDamageReduced(config.GetBossDamageReduced(), config.Uuid)
+	case *proto.APLValue_BossDamageReducedRemainingTime:
+		value = rot.newValueBossDamageReducedRemainingTime(config.GetBossDamageReducedRemainingTime(), config.Uuid)
+	case *proto.APLValue_BossSpellRemainingCastTime:
+		value = rot.newValueBossSpellRemainingCastTime(config.GetBossSpellRemainingCastTime(), config.Uuid)
 
 	// Resources
 	case *proto.APLValue_CurrentHealth:
@@ -202,6 +212,8 @@ func (rot *APLRotation) newAPLValueWithContext(config *proto.APLValue, groupVari
 		value = rot.newValueSpellCastTime(config.GetSpellCastTime(), config.Uuid)
 	case *proto.APLValue_SpellTravelTime:
 		value = rot.newValueSpellTravelTime(config.GetSpellTravelTime(), config.Uuid)
+	case *proto.APLValue_SpellExpectedDamage:
+		value = rot.newValueSpellExpectedDamage(config.GetSpellExpectedDamage(), config.Uuid)
 	case *proto.APLValue_SpellCpm:
 		value = rot.newValueSpellCPM(config.GetSpellCpm(), config.Uuid)
 	case *proto.APLValue_SpellIsCasting:
@@ -222,6 +234,10 @@ func (rot *APLRotation) newAPLValueWithContext(config *proto.APLValue, groupVari
 		value = rot.newValueSpellFullCooldown(config.GetSpellFullCooldown(), config.Uuid)
 	case *proto.APLValue_SpellInFlight:
 		value = rot.newValueSpellInFlight(config.GetSpellInFlight(), config.Uuid)
+	case *proto.APLValue_SpellCastsInWindow:
+		value = rot.newValueSpellCastsInWindow(config.GetSpellCastsInWindow(), config.Uuid)
+	case *proto.APLValue_PreviousGcdSpellIs:
+		value = rot.newValuePreviousGCDSpellIs(config.GetPreviousGcdSpellIs(), config.Uuid)
 
 	// Auras
 	case *proto.APLValue_AuraIsKnown:
@@ -244,6 +260,10 @@ func (rot *APLRotation) newAPLValueWithContext(config *proto.APLValue, groupVari
 		value = rot.newValueAuraRemainingTime(config.GetAuraRemainingTime(), config.Uuid)
 	case *proto.APLValue_AuraNumStacks:
 		value = rot.newValueAuraNumStacks(config.GetAuraNumStacks(), config.Uuid)
+	case *proto.APLValue_AuraStackAge:
+		value = rot.newValueAuraStackAge(config.GetAuraStackAge(), config.Uuid)
+	case *proto.APLValue_AuraChargesToDischarge:
+		value = rot.newValueAuraChargesToDischarge(config.GetAuraChargesToDischarge(), config.Uuid)
 	case *proto.APLValue_AuraInternalCooldown:
 		value = rot.newValueAuraInternalCooldown(config.GetAuraInternalCooldown(), config.Uuid)
 	case *proto.APLValue_AuraIcdIsReady:
@@ -253,6 +273,8 @@ func (rot *APLRotation) newAPLValueWithContext(config *proto.APLValue, groupVari
 		inputConfig := config.GetAuraIcdIsReadyWithReactionTime()
 		inputConfig.IncludeReactionTime = true
 		value = rot.newValueAuraICDIsReady(inputConfig, config.Uuid)
+	case *proto.APLValue_AuraExpectedTimeToProc:
+		value = rot.newValueAuraExpectedTimeToProc(config.GetAuraExpectedTimeToProc(), config.Uuid)
 	case *proto.APLValue_AuraShouldRefresh:
 		value = rot.newValueAuraShouldRefresh(config.GetAuraShouldRefresh(), config.Uuid)
 
@@ -281,14 +303,22 @@ func (rot *APLRotation) newAPLValueWithContext(config *proto.APLValue, groupVari
 		value = rot.newValueDotIsActive(config.GetDotIsActive(), config.Uuid)
 	case *proto.APLValue_DotIsActiveOnAllTargets:
 		value = rot.newValueDotIsActiveOnAllTargets(config.GetDotIsActiveOnAllTargets(), config.Uuid)
+	case *proto.APLValue_DotNumActiveTargets:
+		value = rot.newValueDotNumActiveTargets(config.GetDotNumActiveTargets(), config.Uuid)
 	case *proto.APLValue_DotRemainingTime:
 		value = rot.newValueDotRemainingTime(config.GetDotRemainingTime(), config.Uuid)
 	case *proto.APLValue_DotLowestRemainingTime:
 		value = rot.newValueDotLowestRemainingTime(config.GetDotLowestRemainingTime(), config.Uuid)
 	case *proto.APLValue_DotTickFrequency:
 		value = rot.newValueDotTickFrequency(config.GetDotTickFrequency(), config.Uuid)
+	case *proto.APLValue_DotHasteRatingToNextTick:
+		value = rot.newValueDotHasteRatingToNextTick(config.GetDotHasteRatingToNextTick(), config.Uuid)
 	case *proto.APLValue_DotTimeToNextTick:
 		value = rot.newValueDotTimeToNextTick(config.GetDotTimeToNextTick(), config.Uuid)
+	case *proto.APLValue_DotInPandemicWindow:
+		value = rot.newValueDotInPandemicWindow(config.GetDotInPandemicWindow(), config.Uuid)
+	case *proto.APLValue_DotRefreshThreshold:
+		value = rot.newValueDotRefreshThreshold(config.GetDotRefreshThreshold(), config.Uuid)
 	case *proto.APLValue_DotBaseDuration:
 		value = rot.newValueDotBaseDuration(config.GetDotBaseDuration(), config.Uuid)
 	case *proto.APLValue_DotPercentIncrease:
@@ -297,6 +327,8 @@ func (rot *APLRotation) newAPLValueWithContext(config *proto.APLValue, groupVari
 		value = rot.newValueDotCritPercentIncrease(config.GetDotCritPercentIncrease(), config.Uuid)
 	case *proto.APLValue_DotTickRatePercentIncrease:
 		value = rot.newValueDotTickRatePercentIncrease(config.GetDotTickRatePercentIncrease(), config.Uuid)
+	case *proto.APLValue_DotDamageIncrease:
+		value = rot.newValueDotDamageIncrease(config.GetDotDamageIncrease(), config.Uuid)
 
 	// Sequences
 	case *proto.APLValue_SequenceIsComplete:
@@ -305,12 +337,16 @@ func (rot *APLRotation) newAPLValueWithContext(config *proto.APLValue, groupVari
 		value = rot.newValueSequenceIsReady(config.GetSequenceIsReady(), config.Uuid)
 	case *proto.APLValue_SequenceTimeToReady:
 		value = rot.newValueSequenceTimeToReady(config.GetSequenceTimeToReady(), config.Uuid)
+	case *proto.APLValue_SequencePosition:
+		value = rot.newValueSequencePosition(config.GetSequencePosition(), config.Uuid)
 
 	// Properties
 	case *proto.APLValue_ChannelClipDelay:
 		value = rot.newValueChannelClipDelay(config.GetChannelClipDelay(), config.Uuid)
 	case *proto.APLValue_InputDelay:
 		value = rot.newValueInputDelay(config.GetInputDelay(), config.Uuid)
+	case *proto.APLValue_RandomChance:
+		value = rot.newValueRandomChance(config.GetRandomChance(), config.Uuid)
 
 	case *proto.APLValue_VariableRef:
 		value = rot.newValueVariableRef(config.GetVariableRef(), config.Uuid)
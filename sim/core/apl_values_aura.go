@@ -33,6 +33,7 @@ type APLValueAuraIsActive struct {
 	aura                AuraReference
 	reactionTime        time.Duration
 	includeReactionTime bool
+	skill               *playerSkill
 }
 
 func (rot *APLRotation) newValueAuraIsActive(config *proto.APLValueAuraIsActive, _ *proto.UUID) APLValue {
@@ -47,6 +48,7 @@ func (rot *APLRotation) newValueAuraIsActive(config *proto.APLValueAuraIsActive,
 		aura:                aura,
 		reactionTime:        rot.unit.ReactionTime,
 		includeReactionTime: config.IncludeReactionTime,
+		skill:               rot.unit.skill,
 	}
 }
 func (value *APLValueAuraIsActive) Type() proto.APLValueType {
@@ -55,7 +57,7 @@ func (value *APLValueAuraIsActive) Type() proto.APLValueType {
 func (value *APLValueAuraIsActive) GetBool(sim *Simulation) bool {
 	aura := value.aura.Get()
 	if value.includeReactionTime {
-		return aura.IsActive() && aura.TimeActive(sim) >= value.reactionTime
+		return aura.IsActive() && aura.TimeActive(sim) >= value.reactionTime && !value.skill.missedProc(sim, aura)
 	}
 	return aura.IsActive()
 }
@@ -185,6 +187,86 @@ func (value *APLValueAuraNumStacks) String() string {
 	return fmt.Sprintf("Aura Num Stacks(%s)", value.aura.String())
 }
 
+type APLValueAuraStackAge struct {
+	DefaultAPLValueImpl
+	aura AuraReference
+
+	lastStackGainAt time.Duration
+}
+
+func (rot *APLRotation) newValueAuraStackAge(config *proto.APLValueAuraStackAge, uuid *proto.UUID) APLValue {
+	if config.AuraId == nil {
+		return nil
+	}
+	aura := rot.GetAPLAura(rot.GetSourceUnit(config.SourceUnit), config.AuraId)
+	resolvedAura := aura.Get()
+	if resolvedAura == nil {
+		return nil
+	}
+	if resolvedAura.MaxStacks == 0 {
+		rot.ValidationMessageByUUID(uuid, proto.LogLevel_Warning, "%s is not a stackable aura", ProtoToActionID(config.AuraId))
+		return nil
+	}
+
+	value := &APLValueAuraStackAge{
+		aura: aura,
+	}
+
+	resolvedAura.ApplyOnStacksChange(func(aura *Aura, sim *Simulation, oldStacks int32, newStacks int32) {
+		if newStacks > oldStacks {
+			value.lastStackGainAt = sim.CurrentTime
+		}
+	}).ApplyOnReset(func(aura *Aura, sim *Simulation) {
+		value.lastStackGainAt = 0
+	})
+
+	return value
+}
+func (value *APLValueAuraStackAge) Type() proto.APLValueType {
+	return proto.APLValueType_ValueTypeDuration
+}
+func (value *APLValueAuraStackAge) GetDuration(sim *Simulation) time.Duration {
+	if !value.aura.Get().IsActive() {
+		return 0
+	}
+	return sim.CurrentTime - value.lastStackGainAt
+}
+func (value *APLValueAuraStackAge) String() string {
+	return fmt.Sprintf("Aura Stack Age(%s)", value.aura.String())
+}
+
+type APLValueAuraChargesToDischarge struct {
+	DefaultAPLValueImpl
+	capacitor *Capacitor
+}
+
+func (rot *APLRotation) newValueAuraChargesToDischarge(config *proto.APLValueAuraChargesToDischarge, uuid *proto.UUID) APLValue {
+	if config.AuraId == nil {
+		return nil
+	}
+	unit := rot.GetSourceUnit(config.SourceUnit).Get()
+	if unit == nil {
+		return nil
+	}
+	capacitor := unit.GetCapacitor(ProtoToActionID(config.AuraId))
+	if capacitor == nil {
+		rot.ValidationMessageByUUID(uuid, proto.LogLevel_Warning, "%s does not discharge after accumulating charges", ProtoToActionID(config.AuraId))
+		return nil
+	}
+	return &APLValueAuraChargesToDischarge{
+		capacitor: capacitor,
+	}
+}
+func (value *APLValueAuraChargesToDischarge) Type() proto.APLValueType {
+	return proto.APLValueType_ValueTypeInt
+}
+func (value *APLValueAuraChargesToDischarge) GetInt(sim *Simulation) int32 {
+	return value.capacitor.ChargesToDischarge()
+}
+func (value *APLValueAuraChargesToDischarge) String() string {
+	return fmt.Sprintf("Aura Charges To Discharge(%s)", value.capacitor.Aura.ActionID)
+}
+
 type APLValueAuraInternalCooldown struct {
 	DefaultAPLValueImpl
 	aura AuraReference
@@ -247,6 +329,40 @@ func (value *APLValueAuraICDIsReady) String() string {
 	return fmt.Sprintf("Aura ICD Is Ready(%s)", value.aura.String())
 }
 
+type APLValueAuraExpectedTimeToProc struct {
+	DefaultAPLValueImpl
+	aura AuraReference
+}
+
+func (rot *APLRotation) newValueAuraExpectedTimeToProc(config *proto.APLValueAuraExpectedTimeToProc, _ *proto.UUID) APLValue {
+	if config.AuraId == nil {
+		return nil
+	}
+	aura := rot.GetAPLRppmAura(rot.GetSourceUnit(config.SourceUnit), config.AuraId)
+	if aura.Get() == nil {
+		return nil
+	}
+	return &APLValueAuraExpectedTimeToProc{
+		aura: aura,
+	}
+}
+func (value *APLValueAuraExpectedTimeToProc) Type() proto.APLValueType {
+	return proto.APLValueType_ValueTypeDuration
+}
+func (value *APLValueAuraExpectedTimeToProc) GetDuration(sim *Simulation) time.Duration {
+	aura := value.aura.Get()
+	var icdRemaining time.Duration
+	if aura.Icd != nil {
+		icdRemaining = aura.Icd.TimeToReady(sim)
+	}
+	// Unequipped items, e.g. after an item swap, never proc.
+	expectedTime, _ := aura.Dpm.ExpectedTimeToNextRPPMProc(sim, icdRemaining)
+	return expectedTime
+}
+func (value *APLValueAuraExpectedTimeToProc) String() string {
+	return fmt.Sprintf("Aura Expected Time To Proc(%s)", value.aura.String())
+}
+
 type APLValueAuraShouldRefresh struct {
 	DefaultAPLValueImpl
 	aura       AuraReference
@@ -7,28 +7,95 @@ import (
 	"github.com/wowsims/mop/sim/core/proto"
 )
 
+// Returns the enemies whose casts of the spell are checked, or nil if none of
+// them know it.
+func (rot *APLRotation) getBossCastingUnits(targetUnit *proto.UnitReference, spellId *proto.ActionID, anyTarget bool) ([]*Unit, ActionID) {
+	actionID := ProtoToActionID(spellId)
+	if !anyTarget {
+		spell := rot.GetTargetAPLSpell(spellId, rot.GetTargetUnit(targetUnit))
+		if spell == nil {
+			return nil, actionID
+		}
+		return []*Unit{spell.Unit}, actionID
+	}
+
+	var units []*Unit
+	for _, target := range rot.unit.Env.Encounter.AllTargetUnits {
+		if target.GetSpell(actionID) != nil {
+			units = append(units, target)
+		}
+	}
+	if len(units) == 0 {
+		rot.ValidationMessage(proto.LogLevel_Warning, "No enemy knows spell %s", actionID)
+	}
+	return units, actionID
+}
+
 type APLValueBossSpellIsCasting struct {
 	DefaultAPLValueImpl
-	spell *Spell
+	units     []*Unit
+	actionID  ActionID
+	anyTarget bool
 }
 
 func (rot *APLRotation) newValueBossSpellIsCasting(config *proto.APLValueBossSpellIsCasting, _ *proto.UUID) APLValue {
-	spell := rot.GetTargetAPLSpell(config.SpellId, rot.GetTargetUnit(config.TargetUnit))
-	if spell == nil {
+	units, actionID := rot.getBossCastingUnits(config.TargetUnit, config.SpellId, config.AnyTarget)
+	if units == nil {
 		return nil
 	}
 	return &APLValueBossSpellIsCasting{
-		spell: spell,
+		units:     units,
+		actionID:  actionID,
+		anyTarget: config.AnyTarget,
 	}
 }
 func (value *APLValueBossSpellIsCasting) Type() proto.APLValueType {
 	return proto.APLValueType_ValueTypeBool
 }
 func (value *APLValueBossSpellIsCasting) GetBool(sim *Simulation) bool {
-	return value.spell.Unit.Hardcast.ActionID == value.spell.ActionID && value.spell.Unit.Hardcast.Expires > sim.CurrentTime
+	for _, unit := range value.units {
+		if unit.RemainingCastTimeOf(sim, value.actionID) > 0 {
+			return true
+		}
+	}
+	return false
 }
 func (value *APLValueBossSpellIsCasting) String() string {
-	return fmt.Sprintf("Boss is Casting(%s)", value.spell.ActionID)
+	return fmt.Sprintf("%s is Casting(%s)", Ternary(value.anyTarget, "Any Boss", "Boss"), value.actionID)
+}
+
+type APLValueBossSpellRemainingCastTime struct {
+	DefaultAPLValueImpl
+	units     []*Unit
+	actionID  ActionID
+	anyTarget bool
+}
+
+func (rot *APLRotation) newValueBossSpellRemainingCastTime(config *proto.APLValueBossSpellRemainingCastTime, _ *proto.UUID) APLValue {
+	units, actionID := rot.getBossCastingUnits(config.TargetUnit, config.SpellId, config.AnyTarget)
+	if units == nil {
+		return nil
+	}
+	return &APLValueBossSpellRemainingCastTime{
+		units:     units,
+		actionID:  actionID,
+		anyTarget: config.AnyTarget,
+	}
+}
+func (value *APLValueBossSpellRemainingCastTime) Type() proto.APLValueType {
+	return proto.APLValueType_ValueTypeDuration
+}
+func (value *APLValueBossSpellRemainingCastTime) GetDuration(sim *Simulation) time.Duration {
+	remaining := time.Duration(0)
+	for _, unit := range value.units {
+		if castTime := unit.RemainingCastTimeOf(sim, value.actionID); castTime > 0 && (remaining == 0 || castTime < remaining) {
+			remaining = castTime
+		}
+	}
+	return remaining
+}
+func (value *APLValueBossSpellRemainingCastTime) String() string {
+	return fmt.Sprintf("%s Remaining Cast Time(%s)", Ternary(value.anyTarget, "Any Boss", "Boss"), value.actionID)
 }
 
 type APLValueBossSpellTimeToReady struct {
@@ -80,3 +147,51 @@ func (value *APLValueBossCurrentTarget) GetBool(sim *Simulation) bool {
 func (value *APLValueBossCurrentTarget) String() string {
 	return fmt.Sprintf("IsTanking(%s)", value.target.Get().Label)
 }
+
+type APLValueBossDamageReduced struct {
+	DefaultAPLValueImpl
+	target UnitReference
+}
+
+func (rot *APLRotation) newValueBossDamageReduced(config *proto.APLValueBossDamageReduced, _ *proto.UUID) APLValue {
+	target := rot.GetTargetUnit(config.TargetUnit)
+	if target.Get() == nil {
+		return nil
+	}
+	return &APLValueBossDamageReduced{
+		target: target,
+	}
+}
+func (value *APLValueBossDamageReduced) Type() proto.APLValueType {
+	return proto.APLValueType_ValueTypeBool
+}
+func (value *APLValueBossDamageReduced) GetBool(sim *Simulation) bool {
+	return sim.Encounter.AllTargets[value.target.Get().Index].IsDamageReduced()
+}
+func (value *APLValueBossDamageReduced) String() string {
+	return fmt.Sprintf("Takes Reduced Damage(%s)", value.target.Get().Label)
+}
+
+type APLValueBossDamageReducedRemainingTime struct {
+	DefaultAPLValueImpl
+	target UnitReference
+}
+
+func (rot *APLRotation) newValueBossDamageReducedRemainingTime(config *proto.APLValueBossDamageReducedRemainingTime, _ *proto.UUID) APLValue {
+	target := rot.GetTargetUnit(config.TargetUnit)
+	if target.Get() == nil {
+		return nil
+	}
+	return &APLValueBossDamageReducedRemainingTime{
+		target: target,
+	}
+}
+func (value *APLValueBossDamageReducedRemainingTime) Type() proto.APLValueType {
+	return proto.APLValueType_ValueTypeDuration
+}
+func (value *APLValueBossDamageReducedRemainingTime) GetDuration(sim *Simulation) time.Duration {
+	return sim.Encounter.AllTargets[value.target.Get().Index].DamageReducedRemainingTime(sim)
+}
+func (value *APLValueBossDamageReducedRemainingTime) String() string {
+	return fmt.Sprintf("Reduced Damage Remaining Time(%s)", value.target.Get().Label)
+}
@@ -40,12 +40,13 @@ type APLValueDotIsActiveOnAllTargets struct {
 	spell *Spell
 }
 
-func (rot *APLRotation) newValueDotIsActiveOnAllTargets(config *proto.APLValueDotIsActiveOnAllTargets, _ *proto.UUID) APLValue {
+// Returns the spell's dot on each target, or nil if it has none.
+func (rot *APLRotation) getAPLDotsOnAllTargets(spellId *proto.ActionID) (*Spell, []*Dot) {
 	unit := rot.unit
-	spell := rot.GetAPLMultidotSpell(config.SpellId)
+	spell := rot.GetAPLMultidotSpell(spellId)
 
 	if spell == nil {
-		return nil
+		return nil, nil
 	}
 
 	units := unit.Env.Encounter.AllTargetUnits
@@ -54,7 +55,7 @@ func (rot *APLRotation) newValueDotIsActiveOnAllTargets(config *proto.APLValueDo
 		dot := rot.GetAPLDot(rot.GetTargetUnit(&proto.UnitReference{
 			Type:  proto.UnitReference_Target,
 			Index: unit.Index,
-		}), config.SpellId)
+		}), spellId)
 
 		if dot != nil {
 			dots = append(dots, dot)
@@ -62,7 +63,15 @@ func (rot *APLRotation) newValueDotIsActiveOnAllTargets(config *proto.APLValueDo
 	}
 
 	if len(dots) == 0 {
-		rot.ValidationMessage(proto.LogLevel_Warning, "Could not find a DoT for %s on Target(s)", ProtoToActionID(config.SpellId))
+		rot.ValidationMessage(proto.LogLevel_Warning, "Could not find a DoT for %s on Target(s)", ProtoToActionID(spellId))
+		return nil, nil
+	}
+	return spell, dots
+}
+
+func (rot *APLRotation) newValueDotIsActiveOnAllTargets(config *proto.APLValueDotIsActiveOnAllTargets, _ *proto.UUID) APLValue {
+	spell, dots := rot.getAPLDotsOnAllTargets(config.SpellId)
+	if spell == nil {
 		return nil
 	}
 
@@ -86,6 +95,39 @@ func (value *APLValueDotIsActiveOnAllTargets) String() string {
 	return fmt.Sprintf("Dot Is Active On All Targets(%s)", value.spell.ActionID)
 }
 
+type APLValueDotNumActiveTargets struct {
+	DefaultAPLValueImpl
+	dots  []*Dot
+	spell *Spell
+}
+
+func (rot *APLRotation) newValueDotNumActiveTargets(config *proto.APLValueDotNumActiveTargets, _ *proto.UUID) APLValue {
+	spell, dots := rot.getAPLDotsOnAllTargets(config.SpellId)
+	if spell == nil {
+		return nil
+	}
+
+	return &APLValueDotNumActiveTargets{
+		spell: spell,
+		dots:  dots,
+	}
+}
+func (value *APLValueDotNumActiveTargets) Type() proto.APLValueType {
+	return proto.APLValueType_ValueTypeInt
+}
+func (value *APLValueDotNumActiveTargets) GetInt(sim *Simulation) int32 {
+	numActive := int32(0)
+	for _, dot := range value.dots {
+		if dot.IsActive() && dot.Unit.IsEnabled() {
+			numActive++
+		}
+	}
+	return numActive
+}
+func (value *APLValueDotNumActiveTargets) String() string {
+	return fmt.Sprintf("Dot Num Active Targets(%s)", value.spell.ActionID)
+}
+
 type APLValueDotRemainingTime struct {
 	DefaultAPLValueImpl
 	dot *DotReference
@@ -196,6 +238,38 @@ func (value *APLValueDotTickFrequency) String() string {
 	return fmt.Sprintf("Dot Tick Frequency(%s)", value.dot.Get().Spell.ActionID)
 }
 
+type APLValueDotHasteRatingToNextTick struct {
+	DefaultAPLValueImpl
+	dot *DotReference
+}
+
+func (rot *APLRotation) newValueDotHasteRatingToNextTick(config *proto.APLValueDotHasteRatingToNextTick, uuid *proto.UUID) APLValue {
+	dot := rot.NewDotReference(rot.GetTargetUnit(config.TargetUnit), config.SpellId)
+	if dot.Get() == nil {
+		return nil
+	}
+	if !dot.Get().hasHasteBreakpoints() {
+		rot.ValidationMessageByUUID(uuid, proto.LogLevel_Warning, "%s does not gain ticks from haste", ProtoToActionID(config.SpellId))
+		return nil
+	}
+	return &APLValueDotHasteRatingToNextTick{
+		dot: dot,
+	}
+}
+
+func (value *APLValueDotHasteRatingToNextTick) Type() proto.APLValueType {
+	return proto.APLValueType_ValueTypeFloat
+}
+func (value *APLValueDotHasteRatingToNextTick) GetFloat(sim *Simulation) float64 {
+	dot := value.dot.Get()
+	rating := dot.Spell.Unit.dotHasteRatingToNextTick(dot)
+	// A breakpoint past any realistic amount of haste is as good as unreachable.
+	return TernaryFloat64(rating < 0, maxBreakpointHasteRating, rating)
+}
+func (value *APLValueDotHasteRatingToNextTick) String() string {
+	return fmt.Sprintf("Dot Haste Rating To Next Tick(%s)", value.dot.Get().Spell.ActionID)
+}
+
 type APLValueDotTimeToNextTick struct {
 	DefaultAPLValueImpl
 	dot *DotReference
@@ -221,6 +295,56 @@ func (value *APLValueDotTimeToNextTick) String() string {
 	return fmt.Sprintf("Time To Next Tick(%s)", value.dot.Get().Spell.ActionID)
 }
 
+type APLValueDotInPandemicWindow struct {
+	DefaultAPLValueImpl
+	dot *DotReference
+}
+
+func (rot *APLRotation) newValueDotInPandemicWindow(config *proto.APLValueDotInPandemicWindow, _ *proto.UUID) APLValue {
+	dot := rot.NewDotReference(rot.GetTargetUnit(config.TargetUnit), config.SpellId)
+	if dot.Get() == nil {
+		return nil
+	}
+	return &APLValueDotInPandemicWindow{
+		dot: dot,
+	}
+}
+
+func (value *APLValueDotInPandemicWindow) Type() proto.APLValueType {
+	return proto.APLValueType_ValueTypeBool
+}
+func (value *APLValueDotInPandemicWindow) GetBool(sim *Simulation) bool {
+	return value.dot.Get().InPandemicWindow(sim)
+}
+func (value *APLValueDotInPandemicWindow) String() string {
+	return fmt.Sprintf("Dot In Pandemic Window(%s)", value.dot.Get().Spell.ActionID)
+}
+
+type APLValueDotRefreshThreshold struct {
+	DefaultAPLValueImpl
+	dot *DotReference
+}
+
+func (rot *APLRotation) newValueDotRefreshThreshold(config *proto.APLValueDotRefreshThreshold, _ *proto.UUID) APLValue {
+	dot := rot.NewDotReference(rot.GetTargetUnit(config.TargetUnit), config.SpellId)
+	if dot.Get() == nil {
+		return nil
+	}
+	return &APLValueDotRefreshThreshold{
+		dot: dot,
+	}
+}
+
+func (value *APLValueDotRefreshThreshold) Type() proto.APLValueType {
+	return proto.APLValueType_ValueTypeDuration
+}
+func (value *APLValueDotRefreshThreshold) GetDuration(sim *Simulation) time.Duration {
+	return value.dot.Get().RefreshThreshold(sim)
+}
+func (value *APLValueDotRefreshThreshold) String() string {
+	return fmt.Sprintf("Dot Refresh Threshold(%s)", value.dot.Get().Spell.ActionID)
+}
+
 type APLValueDotBaseDuration struct {
 	DefaultAPLValueImpl
 	baseDuration time.Duration
@@ -327,6 +451,35 @@ func (value *APLValueDotPercentIncrease) GetFloat(sim *Simulation) float64 {
 	return math.Round((value.spell.ExpectedTickDamage(sim, target)/expectedDamage)*100000)/100000 - 1
 }
 
+type APLValueDotDamageIncrease struct {
+	*APLValueDotIncreaseCheck
+}
+
+func (rot *APLRotation) newValueDotDamageIncrease(config *proto.APLValueDotPercentIncrease, _ *proto.UUID) APLValue {
+	parentImpl := rot.newDotIncreaseValue("Dot Damage Increase", config)
+	if parentImpl == nil {
+		return nil
+	}
+
+	return &APLValueDotDamageIncrease{APLValueDotIncreaseCheck: parentImpl}
+}
+
+func (value *APLValueDotDamageIncrease) Finalize(rot *APLRotation) {
+	if value.useBaseValue && value.baseValueDummyAura != nil {
+		value.baseValueDummyAura.ApplyOnEncounterStart(func(aura *Aura, sim *Simulation) {
+			value.baseValue = value.spell.ExpectedTickDamage(sim, value.targetRef.Get())
+		})
+	}
+}
+
+// Expected damage per tick a new DoT would gain (or lose, if negative) over the current snapshot.
+func (value *APLValueDotDamageIncrease) GetFloat(sim *Simulation) float64 {
+	target := value.targetRef.Get()
+	expectedDamage := TernaryFloat64(value.useBaseValue, value.baseValue, value.spell.ExpectedTickDamageFromCurrentSnapshot(sim, target))
+
+	return value.spell.ExpectedTickDamage(sim, target) - expectedDamage
+}
+
 type APLValueDotCritPercentIncrease struct {
 	*APLValueDotIncreaseCheck
 }
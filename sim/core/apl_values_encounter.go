@@ -95,6 +95,46 @@ func (value *APLValueNumberTargets) String() string {
 	return "Num Active Targets"
 }
 
+type APLValueNumberTargetsInRange struct {
+	DefaultAPLValueImpl
+	unit     *Unit
+	maxRange APLValue
+}
+
+func (rot *APLRotation) newValueNumberTargetsInRange(config *proto.APLValueNumberTargetsInRange, uuid *proto.UUID) APLValue {
+	maxRange := rot.coerceTo(rot.newAPLValue(config.MaxRange), proto.APLValueType_ValueTypeFloat)
+	if maxRange == nil {
+		rot.ValidationMessageByUUID(uuid, proto.LogLevel_Warning, "Number of Targets In Range requires a range")
+		return nil
+	}
+	return &APLValueNumberTargetsInRange{
+		unit:     rot.unit,
+		maxRange: maxRange,
+	}
+}
+func (value *APLValueNumberTargetsInRange) GetInnerValues() []APLValue {
+	return []APLValue{value.maxRange}
+}
+func (value *APLValueNumberTargetsInRange) Type() proto.APLValueType {
+	return proto.APLValueType_ValueTypeInt
+}
+func (value *APLValueNumberTargetsInRange) GetInt(sim *Simulation) int32 {
+	maxRange := value.maxRange.GetFloat(sim)
+	numTargets := int32(0)
+	for _, target := range sim.Encounter.ActiveTargets {
+		if target.DistanceFrom(value.unit) <= maxRange {
+			numTargets++
+		}
+	}
+	return numTargets
+}
+func (value *APLValueNumberTargetsInRange) GetFloat(sim *Simulation) float64 {
+	return float64(value.GetInt(sim))
+}
+func (value *APLValueNumberTargetsInRange) String() string {
+	return fmt.Sprintf("Num Targets In Range(%s)", value.maxRange)
+}
+
 type APLValueIsExecutePhase struct {
 	DefaultAPLValueImpl
 	threshold proto.APLValueIsExecutePhase_ExecutePhaseThreshold
@@ -129,3 +169,27 @@ func (value *APLValueIsExecutePhase) GetBool(sim *Simulation) bool {
 func (value *APLValueIsExecutePhase) String() string {
 	return "Is Execute Phase"
 }
+
+type APLValueTimeUntilEncounterEvent struct {
+	DefaultAPLValueImpl
+	eventName string
+}
+
+func (rot *APLRotation) newValueTimeUntilEncounterEvent(config *proto.APLValueTimeUntilEncounterEvent, uuid *proto.UUID) APLValue {
+	if config.EventName == "" {
+		rot.ValidationMessageByUUID(uuid, proto.LogLevel_Warning, "No encounter event name")
+		return nil
+	}
+	return &APLValueTimeUntilEncounterEvent{
+		eventName: config.EventName,
+	}
+}
+func (value *APLValueTimeUntilEncounterEvent) Type() proto.APLValueType {
+	return proto.APLValueType_ValueTypeDuration
+}
+func (value *APLValueTimeUntilEncounterEvent) GetDuration(sim *Simulation) time.Duration {
+	return sim.Encounter.TimeUntilEvent(sim, value.eventName)
+}
+func (value *APLValueTimeUntilEncounterEvent) String() string {
+	return fmt.Sprintf("Time Until Encounter Event(%s)", value.eventName)
+}
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/wowsims/mop/sim/core/proto"
@@ -65,3 +66,46 @@ func (value *APLValueFrontOfTarget) GetBool(sim *Simulation) bool {
 func (value *APLValueFrontOfTarget) String() string {
 	return "Front of Target()"
 }
+
+type APLValueRandomChance struct {
+	DefaultAPLValueImpl
+	chance           float64
+	rollOncePerFight bool
+
+	rolled     bool
+	rollResult bool
+}
+
+func (rot *APLRotation) newValueRandomChance(config *proto.APLValueRandomChance, uuid *proto.UUID) APLValue {
+	if config.Chance < 0 || config.Chance > 1 {
+		rot.ValidationMessageByUUID(uuid, proto.LogLevel_Warning, "Random chance must be between 0 and 1, got %0.2f", config.Chance)
+		return nil
+	}
+
+	value := &APLValueRandomChance{
+		chance:           config.Chance,
+		rollOncePerFight: config.RollOncePerFight,
+	}
+	if value.rollOncePerFight {
+		rot.unit.RegisterResetEffect(func(_ *Simulation) {
+			value.rolled = false
+		})
+	}
+	return value
+}
+func (value *APLValueRandomChance) Type() proto.APLValueType {
+	return proto.APLValueType_ValueTypeBool
+}
+func (value *APLValueRandomChance) GetBool(sim *Simulation) bool {
+	if !value.rollOncePerFight {
+		return sim.Proc(value.chance, "APL Random Chance")
+	}
+	if !value.rolled {
+		value.rolled = true
+		value.rollResult = sim.Proc(value.chance, "APL Random Chance")
+	}
+	return value.rollResult
+}
+func (value *APLValueRandomChance) String() string {
+	return fmt.Sprintf("Random Chance(%0.2f)", value.chance)
+}
@@ -124,3 +124,46 @@ func (value *APLValueSequenceTimeToReady) GetDuration(sim *Simulation) time.Dura
 func (value *APLValueSequenceTimeToReady) String() string {
 	return fmt.Sprintf("Sequence Time To Ready(%s)", value.name)
 }
+
+type APLValueSequencePosition struct {
+	DefaultAPLValueImpl
+	name   string
+	curIdx *int
+}
+
+func (rot *APLRotation) newValueSequencePosition(config *proto.APLValueSequencePosition, uuid *proto.UUID) APLValue {
+	if config.SequenceName == "" {
+		rot.ValidationMessageByUUID(uuid, proto.LogLevel_Warning, "Sequence Position() must provide a sequence name")
+		return nil
+	}
+	return &APLValueSequencePosition{
+		name: config.SequenceName,
+	}
+}
+func (value *APLValueSequencePosition) Finalize(rot *APLRotation) {
+	for _, otherAction := range rot.allAPLActions() {
+		if sequence, ok := otherAction.impl.(*APLActionSequence); ok && sequence.name == value.name {
+			value.curIdx = &sequence.curIdx
+			return
+		}
+		if sequence, ok := otherAction.impl.(*APLActionStrictSequence); ok && sequence.name == value.name {
+			value.curIdx = &sequence.curIdx
+			return
+		}
+	}
+	rot.ValidationMessageByUUID(value.Uuid, proto.LogLevel_Warning, "No sequence with name: '%s'", value.name)
+}
+func (value *APLValueSequencePosition) Type() proto.APLValueType {
+	return proto.APLValueType_ValueTypeInt
+}
+
+// Returns the number of sub-actions the sequence has already executed.
+func (value *APLValueSequencePosition) GetInt(sim *Simulation) int32 {
+	if value.curIdx == nil {
+		return 0
+	}
+	return int32(*value.curIdx)
+}
+func (value *APLValueSequencePosition) String() string {
+	return fmt.Sprintf("Sequence Position(%s)", value.name)
+}
@@ -70,7 +70,7 @@ func (value *APLValueSpellIsReady) Type() proto.APLValueType {
 	return proto.APLValueType_ValueTypeBool
 }
 func (value *APLValueSpellIsReady) GetBool(sim *Simulation) bool {
-	return value.spell.IsReady(sim) || (value.spell.TimeToReady(sim) <= MaxSpellQueueWindow)
+	return value.spell.IsReady(sim) || (value.spell.TimeToReady(sim) <= value.spell.Unit.spellQueueLeniency())
 }
 func (value *APLValueSpellIsReady) String() string {
 	return fmt.Sprintf("Is Ready(%s)", value.spell.ActionID)
@@ -434,3 +434,93 @@ func (value *APLValueSpellInFlight) GetBool(sim *Simulation) bool {
 func (value *APLValueSpellInFlight) String() string {
 	return fmt.Sprintf("SpellInFlight(%s)", value.spell.ActionID)
 }
+
+type APLValueSpellCastsInWindow struct {
+	DefaultAPLValueImpl
+	spell  *Spell
+	window APLValue
+}
+
+func (rot *APLRotation) newValueSpellCastsInWindow(config *proto.APLValueSpellCastsInWindow, _ *proto.UUID) APLValue {
+	spell := rot.GetAPLSpell(config.SpellId)
+	if spell == nil {
+		return nil
+	}
+	window := rot.coerceTo(rot.newAPLValue(config.Window), proto.APLValueType_ValueTypeDuration)
+	if window == nil {
+		rot.ValidationMessage(proto.LogLevel_Warning, "Casts In Window requires a window")
+		return nil
+	}
+
+	spell.TrackCastHistory()
+	return &APLValueSpellCastsInWindow{
+		spell:  spell,
+		window: window,
+	}
+}
+func (value *APLValueSpellCastsInWindow) GetInnerValues() []APLValue {
+	return []APLValue{value.window}
+}
+func (value *APLValueSpellCastsInWindow) Type() proto.APLValueType {
+	return proto.APLValueType_ValueTypeInt
+}
+func (value *APLValueSpellCastsInWindow) GetInt(sim *Simulation) int32 {
+	return int32(value.spell.CastsInWindow(sim, value.window.GetDuration(sim)))
+}
+func (value *APLValueSpellCastsInWindow) String() string {
+	return fmt.Sprintf("Casts In Window(%s, %s)", value.spell.ActionID, value.window)
+}
+
+type APLValuePreviousGCDSpellIs struct {
+	DefaultAPLValueImpl
+	spell *Spell
+}
+
+func (rot *APLRotation) newValuePreviousGCDSpellIs(config *proto.APLValuePreviousGcdSpellIs, _ *proto.UUID) APLValue {
+	spell := rot.GetAPLSpell(config.SpellId)
+	if spell == nil {
+		return nil
+	}
+	return &APLValuePreviousGCDSpellIs{
+		spell: spell,
+	}
+}
+func (value *APLValuePreviousGCDSpellIs) Type() proto.APLValueType {
+	return proto.APLValueType_ValueTypeBool
+}
+func (value *APLValuePreviousGCDSpellIs) GetBool(_ *Simulation) bool {
+	return value.spell.Unit.PreviousGCDSpell() == value.spell
+}
+func (value *APLValuePreviousGCDSpellIs) String() string {
+	return fmt.Sprintf("Previous GCD Spell Is(%s)", value.spell.ActionID)
+}
+
+type APLValueSpellExpectedDamage struct {
+	DefaultAPLValueImpl
+	spell     *Spell
+	targetRef UnitReference
+}
+
+func (rot *APLRotation) newValueSpellExpectedDamage(config *proto.APLValueSpellExpectedDamage, _ *proto.UUID) APLValue {
+	spell := rot.GetAPLSpell(config.SpellId)
+	if spell == nil {
+		return nil
+	}
+	if !spell.HasExpectedDamage() {
+		rot.ValidationMessage(proto.LogLevel_Warning, "%s does not support expected damage calculations", ProtoToActionID(config.SpellId))
+		return nil
+	}
+	return &APLValueSpellExpectedDamage{
+		spell:     spell,
+		targetRef: rot.GetTargetUnit(config.TargetUnit),
+	}
+}
+func (value *APLValueSpellExpectedDamage) Type() proto.APLValueType {
+	return proto.APLValueType_ValueTypeFloat
+}
+func (value *APLValueSpellExpectedDamage) GetFloat(sim *Simulation) float64 {
+	return value.spell.ExpectedDamage(sim, value.targetRef.Get())
+}
+func (value *APLValueSpellExpectedDamage) String() string {
+	return fmt.Sprintf("Expected Damage(%s)", value.spell.ActionID)
+}
@@ -42,3 +42,109 @@ func TestValueConst(t *testing.T) {
 		t.Fatalf("Unexpected coerced duration value %s", coercedDurVal.GetDuration(sim))
 	}
 }
+
+func TestValueBossSpellRemainingCastTime(t *testing.T) {
+	sim := &Simulation{CurrentTime: time.Second}
+	actionID := ActionID{SpellID: 12345}
+
+	newBoss := func(castEnd time.Duration) *Unit {
+		boss := &Unit{Hardcast: Hardcast{ActionID: actionID, Expires: castEnd}}
+		boss.Spellbook = []*Spell{{ActionID: actionID, Unit: boss}}
+		return boss
+	}
+	rot := &APLRotation{
+		unit: &Unit{Env: &Environment{Encounter: Encounter{AllTargetUnits: []*Unit{
+			newBoss(0),
+			newBoss(time.Second * 4),
+			newBoss(time.Second * 3),
+			{},
+		}}}},
+	}
+
+	config := &proto.APLValueBossSpellRemainingCastTime{SpellId: actionID.ToProto(), AnyTarget: true}
+	remainingCastTime := rot.newValueBossSpellRemainingCastTime(config, &proto.UUID{Value: ""})
+	if remainingCastTime.GetDuration(sim) != time.Second*2 {
+		t.Fatalf("Expected the first cast to complete in 2s, found %s", remainingCastTime.GetDuration(sim))
+	}
+
+	isCasting := rot.newValueBossSpellIsCasting(&proto.APLValueBossSpellIsCasting{SpellId: actionID.ToProto(), AnyTarget: true}, &proto.UUID{Value: ""})
+	if !isCasting.GetBool(sim) {
+		t.Fatalf("Expected an enemy to be casting")
+	}
+
+	sim.CurrentTime = time.Second * 4
+	if isCasting.GetBool(sim) || remainingCastTime.GetDuration(sim) != 0 {
+		t.Fatalf("Expected no enemy to be casting after all casts completed")
+	}
+}
+
+func TestValueNumberTargetsInRange(t *testing.T) {
+	encounter := NewEncounter(&proto.Encounter{Targets: []*proto.Target{
+		{},
+		{DistanceFromPrimary: 5},
+		{DistanceFromPrimary: 8},
+		{DistanceFromPrimary: 3, DisabledAtStart: true},
+	}})
+	sim := &Simulation{Environment: &Environment{Encounter: encounter}}
+	unit := &Unit{DistanceFromTarget: 5}
+	rot := &APLRotation{
+		unit: unit,
+	}
+
+	numTargets := &APLValueNumberTargetsInRange{
+		unit:     unit,
+		maxRange: rot.newValueConst(&proto.APLValueConst{Val: "10"}, &proto.UUID{Value: ""}),
+	}
+	if numTargets.GetInt(sim) != 2 {
+		t.Fatalf("Expected 2 active targets within 10 yards, found %d", numTargets.GetInt(sim))
+	}
+
+	unit.DistanceFromTarget = 0
+	if numTargets.GetInt(sim) != 3 {
+		t.Fatalf("Expected 3 active targets within 10 yards when standing at the primary target, found %d", numTargets.GetInt(sim))
+	}
+}
+
+func TestValueRandomChance(t *testing.T) {
+	sim := &Simulation{rand: NewSplitMix(1)}
+	unit := &Unit{}
+	rot := &APLRotation{
+		unit:            unit,
+		uuidValidations: map[*proto.UUID][]*proto.APLValidation{},
+	}
+
+	perEvaluation := rot.newValueRandomChance(&proto.APLValueRandomChance{Chance: 0.85}, &proto.UUID{Value: ""})
+	numTrue := 0
+	for range 1000 {
+		if perEvaluation.GetBool(sim) {
+			numTrue++
+		}
+	}
+	if numTrue < 800 || numTrue > 900 {
+		t.Fatalf("Expected roughly 85%% of evaluations to be true, found %d of 1000", numTrue)
+	}
+
+	perFight := rot.newValueRandomChance(&proto.APLValueRandomChance{Chance: 0.5, RollOncePerFight: true}, &proto.UUID{Value: ""})
+	numTrue = 0
+	for range 100 {
+		firstRoll := perFight.GetBool(sim)
+		for range 10 {
+			if perFight.GetBool(sim) != firstRoll {
+				t.Fatalf("Expected the same result for the whole fight")
+			}
+		}
+		if firstRoll {
+			numTrue++
+		}
+		for _, resetEffect := range unit.resetEffects {
+			resetEffect(sim)
+		}
+	}
+	if numTrue == 0 || numTrue == 100 {
+		t.Fatalf("Expected the result to be rerolled each fight, found %d of 100 true", numTrue)
+	}
+
+	if rot.newValueRandomChance(&proto.APLValueRandomChance{Chance: 1.5}, &proto.UUID{Value: ""}) != nil {
+		t.Fatalf("Expected no value for a chance above 1")
+	}
+}
@@ -30,19 +30,25 @@ func (value *APLValueUnitIsMoving) String() string {
 
 type APLValueUnitDistance struct {
 	DefaultAPLValueImpl
-	unit *Unit
+	unit UnitReference
 }
 
 func (rot *APLRotation) newValueUnitDistance(config *proto.APLValueUnitDistance, _ *proto.UUID) APLValue {
+	unit := rot.GetSourceUnit(config.SourceUnit)
+	if unit.Get() == nil {
+		return nil
+	}
 	return &APLValueUnitDistance{
-		unit: rot.unit,
+		unit: unit,
 	}
 }
 func (value *APLValueUnitDistance) Type() proto.APLValueType {
 	return proto.APLValueType_ValueTypeFloat
 }
 func (value *APLValueUnitDistance) GetFloat(sim *Simulation) float64 {
-	return value.unit.DistanceFromTarget
+	unit := value.unit.Get()
+	unit.UpdatePosition(sim)
+	return unit.DistanceFromTarget
 }
 func (value *APLValueUnitDistance) String() string {
 	return "Unit Distance From Target"
@@ -0,0 +1,101 @@
+package core
+
+import (
+	"time"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+// Why the rotation did nothing while it was able to act.
+type aplWaitReason int
+
+const (
+	aplWaitNone aplWaitReason = iota
+	aplWaitExplicit
+	aplWaitPooling
+	aplWaitResourceStarved
+	aplWaitIdle
+
+	numAPLWaitReasons
+)
+
+// Tracks the time the rotation spends waiting, by reason, so intentional
+// pooling can be told apart from conditions that never pass.
+type aplWaitMetrics struct {
+	// Wait of the current iteration, if any.
+	reason aplWaitReason
+	since  time.Duration
+
+	// Aggregate values, updated as each wait ends.
+	totals [numAPLWaitReasons]time.Duration
+}
+
+// Starts a wait once the unit is free to act again. Waiting on the GCD or a
+// cast in progress isn't counted.
+func (waits *aplWaitMetrics) start(sim *Simulation, unit *Unit, reason aplWaitReason) {
+	waits.reason = reason
+	waits.since = max(sim.CurrentTime, unit.NextGCDAt(), unit.Hardcast.Expires)
+}
+
+func (waits *aplWaitMetrics) stop(sim *Simulation) {
+	if waits.reason != aplWaitNone {
+		waits.totals[waits.reason] += max(0, sim.CurrentTime-waits.since)
+	}
+	waits.reason = aplWaitNone
+}
+
+func (waits *aplWaitMetrics) reset() {
+	waits.reason = aplWaitNone
+	waits.since = 0
+}
+
+func (waits *aplWaitMetrics) toProto(numIterations float64) *proto.APLWaitMetrics {
+	avg := func(reason aplWaitReason) float64 {
+		return waits.totals[reason].Seconds() / numIterations
+	}
+	return &proto.APLWaitMetrics{
+		ExplicitWaitSecondsAvg:    avg(aplWaitExplicit),
+		PoolingSecondsAvg:         avg(aplWaitPooling),
+		ResourceStarvedSecondsAvg: avg(aplWaitResourceStarved),
+		IdleSecondsAvg:            avg(aplWaitIdle),
+	}
+}
+
+// Returns why the rotation is waiting after an evaluation, given whether it
+// executed any actions.
+func (apl *APLRotation) getWaitReason(sim *Simulation, executedAction bool) aplWaitReason {
+	if len(apl.controllingActions) != 0 {
+		switch apl.controllingActions[len(apl.controllingActions)-1].(type) {
+		case *APLActionWait, *APLActionWaitUntil:
+			return aplWaitExplicit
+		case *APLActionPoolResource:
+			return aplWaitPooling
+		}
+	}
+
+	if executedAction {
+		return aplWaitNone
+	}
+
+	if apl.isResourceStarved(sim) {
+		return aplWaitResourceStarved
+	}
+	return aplWaitIdle
+}
+
+// Whether an action in the priority list passed its condition, but one of its
+// spells is off cooldown and short on resources.
+func (apl *APLRotation) isResourceStarved(sim *Simulation) bool {
+	for _, action := range apl.priorityList {
+		if action.condition != nil && !action.condition.GetBool(sim) {
+			continue
+		}
+		for _, spell := range action.GetAllSpells() {
+			spell = spell.Substituted()
+			if spell.Cost != nil && BothTimersReady(spell.CD.Timer, spell.SharedCD.Timer, sim) && !spell.Cost.MeetsRequirement(sim, spell) {
+				return true
+			}
+		}
+	}
+	return false
+}
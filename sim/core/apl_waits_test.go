@@ -0,0 +1,60 @@
+package core
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestAPLWaitMetrics(t *testing.T) {
+	sim := &Simulation{}
+	unit := &Unit{}
+	unit.GCD = unit.NewTimer()
+	waits := &aplWaitMetrics{}
+
+	// Pooling starts during the GCD, so only counts once it ends.
+	sim.CurrentTime = time.Second * 2
+	unit.GCD.Set(time.Second * 3)
+	waits.start(sim, unit, aplWaitPooling)
+	sim.CurrentTime = time.Second * 5
+	waits.stop(sim)
+
+	// Re-evaluated before the GCD ends.
+	sim.CurrentTime = time.Second * 6
+	unit.GCD.Set(time.Second * 7)
+	waits.start(sim, unit, aplWaitIdle)
+	sim.CurrentTime = time.Second*6 + time.Millisecond*500
+	waits.stop(sim)
+
+	sim.CurrentTime = time.Second * 10
+	waits.start(sim, unit, aplWaitResourceStarved)
+	sim.CurrentTime = time.Second * 11
+	waits.stop(sim)
+	waits.start(sim, unit, aplWaitResourceStarved)
+	sim.CurrentTime = time.Second * 12
+	waits.stop(sim)
+
+	// Stopping without a wait does nothing.
+	sim.CurrentTime = time.Second * 20
+	waits.stop(sim)
+
+	result := waits.toProto(2)
+	if math.Abs(result.PoolingSecondsAvg-1) > 1e-9 {
+		t.Fatalf("Expected 1s of pooling per iteration, found %f", result.PoolingSecondsAvg)
+	}
+	if math.Abs(result.ResourceStarvedSecondsAvg-1) > 1e-9 {
+		t.Fatalf("Expected 1s of resource starvation per iteration, found %f", result.ResourceStarvedSecondsAvg)
+	}
+	if result.IdleSecondsAvg != 0 || result.ExplicitWaitSecondsAvg != 0 {
+		t.Fatalf("Expected no idle or explicit wait time, found %f and %f", result.IdleSecondsAvg, result.ExplicitWaitSecondsAvg)
+	}
+
+	// A wait left open at the end of an iteration doesn't carry over.
+	waits.start(sim, unit, aplWaitExplicit)
+	waits.reset()
+	sim.CurrentTime = time.Second * 30
+	waits.stop(sim)
+	if waits.totals[aplWaitExplicit] != 0 {
+		t.Fatalf("Expected no explicit wait time after reset, found %s", waits.totals[aplWaitExplicit])
+	}
+}
@@ -148,6 +148,10 @@ func (weapon *Weapon) CalculateNormalizedWeaponDamage(sim *Simulation, attackPow
 	return weapon.BaseDamage(sim) + (weapon.NormalizedSwingSpeed*attackPower)/weapon.AttackPowerPerDPS
 }
 
+func (weapon *Weapon) CalculateAverageNormalizedWeaponDamage(attackPower float64) float64 {
+	return weapon.AverageDamage() + (weapon.NormalizedSwingSpeed*attackPower)/weapon.AttackPowerPerDPS
+}
+
 func (unit *Unit) MHWeaponDamage(sim *Simulation, attackPower float64) float64 {
 	return unit.AutoAttacks.mh.CalculateWeaponDamage(sim, attackPower)
 }
@@ -49,7 +49,7 @@ type Aura struct {
 	ActionIDForProc ActionID // If set, indicates that this aura is a trigger aura for the specified proc.
 
 	Icd *Cooldown           // The internal cooldown if any
-	Dpm *DynamicProcManager // Dynamic Proc manager for proc trigger auras if any
+	Dpm *DynamicProcManager // Dynamic Proc manager for proc trigger auras, or the auras they trigger, if any
 
 	Duration time.Duration // Duration of aura, upon being applied.
 
@@ -79,6 +79,10 @@ type Aura struct {
 	stacks    int32
 	MaxStacks int32
 
+	// Set while the aura is being removed by expiration rather than consumed,
+	// so stack metrics can tell the two apart.
+	expiring bool
+
 	ExclusiveEffects []*ExclusiveEffect
 
 	// Lifecycle callbacks.
@@ -196,12 +200,23 @@ func (aura *Aura) SetStacks(sim *Simulation, newStacks int32) {
 		panic("MaxStacks required to set Aura stacks: " + aura.Label)
 	}
 	oldStacks := aura.stacks
-	newStacks = min(newStacks, aura.MaxStacks)
+	if newStacks > aura.MaxStacks {
+		aura.metrics.StacksOvercapped += int64(newStacks - aura.MaxStacks)
+		newStacks = aura.MaxStacks
+	}
 
 	if oldStacks == newStacks {
 		return
 	}
 
+	if newStacks > oldStacks {
+		aura.metrics.StacksGained += int64(newStacks - oldStacks)
+	} else if aura.expiring {
+		aura.metrics.StacksExpired += int64(oldStacks - newStacks)
+	} else {
+		aura.metrics.StacksConsumed += int64(oldStacks - newStacks)
+	}
+
 	if sim.Log != nil {
 		aura.Unit.Log(sim, "%s stacks: %d --> %d", aura.ActionID, oldStacks, newStacks)
 	}
@@ -451,6 +466,14 @@ func (at *auraTracker) GetIcdAuraByID(actionID ActionID) *Aura {
 	}
 	return nil
 }
+func (at *auraTracker) GetRppmAuraByID(actionID ActionID) *Aura {
+	for _, aura := range at.auras {
+		if (aura.ActionID.SameAction(actionID) || aura.ActionIDForProc.SameAction(actionID)) && aura.Dpm != nil && aura.Dpm.hasRPPMProc() {
+			return aura
+		}
+	}
+	return nil
+}
 func (at *auraTracker) HasAura(label string) bool {
 	aura := at.GetAura(label)
 	return aura != nil
@@ -612,7 +635,7 @@ restart:
 	at.minExpires = NeverExpires
 	for _, aura := range at.activeAuras {
 		if aura.expires <= sim.CurrentTime {
-			aura.Deactivate(sim)
+			aura.expire(sim)
 			goto restart // activeAuras have changed
 		}
 		at.minExpires = min(at.minExpires, aura.expires)
@@ -623,18 +646,26 @@ restart:
 func (at *auraTracker) expireAll(sim *Simulation) {
 restart:
 	for _, aura := range at.activeAuras {
-		aura.Deactivate(sim)
+		aura.expire(sim)
 		goto restart
 	}
 	at.minExpires = NeverExpires
 }
 
+// Deactivates the aura because it ran out or the encounter ended, counting any
+// remaining stacks as expired instead of consumed.
+func (aura *Aura) expire(sim *Simulation) {
+	aura.expiring = true
+	aura.Deactivate(sim)
+	aura.expiring = false
+}
+
 func (at *auraTracker) doneIteration(sim *Simulation) {
 	// deactivate all auras, even permanent ones
 restart:
 	for _, aura := range at.auras {
 		if aura.active {
-			aura.Deactivate(sim)
+			aura.expire(sim)
 			goto restart
 		}
 	}
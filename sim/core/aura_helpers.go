@@ -746,6 +746,62 @@ func (caster *Unit) NewAllyDamageAbsorptionAuraArray(makeAura func(*Unit) *Damag
 	return auras
 }
 
+// Absorbs incoming healing instead of damage, like boss debuffs that have to
+// be healed through. Multiple applications add up until the aura expires or
+// the absorb is fully consumed.
+type HealingAbsorptionAura struct {
+	*Aura
+
+	AbsorbRemaining float64
+}
+
+func (unit *Unit) NewHealingAbsorptionAura(config Aura) *HealingAbsorptionAura {
+	aura := &HealingAbsorptionAura{}
+
+	oldOnExpire := config.OnExpire
+	config.OnExpire = func(auraInner *Aura, sim *Simulation) {
+		aura.AbsorbRemaining = 0
+		if oldOnExpire != nil {
+			oldOnExpire(auraInner, sim)
+		}
+	}
+
+	aura.Aura = unit.RegisterAura(config)
+	unit.healingAbsorptionAuras = append(unit.healingAbsorptionAuras, aura)
+	return aura
+}
+
+// Adds to the remaining absorb, refreshing the aura.
+func (aura *HealingAbsorptionAura) AddAbsorb(sim *Simulation, amount float64) {
+	aura.Activate(sim)
+	aura.AbsorbRemaining += amount
+}
+
+// Returns the healing left after active healing absorbs on the unit consume
+// as much of it as they can.
+func (unit *Unit) absorbHealing(sim *Simulation, amount float64) float64 {
+	for _, aura := range unit.healingAbsorptionAuras {
+		if amount <= 0 {
+			break
+		}
+		if !aura.IsActive() {
+			continue
+		}
+
+		absorbed := min(amount, aura.AbsorbRemaining)
+		aura.AbsorbRemaining -= absorbed
+		amount -= absorbed
+
+		if sim.Log != nil {
+			unit.Log(sim, "%s absorbed %0.3f healing, %0.3f remaining.", aura.Label, absorbed, aura.AbsorbRemaining)
+		}
+		if aura.AbsorbRemaining <= 0 {
+			aura.Deactivate(sim)
+		}
+	}
+	return amount
+}
+
 func ApplyFixedUptimeAura(aura *Aura, uptime float64, tickLength time.Duration, startTime time.Duration) {
 	auraDuration := aura.Duration
 	ticksPerAura := float64(auraDuration) / float64(tickLength)
@@ -0,0 +1,37 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAuraStackMetrics(t *testing.T) {
+	sim := &Simulation{}
+
+	unit := Unit{
+		Type:        PlayerUnit,
+		Level:       CharacterLevel,
+		auraTracker: newAuraTracker(),
+	}
+	aura := unit.RegisterAura(Aura{
+		Label:     "Stacking Proc",
+		ActionID:  ActionID{SpellID: 1},
+		Duration:  time.Second * 10,
+		MaxStacks: 2,
+	})
+
+	aura.Activate(sim)
+	aura.AddStack(sim)
+	aura.AddStack(sim)
+	aura.AddStack(sim) // Overcaps
+	aura.RemoveStack(sim)
+
+	sim.CurrentTime = time.Second * 10
+	unit.auraTracker.advance(sim)
+
+	metrics := aura.metrics
+	if metrics.StacksGained != 2 || metrics.StacksOvercapped != 1 || metrics.StacksConsumed != 1 || metrics.StacksExpired != 1 {
+		t.Fatalf("Unexpected stack metrics: gained %d, overcapped %d, consumed %d, expired %d",
+			metrics.StacksGained, metrics.StacksOvercapped, metrics.StacksConsumed, metrics.StacksExpired)
+	}
+}
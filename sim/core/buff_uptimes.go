@@ -0,0 +1,103 @@
+package core
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Buffs and debuffs with a partial uptime are missing for the last part of
+// every cycle.
+const buffUptimeCycle = time.Second * 30
+
+// Returns a copy of the buffs with the overridden fields forced on or off, and
+// the fields with a partial uptime, which are left off in the copy.
+func resolveUptimeOverrides[T googleProto.Message](buffs T, overrides []*proto.BuffUptimeOverride) (T, map[string]float64) {
+	if len(overrides) == 0 {
+		return buffs, nil
+	}
+
+	resolved := googleProto.Clone(buffs).(T)
+	message := resolved.ProtoReflect()
+	partialUptimes := make(map[string]float64)
+	for _, override := range overrides {
+		field := message.Descriptor().Fields().ByName(protoreflect.Name(override.Field))
+		if field == nil || field.Kind() != protoreflect.BoolKind {
+			panic(fmt.Sprintf("Invalid uptime override field %s for %s", override.Field, message.Descriptor().Name()))
+		}
+
+		message.Set(field, protoreflect.ValueOfBool(override.Uptime >= 1))
+		if override.Uptime > 0 && override.Uptime < 1 {
+			partialUptimes[override.Field] = override.Uptime
+		}
+	}
+	return resolved, partialUptimes
+}
+
+// Applies each partial uptime buff on its own through applyEffects, which is
+// called with a message that only has that buff set, and limits the uptime of
+// the permanent auras it registers. Other auras are kept as they are.
+func applyPartialUptimes[T googleProto.Message](unit *Unit, partialUptimes map[string]float64, applyEffects func(T)) {
+	fields := make([]string, 0, len(partialUptimes))
+	for field := range partialUptimes {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+
+	for _, field := range fields {
+		var buffs T
+		buffs = buffs.ProtoReflect().Type().New().Interface().(T)
+		message := buffs.ProtoReflect()
+		message.Set(message.Descriptor().Fields().ByName(protoreflect.Name(field)), protoreflect.ValueOfBool(true))
+
+		numAuras := len(unit.auras)
+		applyEffects(buffs)
+		for _, aura := range unit.auras[numAuras:] {
+			if aura.Duration == NeverExpires {
+				limitAuraUptime(aura, partialUptimes[field])
+			}
+		}
+	}
+}
+
+// Makes a permanent aura drop off for the last (1 - uptime) of every uptime
+// cycle. It is first applied as usual, and brought back with the same stacks
+// when the next cycle starts.
+func limitAuraUptime(aura *Aura, uptime float64) {
+	applyOnReset := aura.OnReset
+	if applyOnReset == nil {
+		return
+	}
+	activeTime := time.Duration(float64(buffUptimeCycle) * uptime)
+
+	aura.OnReset = func(aura *Aura, sim *Simulation) {
+		applyOnReset(aura, sim)
+
+		isUp := true
+		stacks := int32(0)
+		pa := &PendingAction{
+			NextActionAt: sim.CurrentTime + activeTime,
+			Priority:     ActionPriorityAuto,
+		}
+		pa.OnAction = func(sim *Simulation) {
+			if isUp {
+				stacks = aura.GetStacks()
+				aura.Deactivate(sim)
+				pa.NextActionAt = sim.CurrentTime + buffUptimeCycle - activeTime
+			} else {
+				aura.Activate(sim)
+				if stacks > 0 {
+					aura.SetStacks(sim, stacks)
+				}
+				pa.NextActionAt = sim.CurrentTime + activeTime
+			}
+			isUp = !isUp
+			sim.AddPendingAction(pa)
+		}
+		sim.AddPendingAction(pa)
+	}
+}
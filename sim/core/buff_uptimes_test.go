@@ -0,0 +1,37 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestResolveUptimeOverrides(t *testing.T) {
+	debuffs := &proto.Debuffs{
+		WeakenedBlows:   true,
+		CurseOfElements: true,
+		UptimeOverrides: []*proto.BuffUptimeOverride{
+			{Field: "weakened_armor", Uptime: 1},
+			{Field: "curse_of_elements", Uptime: 0},
+			{Field: "physical_vulnerability", Uptime: 0.9},
+		},
+	}
+
+	resolved, partialUptimes := resolveUptimeOverrides(debuffs, debuffs.UptimeOverrides)
+	if !resolved.WeakenedBlows || !resolved.WeakenedArmor || resolved.CurseOfElements || resolved.PhysicalVulnerability {
+		t.Fatalf("Expected overridden debuffs forced on or off, got %v", resolved)
+	}
+	if len(partialUptimes) != 1 || partialUptimes["physical_vulnerability"] != 0.9 {
+		t.Fatalf("Expected only the partial uptime to be returned, got %v", partialUptimes)
+	}
+	if !debuffs.CurseOfElements {
+		t.Fatalf("Expected the request to be left unchanged")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("Expected a panic for an unknown field")
+		}
+	}()
+	resolveUptimeOverrides(debuffs, []*proto.BuffUptimeOverride{{Field: "mana_tide_totem_count", Uptime: 1}})
+}
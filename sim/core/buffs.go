@@ -93,6 +93,11 @@ func applyBuffEffects(agent Agent, raidBuffs *proto.RaidBuffs, _ *proto.PartyBuf
 	char := agent.GetCharacter()
 	u := &char.Unit
 
+	raidBuffs, partialUptimes := resolveUptimeOverrides(raidBuffs, raidBuffs.GetUptimeOverrides())
+	applyPartialUptimes(u, partialUptimes, func(buffs *proto.RaidBuffs) {
+		applyBuffEffects(agent, buffs, &proto.PartyBuffs{}, &proto.IndividualBuffs{})
+	})
+
 	// +10% Attack Power
 	if raidBuffs.HornOfWinter {
 		HornOfWinterAura(u, true)
@@ -214,6 +219,11 @@ func applyBuffEffects(agent Agent, raidBuffs *proto.RaidBuffs, _ *proto.PartyBuf
 		registerGuardianSpiritCD(agent, individual.GuardianSpiritCount)
 		registerRallyingCryCD(agent, individual.RallyingCryCount)
 		registerShatteringThrowCD(agent, individual.ShatteringThrowCount)
+		registerStampedingRoarCD(agent, individual.StampedingRoarCount)
+
+		// External mana cooldowns
+		registerInnervateCD(agent, individual.InnervateCount)
+		registerHymnOfHopeCD(agent, individual.HymnOfHopeCount)
 	}
 }
 
@@ -1085,6 +1095,56 @@ func RallyingCryAuraArray(unit *Unit, actionTag int32) AuraArray {
 
 }
 
+var StampedingRoarAuraTag = "StampedingRoar"
+var StampedingRoarActionID = ActionID{SpellID: 106898}
+
+const StampedingRoarDuration = time.Second * 8
+const StampedingRoarCD = time.Minute * 2
+
+// Only used while moving, so it shortens forced movement.
+func registerStampedingRoarCD(agent Agent, numStampedingRoars int32) {
+	if numStampedingRoars == 0 {
+		return
+	}
+
+	roarAura := StampedingRoarAura(agent.GetCharacter(), -1)
+
+	registerExternalConsecutiveCDApproximation(
+		agent,
+		externalConsecutiveCDApproximation{
+			ActionID:         StampedingRoarActionID.WithTag(-1),
+			AuraTag:          StampedingRoarAuraTag,
+			CooldownPriority: CooldownPriorityLow,
+			RelatedSelfBuff:  roarAura,
+			AuraDuration:     StampedingRoarDuration,
+			AuraCD:           StampedingRoarCD,
+			Type:             CooldownTypeDPS,
+
+			ShouldActivate: func(_ *Simulation, character *Character) bool {
+				return character.Moving
+			},
+
+			AddAura: func(sim *Simulation, _ *Character) {
+				roarAura.Activate(sim)
+			},
+		},
+		numStampedingRoars,
+	)
+}
+
+func StampedingRoarAura(character *Character, actionTag int32) *Aura {
+	actionID := StampedingRoarActionID.WithTag(actionTag)
+
+	aura := character.GetOrRegisterAura(Aura{
+		Label:    "StampedingRoar-" + actionID.String(),
+		Tag:      StampedingRoarAuraTag,
+		ActionID: actionID,
+		Duration: StampedingRoarDuration,
+	})
+	aura.NewActiveMovementSpeedEffect(0.6)
+	return aura
+}
+
 const ShatteringThrowCD = time.Minute * 5
 
 func registerShatteringThrowCD(agent Agent, numShatteringThrows int32) {
@@ -1230,6 +1290,148 @@ func ManaTideTotemAura(character *Character, actionTag int32) *Aura {
 	}).AttachStatDependency(dep)
 }
 
+var InnervateActionID = ActionID{SpellID: 29166}
+var InnervateAuraTag = "Innervate"
+
+const InnervateDuration = time.Second * 10
+const InnervateCD = time.Minute * 3
+
+// Innervate restores 10% of the casting druid's maximum mana. Externals in
+// individual sims assume a Restoration Druid with a 300k mana pool.
+const InnervateManaPercent = 0.1
+const InnervateExternalMana = InnervateManaPercent * 300000
+
+func registerInnervateCD(agent Agent, numInnervates int32) {
+	if numInnervates == 0 {
+		return
+	}
+
+	character := agent.GetCharacter()
+	if !character.HasManaBar() {
+		return
+	}
+
+	innervateAura := InnervateAura(&character.Unit, -1, func() float64 { return InnervateExternalMana })
+
+	registerExternalConsecutiveCDApproximation(
+		agent,
+		externalConsecutiveCDApproximation{
+			ActionID:         InnervateActionID.WithTag(-1),
+			AuraTag:          InnervateAuraTag,
+			CooldownPriority: CooldownPriorityDefault,
+			RelatedSelfBuff:  innervateAura,
+			AuraDuration:     InnervateDuration,
+			AuraCD:           InnervateCD,
+			Type:             CooldownTypeMana,
+			ShouldActivate: func(sim *Simulation, character *Character) bool {
+				return InnervateShouldActivate(&character.Unit, InnervateExternalMana)
+			},
+			AddAura: func(sim *Simulation, character *Character) {
+				innervateAura.Activate(sim)
+			},
+		},
+		numInnervates)
+}
+
+// Innervate should be used once the whole restore fits under the mana cap,
+// so that none of it is wasted and other mana cooldowns aren't delayed.
+func InnervateShouldActivate(unit *Unit, manaAmount float64) bool {
+	return unit.CurrentMana() <= unit.MaxMana()-manaAmount
+}
+
+// Restores manaAmount() over the duration of the aura, evaluated when Innervate
+// is applied.
+func InnervateAura(unit *Unit, actionTag int32, manaAmount func() float64) *Aura {
+	actionID := InnervateActionID.WithTag(actionTag)
+	manaMetrics := unit.NewManaMetrics(actionID)
+	const numTicks = 10
+
+	var manaPerTick float64
+	var tickAction *PendingAction
+	return unit.GetOrRegisterAura(Aura{
+		Label:    "Innervate-" + actionID.String(),
+		Tag:      InnervateAuraTag,
+		ActionID: actionID,
+		Duration: InnervateDuration,
+		OnGain: func(aura *Aura, sim *Simulation) {
+			manaPerTick = manaAmount() / numTicks
+			tickAction = StartPeriodicAction(sim, PeriodicActionOptions{
+				Period:   InnervateDuration / numTicks,
+				NumTicks: numTicks,
+				OnAction: func(sim *Simulation) {
+					aura.Unit.AddMana(sim, manaPerTick, manaMetrics)
+				},
+			})
+		},
+		OnExpire: func(aura *Aura, sim *Simulation) {
+			tickAction.Cancel(sim)
+		},
+	})
+}
+
+var HymnOfHopeActionID = ActionID{SpellID: 64901}
+var HymnOfHopeAuraTag = "HymnOfHope"
+
+const HymnOfHopeDuration = time.Second * 8
+const HymnOfHopeCD = time.Minute * 6
+const HymnOfHopeNumTicks = 4
+
+// Each tick of Hymn of Hope restores 2% of the target's maximum mana.
+const HymnOfHopeManaPercentPerTick = 0.02
+
+func registerHymnOfHopeCD(agent Agent, numHymnsOfHope int32) {
+	if numHymnsOfHope == 0 {
+		return
+	}
+
+	character := agent.GetCharacter()
+	if !character.HasManaBar() {
+		return
+	}
+
+	hymnAura := HymnOfHopeAura(character, -1)
+	manaMetrics := character.NewManaMetrics(HymnOfHopeActionID.WithTag(-1))
+
+	registerExternalConsecutiveCDApproximation(
+		agent,
+		externalConsecutiveCDApproximation{
+			ActionID:         HymnOfHopeActionID.WithTag(-1),
+			AuraTag:          HymnOfHopeAuraTag,
+			CooldownPriority: CooldownPriorityDefault,
+			RelatedSelfBuff:  hymnAura,
+			AuraDuration:     HymnOfHopeDuration,
+			AuraCD:           HymnOfHopeCD,
+			Type:             CooldownTypeMana,
+			ShouldActivate: func(sim *Simulation, character *Character) bool {
+				return character.CurrentManaPercent() < 0.5
+			},
+			AddAura: func(sim *Simulation, character *Character) {
+				hymnAura.Activate(sim)
+				StartPeriodicAction(sim, PeriodicActionOptions{
+					Period:   HymnOfHopeDuration / HymnOfHopeNumTicks,
+					NumTicks: HymnOfHopeNumTicks,
+					OnAction: func(sim *Simulation) {
+						character.AddMana(sim, character.MaxMana()*HymnOfHopeManaPercentPerTick, manaMetrics)
+					},
+				})
+			},
+		},
+		numHymnsOfHope)
+}
+
+// Increases maximum mana by 15% while the target is being channeled on. The
+// mana restored by each tick is dealt by the channel, not the aura.
+func HymnOfHopeAura(character *Character, actionTag int32) *Aura {
+	actionID := HymnOfHopeActionID.WithTag(actionTag)
+	dep := character.NewDynamicMultiplyStat(stats.Mana, 1.15)
+	return character.GetOrRegisterAura(Aura{
+		Label:    "HymnOfHope-" + actionID.String(),
+		Tag:      HymnOfHopeAuraTag,
+		ActionID: actionID,
+		Duration: HymnOfHopeDuration,
+	}).AttachStatDependency(dep)
+}
+
 const StormLashAuraTag = "StormLash"
 const StormLashDuration = time.Second * 10
 const StormLashCD = time.Minute * 5
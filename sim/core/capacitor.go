@@ -0,0 +1,89 @@
+package core
+
+// Which enemy a capacitor discharges at.
+type CapacitorTargeting int32
+
+const (
+	// The target of the hit which added the final charge.
+	CapacitorTargetLastCharge CapacitorTargeting = iota
+	// The owner's current target at the time of the discharge.
+	CapacitorTargetCurrent
+	// A random active enemy.
+	CapacitorTargetRandom
+)
+
+// Configures an effect which accumulates charges and discharges once it has
+// enough of them, like Capacitive Primal Diamond.
+type CapacitorConfig struct {
+	// The aura holding the charges. MaxStacks is the number of charges needed
+	// to discharge.
+	Aura Aura
+
+	// Cast at the selected target on discharge, usually a damage spell.
+	Discharge *Spell
+
+	Targeting CapacitorTargeting
+}
+
+// An effect which accumulates charges and discharges once it has enough.
+type Capacitor struct {
+	Aura *Aura
+
+	discharge  *Spell
+	targeting  CapacitorTargeting
+	lastTarget *Unit
+}
+
+// Registers a capacitor, which the APL can look up by the action ID of its
+// aura.
+func (unit *Unit) NewCapacitor(config CapacitorConfig) *Capacitor {
+	if config.Aura.MaxStacks <= 0 || config.Discharge == nil {
+		panic("Capacitor " + config.Aura.Label + " needs max stacks and a discharge spell")
+	}
+
+	capacitor := &Capacitor{
+		discharge: config.Discharge,
+		targeting: config.Targeting,
+	}
+	capacitor.Aura = unit.RegisterAura(config.Aura)
+	unit.capacitors = append(unit.capacitors, capacitor)
+	return capacitor
+}
+
+// Adds a charge from a hit on the given target, discharging if this was the
+// last charge needed.
+func (capacitor *Capacitor) AddCharge(sim *Simulation, target *Unit) {
+	capacitor.lastTarget = target
+	capacitor.Aura.Activate(sim)
+	capacitor.Aura.AddStack(sim)
+
+	if capacitor.Aura.GetStacks() == capacitor.Aura.MaxStacks {
+		capacitor.Aura.SetStacks(sim, 0)
+		capacitor.discharge.Cast(sim, capacitor.selectTarget(sim))
+	}
+}
+
+// Number of charges still needed before the next discharge.
+func (capacitor *Capacitor) ChargesToDischarge() int32 {
+	return capacitor.Aura.MaxStacks - capacitor.Aura.GetStacks()
+}
+
+func (capacitor *Capacitor) selectTarget(sim *Simulation) *Unit {
+	switch capacitor.targeting {
+	case CapacitorTargetCurrent:
+		return capacitor.Aura.Unit.CurrentTarget
+	case CapacitorTargetRandom:
+		targets := sim.Encounter.ActiveTargetUnits
+		return targets[int(sim.RandomFloat(capacitor.Aura.Label)*float64(len(targets)))]
+	}
+	return capacitor.lastTarget
+}
+
+func (unit *Unit) GetCapacitor(auraID ActionID) *Capacitor {
+	for _, capacitor := range unit.capacitors {
+		if capacitor.Aura.ActionID.SameAction(auraID) {
+			return capacitor
+		}
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCapacitorCharges(t *testing.T) {
+	sim := &Simulation{}
+
+	unit := Unit{
+		Type:        PlayerUnit,
+		Level:       CharacterLevel,
+		auraTracker: newAuraTracker(),
+	}
+	target := &Unit{Type: EnemyUnit}
+
+	capacitor := unit.NewCapacitor(CapacitorConfig{
+		Aura: Aura{
+			Label:     "Capacitance",
+			ActionID:  ActionID{SpellID: 1},
+			Duration:  time.Minute,
+			MaxStacks: 5,
+		},
+		Discharge: unit.RegisterSpell(SpellConfig{ActionID: ActionID{SpellID: 2}}),
+	})
+
+	if charges := capacitor.ChargesToDischarge(); charges != 5 {
+		t.Fatalf("Expected 5 charges to discharge before any hits, found %d", charges)
+	}
+
+	capacitor.AddCharge(sim, target)
+	capacitor.AddCharge(sim, target)
+	if charges := capacitor.ChargesToDischarge(); charges != 3 {
+		t.Fatalf("Expected 3 charges to discharge after 2 hits, found %d", charges)
+	}
+	if discharged := capacitor.selectTarget(sim); discharged != target {
+		t.Fatalf("Expected to discharge at the target of the last charge")
+	}
+
+	if unit.GetCapacitor(ActionID{SpellID: 1}) != capacitor || unit.GetCapacitor(ActionID{SpellID: 2}) != nil {
+		t.Fatalf("Expected capacitors to be looked up by the action ID of their aura")
+	}
+}
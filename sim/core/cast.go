@@ -20,6 +20,9 @@ type Hardcast struct {
 	OnComplete func(*Simulation, *Unit)
 	Target     *Unit
 	CanMove    bool
+
+	// Set for casts which can't be interrupted or pushed back.
+	Uninterruptible bool
 }
 
 // Input for constructing the CastSpell function for a spell.
@@ -127,6 +130,10 @@ func (spell *Spell) makeCastFunc(config CastConfig) CastSuccessFunc {
 			return spell.castFailureHelper(sim, "not enough charges")
 		}
 
+		if spell.Unit.IsLockedOut(sim, spell.SpellSchool) {
+			return spell.castFailureHelper(sim, "spell school is locked out")
+		}
+
 		if !config.IgnoreHaste {
 			spell.CurCast.GCD = max(0, spell.Unit.ApplyCastSpeed(spell.CurCast.GCD)).Round(time.Millisecond)
 			spell.CurCast.CastTime = spell.Unit.ApplyCastSpeedForSpell(spell.CurCast.CastTime, spell).Round(time.Millisecond)
@@ -174,6 +181,10 @@ func (spell *Spell) makeCastFunc(config CastConfig) CastSuccessFunc {
 			return spell.castFailureHelper(sim, "casting/channeling while moving not allowed!")
 		}
 
+		if spell.CurCast.GCD > 0 {
+			spell.Unit.previousGCDSpell = spell
+		}
+
 		// Hardcasts
 		if spell.CurCast.CastTime > 0 {
 			if sim.Log != nil && !spell.Flags.Matches(SpellFlagNoLogs) {
@@ -194,7 +205,7 @@ func (spell *Spell) makeCastFunc(config CastConfig) CastSuccessFunc {
 					}
 
 					if spell.Cost != nil {
-						spell.Cost.SpendCost(sim, spell)
+						spell.spendCost(sim, target)
 					}
 
 					if spell.MaxCharges > 0 {
@@ -230,7 +241,7 @@ func (spell *Spell) makeCastFunc(config CastConfig) CastSuccessFunc {
 		}
 
 		if spell.Cost != nil {
-			spell.Cost.SpendCost(sim, spell)
+			spell.spendCost(sim, target)
 		}
 
 		if spell.MaxCharges > 0 {
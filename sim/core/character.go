@@ -81,6 +81,13 @@ type Character struct {
 	// This stores a timer on spell category ID so that we can track on use effects.
 	spellCategoryTimers map[int32]*Timer
 
+	// Only set when the sim was asked to plan cooldowns.
+	cooldownPlanner *cooldownPlanner
+
+	// Computed on the first reset, once permanent buffs are active.
+	dotBreakpoints         []*proto.DotBreakpoint
+	dotBreakpointsComputed bool
+
 	Pets []*Pet // cached in AddPet, for advance()
 }
 
@@ -103,6 +110,7 @@ func NewCharacter(party *Party, partyIndex int, player *proto.Player) Character
 
 			ReactionTime:            time.Duration(max(player.ReactionTimeMs, 10)) * time.Millisecond,
 			ChannelClipDelay:        max(0, time.Duration(player.ChannelClipDelayMs)*time.Millisecond),
+			SpellQueueWindow:        min(max(0, time.Duration(player.SpellQueueWindowMs)*time.Millisecond), MaxSpellQueueWindow),
 			StartDistanceFromTarget: player.DistanceFromTarget,
 			DistanceFromTarget:      player.DistanceFromTarget,
 		},
@@ -127,6 +135,10 @@ func NewCharacter(party *Party, partyIndex int, player *proto.Player) Character
 	character.GCD = character.NewTimer()
 	character.RotationTimer = character.NewTimer()
 
+	if character.skill = newPlayerSkill(player.SkillProfile); character.skill != nil {
+		character.ReactionTime += character.skill.addedLatency()
+	}
+
 	character.Label = fmt.Sprintf("%s (#%d)", character.Name, character.Index+1)
 
 	if player.Glyphs != nil {
@@ -436,9 +448,13 @@ func (character *Character) Finalize() {
 
 	character.PseudoStats.ParryHaste = character.PseudoStats.CanParry
 
+	character.applySharedCooldownGroups()
+
 	character.Unit.finalize()
 
 	character.majorCooldownManager.finalize()
+
+	character.applyCooldownDelays()
 }
 
 func (character *Character) FillPlayerStats(playerStats *proto.PlayerStats) {
@@ -473,6 +489,18 @@ func (character *Character) reset(sim *Simulation, agent Agent) {
 	character.majorCooldownManager.reset(sim)
 	character.CurrentTarget = character.defaultTarget
 
+	if !character.dotBreakpointsComputed {
+		character.dotBreakpoints = character.computeDotBreakpoints()
+		character.dotBreakpointsComputed = true
+	}
+
+	if character.cooldownPlanner != nil {
+		character.cooldownPlanner.reset(sim)
+	}
+	if character.skill != nil {
+		character.skill.reset()
+	}
+
 	agent.Reset(sim)
 
 	character.ItemSwap.reset(sim)
@@ -655,10 +683,20 @@ func (character *Character) GetProcMaskForWeaponSlot(slot proto.ItemSlot) ProcMa
 func (character *Character) doneIteration(sim *Simulation) {
 	character.ItemSwap.doneIteration(sim)
 
+	if character.Rotation != nil {
+		character.Rotation.waits.stop(sim)
+	}
+
+	if character.cooldownPlanner != nil {
+		character.cooldownPlanner.doneIteration()
+	}
+
 	// Need to do pets first, so we can add their results to the owners.
 	for _, pet := range character.Pets {
 		pet.doneIteration(sim)
-		character.Metrics.AddFinalPetMetrics(&pet.Metrics)
+		if sim.Options.PetMetricsMode != proto.PetMetricsMode_PetMetricsSeparate {
+			character.Metrics.AddFinalPetMetrics(&pet.Metrics)
+		}
 	}
 
 	character.Unit.doneIteration(sim)
@@ -705,20 +743,38 @@ func (character *Character) GetMetricsProto() *proto.UnitMetrics {
 		metrics.Pets[i] = pet.GetMetricsProto()
 	}
 
+	if character.cooldownPlanner != nil {
+		metrics.CooldownPlan = character.cooldownPlanner.toProto()
+	}
+	if character.Rotation != nil && character.Rotation.trace != nil {
+		metrics.RotationTrace = character.Rotation.trace.toProto()
+	}
+	if character.Type == PlayerUnit && character.Rotation != nil && (character.Metrics.dps.n > 0) {
+		metrics.RotationWaits = character.Rotation.waits.toProto(float64(character.Metrics.dps.n))
+	}
+	metrics.DotBreakpoints = character.dotBreakpoints
+	if character.Spec != proto.Spec_SpecUnknown {
+		metrics.ModelingStatus = GetModelingStatus(character.Spec)
+	}
+
+	if economy, ok := character.secondaryResourceBar.(secondaryResourceEconomy); ok && (character.Metrics.dps.n > 0) {
+		metrics.SecondaryResource = economy.economyToProto(float64(character.Metrics.dps.n))
+	}
+
 	return metrics
 }
 
 func (character *Character) GetDefensiveTrinketCD() *Timer {
-	return character.GetOrInitSpellCategoryTimer(1190)
+	return character.GetOrInitSpellCategoryTimer(SpellCategoryDefensiveTrinket)
 }
 func (character *Character) GetOffensiveTrinketCD() *Timer {
-	return character.GetOrInitSpellCategoryTimer(1141)
+	return character.GetOrInitSpellCategoryTimer(SpellCategoryOffensiveTrinket)
 }
 func (character *Character) GetConjuredCD() *Timer {
-	return character.GetOrInitSpellCategoryTimer(30)
+	return character.GetOrInitSpellCategoryTimer(SpellCategoryConjured)
 }
 func (character *Character) GetPotionCD() *Timer {
-	return character.GetOrInitSpellCategoryTimer(4)
+	return character.GetOrInitSpellCategoryTimer(SpellCategoryPotion)
 }
 
 func (character *Character) AddStatProcBuff(effectID int32, procAura *StatBuffAura, isEnchant bool, eligibleSlots []proto.ItemSlot) {
@@ -742,6 +798,9 @@ func (character *Character) GetMatchingItemProcAuras(statTypesToMatch []stats.St
 // Uses proto reflection to set fields in a talents proto (e.g. MageTalents,
 // WarriorTalents) based on a talentsStr.
 func FillTalentsProto(data protoreflect.Message, talentsStr string) {
+	if err := ValidateTalentsString(talentsStr); err != nil {
+		panic(fmt.Sprintf("Invalid talents string %s: %s", talentsStr, err))
+	}
 	fieldDescriptors := data.Descriptor().Fields()
 
 	for talentIdx, talentValStr := range talentsStr {
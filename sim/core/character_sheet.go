@@ -0,0 +1,64 @@
+package core
+
+import (
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/core/stats"
+)
+
+// Builds the fully buffed paperdoll for this character. Must be called after
+// the environment has been finalized.
+func (character *Character) GetCharacterSheet() *proto.CharacterSheet {
+	character.applyBuildPhaseAuras(CharacterBuildPhaseAll)
+	defer character.clearBuildPhaseAuras(CharacterBuildPhaseAll)
+
+	pseudoStats := character.GetPseudoStatsProto()
+
+	return &proto.CharacterSheet{
+		Name:        character.Name,
+		PartyIndex:  int32(character.Party.Index),
+		PlayerIndex: int32(character.PartyIndex),
+
+		FinalStats: &proto.UnitStats{
+			Stats:       character.GetStats().ToProtoArray(),
+			PseudoStats: pseudoStats,
+			ApiVersion:  GetCurrentProtoVersion(),
+		},
+
+		PhysicalHitPercent:  pseudoStats[proto.PseudoStat_PseudoStatPhysicalHitPercent],
+		SpellHitPercent:     pseudoStats[proto.PseudoStat_PseudoStatSpellHitPercent],
+		ExpertisePercent:    character.GetStat(stats.ExpertiseRating) / ExpertisePerQuarterPercentReduction / 4,
+		PhysicalCritPercent: pseudoStats[proto.PseudoStat_PseudoStatPhysicalCritPercent],
+		SpellCritPercent:    pseudoStats[proto.PseudoStat_PseudoStatSpellCritPercent],
+		MeleeHastePercent:   pseudoStats[proto.PseudoStat_PseudoStatMeleeHastePercent],
+		RangedHastePercent:  pseudoStats[proto.PseudoStat_PseudoStatRangedHastePercent],
+		SpellHastePercent:   pseudoStats[proto.PseudoStat_PseudoStatSpellHastePercent],
+		MasteryPoints:       character.GetMasteryPoints(),
+
+		Armor:        character.Armor(),
+		DodgePercent: pseudoStats[proto.PseudoStat_PseudoStatDodgePercent],
+		ParryPercent: pseudoStats[proto.PseudoStat_PseudoStatParryPercent],
+		BlockPercent: pseudoStats[proto.PseudoStat_PseudoStatBlockPercent],
+
+		Resources: character.getResourceMaximums(),
+	}
+}
+
+func (character *Character) getResourceMaximums() []*proto.ResourceMaximum {
+	resources := []*proto.ResourceMaximum{
+		{Type: proto.ResourceType_ResourceTypeHealth, Value: character.MaxHealth()},
+	}
+
+	addResource := func(hasBar bool, resourceType proto.ResourceType, value func() float64) {
+		if hasBar {
+			resources = append(resources, &proto.ResourceMaximum{Type: resourceType, Value: value()})
+		}
+	}
+
+	addResource(character.HasManaBar(), proto.ResourceType_ResourceTypeMana, character.MaxMana)
+	addResource(character.HasEnergyBar(), proto.ResourceType_ResourceTypeEnergy, character.MaximumEnergy)
+	addResource(character.HasRageBar(), proto.ResourceType_ResourceTypeRage, character.MaximumRage)
+	addResource(character.HasFocusBar(), proto.ResourceType_ResourceTypeFocus, character.MaximumFocus)
+	addResource(character.HasRunicPowerBar(), proto.ResourceType_ResourceTypeRunicPower, character.MaximumRunicPower)
+
+	return resources
+}
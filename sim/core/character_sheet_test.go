@@ -0,0 +1,24 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestComputeCharacterSheetRejectsInvalidRequests(t *testing.T) {
+	if result := ComputeCharacterSheet(&proto.ComputeCharacterSheetRequest{}); result.Error == nil {
+		t.Fatalf("Expected an error without a raid")
+	}
+
+	// No agent is registered for a mage without a spec, so constructing the
+	// character panics.
+	result := ComputeCharacterSheet(&proto.ComputeCharacterSheetRequest{
+		Raid: &proto.Raid{
+			Parties: []*proto.Party{{Players: []*proto.Player{{Name: "Mage", Class: proto.Class_ClassMage}}}},
+		},
+	})
+	if result.Error == nil || len(result.Players) != 0 {
+		t.Fatalf("Expected an error for a player without a spec, got %v", result)
+	}
+}
@@ -0,0 +1,242 @@
+package core
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/core/simsignals"
+	"github.com/wowsims/mop/sim/core/stats"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+var defaultComparedConsumableTypes = []proto.ConsumableType{
+	proto.ConsumableType_ConsumableTypeFlask,
+	proto.ConsumableType_ConsumableTypeFood,
+	proto.ConsumableType_ConsumableTypePotion,
+}
+
+// Returns the ID of the consumable currently used for the given type, or 0.
+func getConsumableIDForType(consumes *proto.ConsumesSpec, consumableType proto.ConsumableType) int32 {
+	switch consumableType {
+	case proto.ConsumableType_ConsumableTypeFlask:
+		return consumes.FlaskId
+	case proto.ConsumableType_ConsumableTypeBattleElixir:
+		return consumes.BattleElixirId
+	case proto.ConsumableType_ConsumableTypeGuardianElixir:
+		return consumes.GuardianElixirId
+	case proto.ConsumableType_ConsumableTypeFood:
+		return consumes.FoodId
+	case proto.ConsumableType_ConsumableTypePotion:
+		return consumes.PotId
+	case proto.ConsumableType_ConsumableTypeExplosive:
+		return consumes.ExplosiveId
+	}
+	return 0
+}
+
+// Swaps the consumable into the spec, clearing anything it cannot be used
+// alongside. Returns false for types that can't be compared.
+func setConsumableForType(consumes *proto.ConsumesSpec, consumable Consumable) bool {
+	switch consumable.Type {
+	case proto.ConsumableType_ConsumableTypeFlask:
+		consumes.FlaskId = consumable.Id
+		consumes.BattleElixirId = 0
+		consumes.GuardianElixirId = 0
+	case proto.ConsumableType_ConsumableTypeBattleElixir:
+		consumes.BattleElixirId = consumable.Id
+		consumes.FlaskId = 0
+	case proto.ConsumableType_ConsumableTypeGuardianElixir:
+		consumes.GuardianElixirId = consumable.Id
+		consumes.FlaskId = 0
+	case proto.ConsumableType_ConsumableTypeFood:
+		consumes.FoodId = consumable.Id
+	case proto.ConsumableType_ConsumableTypePotion:
+		// Keep the prepot in sync, since both share a cooldown.
+		if consumes.PrepotId != 0 || consumes.PotId == 0 {
+			consumes.PrepotId = consumable.Id
+		}
+		consumes.PotId = consumable.Id
+	case proto.ConsumableType_ConsumableTypeExplosive:
+		consumes.ExplosiveId = consumable.Id
+	default:
+		return false
+	}
+	return true
+}
+
+// Whether a consumable only buffs stats that are useful to a character with
+// the given main stat.
+func isViableConsumable(consumable Consumable, mainStat stats.Stat, isTank bool) bool {
+	if consumable.BuffsMainStat {
+		return true
+	}
+
+	hasUsefulStat := false
+	for stat, value := range consumable.Stats {
+		if value <= 0 {
+			continue
+		}
+
+		switch stats.Stat(stat) {
+		case stats.Strength, stats.Agility, stats.Intellect:
+			if stats.Stat(stat) != mainStat {
+				return false
+			}
+		case stats.Spirit:
+			if mainStat != stats.Intellect {
+				return false
+			}
+		case stats.Stamina, stats.Armor, stats.BonusArmor, stats.DodgeRating, stats.ParryRating:
+			if !isTank {
+				return false
+			}
+		}
+		hasUsefulStat = true
+	}
+
+	return hasUsefulStat
+}
+
+func getConsumableCandidates(request *proto.ConsumableComparisonRequest) []Consumable {
+	if len(request.CandidateIds) > 0 {
+		var candidates []Consumable
+		for _, id := range request.CandidateIds {
+			if consumable, ok := ConsumablesByID[id]; ok {
+				candidates = append(candidates, consumable)
+			}
+		}
+		return candidates
+	}
+
+	types := request.Types
+	if len(types) == 0 {
+		types = defaultComparedConsumableTypes
+	}
+
+	raidProto := SinglePlayerRaidProto(googleProto.Clone(request.Player).(*proto.Player), request.PartyBuffs, request.RaidBuffs, request.Debuffs)
+	env, _, _ := NewEnvironment(raidProto, request.Encounter, false)
+	character := env.Raid.Parties[0].Players[0].GetCharacter()
+	mainStat := character.GetHighestStatType([]stats.Stat{stats.Strength, stats.Agility, stats.Intellect})
+
+	isTank := slices.ContainsFunc(request.Tanks, func(ref *proto.UnitReference) bool {
+		return ref.Type == proto.UnitReference_Player && ref.Index == 0
+	})
+
+	var candidates []Consumable
+	for _, consumable := range ConsumablesByID {
+		if slices.Contains(types, consumable.Type) && isViableConsumable(consumable, mainStat, isTank) {
+			candidates = append(candidates, consumable)
+		}
+	}
+
+	slices.SortFunc(candidates, func(a, b Consumable) int {
+		return cmp.Compare(a.Id, b.Id)
+	})
+	return candidates
+}
+
+// Sims each alternative consumable against the player's current consumes.
+// RNG is fixed across sims, so per-iteration deltas have very low variance.
+func runConsumableComparison(request *proto.ConsumableComparisonRequest, signals simsignals.Signals) *proto.ConsumableComparisonResult {
+	if request.Player == nil {
+		return &proto.ConsumableComparisonResult{Error: &proto.ErrorOutcome{Message: "No player to compare consumables for"}}
+	}
+	if request.SimOptions == nil {
+		return &proto.ConsumableComparisonResult{Error: &proto.ErrorOutcome{Message: "No sim options"}}
+	}
+	if request.Encounter == nil {
+		return &proto.ConsumableComparisonResult{Error: &proto.ErrorOutcome{Message: "No encounter"}}
+	}
+
+	// Work on a copy, so the caller's player is left untouched.
+	request = googleProto.Clone(request).(*proto.ConsumableComparisonRequest)
+	if request.Player.Consumables == nil {
+		request.Player.Consumables = &proto.ConsumesSpec{}
+	}
+
+	simOptions := googleProto.Clone(request.SimOptions).(*proto.SimOptions)
+	simOptions.SaveAllValues = true
+	simOptions.UseLabeledRands = true
+	if simOptions.RandomSeed == 0 {
+		simOptions.RandomSeed = time.Now().UnixNano()
+	}
+
+	newRequest := func(consumes *proto.ConsumesSpec) *proto.RaidSimRequest {
+		player := googleProto.Clone(request.Player).(*proto.Player)
+		player.Consumables = consumes
+
+		raidProto := SinglePlayerRaidProto(player, request.PartyBuffs, request.RaidBuffs, request.Debuffs)
+		raidProto.Tanks = request.Tanks
+
+		return &proto.RaidSimRequest{
+			Raid:       raidProto,
+			Encounter:  request.Encounter,
+			SimOptions: simOptions,
+		}
+	}
+
+	simFunc := runSimConcurrent
+	// Don't use go threads in wasm, it just adds more overhead and makes the worker more unresponsive.
+	if IsRunningInWasm() {
+		simFunc = RunSim
+	}
+
+	baseResult := simFunc(newRequest(request.Player.Consumables), nil, signals)
+	if baseResult.Error != nil {
+		return &proto.ConsumableComparisonResult{Error: baseResult.Error}
+	}
+	basePlayer := baseResult.RaidMetrics.Parties[0].Players[0]
+
+	result := &proto.ConsumableComparisonResult{
+		BaseDps: basePlayer.Dps.Avg,
+		BaseHps: basePlayer.Hps.Avg,
+		BaseTps: basePlayer.Threat.Avg,
+	}
+
+	for _, consumable := range getConsumableCandidates(request) {
+		if getConsumableIDForType(request.Player.Consumables, consumable.Type) == consumable.Id {
+			continue
+		}
+
+		consumes := googleProto.Clone(request.Player.Consumables).(*proto.ConsumesSpec)
+		if !setConsumableForType(consumes, consumable) {
+			continue
+		}
+
+		simResult := simFunc(newRequest(consumes), nil, signals)
+		if simResult.Error != nil {
+			return &proto.ConsumableComparisonResult{Error: simResult.Error}
+		}
+		player := simResult.RaidMetrics.Parties[0].Players[0]
+
+		var dpsDelta aggregator
+		for i := range basePlayer.Dps.AllValues {
+			dpsDelta.add(player.Dps.AllValues[i] - basePlayer.Dps.AllValues[i])
+		}
+		dpsDeltaMean, dpsDeltaStdev := dpsDelta.meanAndStdDev()
+
+		result.Comparisons = append(result.Comparisons, &proto.ConsumableComparison{
+			Type:          consumable.Type,
+			ConsumableId:  consumable.Id,
+			Name:          consumable.Name,
+			Dps:           player.Dps.Avg,
+			DpsDelta:      dpsDeltaMean,
+			DpsDeltaStdev: dpsDeltaStdev,
+			Hps:           player.Hps.Avg,
+			HpsDelta:      player.Hps.Avg - basePlayer.Hps.Avg,
+			Tps:           player.Threat.Avg,
+			TpsDelta:      player.Threat.Avg - basePlayer.Threat.Avg,
+		})
+	}
+
+	slices.SortStableFunc(result.Comparisons, func(a, b *proto.ConsumableComparison) int {
+		if a.Type != b.Type {
+			return cmp.Compare(a.Type, b.Type)
+		}
+		return cmp.Compare(b.DpsDelta, a.DpsDelta)
+	})
+
+	return result
+}
@@ -0,0 +1,84 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/core/simsignals"
+	"github.com/wowsims/mop/sim/core/stats"
+)
+
+func TestIsViableConsumable(t *testing.T) {
+	agilityFlask := Consumable{Stats: stats.Stats{stats.Agility: 1000}}
+	intellectFlask := Consumable{Stats: stats.Stats{stats.Intellect: 1000}}
+	staminaFlask := Consumable{Stats: stats.Stats{stats.Stamina: 1500}}
+	critFood := Consumable{Stats: stats.Stats{stats.CritRating: 300}}
+	spiritFood := Consumable{Stats: stats.Stats{stats.Spirit: 300}}
+	mainStatFood := Consumable{Stats: stats.Stats{stats.Stamina: 300}, BuffsMainStat: true}
+	emptyPotion := Consumable{}
+
+	for _, tc := range []struct {
+		name       string
+		consumable Consumable
+		mainStat   stats.Stat
+		isTank     bool
+		expected   bool
+	}{
+		{"Matching main stat", agilityFlask, stats.Agility, false, true},
+		{"Other main stat", intellectFlask, stats.Agility, false, false},
+		{"Stamina for dps", staminaFlask, stats.Agility, false, false},
+		{"Stamina for tank", staminaFlask, stats.Agility, true, true},
+		{"Secondary stat", critFood, stats.Strength, false, true},
+		{"Spirit for caster", spiritFood, stats.Intellect, false, true},
+		{"Spirit for melee", spiritFood, stats.Strength, false, false},
+		{"Main stat food", mainStatFood, stats.Strength, false, true},
+		{"No stats", emptyPotion, stats.Strength, false, false},
+	} {
+		if viable := isViableConsumable(tc.consumable, tc.mainStat, tc.isTank); viable != tc.expected {
+			t.Errorf("%s: expected %t but found %t", tc.name, tc.expected, viable)
+		}
+	}
+}
+
+func TestSetConsumableForType(t *testing.T) {
+	consumes := &proto.ConsumesSpec{
+		PrepotId:       1,
+		PotId:          1,
+		BattleElixirId: 2,
+		FoodId:         3,
+	}
+
+	setConsumableForType(consumes, Consumable{Id: 10, Type: proto.ConsumableType_ConsumableTypeFlask})
+	if consumes.FlaskId != 10 || consumes.BattleElixirId != 0 {
+		t.Fatalf("Expected flask to replace elixirs, found %v", consumes)
+	}
+
+	setConsumableForType(consumes, Consumable{Id: 11, Type: proto.ConsumableType_ConsumableTypePotion})
+	if consumes.PotId != 11 || consumes.PrepotId != 11 {
+		t.Fatalf("Expected potion and prepot to be swapped together, found %v", consumes)
+	}
+
+	if setConsumableForType(consumes, Consumable{Id: 12, Type: proto.ConsumableType_ConsumableTypeScroll}) {
+		t.Fatalf("Scrolls should not be comparable")
+	}
+	if getConsumableIDForType(consumes, proto.ConsumableType_ConsumableTypeFood) != 3 {
+		t.Fatalf("Food should be left untouched")
+	}
+}
+
+func TestConsumableComparisonRejectsIncompleteRequests(t *testing.T) {
+	if result := runConsumableComparison(&proto.ConsumableComparisonRequest{SimOptions: &proto.SimOptions{}}, simsignals.CreateSignals()); result.Error == nil {
+		t.Fatalf("Expected an error without a player")
+	}
+
+	player := &proto.Player{}
+	if result := runConsumableComparison(&proto.ConsumableComparisonRequest{Player: player}, simsignals.CreateSignals()); result.Error == nil {
+		t.Fatalf("Expected an error without sim options")
+	}
+	if result := runConsumableComparison(&proto.ConsumableComparisonRequest{Player: player, SimOptions: &proto.SimOptions{}}, simsignals.CreateSignals()); result.Error == nil {
+		t.Fatalf("Expected an error without an encounter")
+	}
+	if player.Consumables != nil {
+		t.Fatalf("Expected the request's player to be left untouched")
+	}
+}
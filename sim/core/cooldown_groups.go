@@ -0,0 +1,69 @@
+package core
+
+import (
+	"time"
+)
+
+// Spell category IDs for cooldowns shared between on-use effects, matching the
+// game's SpellCategory table.
+const (
+	SpellCategoryPotion           int32 = 4
+	SpellCategoryConjured         int32 = 30
+	SpellCategoryOffensiveTrinket int32 = 1141
+	SpellCategoryDefensiveTrinket int32 = 1190
+)
+
+type SharedCooldownGroup struct {
+	CategoryID int32
+
+	// Lockout applied to the rest of the group when a member is used. If 0, the
+	// spell keeps its configured shared cooldown duration, falling back to its
+	// own cooldown.
+	Duration time.Duration
+}
+
+// Shared cooldown groups keyed by the action ID of the on-use spell. Only
+// spells listed here are regrouped; item on-use spells get their category
+// cooldown from the item data when they are registered.
+var sharedCooldownGroups = map[ActionID]SharedCooldownGroup{
+	{SpellID: 126734}: {CategoryID: SpellCategoryOffensiveTrinket, Duration: time.Second * 10}, // Synapse Springs
+	{SpellID: 108788}: {CategoryID: SpellCategoryDefensiveTrinket, Duration: time.Second * 10}, // Phase Fingers
+}
+
+// Adds or replaces the shared cooldown group for an on-use spell. Must be
+// called during package init, before any sims are constructed.
+func RegisterSharedCooldownGroup(actionID ActionID, group SharedCooldownGroup) {
+	sharedCooldownGroups[actionID] = group
+}
+
+func getSharedCooldownGroup(actionID ActionID) (SharedCooldownGroup, bool) {
+	group, ok := sharedCooldownGroups[actionID]
+	return group, ok
+}
+
+// Points every grouped spell at its category timer, so that CanCast, the
+// major cooldown manager and APL cooldown values all see the shared lockout.
+func (character *Character) applySharedCooldownGroups() {
+	for _, spell := range character.Spellbook {
+		group, ok := getSharedCooldownGroup(spell.ActionID)
+		if !ok {
+			continue
+		}
+
+		duration := group.Duration
+		if duration == 0 {
+			duration = spell.SharedCD.Duration
+		}
+		if duration == 0 {
+			duration = spell.CD.Duration
+		}
+		if duration == 0 {
+			continue
+		}
+
+		spell.SharedCD = Cooldown{
+			Timer:    character.GetOrInitSpellCategoryTimer(group.CategoryID),
+			Duration: duration,
+		}
+	}
+}
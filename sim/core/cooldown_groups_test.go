@@ -0,0 +1,46 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestSharedCooldownGroupIgnoresItemData(t *testing.T) {
+	const itemID = 999001
+	ItemsByID[itemID] = Item{
+		ID: itemID,
+		ItemEffect: &proto.ItemEffect{
+			Effect: &proto.ItemEffect_OnUse{
+				OnUse: &proto.OnUseEffect{
+					CooldownMs:         120000,
+					CategoryId:         SpellCategoryOffensiveTrinket,
+					CategoryCooldownMs: 20000,
+				},
+			},
+		},
+	}
+	defer delete(ItemsByID, itemID)
+
+	// The item's own spell registration already sets up its category
+	// cooldown, so it must not be regrouped here.
+	if group, ok := getSharedCooldownGroup(ActionID{ItemID: itemID}); ok {
+		t.Fatalf("Expected no shared cooldown group for an unlisted item, found %v", group)
+	}
+
+	RegisterSharedCooldownGroup(ActionID{ItemID: itemID}, SharedCooldownGroup{CategoryID: SpellCategoryDefensiveTrinket, Duration: 20 * time.Second})
+	defer delete(sharedCooldownGroups, ActionID{ItemID: itemID})
+
+	group, ok := getSharedCooldownGroup(ActionID{ItemID: itemID})
+	if !ok || group.CategoryID != SpellCategoryDefensiveTrinket || group.Duration != 20*time.Second {
+		t.Fatalf("Expected the registered shared cooldown group, found %v", group)
+	}
+}
+
+func TestSharedCooldownGroupTable(t *testing.T) {
+	group, ok := getSharedCooldownGroup(ActionID{SpellID: 126734})
+	if !ok || group.CategoryID != SpellCategoryOffensiveTrinket {
+		t.Fatalf("Expected Synapse Springs to share the offensive trinket cooldown, found %v", group)
+	}
+}
@@ -0,0 +1,263 @@
+package core
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+// Uses that happen in fewer iterations than this are left out of the note.
+const cooldownPlanMinFrequency = 0.5
+
+type cooldownPlanTracker struct {
+	actionID ActionID
+	name     string
+
+	// Times of each use in the current iteration.
+	times []time.Duration
+
+	uses []*proto.CooldownUsage
+}
+
+func newCooldownPlanTracker(actionID ActionID, name string) *cooldownPlanTracker {
+	return &cooldownPlanTracker{
+		actionID: actionID,
+		name:     name,
+	}
+}
+
+func (tracker *cooldownPlanTracker) record(sim *Simulation) {
+	// Spells cast on every target still only count as a single use.
+	if n := len(tracker.times); n > 0 && tracker.times[n-1] == sim.CurrentTime {
+		return
+	}
+	tracker.times = append(tracker.times, sim.CurrentTime)
+}
+
+func (tracker *cooldownPlanTracker) doneIteration() {
+	for i, useTime := range tracker.times {
+		if i == len(tracker.uses) {
+			tracker.uses = append(tracker.uses, &proto.CooldownUsage{})
+		}
+		seconds := useTime.Seconds()
+		tracker.uses[i].Count++
+		tracker.uses[i].TimeSum += seconds
+		tracker.uses[i].TimeSumSq += seconds * seconds
+	}
+	tracker.times = tracker.times[:0]
+}
+
+func (tracker *cooldownPlanTracker) toProto() *proto.PlannedCooldown {
+	return &proto.PlannedCooldown{
+		Id:   tracker.actionID.ToProto(),
+		Name: tracker.name,
+		Uses: tracker.uses,
+	}
+}
+
+type cooldownPlanner struct {
+	iterations int32
+
+	cooldowns     []*cooldownPlanTracker
+	lust          *cooldownPlanTracker
+	executePhases map[int32]*cooldownPlanTracker
+	events        []*cooldownPlanTracker
+}
+
+// Best effort display name for a cooldown, since spell names only exist in
+// the UI database.
+func cooldownPlanName(spell *Spell, buffAura *StatBuffAura) string {
+	if spell.RelatedSelfBuff != nil && spell.RelatedSelfBuff.Label != "" {
+		return spell.RelatedSelfBuff.Label
+	}
+	if buffAura != nil && buffAura.Label != "" {
+		return buffAura.Label
+	}
+	if item, ok := ItemsByID[spell.ActionID.ItemID]; ok && item.Name != "" {
+		return item.Name
+	}
+	if consumable, ok := ConsumablesByID[spell.ActionID.ItemID]; ok && consumable.Name != "" {
+		return consumable.Name
+	}
+	return spell.ActionID.String()
+}
+
+// APL spells with at least this much cooldown are planned even when they
+// aren't registered as major cooldowns.
+const cooldownPlanMinCooldown = time.Minute
+
+func isPlannedAPLCooldown(spell *Spell) bool {
+	if spell.Flags.Matches(SpellFlagPotion) {
+		return true
+	}
+	return spell.Flags.Matches(SpellFlagAPL) && (spell.CD.Duration >= cooldownPlanMinCooldown)
+}
+
+// Starts recording when each cooldown is used, along with Bloodlust and
+// execute phase timings. Called once the environment is finalized.
+func (character *Character) enableCooldownPlanner() {
+	if character.cooldownPlanner != nil {
+		return
+	}
+
+	planner := &cooldownPlanner{
+		executePhases: make(map[int32]*cooldownPlanTracker),
+	}
+
+	lustAuras := character.GetAurasWithTag(BloodlustAuraTag)
+	isLust := func(spell *Spell) bool {
+		return slices.ContainsFunc(lustAuras, func(aura *Aura) bool {
+			return aura.ActionID.SameActionIgnoreTag(spell.ActionID)
+		})
+	}
+
+	track := func(spell *Spell, buffAura *StatBuffAura) {
+		if (spell.cooldownPlan != nil) || isLust(spell) {
+			return
+		}
+		tracker := newCooldownPlanTracker(spell.ActionID, cooldownPlanName(spell, buffAura))
+		spell.cooldownPlan = tracker
+		planner.cooldowns = append(planner.cooldowns, tracker)
+	}
+
+	for i := range character.initialMajorCooldowns {
+		mcd := &character.initialMajorCooldowns[i]
+		if mcd.Priority != CooldownPriorityBloodlust {
+			track(mcd.Spell, mcd.BuffAura)
+		}
+	}
+	for _, spell := range character.Spellbook {
+		if isPlannedAPLCooldown(spell) {
+			track(spell, nil)
+		}
+	}
+
+	if len(lustAuras) > 0 {
+		planner.lust = newCooldownPlanTracker(lustAuras[0].ActionID, BloodlustAuraTag)
+		planner.events = append(planner.events, planner.lust)
+		for _, aura := range lustAuras {
+			aura.ApplyOnGain(func(_ *Aura, sim *Simulation) {
+				planner.lust.record(sim)
+			})
+		}
+	}
+
+	for _, phase := range []int32{45, 35, 25, 20} {
+		tracker := newCooldownPlanTracker(ActionID{}, fmt.Sprintf("Execute (%d%%)", phase))
+		planner.executePhases[phase] = tracker
+		planner.events = append(planner.events, tracker)
+	}
+
+	character.cooldownPlanner = planner
+}
+
+func (planner *cooldownPlanner) reset(sim *Simulation) {
+	sim.RegisterExecutePhaseCallback(func(sim *Simulation, executePhase int32) {
+		if tracker, ok := planner.executePhases[executePhase]; ok {
+			tracker.record(sim)
+		}
+	})
+}
+
+func (planner *cooldownPlanner) doneIteration() {
+	planner.iterations++
+	for _, tracker := range planner.cooldowns {
+		tracker.doneIteration()
+	}
+	for _, tracker := range planner.events {
+		tracker.doneIteration()
+	}
+}
+
+func (planner *cooldownPlanner) toProto() *proto.CooldownPlan {
+	plan := &proto.CooldownPlan{
+		Iterations: planner.iterations,
+	}
+	for _, tracker := range planner.cooldowns {
+		plan.Cooldowns = append(plan.Cooldowns, tracker.toProto())
+	}
+	for _, tracker := range planner.events {
+		plan.Events = append(plan.Events, tracker.toProto())
+	}
+
+	finalizeCooldownPlan(plan)
+	return plan
+}
+
+// Fills in the derived averages and the human-readable note. Also used after
+// combining plans from concurrent sims.
+func finalizeCooldownPlan(plan *proto.CooldownPlan) {
+	var sb strings.Builder
+
+	writeLines := func(entries []*proto.PlannedCooldown) {
+		for _, entry := range entries {
+			var timings []string
+			for _, use := range entry.Uses {
+				if use.Count == 0 {
+					continue
+				}
+				use.TimeAvg = use.TimeSum / float64(use.Count)
+				use.TimeStdev = math.Sqrt(max(0, use.TimeSumSq/float64(use.Count)-use.TimeAvg*use.TimeAvg))
+				use.Frequency = float64(use.Count) / float64(max(plan.Iterations, 1))
+
+				if use.Frequency >= cooldownPlanMinFrequency {
+					timings = append(timings, formatCooldownPlanTime(use.TimeAvg))
+				}
+			}
+
+			if len(timings) > 0 {
+				sb.WriteString(fmt.Sprintf("%s at %s\n", entry.Name, strings.Join(timings, ", ")))
+			}
+		}
+	}
+
+	writeLines(plan.Events)
+	writeLines(plan.Cooldowns)
+	plan.Note = sb.String()
+}
+
+func formatCooldownPlanTime(seconds float64) string {
+	sign := ""
+	if seconds < 0 {
+		sign = "-"
+	}
+	totalSeconds := int(math.Round(math.Abs(seconds)))
+	return fmt.Sprintf("%s%d:%02d", sign, totalSeconds/60, totalSeconds%60)
+}
+
+func combineCooldownPlans(base *proto.CooldownPlan, add *proto.CooldownPlan) {
+	base.Iterations += add.Iterations
+
+	combineEntries := func(baseEntries []*proto.PlannedCooldown, addEntries []*proto.PlannedCooldown) []*proto.PlannedCooldown {
+		for _, addEntry := range addEntries {
+			var entry *proto.PlannedCooldown
+			for _, baseEntry := range baseEntries {
+				if baseEntry.Name == addEntry.Name && baseEntry.Id.String() == addEntry.Id.String() {
+					entry = baseEntry
+					break
+				}
+			}
+			if entry == nil {
+				entry = &proto.PlannedCooldown{Id: addEntry.Id, Name: addEntry.Name}
+				baseEntries = append(baseEntries, entry)
+			}
+
+			for i, addUse := range addEntry.Uses {
+				if i == len(entry.Uses) {
+					entry.Uses = append(entry.Uses, &proto.CooldownUsage{})
+				}
+				entry.Uses[i].Count += addUse.Count
+				entry.Uses[i].TimeSum += addUse.TimeSum
+				entry.Uses[i].TimeSumSq += addUse.TimeSumSq
+			}
+		}
+		return baseEntries
+	}
+
+	base.Cooldowns = combineEntries(base.Cooldowns, add.Cooldowns)
+	base.Events = combineEntries(base.Events, add.Events)
+}
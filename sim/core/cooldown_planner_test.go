@@ -0,0 +1,48 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestCombineCooldownPlans(t *testing.T) {
+	newPlan := func(times ...float64) *proto.CooldownPlan {
+		uses := make([]*proto.CooldownUsage, len(times))
+		for i, time := range times {
+			uses[i] = &proto.CooldownUsage{Count: 1, TimeSum: time, TimeSumSq: time * time}
+		}
+		return &proto.CooldownPlan{
+			Iterations: 1,
+			Cooldowns: []*proto.PlannedCooldown{
+				{Id: ActionID{SpellID: 31884}.ToProto(), Name: "Avenging Wrath", Uses: uses},
+			},
+		}
+	}
+
+	combined := &proto.CooldownPlan{}
+	combineCooldownPlans(combined, newPlan(1, 181))
+	combineCooldownPlans(combined, newPlan(3, 185, 370))
+	finalizeCooldownPlan(combined)
+
+	if combined.Iterations != 2 || len(combined.Cooldowns) != 1 {
+		t.Fatalf("Expected a single cooldown over 2 iterations, found %v", combined)
+	}
+
+	uses := combined.Cooldowns[0].Uses
+	if len(uses) != 3 || uses[0].TimeAvg != 2 || uses[0].TimeStdev != 1 || uses[2].Frequency != 0.5 {
+		t.Fatalf("Unexpected combined uses %v", uses)
+	}
+
+	if expected := "Avenging Wrath at 0:02, 3:03, 6:10\n"; combined.Note != expected {
+		t.Fatalf("Expected note %q, found %q", expected, combined.Note)
+	}
+}
+
+func TestFormatCooldownPlanTime(t *testing.T) {
+	for seconds, expected := range map[float64]string{0: "0:00", 65.4: "1:05", -1.2: "-0:01", 599.6: "10:00"} {
+		if formatted := formatCooldownPlanTime(seconds); formatted != expected {
+			t.Errorf("Expected %s for %f seconds, found %s", expected, seconds, formatted)
+		}
+	}
+}
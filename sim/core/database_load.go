@@ -5,6 +5,12 @@
 package core
 
 import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+
 	"github.com/wowsims/mop/assets/database"
 	"github.com/wowsims/mop/sim/core/proto"
 )
@@ -96,4 +102,27 @@ func init() {
 	}
 
 	addToDatabase(simDB)
+	addLootTables(db.Npcs, db.Items)
+
+	englishNames := &LocalizedNames{Spells: make(map[int32]string, len(db.SpellIcons))}
+	for _, spell := range db.SpellIcons {
+		englishNames.Spells[spell.Id] = spell.Name
+	}
+	AddLocalizedNames(DefaultLocale, englishNames)
+
+	localeFiles, err := fs.Glob(database.LocaleFiles, "locales/*.json")
+	if err != nil {
+		panic(err)
+	}
+	for _, localeFile := range localeFiles {
+		data, err := database.LocaleFiles.ReadFile(localeFile)
+		if err != nil {
+			panic(err)
+		}
+		names := &LocalizedNames{}
+		if err := json.Unmarshal(data, names); err != nil {
+			panic(fmt.Errorf("unmarshal %s: %w", localeFile, err))
+		}
+		AddLocalizedNames(strings.TrimSuffix(path.Base(localeFile), ".json"), names)
+	}
 }
@@ -9,6 +9,11 @@ import (
 
 // applyRaidDebuffEffects applies all raid-level debuffs based on the provided Debuffs proto.
 func applyDebuffEffects(target *Unit, targetIdx int, debuffs *proto.Debuffs, raid *proto.Raid) {
+	debuffs, partialUptimes := resolveUptimeOverrides(debuffs, debuffs.GetUptimeOverrides())
+	applyPartialUptimes(target, partialUptimes, func(partialDebuffs *proto.Debuffs) {
+		applyDebuffEffects(target, targetIdx, partialDebuffs, raid)
+	})
+
 	// –10% Physical damage dealt for 30s
 	if debuffs.WeakenedBlows {
 		MakePermanent(WeakenedBlowsAura(target))
@@ -73,6 +78,37 @@ func applyDebuffEffects(target *Unit, targetIdx int, debuffs *proto.Debuffs, rai
 	}
 }
 
+// MoP debuffs with the same effect don't stack, no matter which class applies
+// them. Every debuff registers an exclusive effect in one of these categories
+// on its target, so only the strongest debuff of each category is applied.
+const (
+	WeakenedArmorCategory      = "MajorArmorReduction"
+	PhysVulnerabilityCategory  = "PhysicalDmg"
+	WeakenedBlowsCategory      = "PhysDamageReduction"
+	SpellDamageTakenCategory   = "SpellDamageTaken%"
+	CastSpeedReductionCategory = "CastSpdReduction"
+	HealingReductionCategory   = "HealingReduction"
+)
+
+// Whether a new debuff replaces the active aura of its category, instead of
+// both auras staying up with only the strongest one applied.
+var debuffCategorySingleAura = map[string]bool{
+	WeakenedArmorCategory:      true,
+	PhysVulnerabilityCategory:  false,
+	WeakenedBlowsCategory:      false,
+	SpellDamageTakenCategory:   true,
+	CastSpeedReductionCategory: false,
+	HealingReductionCategory:   false,
+}
+
+func (aura *Aura) newDebuffEffect(category string, config ExclusiveEffect) *ExclusiveEffect {
+	singleAura, ok := debuffCategorySingleAura[category]
+	if !ok {
+		panic("Unknown debuff category " + category)
+	}
+	return aura.NewExclusiveEffect(category, singleAura, config)
+}
+
 const WeakenedBlowsDuration = time.Second * 30
 
 // –10% Physical damage dealt
@@ -140,7 +176,7 @@ func WeakenedArmorAura(target *Unit) *Aura {
 }
 
 func MortalWoundsAura(target *Unit) *Aura {
-	return majorHealingReductionAura(target, "Mortal Wounds", 115804, 0.25)
+	return majorHealingReductionAura(target, Aura{Label: "Mortal Wounds", ActionID: ActionID{SpellID: 115804}, Duration: time.Second * 30}, 0.25)
 }
 
 // Spell‐damage‐taken sources
@@ -157,10 +193,12 @@ func CurseOfElementsAura(target *Unit) *Aura {
 	return spellDamageEffectAura(Aura{Label: "Curse of Elements", ActionID: ActionID{SpellID: 1490}, Duration: time.Minute * 5}, target, 1.05)
 }
 
-func majorHealingReductionAura(target *Unit, label string, spellID int32, multiplier float64) *Aura {
-	aura := target.GetOrRegisterAura(Aura{Label: label, ActionID: ActionID{SpellID: spellID}, Duration: time.Second * 30})
-	aura.NewExclusiveEffect("HealingReduction", false, ExclusiveEffect{
-		Priority: multiplier,
+// Major healing reductions don't stack with each other, only the strongest one applies.
+func majorHealingReductionAura(target *Unit, config Aura, reduction float64) *Aura {
+	multiplier := 1 - reduction
+	aura := target.GetOrRegisterAura(config)
+	aura.newDebuffEffect(HealingReductionCategory, ExclusiveEffect{
+		Priority: reduction,
 		OnGain: func(ee *ExclusiveEffect, sim *Simulation) {
 			ee.Aura.Unit.PseudoStats.HealingTakenMultiplier *= multiplier
 		},
@@ -192,7 +230,7 @@ func SlowAura(target *Unit) *Aura {
 }
 func castSpeedReductionAura(target *Unit, label string, spellID int32, multiplier float64, duration time.Duration) *Aura {
 	aura := target.GetOrRegisterAura(Aura{Label: label, ActionID: ActionID{SpellID: spellID}, Duration: duration})
-	aura.NewExclusiveEffect("CastSpdReduction", false, ExclusiveEffect{
+	aura.newDebuffEffect(CastSpeedReductionCategory, ExclusiveEffect{
 		Priority: multiplier,
 		OnGain: func(ee *ExclusiveEffect, sim *Simulation) {
 			ee.Aura.Unit.MultiplyCastSpeed(sim, 1/multiplier)
@@ -209,7 +247,7 @@ const SpellDamageEffectAuraTag = "SpellDamageAuraTag"
 func spellDamageEffectAura(auraConfig Aura, target *Unit, multiplier float64) *Aura {
 	auraConfig.Tag = SpellDamageEffectAuraTag
 	aura := target.GetOrRegisterAura(auraConfig)
-	aura.NewExclusiveEffect("SpellDamageTaken%", true, ExclusiveEffect{
+	aura.newDebuffEffect(SpellDamageTakenCategory, ExclusiveEffect{
 		Priority: multiplier,
 		OnGain: func(ee *ExclusiveEffect, sim *Simulation) {
 			ee.Aura.Unit.PseudoStats.SchoolDamageTakenMultiplier[stats.SchoolIndexArcane] *= multiplier
@@ -231,10 +269,8 @@ func spellDamageEffectAura(auraConfig Aura, target *Unit, multiplier float64) *A
 	return aura
 }
 
-var majorArmorReductionEffectCategory = "MajorArmorReduction"
-
 func registerMajorArpEffect(aura *Aura, initialArp float64) *ExclusiveEffect {
-	return aura.NewExclusiveEffect(majorArmorReductionEffectCategory, true, ExclusiveEffect{
+	return aura.newDebuffEffect(WeakenedArmorCategory, ExclusiveEffect{
 		Priority: initialArp,
 		OnGain: func(ee *ExclusiveEffect, sim *Simulation) {
 			ee.Aura.Unit.PseudoStats.ArmorMultiplier *= 1 - ee.Priority
@@ -266,7 +302,7 @@ func ShatteringThrowAura(target *Unit, actionTag int32) *Aura {
 }
 
 func PhysDamageTakenEffect(aura *Aura, multiplier float64) *ExclusiveEffect {
-	return aura.NewExclusiveEffect("PhysicalDmg", false, ExclusiveEffect{
+	return aura.newDebuffEffect(PhysVulnerabilityCategory, ExclusiveEffect{
 		Priority: multiplier,
 		OnGain: func(ee *ExclusiveEffect, sim *Simulation) {
 			ee.Aura.Unit.PseudoStats.SchoolDamageTakenMultiplier[stats.SchoolIndexPhysical] *= multiplier
@@ -279,7 +315,7 @@ func PhysDamageTakenEffect(aura *Aura, multiplier float64) *ExclusiveEffect {
 
 func PhysDamageReductionEffect(aura *Aura, dmgReduction float64) *ExclusiveEffect {
 	reductionMult := 1.0 - dmgReduction
-	return aura.NewExclusiveEffect("PhysDamageReduction", false, ExclusiveEffect{
+	return aura.newDebuffEffect(WeakenedBlowsCategory, ExclusiveEffect{
 		Priority: dmgReduction,
 		OnGain: func(ee *ExclusiveEffect, sim *Simulation) {
 			ee.Aura.Unit.PseudoStats.SchoolDamageDealtMultiplier[stats.SchoolIndexPhysical] *= reductionMult
@@ -9,6 +9,33 @@ import (
 type OnSnapshot func(sim *Simulation, target *Unit, dot *Dot, isRollover bool)
 type OnTick func(sim *Simulation, target *Unit, dot *Dot)
 
+// How the tick period of a hasted dot follows haste changes after it was applied.
+type DotHastePolicy uint8
+
+const (
+	// Haste is snapshot when the dot is applied or refreshed, as for most MoP dots.
+	DotHasteSnapshot DotHastePolicy = iota
+
+	// The tick period is recalculated from the current haste after every tick,
+	// keeping the number of remaining ticks.
+	DotHasteDynamic
+)
+
+// How the hasted tick count is rounded when the duration is not a whole number
+// of tick periods.
+type DotTickRounding uint8
+
+const (
+	// Round to the nearest tick count, exact halves go to the even count.
+	DotTickRoundToEven DotTickRounding = iota
+
+	// Round to the nearest tick count, exact halves gain the extra tick.
+	DotTickRoundHalfUp
+
+	// Only count ticks that fully fit into the duration.
+	DotTickRoundDown
+)
+
 type DotConfig struct {
 	// Optional, will default to the corresponding spell.
 	Spell *Spell
@@ -27,6 +54,9 @@ type DotConfig struct {
 	AffectedByRealHaste  bool // tick length are shortened based on real haste (melee/ranged but not spell)
 	HasteReducesDuration bool // does not gain additional ticks after a certain haste threshold
 
+	HastePolicy  DotHastePolicy  // whether haste is snapshot on application or follows haste changes
+	TickRounding DotTickRounding // how the hasted tick count is rounded
+
 	BonusCoefficient float64 // EffectBonusCoefficient in SpellEffect client DB table, "SP mod" on Wowhead (not necessarily shown there even if > 0)
 
 	PeriodicDamageMultiplier float64 // Multiplier for periodic damage on top of the spell's damage multiplier
@@ -61,6 +91,9 @@ type Dot struct {
 	affectedByRealHaste  bool // tick length are shortened based on real haste
 	hasteReducesDuration bool // does not gain additional ticks after a haste threshold, HasteAffectsDuration in dbc
 	isChanneled          bool
+
+	hastePolicy  DotHastePolicy
+	tickRounding DotTickRounding
 }
 
 // Takes a new snapshot of this Dot's effects.
@@ -85,6 +118,7 @@ func (dot *Dot) Apply(sim *Simulation) {
 
 	dot.TakeSnapshot(sim, false)
 	dot.recomputeAuraDuration(sim)
+	dot.recordBreakpointApplication()
 	dot.Activate(sim)
 }
 
@@ -98,6 +132,7 @@ func (dot *Dot) ApplyRollover(sim *Simulation) {
 
 	dot.TakeSnapshot(sim, true)
 	dot.recomputeAuraDuration(sim)
+	dot.recordBreakpointApplication()
 	dot.Activate(sim)
 }
 
@@ -157,7 +192,14 @@ func (dot *Dot) TimeUntilNextTick(sim *Simulation) time.Duration {
 }
 
 func (dot *Dot) calculateTickCount(baseDuration time.Duration, tickPeriod time.Duration) int32 {
-	return int32(math.RoundToEven(float64(baseDuration) / float64(tickPeriod)))
+	switch dot.tickRounding {
+	case DotTickRoundHalfUp:
+		return int32((2*baseDuration + tickPeriod) / (2 * tickPeriod))
+	case DotTickRoundDown:
+		return int32(baseDuration / tickPeriod)
+	default:
+		return int32(math.RoundToEven(float64(baseDuration) / float64(tickPeriod)))
+	}
 }
 
 // Returns the total amount of ticks with the snapshotted haste
@@ -174,6 +216,28 @@ func (dot *Dot) ExpectedTickCount() int32 {
 	return tickCount
 }
 
+// Fraction of the base duration below which a dot is in its pandemic window.
+const dotPandemicWindowFraction = 0.3
+
+// Whether the dot is inactive or has less than dotPandemicWindowFraction of
+// its base duration remaining.
+func (dot *Dot) InPandemicWindow(sim *Simulation) bool {
+	if !dot.IsActive() {
+		return true
+	}
+	return float64(dot.RemainingDuration(sim)) < float64(dot.BaseDuration())*dotPandemicWindowFraction
+}
+
+// Remaining duration at or below which refreshing the dot loses no ticks.
+// A refresh only carries over the running tick, so this is the time until the
+// next tick, or 0 if the dot isn't active.
+func (dot *Dot) RefreshThreshold(sim *Simulation) time.Duration {
+	if !dot.IsActive() {
+		return 0
+	}
+	return dot.TimeUntilNextTick(sim)
+}
+
 func (dot *Dot) RemainingTicks() int32 {
 	return dot.remainingTicks
 }
@@ -308,11 +372,27 @@ func (dot *Dot) periodicTick(sim *Simulation) {
 
 	// Dot might have been disabled in tick
 	if dot.IsActive() {
+		if dot.hastePolicy == DotHasteDynamic {
+			dot.rehasteRemainingTicks(sim)
+		}
+
 		dot.tickAction.NextActionAt = sim.CurrentTime + dot.tickPeriod
 		sim.AddPendingAction(dot.tickAction)
 	}
 }
 
+// Applies the current haste to the remaining ticks of a dynamic haste dot.
+func (dot *Dot) rehasteRemainingTicks(sim *Simulation) {
+	tickPeriod := dot.CalcTickPeriod()
+	if tickPeriod == dot.tickPeriod {
+		return
+	}
+
+	dot.tickPeriod = tickPeriod
+	dot.Duration = tickPeriod * time.Duration(dot.remainingTicks)
+	dot.Refresh(sim)
+}
+
 func (dot *Dot) getChannelClipDelay(sim *Simulation) time.Duration {
 	channeledDot := dot.Spell.Unit.ChanneledDot
 	if channeledDot == nil {
@@ -431,6 +511,8 @@ func (spell *Spell) createDots(config DotConfig, isHot bool) {
 		affectedByRealHaste:  config.AffectedByRealHaste,
 		hasteReducesDuration: config.HasteReducesDuration,
 		isChanneled:          config.Spell.Flags.Matches(SpellFlagChanneled),
+		hastePolicy:          config.HastePolicy,
+		tickRounding:         config.TickRounding,
 
 		BonusCoefficient:         config.BonusCoefficient,
 		BaseDurationMultiplier:   1,
@@ -0,0 +1,184 @@
+package core
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/core/stats"
+)
+
+// Haste rating of a single rare secondary stat gem, used for gem suggestions.
+const breakpointHasteGemRating = 320
+
+// Upper bound for breakpoint searches, well past any realistic gear set.
+const maxBreakpointHasteRating = 30000
+
+// Number of ticks a DoT would have with the given total haste multiplier,
+// mirroring Dot.CalcTickPeriod and Dot.HastedTickCount.
+func dotTicksAtHaste(dot *Dot, hasteMultiplier float64) int32 {
+	tickLength := dot.BaseTickLength
+	if dot.affectedByCastSpeed && dot.isChanneled {
+		tickLength = time.Duration(float64(tickLength) * max(0, dot.Spell.CastTimeMultiplier))
+	}
+	tickPeriod := time.Duration(float64(tickLength) / hasteMultiplier).Round(time.Millisecond)
+	if tickPeriod <= 0 {
+		return 0
+	}
+	return dot.calculateTickCount(dot.BaseDuration(), tickPeriod)
+}
+
+// Smallest amount of rating in [lo, hi] for which gainsTick returns true,
+// or -1 if there is none. gainsTick must be monotonic.
+func searchHasteRating(lo int, hi int, gainsTick func(rating float64) bool) int {
+	if !gainsTick(float64(hi)) {
+		return -1
+	}
+	for lo < hi {
+		mid := (lo + hi) / 2
+		if gainsTick(float64(mid)) {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	return lo
+}
+
+// Number of ticks the DoT would have if the unit's haste rating were changed to
+// the given amount, keeping its other haste effects.
+func (unit *Unit) dotTicksWithHasteRating(dot *Dot) func(rating float64) int32 {
+	ratingScale := HasteRatingPerHastePercent * 100
+	baseMultiplier := Ternary(dot.affectedByCastSpeed, unit.TotalSpellHasteMultiplier(), unit.TotalRealHasteMultiplier()) / (1 + unit.GetStat(stats.HasteRating)/ratingScale)
+
+	return func(rating float64) int32 {
+		return dotTicksAtHaste(dot, baseMultiplier*(1+max(rating, 0)/ratingScale))
+	}
+}
+
+// Whether the DoT's tick count depends on haste, so it has breakpoints.
+func (dot *Dot) hasHasteBreakpoints() bool {
+	return (dot.affectedByCastSpeed || dot.affectedByRealHaste) && !dot.hasteReducesDuration && (dot.BaseTickLength > 0)
+}
+
+// Haste rating the unit needs on top of its current haste, including temporary
+// buffs, for the DoT to gain a tick. Returns -1 if no realistic amount does.
+func (unit *Unit) dotHasteRatingToNextTick(dot *Dot) float64 {
+	ticksWithRating := unit.dotTicksWithHasteRating(dot)
+	currentRating := unit.GetStat(stats.HasteRating)
+	ticks := ticksWithRating(currentRating)
+
+	start := int(math.Ceil(currentRating))
+	next := searchHasteRating(start, start+maxBreakpointHasteRating, func(rating float64) bool {
+		return ticksWithRating(rating) > ticks
+	})
+	if next < 0 {
+		return -1
+	}
+	return float64(next) - currentRating
+}
+
+func (character *Character) dotBreakpoint(dot *Dot, name string) *proto.DotBreakpoint {
+	if !dot.hasHasteBreakpoints() {
+		return nil
+	}
+
+	currentRating := character.GetStat(stats.HasteRating)
+	ticksWithRating := character.dotTicksWithHasteRating(dot)
+
+	ticks := ticksWithRating(currentRating)
+	breakpoint := &proto.DotBreakpoint{
+		Id:    dot.Spell.ActionID.ToProto(),
+		Name:  name,
+		Ticks: ticks,
+	}
+
+	if next := character.dotHasteRatingToNextTick(dot); next >= 0 {
+		breakpoint.HasteRatingToNextTick = next
+	}
+
+	// Lowest rating which still keeps the current number of ticks.
+	floor := int(math.Floor(currentRating))
+	if lowest := searchHasteRating(0, floor, func(rating float64) bool {
+		return ticksWithRating(rating) >= ticks
+	}); lowest >= 0 {
+		breakpoint.HasteRatingAboveBreakpoint = currentRating - float64(lowest)
+	}
+
+	breakpoint.Note = character.dotBreakpointNote(breakpoint)
+	return breakpoint
+}
+
+func (dot *Dot) recordBreakpointApplication() {
+	if breakpoint := dot.Spell.dotBreakpoint; breakpoint != nil {
+		breakpoint.Applications++
+		if dot.HastedTickCount() > breakpoint.Ticks {
+			breakpoint.HastedApplications++
+		}
+	}
+}
+
+// Number of equipped regular gems which could be swapped for haste gems.
+func (character *Character) numNonHasteGems() int {
+	numGems := 0
+	for _, item := range character.Equipment {
+		for _, gem := range item.Gems {
+			if (gem.ID == 0) || (gem.Stats[stats.HasteRating] > 0) {
+				continue
+			}
+			if (gem.Color == proto.GemColor_GemColorMeta) || (gem.Color == proto.GemColor_GemColorCogwheel) {
+				continue
+			}
+			numGems++
+		}
+	}
+	return numGems
+}
+
+func (character *Character) dotBreakpointNote(breakpoint *proto.DotBreakpoint) string {
+	if breakpoint.HasteRatingToNextTick <= 0 {
+		return ""
+	}
+
+	needed := int(math.Ceil(breakpoint.HasteRatingToNextTick))
+	note := fmt.Sprintf("%d haste rating from the next %s tick (%d to %d ticks).", needed, breakpoint.Name, breakpoint.Ticks, breakpoint.Ticks+1)
+
+	numGemsNeeded := int(math.Ceil(float64(needed) / breakpointHasteGemRating))
+	if numSwappable := character.numNonHasteGems(); numGemsNeeded <= numSwappable {
+		note += fmt.Sprintf(" Swapping %d of %d non-haste gems for +%d Haste gems reaches it.", numGemsNeeded, numSwappable, breakpointHasteGemRating)
+	} else {
+		note += fmt.Sprintf(" Swapping all %d non-haste gems adds %d, so reforging into haste is needed too.", numSwappable, numSwappable*breakpointHasteGemRating)
+	}
+	return note
+}
+
+// Computes breakpoints for every hasted DoT the character has registered.
+// Called once the character's permanent buffs are active.
+func (character *Character) computeDotBreakpoints() []*proto.DotBreakpoint {
+	var breakpoints []*proto.DotBreakpoint
+	for _, spell := range character.Spellbook {
+		dot := spell.AOEDot()
+		if dot == nil && spell.dots != nil {
+			dot = spell.dots.Get(character.CurrentTarget)
+		}
+		if dot == nil {
+			continue
+		}
+
+		name := strings.TrimSuffix(dot.Label, "-"+strconv.Itoa(int(character.UnitIndex)))
+		name = strings.TrimSuffix(name, "-"+character.Label)
+		if breakpoint := character.dotBreakpoint(dot, name); breakpoint != nil {
+			spell.dotBreakpoint = breakpoint
+			breakpoints = append(breakpoints, breakpoint)
+		}
+	}
+
+	slices.SortStableFunc(breakpoints, func(a, b *proto.DotBreakpoint) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return breakpoints
+}
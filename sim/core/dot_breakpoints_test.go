@@ -0,0 +1,57 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestDotTicksAtHaste(t *testing.T) {
+	dot := &Dot{
+		BaseTickCount:          6,
+		BaseTickLength:         time.Second * 3,
+		BaseDurationMultiplier: 1,
+		affectedByCastSpeed:    true,
+	}
+
+	if ticks := dotTicksAtHaste(dot, 1); ticks != 6 {
+		t.Fatalf("Expected 6 ticks without haste, found %d", ticks)
+	}
+	if ticks := dotTicksAtHaste(dot, 1.1); ticks != 7 {
+		t.Fatalf("Expected 7 ticks with 10%% haste, found %d", ticks)
+	}
+
+	// The 7th tick is gained once the rounded tick period drops below 18s / 6.5.
+	hasteRating := searchHasteRating(0, 10000, func(rating float64) bool {
+		return dotTicksAtHaste(dot, 1+rating/(HasteRatingPerHastePercent*100)) > 6
+	})
+	if got := dotTicksAtHaste(dot, 1+float64(hasteRating-1)/(HasteRatingPerHastePercent*100)); got != 6 {
+		t.Fatalf("Expected one less rating than the breakpoint to keep 6 ticks, found %d", got)
+	}
+	if hasteRating <= 0 || hasteRating > 425*10 {
+		t.Fatalf("Unexpected breakpoint rating %d", hasteRating)
+	}
+}
+
+func TestRecordBreakpointApplication(t *testing.T) {
+	breakpoint := &proto.DotBreakpoint{Ticks: 6}
+	dot := &Dot{
+		Spell:                  &Spell{dotBreakpoint: breakpoint},
+		BaseTickCount:          6,
+		BaseTickLength:         time.Second * 3,
+		BaseDurationMultiplier: 1,
+		affectedByCastSpeed:    true,
+	}
+
+	dot.tickPeriod = dot.BaseTickLength
+	dot.recordBreakpointApplication()
+
+	// 10% temporary haste gains the 7th tick.
+	dot.tickPeriod = time.Duration(float64(dot.BaseTickLength) / 1.1)
+	dot.recordBreakpointApplication()
+
+	if breakpoint.Applications != 2 || breakpoint.HastedApplications != 1 {
+		t.Fatalf("Expected 1 of 2 applications to be hasted, found %d of %d", breakpoint.HastedApplications, breakpoint.Applications)
+	}
+}
@@ -171,3 +171,97 @@ func TestDotSnapshotSpellMultiplier(t *testing.T) {
 	fa.Dot.Apply(sim)
 	expectDotTickDamage(t, sim, fa.Dot, 300) // (100) * 1.5 * 2
 }
+
+func TestDotTickRounding(t *testing.T) {
+	tickPeriod := time.Second * 2
+	testCases := []struct {
+		duration time.Duration
+		rounding DotTickRounding
+		expected int32
+	}{
+		{time.Millisecond * 12800, DotTickRoundToEven, 6},
+		{time.Millisecond * 12800, DotTickRoundHalfUp, 6},
+		{time.Millisecond * 12800, DotTickRoundDown, 6},
+		{time.Millisecond * 13000, DotTickRoundToEven, 6},
+		{time.Millisecond * 13000, DotTickRoundHalfUp, 7},
+		{time.Millisecond * 13000, DotTickRoundDown, 6},
+		{time.Millisecond * 13200, DotTickRoundToEven, 7},
+		{time.Millisecond * 13200, DotTickRoundHalfUp, 7},
+		{time.Millisecond * 13200, DotTickRoundDown, 6},
+		{time.Millisecond * 15000, DotTickRoundToEven, 8},
+		{time.Millisecond * 15000, DotTickRoundHalfUp, 8},
+		{time.Millisecond * 15000, DotTickRoundDown, 7},
+	}
+
+	for _, tc := range testCases {
+		dot := &Dot{tickRounding: tc.rounding}
+		if ticks := dot.calculateTickCount(tc.duration, tickPeriod); ticks != tc.expected {
+			t.Errorf("Expected %d ticks for %s with rounding %d, found %d", tc.expected, tc.duration, tc.rounding, ticks)
+		}
+	}
+}
+
+func TestDotHastePolicy(t *testing.T) {
+	testCases := []struct {
+		policy             DotHastePolicy
+		expectedTickPeriod time.Duration
+		expectedExpiresAt  time.Duration
+	}{
+		// Keeps ticking every 3s until the original 18s duration ends.
+		{DotHasteSnapshot, time.Second * 3, time.Second * 18},
+		// The remaining 5 ticks are hasted after the first tick.
+		{DotHasteDynamic, time.Second * 2, time.Second * 13},
+	}
+
+	for _, tc := range testCases {
+		sim := SetupFakeSim()
+		fa := sim.Raid.Parties[0].Players[0].(*FakeAgent)
+		fa.Dot.hastePolicy = tc.policy
+
+		fa.Dot.Apply(sim)
+		fa.MultiplyCastSpeed(sim, 1.5)
+
+		sim.CurrentTime = fa.Dot.NextTickAt()
+		fa.Dot.periodicTick(sim)
+
+		if fa.Dot.TickPeriod() != tc.expectedTickPeriod || fa.Dot.ExpiresAt() != tc.expectedExpiresAt {
+			t.Errorf("Haste policy %d: expected tick period %s and expiration at %s, found %s and %s",
+				tc.policy, tc.expectedTickPeriod, tc.expectedExpiresAt, fa.Dot.TickPeriod(), fa.Dot.ExpiresAt())
+		}
+		if fa.Dot.RemainingTicks() != 5 {
+			t.Errorf("Haste policy %d: expected 5 remaining ticks, found %d", tc.policy, fa.Dot.RemainingTicks())
+		}
+	}
+}
+
+func TestDotRefreshWindow(t *testing.T) {
+	sim := SetupFakeSim()
+	fa := sim.Raid.Parties[0].Players[0].(*FakeAgent)
+
+	fa.Dot.Apply(sim)
+	if fa.Dot.InPandemicWindow(sim) || fa.Dot.RefreshThreshold(sim) != time.Second*3 {
+		t.Fatalf("Expected a fresh dot outside of its pandemic window with a 3s refresh threshold, found %t and %s", fa.Dot.InPandemicWindow(sim), fa.Dot.RefreshThreshold(sim))
+	}
+
+	for range 4 {
+		sim.CurrentTime = fa.Dot.NextTickAt()
+		fa.Dot.periodicTick(sim)
+	}
+	sim.CurrentTime = time.Second * 13
+
+	// 5s of the 18s duration remain, with the next tick in 2s.
+	if !fa.Dot.InPandemicWindow(sim) || fa.Dot.RefreshThreshold(sim) != time.Second*2 {
+		t.Fatalf("Expected the dot in its pandemic window with a 2s refresh threshold, found %t and %s", fa.Dot.InPandemicWindow(sim), fa.Dot.RefreshThreshold(sim))
+	}
+
+	// Refreshing above the threshold loses the last tick, only the running one carries over.
+	fa.Dot.Apply(sim)
+	if fa.Dot.RemainingTicks() != 7 || fa.Dot.RemainingDuration(sim) != time.Second*20 {
+		t.Fatalf("Expected the running tick to carry over, found %d ticks in %s", fa.Dot.RemainingTicks(), fa.Dot.RemainingDuration(sim))
+	}
+
+	fa.Dot.Deactivate(sim)
+	if !fa.Dot.InPandemicWindow(sim) || fa.Dot.RefreshThreshold(sim) != 0 {
+		t.Fatalf("Expected an inactive dot to be refreshable")
+	}
+}
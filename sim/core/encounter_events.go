@@ -0,0 +1,65 @@
+package core
+
+import (
+	"slices"
+	"time"
+)
+
+// Names of the encounter events scheduled by the core sim. Target AIs may
+// schedule events under any other name.
+const (
+	EncounterEventExecute45 = "Execute 45%"
+	EncounterEventExecute35 = "Execute 35%"
+	EncounterEventExecute25 = "Execute 25%"
+	EncounterEventExecute20 = "Execute 20%"
+	EncounterEventAddSpawn  = "Add Spawn"
+	EncounterEventMovement  = "Movement"
+)
+
+// Clears all events and schedules the start of each execute phase. Execute
+// phases in health-based fights depend on damage done, so they are not scheduled.
+func (encounter *Encounter) resetScheduledEvents(sim *Simulation) {
+	if encounter.scheduledEvents == nil {
+		encounter.scheduledEvents = make(map[string][]time.Duration)
+	}
+	for name := range encounter.scheduledEvents {
+		encounter.scheduledEvents[name] = encounter.scheduledEvents[name][:0]
+	}
+
+	if encounter.EndFightAtHealth > 0 {
+		return
+	}
+	for name, proportion := range map[string]float64{
+		EncounterEventExecute45: encounter.ExecuteProportion_45,
+		EncounterEventExecute35: encounter.ExecuteProportion_35,
+		EncounterEventExecute25: encounter.ExecuteProportion_25,
+		EncounterEventExecute20: encounter.ExecuteProportion_20,
+	} {
+		if proportion > 0 {
+			encounter.ScheduleEvent(name, time.Duration((1-proportion)*float64(sim.Duration)))
+		}
+	}
+}
+
+// Records that the named event will happen at the given time. Events are
+// cleared at the start of every iteration, so target AIs should schedule
+// them from Reset().
+func (encounter *Encounter) ScheduleEvent(name string, at time.Duration) {
+	if encounter.scheduledEvents == nil {
+		encounter.scheduledEvents = make(map[string][]time.Duration)
+	}
+	times := encounter.scheduledEvents[name]
+	index, _ := slices.BinarySearch(times, at)
+	encounter.scheduledEvents[name] = slices.Insert(times, index, at)
+}
+
+// Returns the time until the next occurrence of the named event, or
+// NeverExpires if it is not scheduled again this iteration.
+func (encounter *Encounter) TimeUntilEvent(sim *Simulation, name string) time.Duration {
+	times := encounter.scheduledEvents[name]
+	index, _ := slices.BinarySearch(times, sim.CurrentTime)
+	if index == len(times) {
+		return NeverExpires
+	}
+	return times[index] - sim.CurrentTime
+}
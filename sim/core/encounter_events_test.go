@@ -0,0 +1,46 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestEncounterEvents(t *testing.T) {
+	encounter := NewEncounter(&proto.Encounter{Duration: 300, ExecuteProportion_20: 0.2, Targets: []*proto.Target{{}}})
+	sim := &Simulation{Environment: &Environment{Encounter: encounter}, Duration: 300 * time.Second}
+
+	sim.Encounter.resetScheduledEvents(sim)
+	sim.Encounter.ScheduleEvent(EncounterEventAddSpawn, 90*time.Second)
+	sim.Encounter.ScheduleEvent(EncounterEventAddSpawn, 30*time.Second)
+
+	if timeUntil := sim.Encounter.TimeUntilEvent(sim, EncounterEventExecute20); timeUntil != 240*time.Second {
+		t.Fatalf("Expected execute phase in 240s, found %s", timeUntil)
+	}
+	if timeUntil := sim.Encounter.TimeUntilEvent(sim, EncounterEventExecute35); timeUntil != 240*time.Second {
+		t.Fatalf("Expected the 35%% execute phase to start with the 20%% phase, found %s", timeUntil)
+	}
+
+	sim.CurrentTime = 30 * time.Second
+	if timeUntil := sim.Encounter.TimeUntilEvent(sim, EncounterEventAddSpawn); timeUntil != 0 {
+		t.Fatalf("Expected add spawn now, found %s", timeUntil)
+	}
+	sim.CurrentTime = 31 * time.Second
+	if timeUntil := sim.Encounter.TimeUntilEvent(sim, EncounterEventAddSpawn); timeUntil != 59*time.Second {
+		t.Fatalf("Expected next add spawn in 59s, found %s", timeUntil)
+	}
+	sim.CurrentTime = 91 * time.Second
+	if timeUntil := sim.Encounter.TimeUntilEvent(sim, EncounterEventAddSpawn); timeUntil != NeverExpires {
+		t.Fatalf("Expected no more add spawns, found %s", timeUntil)
+	}
+	if timeUntil := sim.Encounter.TimeUntilEvent(sim, "Unknown Event"); timeUntil != NeverExpires {
+		t.Fatalf("Expected unknown events to never happen, found %s", timeUntil)
+	}
+
+	sim.CurrentTime = 0
+	sim.Encounter.resetScheduledEvents(sim)
+	if timeUntil := sim.Encounter.TimeUntilEvent(sim, EncounterEventAddSpawn); timeUntil != NeverExpires {
+		t.Fatalf("Expected add spawns to be cleared on reset, found %s", timeUntil)
+	}
+}
@@ -0,0 +1,281 @@
+package core
+
+import (
+	"time"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+// Something a target does at each occurrence of one of its encounter script
+// events. Target AIs can implement their own and add them with AddScriptEvent.
+type EncounterScriptAction interface {
+	Execute(sim *Simulation)
+}
+
+// Optionally implemented by actions which also happen under one of the
+// generic encounter events, like add spawns.
+type encounterScriptEventNamer interface {
+	EncounterEventName() string
+}
+
+type EncounterScriptEvent struct {
+	Name string
+
+	// Time of the first occurrence, and between occurrences. 0 only runs the
+	// event once.
+	Start          time.Duration
+	RepeatInterval time.Duration
+
+	Action EncounterScriptAction
+}
+
+// Runs the event's action at each of its occurrences, and schedules them as
+// encounter events so rotations can look ahead to them.
+func (target *Target) AddScriptEvent(event EncounterScriptEvent) {
+	eventNames := []string{event.Name}
+	if namer, ok := event.Action.(encounterScriptEventNamer); ok && (namer.EncounterEventName() != event.Name) {
+		eventNames = append(eventNames, namer.EncounterEventName())
+	}
+
+	target.RegisterResetEffect(func(sim *Simulation) {
+		for at := event.Start; at < sim.Duration; at += event.RepeatInterval {
+			for _, name := range eventNames {
+				sim.Encounter.ScheduleEvent(name, at)
+			}
+			if event.RepeatInterval <= 0 {
+				break
+			}
+		}
+
+		pa := &PendingAction{
+			NextActionAt: event.Start,
+			Priority:     ActionPriorityDOT,
+		}
+
+		pa.OnAction = func(sim *Simulation) {
+			if sim.Log != nil {
+				target.Log(sim, "Encounter script event %s", event.Name)
+			}
+			event.Action.Execute(sim)
+
+			if event.RepeatInterval > 0 {
+				pa.NextActionAt = sim.CurrentTime + event.RepeatInterval
+				sim.AddPendingAction(pa)
+			}
+		}
+
+		sim.AddPendingAction(pa)
+	})
+}
+
+func (target *Target) registerEncounterScript(script []*proto.EncounterScriptEvent) {
+	abilityTag := int32(0)
+	if target.DamageProfile != nil {
+		abilityTag = int32(len(target.DamageProfile.Specials))
+	}
+
+	for _, config := range script {
+		var action EncounterScriptAction
+		switch eventAction := config.Action.(type) {
+		case *proto.EncounterScriptEvent_Ability:
+			abilityTag++
+			action = &scriptAbilityAction{
+				target:     target,
+				useSpecial: target.newBossSpecial(eventAction.Ability, abilityTag),
+			}
+		case *proto.EncounterScriptEvent_SpawnAdds:
+			action = target.newScriptSpawnAddsAction(eventAction.SpawnAdds)
+		case *proto.EncounterScriptEvent_RaidMovement:
+			action = &scriptRaidMovementAction{
+				yards:        eventAction.RaidMovement.Yards,
+				reactionTime: DurationFromSeconds(eventAction.RaidMovement.ReactionTime),
+			}
+		case *proto.EncounterScriptEvent_FrontalPhase:
+			action = &scriptFrontalPhaseAction{
+				duration: DurationFromSeconds(eventAction.FrontalPhase.Duration),
+			}
+			target.RegisterResetEffect(func(sim *Simulation) {
+				sim.Encounter.resetFrontalPhases()
+			})
+		case *proto.EncounterScriptEvent_Interrupt:
+			action = &scriptInterruptAction{
+				lockout:  DurationFromSeconds(eventAction.Interrupt.LockoutDuration),
+				pushback: DurationFromSeconds(eventAction.Interrupt.Pushback),
+				chance:   TernaryFloat64(eventAction.Interrupt.Chance > 0, eventAction.Interrupt.Chance, 1),
+			}
+		}
+
+		if action == nil {
+			continue
+		}
+
+		target.AddScriptEvent(EncounterScriptEvent{
+			Name:           config.Name,
+			Start:          DurationFromSeconds(config.Start),
+			RepeatInterval: DurationFromSeconds(config.RepeatInterval),
+			Action:         action,
+		})
+	}
+}
+
+type scriptAbilityAction struct {
+	target     *Target
+	useSpecial func(sim *Simulation) bool
+}
+
+func (action *scriptAbilityAction) Execute(sim *Simulation) {
+	if !action.target.IsEnabled() || action.useSpecial(sim) {
+		return
+	}
+
+	// Wait for the current cast, so it isn't replaced.
+	pa := sim.GetConsumedPendingActionFromPool()
+	pa.NextActionAt = action.target.Hardcast.Expires
+	pa.Priority = ActionPriorityDOT
+	pa.OnAction = action.Execute
+	sim.AddPendingAction(pa)
+}
+
+type scriptSpawnAddsAction struct {
+	adds     []*Unit
+	duration time.Duration
+}
+
+func (target *Target) newScriptSpawnAddsAction(config *proto.EncounterScriptSpawnAdds) EncounterScriptAction {
+	action := &scriptSpawnAddsAction{
+		duration: DurationFromSeconds(config.Duration),
+	}
+	for _, index := range config.TargetIndexes {
+		if (index >= 0) && (index < target.Env.TotalTargetCount()) && (index != target.Index) {
+			action.adds = append(action.adds, &target.Env.GetTargetByIndex(index).Unit)
+		}
+	}
+
+	if len(action.adds) == 0 {
+		return nil
+	}
+	return action
+}
+
+func (action *scriptSpawnAddsAction) Execute(sim *Simulation) {
+	for _, addUnit := range action.adds {
+		sim.EnableTargetUnit(addUnit)
+	}
+
+	if action.duration <= 0 {
+		return
+	}
+
+	pa := sim.GetConsumedPendingActionFromPool()
+	pa.NextActionAt = sim.CurrentTime + action.duration
+	pa.Priority = ActionPriorityDOT
+	pa.OnAction = func(sim *Simulation) {
+		for _, addUnit := range action.adds {
+			sim.DisableTargetUnit(addUnit, true)
+		}
+	}
+	sim.AddPendingAction(pa)
+}
+
+func (action *scriptSpawnAddsAction) EncounterEventName() string {
+	return EncounterEventAddSpawn
+}
+
+type scriptRaidMovementAction struct {
+	yards        float64
+	reactionTime time.Duration
+}
+
+func (action *scriptRaidMovementAction) Execute(sim *Simulation) {
+	for _, player := range sim.Raid.AllPlayerUnits {
+		player.StepAside(action.yards, action.reactionTime, sim)
+	}
+}
+
+func (action *scriptRaidMovementAction) EncounterEventName() string {
+	return EncounterEventMovement
+}
+
+type scriptFrontalPhaseAction struct {
+	duration time.Duration
+}
+
+func (action *scriptFrontalPhaseAction) Execute(sim *Simulation) {
+	sim.Encounter.beginFrontalPhase(sim)
+
+	if action.duration <= 0 {
+		return
+	}
+
+	pa := sim.GetConsumedPendingActionFromPool()
+	pa.NextActionAt = sim.CurrentTime + action.duration
+	pa.Priority = ActionPriorityDOT
+	pa.OnAction = sim.Encounter.endFrontalPhase
+	sim.AddPendingAction(pa)
+}
+
+// Moves every raid unit attacking from behind in front of the targets, until
+// all overlapping frontal phases have ended.
+func (encounter *Encounter) beginFrontalPhase(sim *Simulation) {
+	encounter.numFrontalPhases++
+	if encounter.numFrontalPhases > 1 {
+		return
+	}
+
+	for _, unit := range sim.Raid.AllUnits {
+		if !unit.PseudoStats.InFrontOfTarget {
+			unit.PseudoStats.InFrontOfTarget = true
+			encounter.movedInFront = append(encounter.movedInFront, unit)
+		}
+	}
+
+	if sim.Log != nil && len(encounter.movedInFront) > 0 {
+		sim.Log("%d units moved in front of the targets", len(encounter.movedInFront))
+	}
+}
+
+func (encounter *Encounter) endFrontalPhase(sim *Simulation) {
+	if encounter.numFrontalPhases == 0 {
+		return
+	}
+	encounter.numFrontalPhases--
+	if encounter.numFrontalPhases > 0 {
+		return
+	}
+
+	if sim.Log != nil && len(encounter.movedInFront) > 0 {
+		sim.Log("%d units moved back behind the targets", len(encounter.movedInFront))
+	}
+	encounter.resetFrontalPhases()
+}
+
+func (encounter *Encounter) resetFrontalPhases() {
+	for _, unit := range encounter.movedInFront {
+		unit.PseudoStats.InFrontOfTarget = false
+	}
+	encounter.movedInFront = encounter.movedInFront[:0]
+	encounter.numFrontalPhases = 0
+}
+
+type scriptInterruptAction struct {
+	lockout  time.Duration
+	pushback time.Duration
+	chance   float64
+}
+
+func (action *scriptInterruptAction) Execute(sim *Simulation) {
+	for _, player := range sim.Raid.AllPlayerUnits {
+		if (player.Hardcast.Expires <= sim.CurrentTime) && (player.ChanneledDot == nil) {
+			continue
+		}
+		if (action.chance < 1) && (sim.RandomFloat("Encounter Interrupt") >= action.chance) {
+			continue
+		}
+
+		if action.pushback > 0 {
+			player.PushbackCast(sim, action.pushback)
+		} else {
+			player.InterruptCast(sim, action.lockout)
+		}
+	}
+}
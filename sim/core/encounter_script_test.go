@@ -0,0 +1,62 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/core/stats"
+)
+
+func TestScriptSpawnAddsAction(t *testing.T) {
+	env := &Environment{}
+	for idx := range 3 {
+		env.Encounter.AllTargets = append(env.Encounter.AllTargets, &Target{Unit: Unit{Index: int32(idx), Env: env}})
+	}
+	boss := env.Encounter.AllTargets[0]
+
+	action := boss.newScriptSpawnAddsAction(&proto.EncounterScriptSpawnAdds{TargetIndexes: []int32{0, 1, 2, 5}})
+	if action == nil {
+		t.Fatalf("Expected a spawn adds action")
+	}
+	if adds := action.(*scriptSpawnAddsAction).adds; len(adds) != 2 || adds[0].Index != 1 || adds[1].Index != 2 {
+		t.Fatalf("Expected only the valid add indexes to be spawned, found %d adds", len(adds))
+	}
+	if name := action.(encounterScriptEventNamer).EncounterEventName(); name != EncounterEventAddSpawn {
+		t.Fatalf("Expected spawns to also be scheduled as %s, found %s", EncounterEventAddSpawn, name)
+	}
+
+	if action := boss.newScriptSpawnAddsAction(&proto.EncounterScriptSpawnAdds{TargetIndexes: []int32{0}}); action != nil {
+		t.Fatalf("Expected no action when the boss only spawns itself")
+	}
+}
+
+func TestFrontalPhases(t *testing.T) {
+	tank := &Unit{PseudoStats: stats.NewPseudoStats()}
+	tank.PseudoStats.InFrontOfTarget = true
+	rogue := &Unit{PseudoStats: stats.NewPseudoStats()}
+	sim := &Simulation{Environment: &Environment{Raid: &Raid{AllUnits: []*Unit{tank, rogue}}}}
+	encounter := &sim.Encounter
+
+	// Overlapping phases keep the rogue in front until the last one ends.
+	encounter.beginFrontalPhase(sim)
+	encounter.beginFrontalPhase(sim)
+	if !rogue.PseudoStats.InFrontOfTarget {
+		t.Fatalf("Expected the rogue to be moved in front of the target")
+	}
+	encounter.endFrontalPhase(sim)
+	if !rogue.PseudoStats.InFrontOfTarget {
+		t.Fatalf("Expected the rogue to stay in front while a frontal phase is active")
+	}
+	encounter.endFrontalPhase(sim)
+	if rogue.PseudoStats.InFrontOfTarget || !tank.PseudoStats.InFrontOfTarget {
+		t.Fatalf("Expected only the rogue to move back behind the target")
+	}
+
+	// Phases still active at the end of an iteration are cleared on reset.
+	encounter.beginFrontalPhase(sim)
+	encounter.resetFrontalPhases()
+	encounter.endFrontalPhase(sim)
+	if rogue.PseudoStats.InFrontOfTarget || encounter.numFrontalPhases != 0 {
+		t.Fatalf("Expected the frontal phase to be cleared by the reset")
+	}
+}
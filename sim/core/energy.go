@@ -345,6 +345,7 @@ func (ec *EnergyCost) SpendCost(sim *Simulation, spell *Spell) {
 func (ec *EnergyCost) IssueRefund(sim *Simulation, spell *Spell) {
 	if ec.Refund > 0 && spell.CurCast.Cost > 0 {
 		spell.Unit.AddEnergy(sim, ec.Refund*spell.CurCast.Cost, ec.RefundMetrics)
+		spell.RecordRefund(ec.Refund * spell.CurCast.Cost)
 	}
 }
 
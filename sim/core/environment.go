@@ -46,6 +46,9 @@ type Environment struct {
 
 	prepullActions []*PrepullAction
 
+	// Raid-wide countdown that all prepull actions are aligned to, if any.
+	pullTimer *proto.PullTimer
+
 	// Used to model variation in pet stat inheritance
 	heartbeatOffset time.Duration
 
@@ -78,6 +81,7 @@ func (env *Environment) construct(raidProto *proto.Raid, encounterProto *proto.E
 	env.BaseDuration = env.Encounter.Duration
 	env.DurationVariation = env.Encounter.DurationVariation
 	env.Raid = NewRaid(raidProto)
+	env.pullTimer = raidProto.PullTimer
 
 	env.Raid.updatePlayersAndPets()
 
@@ -142,6 +146,8 @@ func (env *Environment) initialize(raidProto *proto.Raid, encounterProto *proto.
 	}
 
 	raidStats := env.Raid.applyCharacterEffects(raidProto)
+	env.registerExternalCooldowns(raidProto.ExternalCooldowns)
+	env.registerBattleFatigue()
 
 	for _, party := range env.Raid.Parties {
 		for _, playerOrPet := range party.PlayersAndPets {
@@ -187,7 +193,13 @@ func (env *Environment) finalize(raidProto *proto.Raid, _ *proto.Encounter, raid
 			}
 			playerProto := partyProto.Players[playerIdx]
 			char := player.GetCharacter()
-			char.Rotation = char.newAPLRotation(playerProto.Rotation)
+			rotationProto := playerProto.Rotation
+			if rotationProto.GetType() == proto.APLRotation_TypeAuto && len(rotationProto.GetPriorityList()) == 0 {
+				// The UI resolves auto rotations to a preset, so this only happens for
+				// specs without one.
+				rotationProto = char.GenerateDefaultAPLRotation()
+			}
+			char.Rotation = char.newAPLRotation(rotationProto)
 		}
 	}
 
@@ -422,11 +434,26 @@ func (unit *Unit) RegisterPrepullAction(doAt APLValue, action func(*Simulation))
 
 	env.prepullActions = append(env.prepullActions, &PrepullAction{
 		DoAt:     doAt,
-		doAtTime: doAt.GetDuration(nil),
+		doAtTime: env.alignPrepullTime(doAt.GetDuration(nil)),
 		Action:   action,
 	})
 }
 
+// Applies the raid's shared pull timer to a prepull action time, so that all
+// players start their openers from the same countdown.
+func (env *Environment) alignPrepullTime(doAtTime time.Duration) time.Duration {
+	// Positive times are invalid prepull actions and get filtered out later.
+	if (env.pullTimer == nil) || (doAtTime > 0) {
+		return doAtTime
+	}
+
+	doAtTime += DurationFromSeconds(env.pullTimer.PrecastOffset)
+	if env.pullTimer.Countdown > 0 {
+		doAtTime = max(doAtTime, -DurationFromSeconds(env.pullTimer.Countdown))
+	}
+	return min(doAtTime, 0)
+}
+
 func (env *Environment) PrepullStartTime(sim *Simulation) time.Duration {
 	if !env.IsFinalized() {
 		panic("Env not yet finalized")
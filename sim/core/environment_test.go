@@ -0,0 +1,27 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestAlignPrepullTime(t *testing.T) {
+	env := &Environment{}
+	if aligned := env.alignPrepullTime(-time.Second * 20); aligned != -time.Second*20 {
+		t.Fatalf("Expected prepull time to be unchanged without a pull timer, found %s", aligned)
+	}
+
+	env.pullTimer = &proto.PullTimer{Countdown: 10, PrecastOffset: 0.5}
+	for doAt, expected := range map[time.Duration]time.Duration{
+		-time.Second * 20:       -time.Second * 10,
+		-time.Second * 2:        -time.Millisecond * 1500,
+		-time.Millisecond * 200: 0,
+		time.Second:             time.Second,
+	} {
+		if aligned := env.alignPrepullTime(doAt); aligned != expected {
+			t.Errorf("Expected %s to be aligned to %s, found %s", doAt, expected, aligned)
+		}
+	}
+}
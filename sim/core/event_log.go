@@ -0,0 +1,108 @@
+package core
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Compact binary alternative to the text logs, for long fights with full
+// logging where the text logs get very large. The log is a gzip stream of
+// entries, each made of:
+//   - the sim time of the entry in nanoseconds, as a varint
+//   - the length of the message, as a uvarint
+//   - the message itself
+//
+// Times are absolute rather than relative to the previous entry, so logs from
+// separate sims can be concatenated and still read as one log.
+type EventLogWriter struct {
+	zw      *gzip.Writer
+	scratch [2 * binary.MaxVarintLen64]byte
+	err     error
+}
+
+func NewEventLogWriter(w io.Writer) *EventLogWriter {
+	zw, _ := gzip.NewWriterLevel(w, gzip.BestSpeed)
+	return &EventLogWriter{zw: zw}
+}
+
+// Appends an entry to the log. Errors are kept and returned by Close, so
+// logging doesn't need to be checked at every call site.
+func (elw *EventLogWriter) Write(at time.Duration, message string) {
+	if elw.err != nil {
+		return
+	}
+
+	n := binary.PutVarint(elw.scratch[:], int64(at))
+	n += binary.PutUvarint(elw.scratch[n:], uint64(len(message)))
+	if _, elw.err = elw.zw.Write(elw.scratch[:n]); elw.err != nil {
+		return
+	}
+	_, elw.err = io.WriteString(elw.zw, message)
+}
+
+// Flushes the entries written so far to the underlying writer, e.g. to stream
+// the log while the sim is still running.
+func (elw *EventLogWriter) Flush() error {
+	if elw.err != nil {
+		return elw.err
+	}
+	elw.err = elw.zw.Flush()
+	return elw.err
+}
+
+func (elw *EventLogWriter) Close() error {
+	if closeErr := elw.zw.Close(); elw.err == nil {
+		elw.err = closeErr
+	}
+	return elw.err
+}
+
+// Reads a compact event log, calling handler for each entry in order.
+func ReadEventLog(r io.Reader, handler func(at time.Duration, message string) error) error {
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+
+	br := bufio.NewReader(zr)
+	for {
+		at, err := binary.ReadVarint(br)
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		length, err := binary.ReadUvarint(br)
+		if err != nil {
+			return fmt.Errorf("truncated event log entry: %w", err)
+		}
+		message := make([]byte, length)
+		if _, err := io.ReadFull(br, message); err != nil {
+			return fmt.Errorf("truncated event log entry: %w", err)
+		}
+
+		if err := handler(time.Duration(at), string(message)); err != nil {
+			return err
+		}
+	}
+}
+
+// Converts a compact event log to the text logs format.
+func EventLogToText(r io.Reader, w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	err := ReadEventLog(r, func(at time.Duration, message string) error {
+		_, err := fmt.Fprintf(bw, "[%0.2f] %s\n", at.Seconds(), message)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,107 @@
+package core
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/core/simsignals"
+)
+
+func TestEventLogRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	for _, start := range []time.Duration{0, time.Second} {
+		elw := NewEventLogWriter(&buf)
+		elw.Write(start, "Started")
+		elw.Write(start+time.Millisecond*1500, "")
+		elw.Write(start+time.Minute*12, strings.Repeat("Long entry ", 100))
+		if err := elw.Close(); err != nil {
+			t.Fatalf("Failed to write event log: %s", err)
+		}
+	}
+
+	var times []time.Duration
+	var messages []string
+	err := ReadEventLog(&buf, func(at time.Duration, message string) error {
+		times = append(times, at)
+		messages = append(messages, message)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to read event log: %s", err)
+	}
+
+	// Concatenated logs read as one.
+	expectedTimes := []time.Duration{0, time.Millisecond * 1500, time.Minute * 12, time.Second, time.Millisecond * 2500, time.Minute*12 + time.Second}
+	if len(times) != len(expectedTimes) {
+		t.Fatalf("Expected %d entries, found %d", len(expectedTimes), len(times))
+	}
+	for i, at := range times {
+		if at != expectedTimes[i] {
+			t.Fatalf("Expected entry %d at %s, found %s", i, expectedTimes[i], at)
+		}
+	}
+	if messages[0] != "Started" || messages[1] != "" || messages[5] != strings.Repeat("Long entry ", 100) {
+		t.Fatalf("Unexpected messages: %v", messages)
+	}
+}
+
+func TestCompactLogsMatchTextLogs(t *testing.T) {
+	runWithLogs := func(compact bool) *proto.RaidSimResult {
+		sim := NewSim(&proto.RaidSimRequest{
+			SimOptions: &proto.SimOptions{
+				RandomSeed:          100,
+				Iterations:          1,
+				DebugFirstIteration: true,
+				CompactLogs:         compact,
+			},
+			Raid: &proto.Raid{
+				Parties: []*proto.Party{
+					{
+						Players: []*proto.Player{
+							{
+								Name:      "Logger",
+								Class:     proto.Class_ClassShaman,
+								Buffs:     &proto.IndividualBuffs{},
+								Spec:      &proto.Player_ElementalShaman{},
+								Equipment: &proto.EquipmentSpec{},
+							},
+						},
+						Buffs: &proto.PartyBuffs{},
+					},
+				},
+				// Something to log.
+				ExternalCooldowns: []*proto.ExternalCooldownAssignment{
+					{
+						Cooldown: proto.ExternalCooldown_ExternalCooldownIronbark,
+						Source:   &proto.UnitReference{Type: proto.UnitReference_Player, Index: 0},
+						Target:   &proto.UnitReference{Type: proto.UnitReference_Player, Index: 0},
+					},
+				},
+			},
+			Encounter: &proto.Encounter{
+				Targets: []*proto.Target{
+					{Name: "target", Level: 93, MobType: proto.MobType_MobTypeDemon},
+				},
+				Duration: 60,
+			},
+		}, simsignals.CreateSignals())
+		return sim.run()
+	}
+
+	textResult := runWithLogs(false)
+	compactResult := runWithLogs(true)
+	if compactResult.Logs != "" || len(compactResult.CompactLogs) == 0 {
+		t.Fatalf("Expected only compact logs")
+	}
+
+	var converted strings.Builder
+	if err := EventLogToText(bytes.NewReader(compactResult.CompactLogs), &converted); err != nil {
+		t.Fatalf("Failed to convert compact logs: %s", err)
+	}
+	if textResult.Logs == "" || converted.String() != textResult.Logs {
+		t.Fatalf("Expected the compact logs to convert back to the text logs")
+	}
+}
@@ -105,6 +105,9 @@ func (aura *Aura) NewExclusiveEffect(categoryName string, singleAura bool, confi
 
 	eem := aura.Unit.ExclusiveEffectManager
 	category := eem.GetExclusiveEffectCategory(categoryName)
+	if len(category.effects) > 0 && category.SingleAura != singleAura {
+		panic("Exclusive category " + categoryName + " already has effects with different stacking rules")
+	}
 	category.SingleAura = singleAura
 
 	// If there is already an effect in this category with the same aura, use that instead.
@@ -3,6 +3,8 @@ package core
 import (
 	"testing"
 	"time"
+
+	"github.com/wowsims/mop/sim/core/stats"
 )
 
 func TestSingleAuraExclusiveDurationNoOverwrite(t *testing.T) {
@@ -54,3 +56,150 @@ func TestSingleAuraExclusiveDurationOverwrite(t *testing.T) {
 		t.Fatalf("longer duration exclusive aura failed to overwrite")
 	}
 }
+
+func TestMortalWoundsHealingTakenMultiplier(t *testing.T) {
+	sim := &Simulation{}
+
+	target := Unit{
+		Type:        EnemyUnit,
+		Index:       0,
+		Level:       83,
+		auraTracker: newAuraTracker(),
+		PseudoStats: stats.NewPseudoStats(),
+	}
+	mortalWounds := MortalWoundsAura(&target)
+
+	mortalWounds.Activate(sim)
+	if target.PseudoStats.HealingTakenMultiplier != 0.75 {
+		t.Fatalf("Expected Mortal Wounds to reduce healing taken by 25%%, found multiplier %f", target.PseudoStats.HealingTakenMultiplier)
+	}
+
+	mortalWounds.Deactivate(sim)
+	if target.PseudoStats.HealingTakenMultiplier != 1 {
+		t.Fatalf("Expected healing taken to be restored, found multiplier %f", target.PseudoStats.HealingTakenMultiplier)
+	}
+}
+
+func TestHealingReductionsDoNotStack(t *testing.T) {
+	sim := &Simulation{}
+
+	player := Unit{
+		Type:        PlayerUnit,
+		Index:       0,
+		Level:       CharacterLevel,
+		auraTracker: newAuraTracker(),
+		PseudoStats: stats.NewPseudoStats(),
+	}
+
+	// There is no Battle Fatigue in PvE, so healing is untouched until a debuff lands.
+	if player.PseudoStats.HealingTakenMultiplier != 1 {
+		t.Fatalf("Expected no baseline healing reduction, found %f", player.PseudoStats.HealingTakenMultiplier)
+	}
+
+	mortalWounds := MortalWoundsAura(&player)
+	bossMortalStrike := majorHealingReductionAura(&player, Aura{Label: "Mortal Strike", ActionID: ActionID{SpellID: 1}, Duration: time.Second * 10}, 0.5)
+
+	mortalWounds.Activate(sim)
+	if player.PseudoStats.HealingTakenMultiplier != 0.75 {
+		t.Fatalf("Expected 25%% healing reduction, found multiplier %f", player.PseudoStats.HealingTakenMultiplier)
+	}
+
+	bossMortalStrike.Activate(sim)
+	if player.PseudoStats.HealingTakenMultiplier != 0.5 {
+		t.Fatalf("Expected only the strongest healing reduction to apply, found multiplier %f", player.PseudoStats.HealingTakenMultiplier)
+	}
+}
+
+func newDebuffTestTarget() *Unit {
+	return &Unit{
+		Type:        EnemyUnit,
+		Index:       0,
+		Level:       93,
+		auraTracker: newAuraTracker(),
+		PseudoStats: stats.NewPseudoStats(),
+	}
+}
+
+func TestSpellDamageTakenDebuffsDoNotStack(t *testing.T) {
+	sim := &Simulation{}
+	target := newDebuffTestTarget()
+
+	FireBreathDebuff(target).Activate(sim)
+	MasterPoisonerDebuff(target).Activate(sim)
+	curseOfElements := CurseOfElementsAura(target)
+	curseOfElements.Activate(sim)
+
+	if multiplier := target.PseudoStats.SchoolDamageTakenMultiplier[stats.SchoolIndexFire]; multiplier != 1.05 {
+		t.Fatalf("Expected only one spell damage taken debuff to apply, found multiplier %f", multiplier)
+	}
+	if !curseOfElements.IsActive() {
+		t.Fatalf("Expected the longest spell damage taken debuff to replace the others")
+	}
+}
+
+func TestPhysVulnerabilityDebuffsDoNotStack(t *testing.T) {
+	sim := &Simulation{}
+	target := newDebuffTestTarget()
+
+	// Colossus Smash, Gore and Acid Spit from different players.
+	colossusSmash := PhysVulnerabilityAura(target)
+	gore := GoreAura(target)
+	acidSpit := AcidSpitAura(target)
+	for _, aura := range []*Aura{colossusSmash, gore, acidSpit} {
+		aura.Activate(sim)
+	}
+
+	if multiplier := target.PseudoStats.SchoolDamageTakenMultiplier[stats.SchoolIndexPhysical]; multiplier != 1.04 {
+		t.Fatalf("Expected only one physical vulnerability debuff to apply, found multiplier %f", multiplier)
+	}
+
+	colossusSmash.Deactivate(sim)
+	if multiplier := target.PseudoStats.SchoolDamageTakenMultiplier[stats.SchoolIndexPhysical]; multiplier != 1.04 {
+		t.Fatalf("Expected the remaining debuffs to keep applying, found multiplier %f", multiplier)
+	}
+
+	gore.Deactivate(sim)
+	acidSpit.Deactivate(sim)
+	if multiplier := target.PseudoStats.SchoolDamageTakenMultiplier[stats.SchoolIndexPhysical]; multiplier != 1 {
+		t.Fatalf("Expected no physical vulnerability without debuffs, found multiplier %f", multiplier)
+	}
+}
+
+func TestWeakenedArmorSharedBetweenSources(t *testing.T) {
+	sim := &Simulation{}
+	target := newDebuffTestTarget()
+
+	// Sunder Armor and Faerie Fire both apply the same debuff, so their stacks add up to the cap.
+	sunderArmor := WeakenedArmorAura(target)
+	faerieFire := WeakenedArmorAura(target)
+	if sunderArmor != faerieFire {
+		t.Fatalf("Expected all Weakened Armor sources to share one aura")
+	}
+
+	sunderArmor.Activate(sim)
+	sunderArmor.AddStacks(sim, 2)
+	faerieFire.Activate(sim)
+	faerieFire.AddStacks(sim, 3)
+	if !WithinToleranceFloat64(0.88, target.PseudoStats.ArmorMultiplier, 0.0001) {
+		t.Fatalf("Expected Weakened Armor to cap at 3 stacks, found armor multiplier %f", target.PseudoStats.ArmorMultiplier)
+	}
+
+	// Shattering Throw isn't part of the category and stacks with Weakened Armor.
+	ShatteringThrowAura(target, 0).Activate(sim)
+	if !WithinToleranceFloat64(0.88*0.8, target.PseudoStats.ArmorMultiplier, 0.0001) {
+		t.Fatalf("Expected Shattering Throw to stack with Weakened Armor, found armor multiplier %f", target.PseudoStats.ArmorMultiplier)
+	}
+}
+
+func TestExclusiveCategoryRejectsMixedStackingRules(t *testing.T) {
+	target := newDebuffTestTarget()
+	PhysVulnerabilityAura(target)
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("Expected a panic for an effect with different stacking rules")
+		}
+	}()
+	aura := target.GetOrRegisterAura(Aura{Label: "Test Vulnerability", Duration: time.Second * 10})
+	aura.NewExclusiveEffect(PhysVulnerabilityCategory, true, ExclusiveEffect{})
+}
@@ -0,0 +1,188 @@
+package core
+
+import (
+	"time"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+type externalCooldownConfig struct {
+	spellID  int32
+	label    string
+	duration time.Duration
+	cooldown time.Duration
+
+	// Damage taken multiplier of the target while the cooldown is active.
+	damageTakenMultiplier float64
+
+	// Whether the damage prevented is taken by the caster instead, like Hand
+	// of Sacrifice.
+	transfersDamage bool
+}
+
+var externalCooldownConfigs = map[proto.ExternalCooldown]externalCooldownConfig{
+	proto.ExternalCooldown_ExternalCooldownPainSuppression: {
+		spellID:               33206,
+		label:                 "Pain Suppression",
+		duration:              PainSuppressionDuration,
+		cooldown:              PainSuppressionCD,
+		damageTakenMultiplier: 0.6,
+	},
+	proto.ExternalCooldown_ExternalCooldownHandOfSacrifice: {
+		spellID:               6940,
+		label:                 "Hand of Sacrifice",
+		duration:              time.Second * 12,
+		cooldown:              time.Minute * 2,
+		damageTakenMultiplier: 0.7,
+		transfersDamage:       true,
+	},
+	proto.ExternalCooldown_ExternalCooldownVigilance: {
+		spellID:               VigilanceSpellID,
+		label:                 "Vigilance",
+		duration:              VigilanceDuration,
+		cooldown:              VigilanceCD,
+		damageTakenMultiplier: 0.7,
+	},
+	proto.ExternalCooldown_ExternalCooldownIronbark: {
+		spellID:               102342,
+		label:                 "Ironbark",
+		duration:              time.Second * 12,
+		cooldown:              time.Minute,
+		damageTakenMultiplier: 0.8,
+	},
+}
+
+type externalCooldownKey struct {
+	unit     *Unit
+	cooldown proto.ExternalCooldown
+}
+
+// An external cooldown aura on a target, along with the caster of its latest
+// application.
+type externalCooldownAura struct {
+	*Aura
+	caster *Unit
+}
+
+// Registers the raid's external cooldown assignments. Each caster has one
+// cooldown per external, shared by all of its assignments, and each target one
+// aura per external, so the same external from different casters doesn't
+// stack.
+func (env *Environment) registerExternalCooldowns(assignments []*proto.ExternalCooldownAssignment) {
+	timers := make(map[externalCooldownKey]*Timer)
+	auras := make(map[externalCooldownKey]*externalCooldownAura)
+
+	for _, assignment := range assignments {
+		config, ok := externalCooldownConfigs[assignment.Cooldown]
+		source := env.GetUnit(assignment.Source, nil)
+		target := env.GetUnit(assignment.Target, nil)
+		if !ok || (source == nil) || (target == nil) {
+			continue
+		}
+
+		sourceKey := externalCooldownKey{unit: source, cooldown: assignment.Cooldown}
+		if timers[sourceKey] == nil {
+			timers[sourceKey] = source.NewTimer()
+		}
+
+		targetKey := externalCooldownKey{unit: target, cooldown: assignment.Cooldown}
+		if auras[targetKey] == nil {
+			auras[targetKey] = target.newExternalCooldownAura(config)
+		}
+		aura := auras[targetKey]
+
+		spell := source.GetOrRegisterSpell(SpellConfig{
+			ActionID: ActionID{SpellID: config.spellID, Tag: target.UnitIndex + 1},
+			ProcMask: ProcMaskEmpty,
+			Flags:    SpellFlagHelpful | SpellFlagNoOnCastComplete,
+
+			Cast: CastConfig{
+				CD: Cooldown{
+					Timer:    timers[sourceKey],
+					Duration: config.cooldown,
+				},
+			},
+
+			ApplyEffects: func(sim *Simulation, _ *Unit, _ *Spell) {
+				aura.caster = source
+				aura.Activate(sim)
+			},
+		})
+
+		env.registerExternalCooldownUses(spell, target, assignment)
+	}
+}
+
+func (target *Unit) newExternalCooldownAura(config externalCooldownConfig) *externalCooldownAura {
+	aura := &externalCooldownAura{}
+	aura.Aura = target.RegisterAura(Aura{
+		Label:    config.label + " External",
+		ActionID: ActionID{SpellID: config.spellID},
+		Duration: config.duration,
+	}).AttachMultiplicativePseudoStatBuff(&target.PseudoStats.DamageTakenMultiplier, config.damageTakenMultiplier)
+
+	if config.transfersDamage {
+		transferRatio := (1 - config.damageTakenMultiplier) / config.damageTakenMultiplier
+		transferDamage := func(_ *Aura, sim *Simulation, _ *Spell, result *SpellResult) {
+			if aura.IsActive() && (result.Damage > 0) && (aura.caster != target) {
+				aura.caster.RemoveHealth(sim, result.Damage*transferRatio)
+			}
+		}
+		MakePermanent(target.RegisterAura(Aura{
+			Label:                 config.label + " Transfer",
+			OnSpellHitTaken:       transferDamage,
+			OnPeriodicDamageTaken: transferDamage,
+		}))
+	}
+
+	return aura
+}
+
+func (env *Environment) registerExternalCooldownUses(spell *Spell, target *Unit, assignment *proto.ExternalCooldownAssignment) {
+	tryUse := func(sim *Simulation) bool {
+		if !spell.Unit.IsEnabled() || !spell.IsReady(sim) {
+			return false
+		}
+		return spell.Cast(sim, target)
+	}
+
+	if assignment.HealthThreshold > 0 {
+		useBelowThreshold := func(_ *Aura, sim *Simulation, _ *Spell, result *SpellResult) {
+			if (result.Damage > 0) && (target.CurrentHealthPercent() < assignment.HealthThreshold) {
+				tryUse(sim)
+			}
+		}
+		MakePermanent(target.GetOrRegisterAura(Aura{
+			Label:                 spell.ActionID.String() + " Health Threshold",
+			OnSpellHitTaken:       useBelowThreshold,
+			OnPeriodicDamageTaken: useBelowThreshold,
+		}))
+	}
+
+	timings := make([]time.Duration, len(assignment.Timings))
+	for i, timing := range assignment.Timings {
+		timings[i] = DurationFromSeconds(timing)
+	}
+	onCooldown := (len(timings) == 0) && (assignment.HealthThreshold <= 0)
+	if onCooldown {
+		timings = append(timings, 0)
+	}
+
+	spell.Unit.RegisterResetEffect(func(sim *Simulation) {
+		for _, timing := range timings {
+			pa := &PendingAction{
+				NextActionAt: timing,
+				Priority:     ActionPriorityDOT,
+			}
+			pa.OnAction = func(sim *Simulation) {
+				// Scheduled uses still on cooldown wait until it is ready.
+				if spell.IsReady(sim) && (!tryUse(sim) || !onCooldown) {
+					return
+				}
+				pa.NextActionAt = spell.ReadyAt()
+				sim.AddPendingAction(pa)
+			}
+			sim.AddPendingAction(pa)
+		}
+	})
+}
@@ -0,0 +1,92 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/core/simsignals"
+)
+
+func setupExternalCooldownsSim(assignments ...*proto.ExternalCooldownAssignment) *Simulation {
+	newPlayer := func(name string) *proto.Player {
+		return &proto.Player{
+			Name:      name,
+			Class:     proto.Class_ClassShaman,
+			Buffs:     &proto.IndividualBuffs{},
+			Spec:      &proto.Player_ElementalShaman{},
+			Equipment: &proto.EquipmentSpec{},
+		}
+	}
+
+	sim := NewSim(&proto.RaidSimRequest{
+		SimOptions: &proto.SimOptions{
+			RandomSeed: 100,
+		},
+		Raid: &proto.Raid{
+			Parties: []*proto.Party{
+				{
+					Players: []*proto.Player{newPlayer("Healer"), newPlayer("Tank")},
+					Buffs:   &proto.PartyBuffs{},
+				},
+			},
+			ExternalCooldowns: assignments,
+		},
+		Encounter: &proto.Encounter{
+			Targets: []*proto.Target{
+				{Name: "target", Level: 93, MobType: proto.MobType_MobTypeDemon},
+			},
+			Duration: 300,
+		},
+	}, simsignals.CreateSignals())
+	sim.Reset()
+
+	return sim
+}
+
+func newExternalCooldownAssignment(cooldown proto.ExternalCooldown, timings ...float64) *proto.ExternalCooldownAssignment {
+	return &proto.ExternalCooldownAssignment{
+		Cooldown: cooldown,
+		Source:   &proto.UnitReference{Type: proto.UnitReference_Player, Index: 0},
+		Target:   &proto.UnitReference{Type: proto.UnitReference_Player, Index: 1},
+		Timings:  timings,
+	}
+}
+
+func TestScheduledExternalCooldowns(t *testing.T) {
+	sim := setupExternalCooldownsSim(newExternalCooldownAssignment(proto.ExternalCooldown_ExternalCooldownPainSuppression, 5, 10))
+	tank := &sim.Raid.Parties[0].Players[1].GetCharacter().Unit
+	aura := tank.GetAuraByID(ActionID{SpellID: 33206})
+
+	runDamageProfileSimUntil(sim, time.Second*6)
+	if !aura.IsActive() || tank.PseudoStats.DamageTakenMultiplier != 0.6 {
+		t.Fatalf("Expected Pain Suppression to reduce the tank's damage taken by 40%%, found %f", tank.PseudoStats.DamageTakenMultiplier)
+	}
+
+	// The second use waits for the cooldown.
+	runDamageProfileSimUntil(sim, time.Second*20)
+	if aura.IsActive() || tank.PseudoStats.DamageTakenMultiplier != 1 {
+		t.Fatalf("Expected Pain Suppression to have expired")
+	}
+	runDamageProfileSimUntil(sim, time.Second*184)
+	if aura.IsActive() {
+		t.Fatalf("Expected the second use to wait until the cooldown is ready at 185s")
+	}
+	runDamageProfileSimUntil(sim, time.Second*186)
+	if !aura.IsActive() {
+		t.Fatalf("Expected the second use as soon as the cooldown is ready")
+	}
+}
+
+func TestExternalCooldownsOnCooldown(t *testing.T) {
+	sim := setupExternalCooldownsSim(newExternalCooldownAssignment(proto.ExternalCooldown_ExternalCooldownIronbark))
+	tank := &sim.Raid.Parties[0].Players[1].GetCharacter().Unit
+	aura := tank.GetAuraByID(ActionID{SpellID: 102342})
+
+	for _, at := range []time.Duration{time.Second, time.Second * 61, time.Second * 121} {
+		runDamageProfileSimUntil(sim, at)
+		if !aura.IsActive() || tank.PseudoStats.DamageTakenMultiplier != 0.8 {
+			t.Fatalf("Expected Ironbark to be active at %s", at)
+		}
+	}
+}
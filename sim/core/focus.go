@@ -238,5 +238,6 @@ func (ec *FocusCost) SpendCost(sim *Simulation, spell *Spell) {
 func (ec *FocusCost) IssueRefund(sim *Simulation, spell *Spell) {
 	if ec.Refund > 0 && spell.CurCast.Cost > 0 {
 		spell.Unit.AddFocus(sim, ec.Refund*spell.CurCast.Cost, ec.RefundMetrics)
+		spell.RecordRefund(ec.Refund * spell.CurCast.Cost)
 	}
 }
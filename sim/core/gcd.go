@@ -46,6 +46,9 @@ func (unit *Unit) SetGCDTimer(sim *Simulation, gcdReadyAt time.Duration) {
 	}
 
 	unit.GCD.Set(gcdReadyAt)
+	if unit.SpellQueueWindow > 0 {
+		gcdReadyAt = max(sim.CurrentTime, gcdReadyAt-unit.SpellQueueWindow)
+	}
 	unit.SetRotationTimer(sim, gcdReadyAt)
 }
 
@@ -91,7 +94,10 @@ func (unit *Unit) ReactToEvent(sim *Simulation, randomizeReactionTime bool) {
 // Call this to stop the GCD loop for a unit.
 // This is mostly used for pets that get summoned / expire.
 func (unit *Unit) CancelGCDTimer(sim *Simulation) {
-	unit.rotationAction.Cancel(sim)
+	// Targets without an AI never start a GCD loop.
+	if unit.rotationAction != nil {
+		unit.rotationAction.Cancel(sim)
+	}
 }
 
 func (unit *Unit) CancelHardcast(sim *Simulation) {
@@ -107,6 +113,15 @@ func (unit *Unit) CancelHardcast(sim *Simulation) {
 	}
 }
 
+// Returns the remaining time of the unit's current cast of the spell, or 0 if
+// it isn't casting it.
+func (unit *Unit) RemainingCastTimeOf(sim *Simulation, actionID ActionID) time.Duration {
+	if unit.Hardcast.ActionID != actionID {
+		return 0
+	}
+	return max(0, unit.Hardcast.Expires-sim.CurrentTime)
+}
+
 func (unit *Unit) WaitUntil(sim *Simulation, readyTime time.Duration) {
 	if readyTime < sim.CurrentTime {
 		panic(unit.Label + ": cannot wait negative time")
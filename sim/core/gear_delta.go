@@ -0,0 +1,121 @@
+package core
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/core/simsignals"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+// Returns a copy of the equipment with the item placed in the given slot. A
+// two-hander in the main hand also clears the off hand, unless the player can
+// dual wield them.
+func swapItemInEquipment(equipment *proto.EquipmentSpec, slot proto.ItemSlot, itemSpec *proto.ItemSpec, isFuryWarrior bool) (*proto.EquipmentSpec, error) {
+	item, ok := ItemsByID[itemSpec.Id]
+	if !ok {
+		return nil, fmt.Errorf("no item with id: %d", itemSpec.Id)
+	}
+	if !slices.Contains(eligibleSlotsForItem(&item, isFuryWarrior), slot) {
+		return nil, fmt.Errorf("%s cannot be equipped in %s", item.Name, slot)
+	}
+
+	newEquipment := &proto.EquipmentSpec{}
+	if equipment != nil {
+		newEquipment = googleProto.Clone(equipment).(*proto.EquipmentSpec)
+	}
+	for len(newEquipment.Items) < int(NumItemSlots) {
+		newEquipment.Items = append(newEquipment.Items, &proto.ItemSpec{})
+	}
+
+	newEquipment.Items[slot] = googleProto.Clone(itemSpec).(*proto.ItemSpec)
+	if slot == proto.ItemSlot_ItemSlotMainHand && item.HandType == proto.HandType_HandTypeTwoHand && !isFuryWarrior {
+		newEquipment.Items[proto.ItemSlot_ItemSlotOffHand] = &proto.ItemSpec{}
+	}
+
+	return newEquipment, nil
+}
+
+// Returns a function which sims the player with the given equipment. RNG is
+// fixed across calls, so per-iteration deltas between them have very low variance.
+func newPairedGearSim(player *proto.Player, partyBuffs *proto.PartyBuffs, raidBuffs *proto.RaidBuffs, debuffs *proto.Debuffs, tanks []*proto.UnitReference, encounter *proto.Encounter, simOptions *proto.SimOptions, signals simsignals.Signals) func(*proto.EquipmentSpec) *proto.RaidSimResult {
+	simOptions = googleProto.Clone(simOptions).(*proto.SimOptions)
+	simOptions.SaveAllValues = true
+	simOptions.UseLabeledRands = true
+	if simOptions.RandomSeed == 0 {
+		simOptions.RandomSeed = time.Now().UnixNano()
+	}
+
+	simFunc := runSimConcurrent
+	// Don't use go threads in wasm, it just adds more overhead and makes the worker more unresponsive.
+	if IsRunningInWasm() {
+		simFunc = RunSim
+	}
+
+	return func(equipment *proto.EquipmentSpec) *proto.RaidSimResult {
+		newPlayer := googleProto.Clone(player).(*proto.Player)
+		newPlayer.Equipment = equipment
+
+		raidProto := SinglePlayerRaidProto(newPlayer, partyBuffs, raidBuffs, debuffs)
+		raidProto.Tanks = tanks
+
+		return simFunc(&proto.RaidSimRequest{
+			Raid:       raidProto,
+			Encounter:  encounter,
+			SimOptions: simOptions,
+		}, nil, signals)
+	}
+}
+
+// Returns the mean and standard deviation of the per-iteration DPS delta between two paired sims.
+func pairedDpsDelta(basePlayer *proto.UnitMetrics, player *proto.UnitMetrics) (float64, float64) {
+	var dpsDelta aggregator
+	for i := range basePlayer.Dps.AllValues {
+		dpsDelta.add(player.Dps.AllValues[i] - basePlayer.Dps.AllValues[i])
+	}
+	return dpsDelta.meanAndStdDev()
+}
+
+// Sims the player with a single item swapped against their equipped gear.
+func runGearDelta(request *proto.GearDeltaRequest, signals simsignals.Signals) *proto.GearDeltaResult {
+	if request.Item == nil {
+		return &proto.GearDeltaResult{Error: &proto.ErrorOutcome{Message: "No item to compare"}}
+	}
+
+	isFuryWarrior := PlayerProtoToSpec(request.Player) == proto.Spec_SpecFuryWarrior
+	newEquipment, err := swapItemInEquipment(request.Player.Equipment, request.Slot, request.Item, isFuryWarrior)
+	if err != nil {
+		return &proto.GearDeltaResult{Error: &proto.ErrorOutcome{Message: err.Error()}}
+	}
+
+	simGear := newPairedGearSim(request.Player, request.PartyBuffs, request.RaidBuffs, request.Debuffs, request.Tanks, request.Encounter, request.SimOptions, signals)
+
+	baseResult := simGear(request.Player.Equipment)
+	if baseResult.Error != nil {
+		return &proto.GearDeltaResult{Error: baseResult.Error}
+	}
+	basePlayer := baseResult.RaidMetrics.Parties[0].Players[0]
+
+	simResult := simGear(newEquipment)
+	if simResult.Error != nil {
+		return &proto.GearDeltaResult{Error: simResult.Error}
+	}
+	player := simResult.RaidMetrics.Parties[0].Players[0]
+
+	dpsDeltaMean, dpsDeltaStdev := pairedDpsDelta(basePlayer, player)
+
+	return &proto.GearDeltaResult{
+		BaseDps:       basePlayer.Dps.Avg,
+		BaseHps:       basePlayer.Hps.Avg,
+		BaseTps:       basePlayer.Threat.Avg,
+		Dps:           player.Dps.Avg,
+		DpsDelta:      dpsDeltaMean,
+		DpsDeltaStdev: dpsDeltaStdev,
+		Hps:           player.Hps.Avg,
+		HpsDelta:      player.Hps.Avg - basePlayer.Hps.Avg,
+		Tps:           player.Threat.Avg,
+		TpsDelta:      player.Threat.Avg - basePlayer.Threat.Avg,
+	}
+}
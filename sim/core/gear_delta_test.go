@@ -0,0 +1,51 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestSwapItemInEquipment(t *testing.T) {
+	items := map[int32]Item{
+		-1: {ID: -1, Type: proto.ItemType_ItemTypeHead},
+		-2: {ID: -2, Type: proto.ItemType_ItemTypeWeapon, HandType: proto.HandType_HandTypeTwoHand},
+		-3: {ID: -3, Type: proto.ItemType_ItemTypeWeapon, HandType: proto.HandType_HandTypeOneHand},
+	}
+	for id, item := range items {
+		ItemsByID[id] = item
+		defer delete(ItemsByID, id)
+	}
+
+	equipment := &proto.EquipmentSpec{Items: make([]*proto.ItemSpec, NumItemSlots)}
+	for i := range equipment.Items {
+		equipment.Items[i] = &proto.ItemSpec{}
+	}
+	equipment.Items[proto.ItemSlot_ItemSlotMainHand] = &proto.ItemSpec{Id: -3}
+	equipment.Items[proto.ItemSlot_ItemSlotOffHand] = &proto.ItemSpec{Id: -3}
+
+	swapped, err := swapItemInEquipment(equipment, proto.ItemSlot_ItemSlotHead, &proto.ItemSpec{Id: -1, Enchant: 7}, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if swapped.Items[proto.ItemSlot_ItemSlotHead].Enchant != 7 || equipment.Items[proto.ItemSlot_ItemSlotHead].Id != 0 {
+		t.Fatalf("Expected only the copy to be swapped, found %v", swapped.Items[proto.ItemSlot_ItemSlotHead])
+	}
+
+	swapped, _ = swapItemInEquipment(equipment, proto.ItemSlot_ItemSlotMainHand, &proto.ItemSpec{Id: -2}, false)
+	if swapped.Items[proto.ItemSlot_ItemSlotOffHand].Id != 0 {
+		t.Fatalf("Expected two-hander to clear the off hand")
+	}
+
+	swapped, _ = swapItemInEquipment(equipment, proto.ItemSlot_ItemSlotMainHand, &proto.ItemSpec{Id: -2}, true)
+	if swapped.Items[proto.ItemSlot_ItemSlotOffHand].Id != -3 {
+		t.Fatalf("Expected Fury Warriors to keep their off hand")
+	}
+
+	if _, err := swapItemInEquipment(equipment, proto.ItemSlot_ItemSlotHead, &proto.ItemSpec{Id: -2}, false); err == nil {
+		t.Fatalf("Expected an error for an item in the wrong slot")
+	}
+	if _, err := swapItemInEquipment(equipment, proto.ItemSlot_ItemSlotHead, &proto.ItemSpec{Id: -4}, false); err == nil {
+		t.Fatalf("Expected an error for an unknown item")
+	}
+}
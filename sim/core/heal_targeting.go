@@ -0,0 +1,83 @@
+package core
+
+import (
+	"slices"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+// Health percent used for target selection. Units without a health bar never
+// take damage, so they are always treated as uninjured.
+func smartHealHealthPercent(unit *Unit) float64 {
+	if !unit.HasHealthBar() {
+		return 1
+	}
+	return unit.CurrentHealthPercent()
+}
+
+// Whether any enemy is currently attacking the unit.
+func (unit *Unit) isBeingTanked() bool {
+	for _, enemy := range unit.Env.Encounter.ActiveTargetUnits {
+		if enemy.CurrentTarget == unit {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns up to maxTargets living allies of the unit, ordered by the given
+// strategy. Used by smart heals such as Wild Growth or Chi Wave, which pick
+// their own targets.
+func (unit *Unit) SmartHealTargets(sim *Simulation, strategy proto.HealTargetStrategy, maxTargets int) []*Unit {
+	allies := make([]*Unit, 0, len(unit.Env.Raid.AllPlayerUnits))
+	for _, ally := range unit.Env.Raid.AllPlayerUnits {
+		if ally.IsEnabled() && (smartHealHealthPercent(ally) > 0) {
+			allies = append(allies, ally)
+		}
+	}
+
+	// Ties keep raid order so results are deterministic.
+	slices.SortStableFunc(allies, func(a, b *Unit) int {
+		aPct, bPct := smartHealHealthPercent(a), smartHealHealthPercent(b)
+		if strategy == proto.HealTargetStrategy_HealTargetTankPriority {
+			aTank, bTank := a.isBeingTanked() && (aPct < 1), b.isBeingTanked() && (bPct < 1)
+			if aTank != bTank {
+				return Ternary(aTank, -1, 1)
+			}
+		}
+		switch {
+		case aPct < bPct:
+			return -1
+		case aPct > bPct:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	if strategy == proto.HealTargetStrategy_HealTargetRandomInjured {
+		numInjured := 0
+		for numInjured < len(allies) && smartHealHealthPercent(allies[numInjured]) < 1 {
+			numInjured++
+		}
+		shuffleUnits(sim, allies[:numInjured])
+		shuffleUnits(sim, allies[numInjured:])
+	}
+
+	return allies[:min(maxTargets, len(allies))]
+}
+
+// Single target version of SmartHealTargets. Returns nil if every ally is dead.
+func (unit *Unit) SmartHealTarget(sim *Simulation, strategy proto.HealTargetStrategy) *Unit {
+	if targets := unit.SmartHealTargets(sim, strategy, 1); len(targets) > 0 {
+		return targets[0]
+	}
+	return nil
+}
+
+func shuffleUnits(sim *Simulation, units []*Unit) {
+	for i := len(units) - 1; i > 0; i-- {
+		j := int(sim.RandomFloat("Smart Heal Target") * float64(i+1))
+		units[i], units[j] = units[j], units[i]
+	}
+}
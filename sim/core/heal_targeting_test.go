@@ -0,0 +1,54 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/core/stats"
+)
+
+func TestSmartHealTargets(t *testing.T) {
+	sim := &Simulation{rand: NewSplitMix(1)}
+	env := &Environment{Raid: &Raid{}}
+
+	newAlly := func(healthPercent float64) *Unit {
+		unit := &Unit{Type: PlayerUnit, Env: env, enabled: true}
+		unit.stats[stats.Health] = 100
+		unit.healthBar = healthBar{unit: unit, currentHealth: 100 * healthPercent}
+		env.Raid.AllPlayerUnits = append(env.Raid.AllPlayerUnits, unit)
+		return unit
+	}
+	healer := newAlly(1)
+	tank := newAlly(0.8)
+	injured := newAlly(0.5)
+	dead := newAlly(0)
+	dummy := &Unit{Type: PlayerUnit, Env: env, enabled: true}
+	env.Raid.AllPlayerUnits = append(env.Raid.AllPlayerUnits, dummy)
+
+	boss := &Unit{Type: EnemyUnit, Env: env, CurrentTarget: tank}
+	env.Encounter.ActiveTargetUnits = []*Unit{boss}
+
+	if target := healer.SmartHealTarget(sim, proto.HealTargetStrategy_HealTargetLowestHealth); target != injured {
+		t.Fatalf("Expected the lowest health ally to be picked")
+	}
+	if target := healer.SmartHealTarget(sim, proto.HealTargetStrategy_HealTargetTankPriority); target != tank {
+		t.Fatalf("Expected the injured tank to be picked")
+	}
+
+	targets := healer.SmartHealTargets(sim, proto.HealTargetStrategy_HealTargetLowestHealth, 10)
+	if len(targets) != 4 || targets[0] != injured || targets[1] != tank || targets[2] != healer || targets[3] != dummy {
+		t.Fatalf("Expected living allies ordered by health, with ties in raid order")
+	}
+	for _, target := range targets {
+		if target == dead {
+			t.Fatalf("Dead allies should never be picked")
+		}
+	}
+
+	for i := 0; i < 20; i++ {
+		targets := healer.SmartHealTargets(sim, proto.HealTargetStrategy_HealTargetRandomInjured, 2)
+		if !((targets[0] == tank && targets[1] == injured) || (targets[0] == injured && targets[1] == tank)) {
+			t.Fatalf("Expected injured allies to be picked before uninjured ones")
+		}
+	}
+}
@@ -51,7 +51,7 @@ func (hb *healthBar) GainHealth(sim *Simulation, amount float64, metrics *Resour
 	}
 
 	oldHealth := hb.currentHealth
-	newHealth := min(oldHealth+amount, hb.unit.MaxHealth())
+	newHealth := min(oldHealth+hb.unit.absorbHealing(sim, amount), hb.unit.MaxHealth())
 	metrics.AddEvent(amount, newHealth-oldHealth)
 
 	if sim.Log != nil {
@@ -78,6 +78,10 @@ func (hb *healthBar) RemoveHealth(sim *Simulation, amount float64) {
 			WeightedDamage: amount / hb.MaxHealth(),
 		}
 		hb.unit.Metrics.tmiList = append(hb.unit.Metrics.tmiList, entry)
+
+		if hb.unit.Metrics.activeMitigation != nil {
+			hb.unit.Metrics.activeMitigation.addDamageTaken(amount)
+		}
 	}
 
 	if sim.Log != nil {
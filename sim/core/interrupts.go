@@ -0,0 +1,80 @@
+package core
+
+import (
+	"time"
+)
+
+// Interrupts the unit's current cast or channel, and locks it out of casting
+// spells of the interrupted spell's schools for the lockout duration. Physical
+// schools are never locked out. Returns whether anything was interrupted.
+func (unit *Unit) InterruptCast(sim *Simulation, lockout time.Duration) bool {
+	var spell *Spell
+	var target *Unit
+
+	if hc := unit.Hardcast; (hc.Expires > sim.CurrentTime) && !hc.Uninterruptible {
+		spell = unit.GetSpell(hc.ActionID)
+		target = hc.Target
+		unit.CancelHardcast(sim)
+	} else if channeledDot := unit.ChanneledDot; channeledDot != nil {
+		spell = channeledDot.Spell
+		target = channeledDot.Unit
+		channeledDot.Deactivate(sim)
+		if unit.GCD.IsReady(sim) {
+			unit.WaitUntil(sim, sim.CurrentTime+unit.ReactionTime)
+		}
+	}
+
+	if spell == nil {
+		return false
+	}
+
+	if target != nil {
+		spell.SpellMetrics[target.UnitIndex].Interrupts++
+	}
+
+	lockedSchools := spell.SpellSchool &^ SpellSchoolPhysical
+	if lockout > 0 && lockedSchools != SpellSchoolNone {
+		for i := range unit.schoolLockouts {
+			if lockedSchools.Matches(SpellSchool(1 << i)) {
+				unit.schoolLockouts[i] = max(unit.schoolLockouts[i], sim.CurrentTime+lockout)
+			}
+		}
+	}
+
+	if sim.Log != nil {
+		unit.Log(sim, "Interrupted %s, locking out its school for %s", spell.ActionID, lockout)
+	}
+	return true
+}
+
+// Delays the completion of the unit's current cast, e.g. from taking damage
+// while casting. Channels are not affected. Returns whether a cast was delayed.
+func (unit *Unit) PushbackCast(sim *Simulation, delay time.Duration) bool {
+	hc := &unit.Hardcast
+	if (hc.Expires <= sim.CurrentTime) || hc.Uninterruptible || (delay <= 0) {
+		return false
+	}
+
+	hc.Expires += delay
+	unit.newHardcastAction(sim)
+	unit.SetGCDTimer(sim, max(unit.NextGCDAt(), hc.Expires))
+
+	if spell := unit.GetSpell(hc.ActionID); spell != nil && hc.Target != nil {
+		spell.SpellMetrics[hc.Target.UnitIndex].TotalCastTime += delay
+	}
+
+	if sim.Log != nil {
+		unit.Log(sim, "Pushed back %s by %s", hc.ActionID, delay)
+	}
+	return true
+}
+
+// Returns whether the unit is locked out of casting any of the given schools.
+func (unit *Unit) IsLockedOut(sim *Simulation, school SpellSchool) bool {
+	for i, expiresAt := range unit.schoolLockouts {
+		if (expiresAt > sim.CurrentTime) && school.Matches(SpellSchool(1<<i)) {
+			return true
+		}
+	}
+	return false
+}
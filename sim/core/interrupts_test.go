@@ -0,0 +1,101 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+// Starts a 2s cast of the fake agent's spell, returning whether it completed.
+func startFakeHardcast(sim *Simulation, fa *FakeAgent, target *Unit) *bool {
+	completed := false
+	fa.Hardcast = Hardcast{
+		Expires:  sim.CurrentTime + time.Second*2,
+		ActionID: fa.Spell.ActionID,
+		OnComplete: func(_ *Simulation, _ *Unit) {
+			completed = true
+		},
+		Target: target,
+	}
+	fa.newHardcastAction(sim)
+	return &completed
+}
+
+func TestInterruptCast(t *testing.T) {
+	sim := SetupFakeSim()
+	runDamageProfileSimUntil(sim, time.Second)
+
+	fa := sim.Raid.Parties[0].Players[0].(*FakeAgent)
+	caster := &fa.Unit
+	target := sim.Encounter.ActiveTargetUnits[0]
+
+	if caster.InterruptCast(sim, time.Second) {
+		t.Fatalf("Expected nothing to interrupt while not casting")
+	}
+
+	completed := startFakeHardcast(sim, fa, target)
+	if !caster.PushbackCast(sim, time.Millisecond*500) || caster.Hardcast.Expires != time.Millisecond*3500 {
+		t.Fatalf("Expected the cast to be pushed back to 3.5s, found %s", caster.Hardcast.Expires)
+	}
+
+	runDamageProfileSimUntil(sim, time.Second*2)
+	if !caster.InterruptCast(sim, time.Second*4) {
+		t.Fatalf("Expected the cast to be interrupted")
+	}
+
+	if metrics := fa.Spell.SpellMetrics[target.UnitIndex]; metrics.Interrupts != 1 {
+		t.Fatalf("Expected 1 interrupted cast, found %d", metrics.Interrupts)
+	}
+
+	// Only the school of the interrupted spell is locked out.
+	if !caster.IsLockedOut(sim, SpellSchoolShadow) || caster.IsLockedOut(sim, SpellSchoolFire) {
+		t.Fatalf("Expected only shadow spells to be locked out")
+	}
+	if !caster.IsLockedOut(sim, SpellSchoolShadowFlame) {
+		t.Fatalf("Expected multi-school spells including shadow to be locked out")
+	}
+
+	runDamageProfileSimUntil(sim, time.Second*3)
+	if fa.Spell.CanCast(sim, target) {
+		t.Fatalf("Expected the spell to be locked out")
+	}
+
+	runDamageProfileSimUntil(sim, time.Second*6)
+	if !fa.Spell.CanCast(sim, target) {
+		t.Fatalf("Expected the lockout to have expired")
+	}
+	if *completed {
+		t.Fatalf("Expected the interrupted cast to never complete")
+	}
+}
+
+func TestUninterruptibleCast(t *testing.T) {
+	sim := SetupFakeSim()
+	runDamageProfileSimUntil(sim, time.Second)
+
+	fa := sim.Raid.Parties[0].Players[0].(*FakeAgent)
+	caster := &fa.Unit
+	completed := startFakeHardcast(sim, fa, sim.Encounter.ActiveTargetUnits[0])
+	caster.Hardcast.Uninterruptible = true
+
+	if caster.PushbackCast(sim, time.Millisecond*500) || caster.InterruptCast(sim, time.Second) {
+		t.Fatalf("Expected uninterruptible casts to be neither pushed back nor interrupted")
+	}
+	if caster.Hardcast.Expires != time.Second*3 {
+		t.Fatalf("Expected the cast to finish at 3s, found %s", caster.Hardcast.Expires)
+	}
+
+	runDamageProfileSimUntil(sim, time.Second*4)
+	if !*completed {
+		t.Fatalf("Expected the cast to complete")
+	}
+}
+
+func TestNoLockoutDuringPrepull(t *testing.T) {
+	sim := SetupFakeSim()
+	caster := &sim.Raid.Parties[0].Players[0].(*FakeAgent).Unit
+
+	sim.CurrentTime = -time.Second * 2
+	if caster.IsLockedOut(sim, SpellSchoolFire) {
+		t.Fatalf("Expected no school to be locked out before any interrupt")
+	}
+}
@@ -16,6 +16,9 @@ type ApplyEffect func(Agent, proto.ItemLevelState)
 var itemEffects = map[int32]ApplyEffect{}
 var enchantEffects = map[int32]ApplyEffect{}
 
+// Action IDs of the on-use spells registered by tinkers, keyed by effect ID.
+var tinkerOnUseActionIDs = map[int32]ActionID{}
+
 // IDs of item effects which should be used for tests.
 var itemEffectsForTest []int32
 var enchantEffectsForTest []int32
@@ -74,6 +77,30 @@ func NewEnchantEffect(id int32, enchantEffect ApplyEffect) {
 	}
 }
 
+// Records the on-use spell a tinker registers, so it can be looked up by item slot.
+func RegisterTinkerOnUse(effectID int32, actionID ActionID) {
+	tinkerOnUseActionIDs[effectID] = actionID
+}
+
+// Returns the on-use spell of whatever is equipped in the slot: the item's own
+// on-use effect if it has one, otherwise its tinker.
+func (character *Character) GetOnUseSpellForSlot(slot proto.ItemSlot) *Spell {
+	item := character.Equipment[slot]
+	if item.ID == 0 {
+		return nil
+	}
+
+	if spell := character.GetSpell(ActionID{ItemID: item.ID}); spell != nil {
+		return spell
+	}
+
+	if actionID, ok := tinkerOnUseActionIDs[item.Tinker.EffectID]; ok {
+		return character.GetSpell(actionID)
+	}
+
+	return nil
+}
+
 func (equipment *Equipment) applyItemEffects(agent Agent, registeredItemEffects map[int32]bool, registeredItemEnchantEffects map[int32]bool, includeGemEffects bool) {
 	for _, eq := range equipment {
 		if applyItemEffect, ok := itemEffects[eq.ID]; ok && !registeredItemEffects[eq.ID] {
@@ -0,0 +1,36 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestGetOnUseSpellForSlot(t *testing.T) {
+	const tinkerID = -1
+	tinkerActionID := ActionID{SpellID: 999002}
+	RegisterTinkerOnUse(tinkerID, tinkerActionID)
+	defer delete(tinkerOnUseActionIDs, tinkerID)
+
+	character := &Character{}
+	character.Equipment[proto.ItemSlot_ItemSlotTrinket1] = Item{ID: 999001}
+	character.Equipment[proto.ItemSlot_ItemSlotHands] = Item{ID: 999003, Tinker: Enchant{EffectID: tinkerID}}
+	character.Equipment[proto.ItemSlot_ItemSlotWaist] = Item{ID: 999004}
+
+	trinketSpell := &Spell{ActionID: ActionID{ItemID: 999001}}
+	tinkerSpell := &Spell{ActionID: tinkerActionID}
+	character.Spellbook = []*Spell{trinketSpell, tinkerSpell}
+
+	if spell := character.GetOnUseSpellForSlot(proto.ItemSlot_ItemSlotTrinket1); spell != trinketSpell {
+		t.Fatalf("Expected the trinket's on-use spell, found %v", spell)
+	}
+	if spell := character.GetOnUseSpellForSlot(proto.ItemSlot_ItemSlotHands); spell != tinkerSpell {
+		t.Fatalf("Expected the tinker's on-use spell, found %v", spell)
+	}
+	if spell := character.GetOnUseSpellForSlot(proto.ItemSlot_ItemSlotWaist); spell != nil {
+		t.Fatalf("Expected no on-use spell for an item without one, found %v", spell)
+	}
+	if spell := character.GetOnUseSpellForSlot(proto.ItemSlot_ItemSlotHead); spell != nil {
+		t.Fatalf("Expected no on-use spell for an empty slot, found %v", spell)
+	}
+}
@@ -0,0 +1,64 @@
+package core
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+var specItemFilters = make(map[proto.Spec]ItemFilter)
+
+// Registers the equip rules of a spec, so clients can query them instead of
+// keeping their own copy. Only the equip related fields of the filter are used.
+func RegisterItemFilter(spec proto.Spec, filter ItemFilter) {
+	if _, ok := specItemFilters[spec]; ok {
+		panic("Already registered item filter for spec: " + spec.String())
+	}
+
+	specItemFilters[spec] = filter
+}
+
+func GetItemFilter(spec proto.Spec) (ItemFilter, bool) {
+	filter, ok := specItemFilters[spec]
+	return filter, ok
+}
+
+func (filter *ItemFilter) ToProto() *proto.ItemFilterRules {
+	return &proto.ItemFilterRules{
+		ArmorType:         filter.ArmorType,
+		WeaponTypes:       slices.Clone(filter.WeaponTypes),
+		HandTypes:         slices.Clone(filter.HandTypes),
+		RangedWeaponTypes: slices.Clone(filter.RangedWeaponTypes),
+	}
+}
+
+func getItemFilter(request *proto.ItemFilterRequest) *proto.ItemFilterResult {
+	filter, ok := GetItemFilter(request.Spec)
+	if !ok {
+		return &proto.ItemFilterResult{
+			Error: &proto.ErrorOutcome{Message: fmt.Sprintf("No item filter registered for spec: %s", request.Spec)},
+		}
+	}
+
+	result := &proto.ItemFilterResult{
+		Rules: filter.ToProto(),
+	}
+
+	if len(request.ItemIds) > 0 {
+		for _, itemID := range request.ItemIds {
+			if item, ok := ItemsByID[itemID]; ok && filter.Matches(item, true) {
+				result.ValidItemIds = append(result.ValidItemIds, itemID)
+			}
+		}
+	} else {
+		for itemID, item := range ItemsByID {
+			if filter.Matches(item, true) {
+				result.ValidItemIds = append(result.ValidItemIds, itemID)
+			}
+		}
+		slices.Sort(result.ValidItemIds)
+	}
+
+	return result
+}
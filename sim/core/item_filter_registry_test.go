@@ -0,0 +1,53 @@
+package core
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestGetItemFilterRules(t *testing.T) {
+	const spec = proto.Spec_SpecAfflictionWarlock
+	if _, ok := GetItemFilter(spec); !ok {
+		RegisterItemFilter(spec, ItemFilter{
+			ArmorType:   proto.ArmorType_ArmorTypeCloth,
+			WeaponTypes: []proto.WeaponType{proto.WeaponType_WeaponTypeStaff},
+		})
+		defer delete(specItemFilters, spec)
+	}
+
+	items := map[int32]Item{
+		-1: {ID: -1, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypeCloth},
+		-2: {ID: -2, Type: proto.ItemType_ItemTypeHead, ArmorType: proto.ArmorType_ArmorTypePlate},
+		-3: {ID: -3, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeStaff},
+		-4: {ID: -4, Type: proto.ItemType_ItemTypeWeapon, WeaponType: proto.WeaponType_WeaponTypeFist},
+	}
+	for id, item := range items {
+		ItemsByID[id] = item
+		defer delete(ItemsByID, id)
+	}
+
+	result := GetItemFilterRules(&proto.ItemFilterRequest{
+		Spec:    spec,
+		ItemIds: []int32{-1, -2, -3, -4, -5},
+	})
+	if result.Error != nil {
+		t.Fatalf("Unexpected error: %s", result.Error.Message)
+	}
+	if result.Rules.ArmorType != proto.ArmorType_ArmorTypeCloth || !slices.Contains(result.Rules.WeaponTypes, proto.WeaponType_WeaponTypeStaff) {
+		t.Fatalf("Unexpected rules: %v", result.Rules)
+	}
+	if !slices.Equal(result.ValidItemIds, []int32{-1, -3}) {
+		t.Fatalf("Expected items -1 and -3 to be valid, got %v", result.ValidItemIds)
+	}
+
+	allItems := GetItemFilterRules(&proto.ItemFilterRequest{Spec: spec})
+	if !slices.Contains(allItems.ValidItemIds, -3) || slices.Contains(allItems.ValidItemIds, -4) {
+		t.Fatalf("Unexpected valid items when listing all items")
+	}
+
+	if result := GetItemFilterRules(&proto.ItemFilterRequest{Spec: proto.Spec_SpecUnknown}); result.Error == nil {
+		t.Fatalf("Expected an error for a spec without an item filter")
+	}
+}
@@ -0,0 +1,91 @@
+package core
+
+import (
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+// Names are looked up in this locale when the requested one doesn't have them.
+const DefaultLocale = "en"
+
+// Spell and item names of a single locale, used to name the actions and auras
+// in sim results.
+type LocalizedNames struct {
+	Spells map[int32]string `json:"spells"`
+	Items  map[int32]string `json:"items"`
+}
+
+var namesByLocale = map[string]*LocalizedNames{}
+
+// Adds names to a locale, keeping any existing names for the same IDs.
+func AddLocalizedNames(locale string, names *LocalizedNames) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	existing, ok := namesByLocale[locale]
+	if !ok {
+		existing = &LocalizedNames{Spells: map[int32]string{}, Items: map[int32]string{}}
+		namesByLocale[locale] = existing
+	}
+	for id, name := range names.Spells {
+		if _, ok := existing.Spells[id]; !ok {
+			existing.Spells[id] = name
+		}
+	}
+	for id, name := range names.Items {
+		if _, ok := existing.Items[id]; !ok {
+			existing.Items[id] = name
+		}
+	}
+}
+
+func (names *LocalizedNames) lookup(actionID ActionID) string {
+	if names == nil {
+		return ""
+	} else if actionID.SpellID != 0 {
+		return names.Spells[actionID.SpellID]
+	} else if actionID.ItemID != 0 {
+		return names.Items[actionID.ItemID]
+	}
+	return ""
+}
+
+// Returns the name of the spell or item of this action in the given locale,
+// falling back to the English name. Returns an empty string for other actions
+// and unknown IDs.
+func (actionID ActionID) LocalizedName(locale string) string {
+	if name := namesByLocale[locale].lookup(actionID); name != "" {
+		return name
+	}
+	if name := namesByLocale[DefaultLocale].lookup(actionID); name != "" {
+		return name
+	}
+	if actionID.ItemID != 0 {
+		return ItemsByID[actionID.ItemID].Name
+	}
+	return ""
+}
+
+// Fills in the names of all actions and auras in the results.
+func localizeMetricNames(raidMetrics *proto.RaidMetrics, encounterMetrics *proto.EncounterMetrics, locale string) {
+	var localizeUnit func(unit *proto.UnitMetrics)
+	localizeUnit = func(unit *proto.UnitMetrics) {
+		for _, action := range unit.Actions {
+			action.Name = ProtoToActionID(action.Id).LocalizedName(locale)
+		}
+		for _, aura := range unit.Auras {
+			aura.Name = ProtoToActionID(aura.Id).LocalizedName(locale)
+		}
+		for _, pet := range unit.Pets {
+			localizeUnit(pet)
+		}
+	}
+
+	for _, party := range raidMetrics.Parties {
+		for _, player := range party.Players {
+			localizeUnit(player)
+		}
+	}
+	for _, target := range encounterMetrics.Targets {
+		localizeUnit(target)
+	}
+}
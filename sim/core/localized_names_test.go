@@ -0,0 +1,35 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestLocalizedName(t *testing.T) {
+	AddLocalizedNames(DefaultLocale, &LocalizedNames{Spells: map[int32]string{1: "Fireball", 2: "Frostbolt"}})
+	AddLocalizedNames("xx", &LocalizedNames{Spells: map[int32]string{1: "Boule de feu"}})
+
+	if name := (ActionID{SpellID: 1, Tag: 2}).LocalizedName("xx"); name != "Boule de feu" {
+		t.Fatalf("Expected the translated name, got %s", name)
+	}
+	if name := (ActionID{SpellID: 2}).LocalizedName("xx"); name != "Frostbolt" {
+		t.Fatalf("Expected the English name for untranslated spells, got %s", name)
+	}
+	if name := (ActionID{OtherID: proto.OtherAction_OtherActionAttack}).LocalizedName("xx"); name != "" {
+		t.Fatalf("Expected no name for other actions, got %s", name)
+	}
+
+	raidMetrics := &proto.RaidMetrics{Parties: []*proto.PartyMetrics{{Players: []*proto.UnitMetrics{{
+		Actions: []*proto.ActionMetrics{{Id: ActionID{SpellID: 1}.ToProto()}},
+		Pets: []*proto.UnitMetrics{{
+			Auras: []*proto.AuraMetrics{{Id: ActionID{SpellID: 2}.ToProto()}},
+		}},
+	}}}}}
+	localizeMetricNames(raidMetrics, &proto.EncounterMetrics{}, "xx")
+
+	player := raidMetrics.Parties[0].Players[0]
+	if player.Actions[0].Name != "Boule de feu" || player.Pets[0].Auras[0].Name != "Frostbolt" {
+		t.Fatalf("Expected named player and pet metrics, got %v", player)
+	}
+}
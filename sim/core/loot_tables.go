@@ -0,0 +1,73 @@
+package core
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+// The items dropped by a single boss, split by raid difficulty.
+type LootTable struct {
+	NpcID  int32
+	Name   string
+	ZoneID int32
+
+	ItemIDsByDifficulty map[proto.DungeonDifficulty][]int32
+}
+
+var LootTablesByNpcID = map[int32]*LootTable{}
+
+// Builds loot tables from the drop sources of the given items. Only drops from
+// a named NPC are included, so trash and shared zone loot are skipped.
+func addLootTables(npcs []*proto.UINPC, items []*proto.UIItem) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	npcsByID := make(map[int32]*proto.UINPC, len(npcs))
+	for _, npc := range npcs {
+		npcsByID[npc.Id] = npc
+	}
+
+	for _, item := range items {
+		for _, source := range item.Sources {
+			drop := source.GetDrop()
+			if drop == nil || drop.NpcId == 0 {
+				continue
+			}
+			npc, ok := npcsByID[drop.NpcId]
+			if !ok {
+				continue
+			}
+
+			lootTable, ok := LootTablesByNpcID[npc.Id]
+			if !ok {
+				lootTable = &LootTable{
+					NpcID:               npc.Id,
+					Name:                npc.Name,
+					ZoneID:              npc.ZoneId,
+					ItemIDsByDifficulty: map[proto.DungeonDifficulty][]int32{},
+				}
+				LootTablesByNpcID[npc.Id] = lootTable
+			}
+			if !slices.Contains(lootTable.ItemIDsByDifficulty[drop.Difficulty], item.Id) {
+				lootTable.ItemIDsByDifficulty[drop.Difficulty] = append(lootTable.ItemIDsByDifficulty[drop.Difficulty], item.Id)
+			}
+		}
+	}
+}
+
+// Returns the loot table for a boss by name, e.g. "Horridon", or nil if no boss has that name.
+func GetLootTableByName(name string) *LootTable {
+	for _, lootTable := range LootTablesByNpcID {
+		if strings.EqualFold(lootTable.Name, name) {
+			return lootTable
+		}
+	}
+	return nil
+}
+
+// Returns the IDs of all items dropped on the given difficulty.
+func (lootTable *LootTable) ItemIDs(difficulty proto.DungeonDifficulty) []int32 {
+	return lootTable.ItemIDsByDifficulty[difficulty]
+}
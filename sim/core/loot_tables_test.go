@@ -0,0 +1,56 @@
+package core
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestLootTables(t *testing.T) {
+	drop := func(npcID int32, difficulty proto.DungeonDifficulty) *proto.UIItemSource {
+		return &proto.UIItemSource{Source: &proto.UIItemSource_Drop{Drop: &proto.DropSource{NpcId: npcID, Difficulty: difficulty}}}
+	}
+
+	npcs := []*proto.UINPC{{Id: -1, Name: "Test Boss", ZoneId: -2}}
+	items := []*proto.UIItem{
+		{Id: -10, Type: proto.ItemType_ItemTypeHead, Sources: []*proto.UIItemSource{drop(-1, proto.DungeonDifficulty_DifficultyRaid25), drop(-1, proto.DungeonDifficulty_DifficultyRaid25)}},
+		{Id: -11, Type: proto.ItemType_ItemTypeFinger, Sources: []*proto.UIItemSource{drop(-1, proto.DungeonDifficulty_DifficultyRaid25)}},
+		{Id: -12, Type: proto.ItemType_ItemTypeHead, Sources: []*proto.UIItemSource{drop(-1, proto.DungeonDifficulty_DifficultyRaid25H)}},
+		{Id: -13, Type: proto.ItemType_ItemTypeHead, Sources: []*proto.UIItemSource{{Source: &proto.UIItemSource_Drop{Drop: &proto.DropSource{ZoneId: -2, OtherName: "Trash Mobs"}}}}},
+	}
+	addLootTables(npcs, items)
+	defer delete(LootTablesByNpcID, -1)
+	for _, item := range items[:3] {
+		ItemsByID[item.Id] = Item{ID: item.Id, Type: item.Type}
+		defer delete(ItemsByID, item.Id)
+	}
+
+	lootTable := GetLootTableByName("test boss")
+	if lootTable == nil {
+		t.Fatalf("Expected a loot table for Test Boss")
+	}
+	if itemIDs := lootTable.ItemIDs(proto.DungeonDifficulty_DifficultyRaid25); !slices.Equal(itemIDs, []int32{-10, -11}) {
+		t.Fatalf("Unexpected 25 player loot: %v", itemIDs)
+	}
+	if itemIDs := lootTable.ItemIDs(proto.DungeonDifficulty_DifficultyRaid25H); !slices.Equal(itemIDs, []int32{-12}) {
+		t.Fatalf("Unexpected 25 player heroic loot: %v", itemIDs)
+	}
+	if GetLootTableByName("Not A Boss") != nil {
+		t.Fatalf("Expected no loot table for an unknown boss")
+	}
+
+	equipment := &proto.EquipmentSpec{Items: make([]*proto.ItemSpec, NumItemSlots)}
+	equipment.Items[proto.ItemSlot_ItemSlotHead] = &proto.ItemSpec{Id: 1, Enchant: 7}
+	candidates := lootTableCandidates(lootTable, proto.DungeonDifficulty_DifficultyRaid25, equipment, false)
+	// The head in one slot, and the ring in both finger slots.
+	if len(candidates) != 3 {
+		t.Fatalf("Expected 3 candidates, found %d", len(candidates))
+	}
+	if candidates[0].Slot != proto.ItemSlot_ItemSlotHead || candidates[0].Item.Enchant != 7 {
+		t.Fatalf("Expected the head candidate to keep the equipped enchant, found %v", candidates[0])
+	}
+	if candidates[0].BonusRolls != 2/bonusRollGearChance {
+		t.Fatalf("Unexpected bonus rolls: %f", candidates[0].BonusRolls)
+	}
+}
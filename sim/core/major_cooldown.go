@@ -67,6 +67,9 @@ type MajorCooldown struct {
 	// are used instead of ShouldActivate.
 	timings []time.Duration
 
+	// User-specified policy for when to use this cooldown after its timings.
+	policy proto.CooldownPolicy
+
 	// Number of times this MCD was used so far in the current iteration.
 	numUsages int
 
@@ -130,7 +133,7 @@ func (mcd *MajorCooldown) tryActivateInternal(sim *Simulation, character *Charac
 }
 
 func (mcd *MajorCooldown) shouldActivateHelper(sim *Simulation, character *Character) bool {
-	if !mcd.Spell.CanCast(sim, character.CurrentTarget) {
+	if !mcd.Spell.CanCast(sim, character.CurrentTarget) && !mcd.canQueue(sim, character) {
 		return false
 	}
 
@@ -144,19 +147,46 @@ func (mcd *MajorCooldown) shouldActivateHelper(sim *Simulation, character *Chara
 		}
 	}
 
+	if !mcd.policyAllowsActivation(sim, character) {
+		return false
+	}
+
 	return mcd.ShouldActivate(sim, character)
 }
 
+func (mcd *MajorCooldown) policyAllowsActivation(sim *Simulation, character *Character) bool {
+	switch mcd.policy {
+	case proto.CooldownPolicy_CooldownPolicySyncWithMajorCooldown:
+		return character.canSyncWithOtherCooldown(mcd)
+	case proto.CooldownPolicy_CooldownPolicyHoldForExecute:
+		return sim.IsExecutePhase20()
+	case proto.CooldownPolicy_CooldownPolicyHoldForBloodlust:
+		return character.HasActiveAuraWithTag(BloodlustAuraTag)
+	}
+	return true
+}
+
+// Whether this MCD can be queued up through the character's spell queue window
+// instead of waiting for the GCD to end.
+func (mcd *MajorCooldown) canQueue(sim *Simulation, character *Character) bool {
+	return (character.SpellQueueWindow > 0) && mcd.Spell.CanCastOrQueue(sim, character.CurrentTarget)
+}
+
 // Activates this MCD, if all the conditions pass.
 // Returns whether the MCD was activated.
 func (mcd *MajorCooldown) tryActivateHelper(sim *Simulation, character *Character) bool {
 	shouldActivate := mcd.shouldActivateHelper(sim, character)
 
 	if shouldActivate {
+		target := character.CurrentTarget
 		if mcd.Spell.Flags.Matches(SpellFlagHelpful) {
-			mcd.Spell.Cast(sim, &character.Unit)
+			target = &character.Unit
+		}
+
+		if mcd.canQueue(sim, character) {
+			mcd.Spell.CastOrQueue(sim, target)
 		} else {
-			mcd.Spell.Cast(sim, character.CurrentTarget)
+			mcd.Spell.Cast(sim, target)
 		}
 
 		mcd.numUsages++
@@ -171,6 +201,8 @@ func (mcd *MajorCooldown) tryActivateHelper(sim *Simulation, character *Characte
 type cooldownConfigs struct {
 	Cooldowns              []*proto.Cooldown
 	HpPercentForDefensives float64
+	Trinket1Policy         proto.CooldownPolicy
+	Trinket2Policy         proto.CooldownPolicy
 }
 
 type majorCooldownManager struct {
@@ -197,6 +229,8 @@ func newMajorCooldownManager(cooldowns *proto.Cooldowns) majorCooldownManager {
 
 	cooldownConfigs := cooldownConfigs{
 		HpPercentForDefensives: cooldowns.HpPercentForDefensives,
+		Trinket1Policy:         cooldowns.Trinket1Policy,
+		Trinket2Policy:         cooldowns.Trinket2Policy,
 	}
 	for _, cooldownConfig := range cooldowns.Cooldowns {
 		if cooldownConfig.Id != nil {
@@ -229,11 +263,51 @@ func (mcdm *majorCooldownManager) finalize() {
 				break
 			}
 		}
+
+		mcd.policy = mcdm.trinketPolicy(mcd.Spell.ActionID.ItemID)
 	}
 
 	mcdm.majorCooldowns = make([]*MajorCooldown, len(mcdm.initialMajorCooldowns))
 }
 
+func (mcdm *majorCooldownManager) trinketPolicy(itemID int32) proto.CooldownPolicy {
+	if itemID == 0 {
+		return proto.CooldownPolicy_CooldownPolicyOnCooldown
+	}
+	switch itemID {
+	case mcdm.character.Equipment[proto.ItemSlot_ItemSlotTrinket1].ID:
+		return mcdm.cooldownConfigs.Trinket1Policy
+	case mcdm.character.Equipment[proto.ItemSlot_ItemSlotTrinket2].ID:
+		return mcdm.cooldownConfigs.Trinket2Policy
+	}
+	return proto.CooldownPolicy_CooldownPolicyOnCooldown
+}
+
+// Whether another of the character's DPS cooldowns, other than items, has its
+// buff active. Cooldowns without a known buff can't be synced with, so if there
+// are none nothing is held back.
+func (mcdm *majorCooldownManager) canSyncWithOtherCooldown(mcd *MajorCooldown) bool {
+	foundBuff := false
+	for _, other := range mcdm.majorCooldowns {
+		if (other.Spell == mcd.Spell) || (other.Spell.ActionID.ItemID != 0) || !other.Type.Matches(CooldownTypeDPS) {
+			continue
+		}
+
+		buff := other.Spell.RelatedSelfBuff
+		if (buff == nil) && (other.BuffAura != nil) {
+			buff = other.BuffAura.Aura
+		}
+		if buff == nil {
+			continue
+		}
+		if buff.IsActive() {
+			return true
+		}
+		foundBuff = true
+	}
+	return !foundBuff
+}
+
 func (mcdm *majorCooldownManager) reset(_ *Simulation) {
 	for i := range mcdm.majorCooldowns {
 		newMCD := &MajorCooldown{}
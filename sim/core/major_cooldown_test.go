@@ -0,0 +1,57 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestTrinketCooldownPolicy(t *testing.T) {
+	character := &Character{}
+	character.Equipment[proto.ItemSlot_ItemSlotTrinket1] = Item{ID: 1}
+	character.Equipment[proto.ItemSlot_ItemSlotTrinket2] = Item{ID: 2}
+
+	mcdm := &majorCooldownManager{
+		character: character,
+		cooldownConfigs: cooldownConfigs{
+			Trinket1Policy: proto.CooldownPolicy_CooldownPolicyHoldForBloodlust,
+			Trinket2Policy: proto.CooldownPolicy_CooldownPolicyHoldForExecute,
+		},
+	}
+
+	if policy := mcdm.trinketPolicy(2); policy != proto.CooldownPolicy_CooldownPolicyHoldForExecute {
+		t.Fatalf("Expected the second trinket to hold for execute, found %s", policy)
+	}
+	if policy := mcdm.trinketPolicy(0); policy != proto.CooldownPolicy_CooldownPolicyOnCooldown {
+		t.Fatalf("Expected spells to be used on cooldown, found %s", policy)
+	}
+}
+
+func TestCanSyncWithOtherCooldown(t *testing.T) {
+	sim := &Simulation{}
+
+	unit := Unit{
+		Type:        PlayerUnit,
+		Level:       CharacterLevel,
+		auraTracker: newAuraTracker(),
+	}
+	buff := unit.RegisterAura(Aura{Label: "Class Cooldown", ActionID: ActionID{SpellID: 1}, Duration: NeverExpires})
+
+	trinket := &MajorCooldown{Spell: &Spell{ActionID: ActionID{ItemID: 2}}, Type: CooldownTypeDPS}
+	classCooldown := &MajorCooldown{Spell: &Spell{ActionID: ActionID{SpellID: 1}, RelatedSelfBuff: buff}, Type: CooldownTypeDPS}
+
+	mcdm := &majorCooldownManager{majorCooldowns: []*MajorCooldown{trinket}}
+	if !mcdm.canSyncWithOtherCooldown(trinket) {
+		t.Fatalf("Expected nothing to be held back without another cooldown to sync with")
+	}
+
+	mcdm.majorCooldowns = append(mcdm.majorCooldowns, classCooldown)
+	if mcdm.canSyncWithOtherCooldown(trinket) {
+		t.Fatalf("Expected the trinket to wait for the class cooldown")
+	}
+
+	buff.Activate(sim)
+	if !mcdm.canSyncWithOtherCooldown(trinket) {
+		t.Fatalf("Expected the trinket to be used while the class cooldown is active")
+	}
+}
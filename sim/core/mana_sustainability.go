@@ -0,0 +1,49 @@
+package core
+
+import (
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+// Accumulates mana projections for the fight lengths requested in
+// SimOptions.mana_sustainability_durations. Each iteration's net mana spent
+// per second is assumed to hold for the whole projected fight.
+type manaSustainability struct {
+	durations    []float64
+	remainingSum []float64
+	numOOM       []int32
+}
+
+func newManaSustainability(durations []float64) *manaSustainability {
+	return &manaSustainability{
+		durations:    durations,
+		remainingSum: make([]float64, len(durations)),
+		numOOM:       make([]int32, len(durations)),
+	}
+}
+
+func (ms *manaSustainability) doneIteration(unit *Unit, sim *Simulation) {
+	iterationMetrics := unit.Metrics.CharacterIterationMetrics
+	netManaPerSecond := (iterationMetrics.ManaSpent - iterationMetrics.ManaGained) / sim.Duration.Seconds()
+
+	for i, duration := range ms.durations {
+		remaining := unit.MaxMana() - netManaPerSecond*duration
+		ms.remainingSum[i] += remaining
+
+		wentOOMBefore := iterationMetrics.WentOOM && (iterationMetrics.FirstOOMTimestamp.Seconds() <= duration)
+		if (remaining <= 0) || wentOOMBefore {
+			ms.numOOM[i]++
+		}
+	}
+}
+
+func (ms *manaSustainability) toProto(numIterations float64) []*proto.ManaSustainability {
+	results := make([]*proto.ManaSustainability, len(ms.durations))
+	for i, duration := range ms.durations {
+		results[i] = &proto.ManaSustainability{
+			DurationSeconds:  duration,
+			ManaRemainingAvg: ms.remainingSum[i] / numIterations,
+			ChanceOfOom:      float64(ms.numOOM[i]) / numIterations,
+		}
+	}
+	return results
+}
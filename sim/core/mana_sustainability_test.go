@@ -0,0 +1,40 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wowsims/mop/sim/core/stats"
+)
+
+func TestManaSustainabilityProjection(t *testing.T) {
+	sim := &Simulation{Duration: time.Minute * 5}
+	unit := &Unit{}
+	unit.stats[stats.Mana] = 300000
+
+	ms := newManaSustainability([]float64{300, 600, 900})
+
+	// Net 600 mana per second: lasts 500 seconds.
+	unit.Metrics.CharacterIterationMetrics = CharacterIterationMetrics{ManaSpent: 240000, ManaGained: 60000}
+	ms.doneIteration(unit, sim)
+
+	// Net 200 mana per second, but ran dry early on: lasts 1500 seconds.
+	unit.Metrics.CharacterIterationMetrics = CharacterIterationMetrics{
+		ManaSpent:         90000,
+		ManaGained:        30000,
+		WentOOM:           true,
+		FirstOOMTimestamp: time.Second * 400,
+	}
+	ms.doneIteration(unit, sim)
+
+	results := ms.toProto(2)
+	if results[0].ManaRemainingAvg != 180000 || results[0].ChanceOfOom != 0 {
+		t.Fatalf("Unexpected projection for 300s: %v", results[0])
+	}
+	if results[1].ManaRemainingAvg != 60000 || results[1].ChanceOfOom != 1 {
+		t.Fatalf("Unexpected projection for 600s: %v", results[1])
+	}
+	if results[2].ChanceOfOom != 1 {
+		t.Fatalf("Unexpected projection for 900s: %v", results[2])
+	}
+}
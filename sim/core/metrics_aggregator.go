@@ -101,8 +101,13 @@ type UnitMetrics struct {
 	numItersDead int32
 	deathSeeds   []int64
 	oomTimeSum   float64
+	healingSum   float64
+	manaSpentSum float64
 	actions      map[ActionID]*ActionMetrics
-	resources    []*ResourceMetrics
+
+	manaSustainability *manaSustainability
+	activeMitigation   *activeMitigationMetrics
+	resources          []*ResourceMetrics
 }
 
 // Metrics for the current iteration, for 1 agent. Keep this as a separate
@@ -151,6 +156,7 @@ func (actionMetrics *ActionMetrics) ToProto(actionID ActionID) *proto.ActionMetr
 // Metric totals for a spell against a specific target, for the current iteration.
 type SpellMetrics struct {
 	Casts        int32
+	Interrupts   int32 // Casts and channels of this spell lost to interrupts.
 	Misses       int32
 	Hits         int32
 	Crits        int32
@@ -175,7 +181,12 @@ type SpellMetrics struct {
 	TotalThreat            float64 // Threat generated by all casts of this spell.
 	TotalHealing           float64 // Healing done by all casts of this spell.
 	TotalCritHealing       float64 // Healing done by all critical casts of this spell.
+	TotalOverhealing       float64 // Healing from this spell beyond its targets' missing health.
 	TotalShielding         float64 // Shielding done by all casts of this spell.
+	TotalAbsorbed          float64 // Damage absorbed by shields from this spell.
+	TotalShieldingWasted   float64 // Shielding from this spell which was never consumed.
+	TotalCost              float64 // Resources spent on casts of this spell.
+	TotalRefunds           float64 // Resources refunded to casts of this spell.
 	TotalCastTime          time.Duration
 }
 
@@ -183,6 +194,7 @@ type TargetedActionMetrics struct {
 	UnitIndex int32
 
 	Casts        int32
+	Interrupts   int32
 	Hits         int32
 	Crits        int32
 	Ticks        int32
@@ -206,7 +218,12 @@ type TargetedActionMetrics struct {
 	Threat            float64
 	Healing           float64
 	CritHealing       float64
+	Overhealing       float64
 	Shielding         float64
+	Absorbed          float64
+	ShieldingWasted   float64
+	ResourceCost      float64
+	ResourceRefunded  float64
 	CastTime          time.Duration
 }
 
@@ -215,6 +232,7 @@ func (tam *TargetedActionMetrics) ToProto() *proto.TargetedActionMetrics {
 		UnitIndex: tam.UnitIndex,
 
 		Casts:             tam.Casts,
+		Interrupts:        tam.Interrupts,
 		Hits:              tam.Hits,
 		Crits:             tam.Crits,
 		Ticks:             tam.Ticks,
@@ -237,7 +255,12 @@ func (tam *TargetedActionMetrics) ToProto() *proto.TargetedActionMetrics {
 		Threat:            tam.Threat,
 		Healing:           tam.Healing,
 		CritHealing:       tam.CritHealing,
+		Overhealing:       tam.Overhealing,
 		Shielding:         tam.Shielding,
+		Absorbed:          tam.Absorbed,
+		ShieldingWasted:   tam.ShieldingWasted,
+		ResourceCost:      tam.ResourceCost,
+		ResourceRefunded:  tam.ResourceRefunded,
 		CastTimeMs:        float64(tam.CastTime.Milliseconds()),
 	}
 }
@@ -262,8 +285,11 @@ type ResourceMetrics struct {
 	Gain       float64
 	ActualGain float64
 
-	EventsFromPreviousIterations     int32
-	ActualGainFromPreviousIterations float64
+	EventsFromPreviousIterations int32
+
+	// Tracked separately rather than as a difference of running totals, so
+	// that identical iterations report bit-identical gains.
+	actualGainThisIteration float64
 }
 
 func (resourceMetrics *ResourceMetrics) ToProto() *proto.ResourceMetrics {
@@ -279,19 +305,20 @@ func (resourceMetrics *ResourceMetrics) ToProto() *proto.ResourceMetrics {
 
 func (resourceMetrics *ResourceMetrics) reset() {
 	resourceMetrics.EventsFromPreviousIterations = resourceMetrics.Events
-	resourceMetrics.ActualGainFromPreviousIterations = resourceMetrics.ActualGain
+	resourceMetrics.actualGainThisIteration = 0
 }
 func (resourceMetrics *ResourceMetrics) EventsForCurrentIteration() int32 {
 	return resourceMetrics.Events - resourceMetrics.EventsFromPreviousIterations
 }
 func (resourceMetrics *ResourceMetrics) ActualGainForCurrentIteration() float64 {
-	return resourceMetrics.ActualGain - resourceMetrics.ActualGainFromPreviousIterations
+	return resourceMetrics.actualGainThisIteration
 }
 
 func (resourceMetrics *ResourceMetrics) AddEvent(gain float64, actualGain float64) {
 	resourceMetrics.Events++
 	resourceMetrics.Gain += gain
 	resourceMetrics.ActualGain += actualGain
+	resourceMetrics.actualGainThisIteration += actualGain
 }
 
 func (unitMetrics *UnitMetrics) NewResourceMetrics(actionID ActionID, resourceType proto.ResourceType) *ResourceMetrics {
@@ -361,6 +388,9 @@ func (unitMetrics *UnitMetrics) addSpellMetrics(spell *Spell, actionID ActionID,
 			SpellSchool: spell.SpellSchool,
 		}
 		unitMetrics.actions[actionID] = actionMetrics
+	} else if !spell.Flags.Matches(SpellFlagPassiveSpell) {
+		// Aliased variants of a cast spell keep the row a cast action.
+		actionMetrics.IsPassive = false
 	}
 
 	if len(actionMetrics.Targets) == 0 {
@@ -376,6 +406,7 @@ func (unitMetrics *UnitMetrics) addSpellMetrics(spell *Spell, actionID ActionID,
 		if !spell.Flags.Matches(SpellFlagPassiveSpell) {
 			tam.Casts += spellTargetMetrics.Casts
 		}
+		tam.Interrupts += spellTargetMetrics.Interrupts
 		tam.Misses += spellTargetMetrics.Misses
 		tam.Hits += spellTargetMetrics.Hits
 		tam.Crits += spellTargetMetrics.Crits
@@ -398,7 +429,12 @@ func (unitMetrics *UnitMetrics) addSpellMetrics(spell *Spell, actionID ActionID,
 		tam.Threat += spellTargetMetrics.TotalThreat
 		tam.Healing += spellTargetMetrics.TotalHealing
 		tam.CritHealing += spellTargetMetrics.TotalCritHealing
+		tam.Overhealing += spellTargetMetrics.TotalOverhealing
 		tam.Shielding += spellTargetMetrics.TotalShielding
+		tam.Absorbed += spellTargetMetrics.TotalAbsorbed
+		tam.ShieldingWasted += spellTargetMetrics.TotalShieldingWasted
+		tam.ResourceCost += spellTargetMetrics.TotalCost
+		tam.ResourceRefunded += spellTargetMetrics.TotalRefunds
 		if !spell.Flags.Matches(SpellFlagPassiveSpell) {
 			tam.CastTime += spellTargetMetrics.TotalCastTime
 		}
@@ -422,6 +458,16 @@ func (unitMetrics *UnitMetrics) AddFinalPetMetrics(petMetrics *UnitMetrics) {
 	unitMetrics.dps.Total += petMetrics.dps.Total
 }
 
+// Drops the separate pet results, for PetMetricsRollup where pet damage is
+// only reported as part of their owner's.
+func removePetMetrics(raidMetrics *proto.RaidMetrics) {
+	for _, party := range raidMetrics.Parties {
+		for _, player := range party.Players {
+			player.Pets = nil
+		}
+	}
+}
+
 func (unitMetrics *UnitMetrics) AddOOMTime(sim *Simulation, dur time.Duration) {
 	if dur > 0 {
 		unitMetrics.CharacterIterationMetrics.OOMTime += dur
@@ -448,6 +494,10 @@ func (unitMetrics *UnitMetrics) reset() {
 	unitMetrics.tto.reset()
 	unitMetrics.CharacterIterationMetrics = CharacterIterationMetrics{}
 
+	if unitMetrics.activeMitigation != nil {
+		unitMetrics.activeMitigation.reset()
+	}
+
 	for _, resourceMetrics := range unitMetrics.resources {
 		resourceMetrics.reset()
 	}
@@ -479,6 +529,13 @@ func (unitMetrics *UnitMetrics) doneIteration(unit *Unit, sim *Simulation) {
 
 		// Hack because of the way DistributionMetrics does its calculations.
 		unitMetrics.tto.Total *= encounterDurationSeconds
+
+		if durations := sim.Options.ManaSustainabilityDurations; len(durations) > 0 {
+			if unitMetrics.manaSustainability == nil {
+				unitMetrics.manaSustainability = newManaSustainability(durations)
+			}
+			unitMetrics.manaSustainability.doneIteration(unit, sim)
+		}
 	}
 
 	if unitMetrics.isTanking {
@@ -486,6 +543,10 @@ func (unitMetrics *UnitMetrics) doneIteration(unit *Unit, sim *Simulation) {
 
 		// Hack because of the way DistributionMetrics does its calculations.
 		unitMetrics.tmi.Total *= sim.Duration.Seconds()
+
+		if unitMetrics.activeMitigation != nil {
+			unitMetrics.activeMitigation.doneIteration(sim)
+		}
 	}
 
 	unitMetrics.dps.doneIteration(sim)
@@ -496,6 +557,8 @@ func (unitMetrics *UnitMetrics) doneIteration(unit *Unit, sim *Simulation) {
 	unitMetrics.tto.doneIteration(sim)
 
 	unitMetrics.oomTimeSum += unitMetrics.OOMTime.Seconds()
+	unitMetrics.healingSum += unitMetrics.hps.Total
+	unitMetrics.manaSpentSum += unitMetrics.ManaSpent
 	if unitMetrics.Died {
 		unitMetrics.numItersDead++
 
@@ -592,6 +655,19 @@ func (unitMetrics *UnitMetrics) ToProto() *proto.UnitMetrics {
 		ChanceOfDeath: float64(unitMetrics.numItersDead) / n,
 	}
 
+	if unitMetrics.manaSustainability != nil {
+		protoMetrics.ManaSustainability = unitMetrics.manaSustainability.toProto(n)
+	}
+
+	if unitMetrics.manaSpentSum > 0 {
+		protoMetrics.HealingPerMana = unitMetrics.healingSum / unitMetrics.manaSpentSum
+		protoMetrics.ManaSpentAvg = unitMetrics.manaSpentSum / n
+	}
+
+	if unitMetrics.isTanking && (unitMetrics.activeMitigation != nil) {
+		protoMetrics.ActiveMitigation = unitMetrics.activeMitigation.toProto(n)
+	}
+
 	if len(unitMetrics.deathSeeds) > 0 {
 		slices.Sort(unitMetrics.deathSeeds)
 		protoMetrics.DeathSeeds = unitMetrics.deathSeeds[:]
@@ -619,31 +695,55 @@ type AuraMetrics struct {
 	Uptime time.Duration
 	Procs  int32
 
+	// Stack changes for the current iteration, only used by stacking auras.
+	StacksGained     int64
+	StacksConsumed   int64
+	StacksOvercapped int64 // Stacks which would have been gained past MaxStacks.
+	StacksExpired    int64 // Stacks lost to expiration or the end of the encounter.
+
 	// Aggregate values. These are updated after each iteration.
 	aggregator
-	procsSum int32
+	procsSum            int32
+	stacksGainedSum     int64
+	stacksConsumedSum   int64
+	stacksOvercappedSum int64
+	stacksExpiredSum    int64
 }
 
 func (auraMetrics *AuraMetrics) reset() {
 	auraMetrics.Uptime = 0
 	auraMetrics.Procs = 0
+	auraMetrics.StacksGained = 0
+	auraMetrics.StacksConsumed = 0
+	auraMetrics.StacksOvercapped = 0
+	auraMetrics.StacksExpired = 0
 }
 
 // This should be called when a Sim iteration is complete.
 func (auraMetrics *AuraMetrics) doneIteration() {
 	auraMetrics.add(auraMetrics.Uptime.Seconds())
 	auraMetrics.procsSum += auraMetrics.Procs
+	auraMetrics.stacksGainedSum += auraMetrics.StacksGained
+	auraMetrics.stacksConsumedSum += auraMetrics.StacksConsumed
+	auraMetrics.stacksOvercappedSum += auraMetrics.StacksOvercapped
+	auraMetrics.stacksExpiredSum += auraMetrics.StacksExpired
 }
 
 func (auraMetrics *AuraMetrics) ToProto() *proto.AuraMetrics {
 	mean, stdev := auraMetrics.meanAndStdDev()
+	n := float64(auraMetrics.n)
 
 	return &proto.AuraMetrics{
 		Id: auraMetrics.ID.ToProto(),
 
 		UptimeSecondsAvg:   mean,
 		UptimeSecondsStdev: stdev,
-		ProcsAvg:           float64(auraMetrics.procsSum) / float64(auraMetrics.n),
+		ProcsAvg:           float64(auraMetrics.procsSum) / n,
+
+		StacksGainedAvg:     float64(auraMetrics.stacksGainedSum) / n,
+		StacksConsumedAvg:   float64(auraMetrics.stacksConsumedSum) / n,
+		StacksOvercappedAvg: float64(auraMetrics.stacksOvercappedSum) / n,
+		StacksExpiredAvg:    float64(auraMetrics.stacksExpiredSum) / n,
 
 		AggregatorData: &proto.AggregatorData{
 			N:     int32(auraMetrics.n),
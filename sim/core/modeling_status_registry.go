@@ -0,0 +1,46 @@
+package core
+
+import (
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+var specModelingStatuses = make(map[proto.Spec]*proto.SpecModelingStatus)
+
+// A major mechanic of a spec and how closely it is modeled.
+type MechanicModelingStatus struct {
+	Mechanic string
+	Status   proto.ModelingStatus
+	Notes    string
+}
+
+// Registers how closely the sim models a spec, which is included in the
+// results of its players. Specs that aren't registered report
+// ModelingStatusUnknown.
+func RegisterModelingStatus(spec proto.Spec, status proto.ModelingStatus, mechanics ...MechanicModelingStatus) {
+	if _, ok := specModelingStatuses[spec]; ok {
+		panic("Already registered modeling status for spec: " + spec.String())
+	}
+
+	specModelingStatuses[spec] = &proto.SpecModelingStatus{
+		Status: status,
+		Mechanics: MapSlice(mechanics, func(mechanic MechanicModelingStatus) *proto.MechanicModelingStatus {
+			return &proto.MechanicModelingStatus{
+				Mechanic: mechanic.Mechanic,
+				Status:   mechanic.Status,
+				Notes:    mechanic.Notes,
+			}
+		}),
+	}
+}
+
+// Returns the registered modeling status of a spec. Specs that haven't been
+// rated get an explicit ModelingStatusUnknown, never a missing status.
+func GetModelingStatus(spec proto.Spec) *proto.SpecModelingStatus {
+	if status, ok := specModelingStatuses[spec]; ok {
+		return status
+	}
+	return &proto.SpecModelingStatus{
+		Status:    proto.ModelingStatus_ModelingStatusUnknown,
+		Mechanics: []*proto.MechanicModelingStatus{},
+	}
+}
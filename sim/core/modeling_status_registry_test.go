@@ -0,0 +1,46 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestModelingStatusInResults(t *testing.T) {
+	spec := proto.Spec_SpecElementalShaman
+	defer delete(specModelingStatuses, spec)
+	RegisterModelingStatus(spec, proto.ModelingStatus_ModelingStatusApproximated, MechanicModelingStatus{
+		Mechanic: "Mechanic",
+		Status:   proto.ModelingStatus_ModelingStatusMissing,
+	})
+
+	if status := GetModelingStatus(proto.Spec_SpecArcaneMage); status.Status != proto.ModelingStatus_ModelingStatusUnknown {
+		t.Fatalf("Expected unknown status for unregistered specs, got %v", status)
+	}
+
+	character := &Character{Spec: spec}
+	status := character.GetMetricsProto().ModelingStatus
+	if status.Status != proto.ModelingStatus_ModelingStatusApproximated || len(status.Mechanics) != 1 || status.Mechanics[0].Status != proto.ModelingStatus_ModelingStatusMissing {
+		t.Fatalf("Expected the registered status in the results, got %v", status)
+	}
+}
+
+func TestModelingStatusUnknownForUnregisteredSpecs(t *testing.T) {
+	for specValue := range proto.Spec_name {
+		spec := proto.Spec(specValue)
+		if _, ok := specModelingStatuses[spec]; ok {
+			continue
+		}
+
+		status := GetModelingStatus(spec)
+		if status == nil || status.Status != proto.ModelingStatus_ModelingStatusUnknown || len(status.Mechanics) != 0 {
+			t.Fatalf("Expected an explicit unknown status for %s, got %v", spec, status)
+		}
+	}
+
+	character := &Character{Spec: proto.Spec_SpecArcaneMage}
+	exported := exportUnit(character.GetMetricsProto(), 1)
+	if exported.ModelingStatus == nil || exported.ModelingStatus.Status != "ModelingStatusUnknown" {
+		t.Fatalf("Expected ModelingStatusUnknown in the exported results, got %v", exported.ModelingStatus)
+	}
+}
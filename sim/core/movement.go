@@ -9,13 +9,21 @@ import (
 
 type MovementAction struct {
 	PendingAction
-	srcPosition float64       // starting position
+	srcPosition Position      // starting position
+	dstPosition Position      // position at the end of the current leg
+	waypoints   []Position    // positions to move through after the current leg
 	startTime   time.Duration // starting time of the movement
 	speed       float64       // theoretical movement speed, can be 0
 }
 
-func (action *MovementAction) GetCurrentPosition(sim *Simulation) float64 {
-	return action.srcPosition + float64(sim.CurrentTime-action.startTime)*action.speed/float64(time.Second)
+func (action *MovementAction) GetCurrentPosition(sim *Simulation) Position {
+	legDistance := action.srcPosition.DistanceTo(action.dstPosition)
+	if legDistance == 0 {
+		return action.srcPosition
+	}
+
+	travelled := float64(sim.CurrentTime-action.startTime) * action.speed / float64(time.Second)
+	return action.srcPosition.Lerp(action.dstPosition, min(travelled/legDistance, 1))
 }
 
 func (unit *Unit) initMovement() {
@@ -51,9 +59,24 @@ func (unit *Unit) MoveTo(moveRange float64, sim *Simulation) {
 	}
 
 	unit.UpdatePosition(sim)
-	moveDistance := moveRange - unit.DistanceFromTarget
-	timeToMove := time.Duration(math.Abs(moveDistance)/unit.GetMovementSpeed()*1000) * time.Millisecond
-	registerMovementAction(unit, sim, unit.GetMovementSpeed()*TernaryFloat64(moveDistance < 0, -1., 1.), sim.CurrentTime+timeToMove)
+	unit.syncPositionToDistance()
+	unit.moveAlong(sim, []Position{TargetPosition.Offset(moveRange, unit.BearingFromTarget())})
+}
+
+// Moves the unit in a straight line through each of the waypoints in turn.
+func (unit *Unit) MoveAlong(waypoints []Position, sim *Simulation) {
+	if len(waypoints) == 0 {
+		return
+	}
+
+	unit.UpdatePosition(sim)
+	unit.syncPositionToDistance()
+	unit.moveAlong(sim, waypoints)
+}
+
+func (unit *Unit) moveAlong(sim *Simulation, waypoints []Position) {
+	timeToMove := time.Duration(unit.Position.DistanceTo(waypoints[0])/unit.GetMovementSpeed()*1000) * time.Millisecond
+	registerMovementAction(unit, sim, unit.GetMovementSpeed(), waypoints[0], waypoints[1:], sim.CurrentTime+timeToMove)
 }
 
 func (unit *Unit) MoveDuration(duration time.Duration, sim *Simulation) {
@@ -62,7 +85,8 @@ func (unit *Unit) MoveDuration(duration time.Duration, sim *Simulation) {
 	}
 
 	unit.UpdatePosition(sim)
-	registerMovementAction(unit, sim, 0., sim.CurrentTime+duration)
+	unit.syncPositionToDistance()
+	registerMovementAction(unit, sim, 0., unit.Position, nil, sim.CurrentTime+duration)
 }
 
 func (unit *Unit) UpdatePosition(sim *Simulation) {
@@ -71,7 +95,8 @@ func (unit *Unit) UpdatePosition(sim *Simulation) {
 	}
 
 	oldDist := unit.DistanceFromTarget
-	unit.DistanceFromTarget = unit.movementAction.GetCurrentPosition(sim)
+	unit.Position = unit.movementAction.GetCurrentPosition(sim)
+	unit.DistanceFromTarget = unit.Position.DistanceTo(TargetPosition)
 	if oldDist == unit.DistanceFromTarget {
 		return
 	}
@@ -108,11 +133,14 @@ func (unit *Unit) FinalizeMovement(sim *Simulation) {
 
 	unit.UpdatePosition(sim)
 	unit.moveAura.Deactivate(sim)
+	unit.FaceTowards(TargetPosition)
 
 	unit.OnMovement(sim, unit.DistanceFromTarget, MovementEnd)
 }
 
-func registerMovementAction(unit *Unit, sim *Simulation, speed float64, endTime time.Duration) {
+func registerMovementAction(unit *Unit, sim *Simulation, speed float64, destination Position, waypoints []Position, endTime time.Duration) {
+	unit.interruptCastingForMovement(sim)
+
 	if unit.movementAction != nil {
 		unit.movementAction.Cancel(sim)
 	} else {
@@ -122,11 +150,20 @@ func registerMovementAction(unit *Unit, sim *Simulation, speed float64, endTime
 	movementAction := MovementAction{
 		startTime:   sim.CurrentTime,
 		speed:       speed,
-		srcPosition: unit.DistanceFromTarget,
+		srcPosition: unit.Position,
+		dstPosition: destination,
+		waypoints:   waypoints,
 	}
+	unit.FaceTowards(destination)
 
 	movementAction.NextActionAt = endTime
 	movementAction.OnAction = func(sim *Simulation) {
+		if len(waypoints) > 0 {
+			unit.UpdatePosition(sim)
+			unit.moveAlong(sim, waypoints)
+			return
+		}
+
 		unit.FinalizeMovement(sim)
 	}
 
@@ -135,6 +172,51 @@ func registerMovementAction(unit *Unit, sim *Simulation, speed float64, endTime
 	sim.AddPendingAction(&movementAction.PendingAction)
 }
 
+// Makes the unit step aside by half the given distance and back, as if dodging
+// an ability. Casts which finish within the reaction time are completed first,
+// and longer ones are interrupted.
+func (unit *Unit) StepAside(yards float64, reactionTime time.Duration, sim *Simulation) {
+	if yards <= 0 {
+		return
+	}
+
+	castEndsAt := unit.Hardcast.Expires - sim.CurrentTime
+	if (castEndsAt > 0) && (castEndsAt <= reactionTime) && !unit.Hardcast.CanMove {
+		pa := sim.GetConsumedPendingActionFromPool()
+		pa.NextActionAt = unit.Hardcast.Expires
+		pa.Priority = ActionPriorityHigh + 1
+
+		pa.OnAction = func(sim *Simulation) {
+			unit.StepAside(yards, 0, sim)
+		}
+
+		sim.AddPendingAction(pa)
+		return
+	}
+
+	unit.UpdatePosition(sim)
+	start := unit.Position
+	aside := start.Offset(yards/2, unit.BearingFromTarget()+math.Pi/2)
+	unit.MoveAlong([]Position{aside, start}, sim)
+}
+
+// Stops any cast or channel which can't be kept up while moving.
+func (unit *Unit) interruptCastingForMovement(sim *Simulation) {
+	if (unit.Hardcast.Expires > sim.CurrentTime) && !unit.Hardcast.CanMove {
+		unit.CancelHardcast(sim)
+	}
+
+	if channeledDot := unit.ChanneledDot; (channeledDot != nil) && !channeledDot.Spell.Flags.Matches(SpellFlagCanCastWhileMoving) {
+		if sim.Log != nil {
+			unit.Log(sim, "Interrupted channel of %s to move", channeledDot.Spell.ActionID)
+		}
+		channeledDot.Deactivate(sim)
+		if unit.GCD.IsReady(sim) {
+			unit.WaitUntil(sim, sim.CurrentTime+unit.ReactionTime)
+		}
+	}
+}
+
 type MovementUpdateType byte
 
 const (
@@ -165,11 +247,28 @@ func (unit *Unit) MultiplyMovementSpeed(sim *Simulation, amount float64) {
 
 	// we have a pending movement action that depends on our movement speed
 	if unit.movementAction != nil && unit.movementAction.speed != 0 {
-		dest := unit.movementAction.speed * float64(unit.movementAction.NextActionAt-unit.movementAction.startTime) / float64(time.Second)
-		unit.MoveTo(dest, sim)
+		unit.MoveAlong(append([]Position{unit.movementAction.dstPosition}, unit.movementAction.waypoints...), sim)
 	}
 }
 
+// Returns how long the unit will keep travelling before its current movement
+// ends. Movement with a fixed duration isn't shortened by movement speed, so it
+// counts as no travel time.
+func (unit *Unit) RemainingTravelTime(sim *Simulation) time.Duration {
+	if !unit.Moving || (unit.movementAction == nil) || (unit.movementAction.speed == 0) {
+		return 0
+	}
+
+	distance := 0.0
+	position := unit.movementAction.dstPosition
+	for _, waypoint := range unit.movementAction.waypoints {
+		distance += position.DistanceTo(waypoint)
+		position = waypoint
+	}
+
+	return unit.movementAction.NextActionAt - sim.CurrentTime + DurationFromSeconds(distance/unit.movementAction.speed)
+}
+
 // Returns the units current movement speed in yards / second
 func (unit *Unit) GetMovementSpeed() float64 {
 	if unit.Type == PlayerUnit {
@@ -0,0 +1,82 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/core/simsignals"
+)
+
+func setupMovementSim(buffs *proto.IndividualBuffs) (*Simulation, *Unit) {
+	sim := NewSim(&proto.RaidSimRequest{
+		SimOptions: &proto.SimOptions{
+			RandomSeed: 100,
+		},
+		Raid: &proto.Raid{
+			Parties: []*proto.Party{
+				{
+					Players: []*proto.Player{
+						{
+							Name:      "Mover",
+							Class:     proto.Class_ClassShaman,
+							Buffs:     buffs,
+							Spec:      &proto.Player_ElementalShaman{},
+							Equipment: &proto.EquipmentSpec{},
+						},
+					},
+					Buffs: &proto.PartyBuffs{},
+				},
+			},
+		},
+		Encounter: &proto.Encounter{
+			Targets: []*proto.Target{
+				{Name: "target", Level: 93, MobType: proto.MobType_MobTypeDemon},
+			},
+			Duration: 180,
+		},
+	}, simsignals.CreateSignals())
+	sim.Reset()
+
+	return sim, &sim.Raid.Parties[0].Players[0].GetCharacter().Unit
+}
+
+func TestStepAsideDuration(t *testing.T) {
+	sim, unit := setupMovementSim(&proto.IndividualBuffs{})
+	runDamageProfileSimUntil(sim, time.Second)
+
+	// 7 yards aside and 7 back, at the base speed of 7 yards per second.
+	unit.StepAside(14, 0, sim)
+	if travelTime := unit.RemainingTravelTime(sim); travelTime != time.Second*2 {
+		t.Fatalf("Expected 2s of travel left, got %s", travelTime)
+	}
+	runDamageProfileSimUntil(sim, time.Millisecond*2990)
+	if !unit.Moving {
+		t.Fatalf("Expected the unit to still be moving")
+	}
+	runDamageProfileSimUntil(sim, time.Millisecond*3010)
+	if unit.Moving {
+		t.Fatalf("Expected the unit to have stepped aside and back after 2s")
+	}
+}
+
+func TestSprintShortensMovement(t *testing.T) {
+	sim, unit := setupMovementSim(&proto.IndividualBuffs{StampedingRoarCount: 1})
+	roar := unit.GetAuraByID(StampedingRoarActionID.WithTag(-1))
+	runDamageProfileSimUntil(sim, time.Second)
+
+	// Sprinting halfway through the first leg covers the remaining 10.5 yards
+	// at 11.2 yards per second.
+	unit.StepAside(14, 0, sim)
+	runDamageProfileSimUntil(sim, time.Millisecond*1500)
+	roar.Activate(sim)
+
+	runDamageProfileSimUntil(sim, time.Millisecond*2430)
+	if !unit.Moving {
+		t.Fatalf("Expected the unit to still be moving")
+	}
+	runDamageProfileSimUntil(sim, time.Millisecond*2450)
+	if unit.Moving {
+		t.Fatalf("Expected Stampeding Roar to shorten the movement to 1.44s")
+	}
+}
@@ -0,0 +1,78 @@
+package core
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Supplies the base combat table chances between an attacker and a defender.
+// Alternate models can be registered to test disputed mechanics, such as
+// glancing blow rates or crit suppression, against the default MoP model.
+type OutcomeModel interface {
+	// Fills in the base miss, avoidance, glance and suppression values of a
+	// newly created attack table.
+	InitAttackTable(table *AttackTable)
+}
+
+const DefaultOutcomeModelName = "mop"
+
+var outcomeModels = map[string]OutcomeModel{
+	DefaultOutcomeModelName: MopOutcomeModel{},
+}
+
+// Registers an outcome model, which encounters can then select by name.
+func RegisterOutcomeModel(name string, model OutcomeModel) {
+	if _, ok := outcomeModels[name]; ok {
+		panic("Outcome model " + name + " is already registered")
+	}
+	outcomeModels[name] = model
+}
+
+// Returns the outcome model with the given name. An empty name returns the
+// default MoP model.
+func GetOutcomeModel(name string) (OutcomeModel, error) {
+	if name == "" {
+		name = DefaultOutcomeModelName
+	}
+	model, ok := outcomeModels[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown outcome model %q", name)
+	}
+	return model, nil
+}
+
+// Names of all registered outcome models, sorted.
+func OutcomeModelNames() []string {
+	names := make([]string, 0, len(outcomeModels))
+	for name := range outcomeModels {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// The MoP level-based combat table, as used by default.
+type MopOutcomeModel struct{}
+
+func (MopOutcomeModel) InitAttackTable(table *AttackTable) {
+	attacker, defender := table.Attacker, table.Defender
+
+	if defender.Type == EnemyUnit {
+		table.BaseSpellMissChance = UnitLevelFloat64(defender.Level, 0.06, 0.09, 0.12, 0.15)
+		table.BaseMissChance = UnitLevelFloat64(defender.Level, 0.03, 0.045, 0.06, 0.075)
+		table.BaseBlockChance = UnitLevelFloat64(defender.Level, 0.03, 0.045, 0.06, 0.075)
+		table.BaseDodgeChance = UnitLevelFloat64(defender.Level, 0.03, 0.045, 0.06, 0.075)
+		table.BaseParryChance = UnitLevelFloat64(defender.Level, 0.03, 0.045, 0.06, 0.075)
+		table.BaseGlanceChance = UnitLevelFloat64(defender.Level, 0.06, 0.12, 0.18, 0.24)
+
+		table.GlanceMultiplier = UnitLevelFloat64(defender.Level, 0.95, 0.95, 0.85, 0.75)
+		table.MeleeCritSuppression = UnitLevelFloat64(defender.Level, 0, 0.01, 0.02, 0.03)
+		table.SpellCritSuppression = UnitLevelFloat64(defender.Level, 0, 0.01, 0.02, 0.03)
+	} else {
+		table.BaseSpellMissChance = UnitLevelFloat64(attacker.Level, 0.06, 0.03, 0, -0.03)
+		table.BaseMissChance = UnitLevelFloat64(attacker.Level, 0.03, 0.015, 0, -0.015)
+		table.BaseBlockChance = UnitLevelFloat64(attacker.Level, 0, -0.015, -0.03, -0.045)
+		table.BaseDodgeChance = UnitLevelFloat64(attacker.Level, 0, -0.015, -0.03, -0.045)
+		table.BaseParryChance = UnitLevelFloat64(attacker.Level, 0, -0.015, -0.03, -0.045)
+	}
+}
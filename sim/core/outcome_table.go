@@ -0,0 +1,81 @@
+package core
+
+import (
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+// Dumps the combat table of every player against every target, so the
+// outcome model can be checked against in-game data. Must be called after the
+// environment has been finalized.
+func (env *Environment) GetOutcomeTables() []*proto.OutcomeTable {
+	var tables []*proto.OutcomeTable
+	for _, party := range env.Raid.Parties {
+		for _, player := range party.Players {
+			if _, isDummy := player.(*TargetDummy); isDummy {
+				continue
+			}
+			tables = append(tables, player.GetCharacter().getOutcomeTables()...)
+		}
+	}
+	return tables
+}
+
+func (character *Character) getOutcomeTables() []*proto.OutcomeTable {
+	character.applyBuildPhaseAuras(CharacterBuildPhaseAll)
+	defer character.clearBuildPhaseAuras(CharacterBuildPhaseAll)
+
+	tables := make([]*proto.OutcomeTable, 0, len(character.Env.Encounter.AllTargets))
+	for _, target := range character.Env.Encounter.AllTargets {
+		attackTable := character.AttackTables[target.UnitIndex]
+
+		table := &proto.OutcomeTable{
+			PlayerName:  character.Name,
+			TargetIndex: target.Index,
+
+			BaseMissChance:       attackTable.BaseMissChance,
+			BaseSpellMissChance:  attackTable.BaseSpellMissChance,
+			BaseDodgeChance:      attackTable.BaseDodgeChance,
+			BaseParryChance:      attackTable.BaseParryChance,
+			BaseBlockChance:      attackTable.BaseBlockChance,
+			BaseGlanceChance:     attackTable.BaseGlanceChance,
+			GlanceMultiplier:     attackTable.GlanceMultiplier,
+			MeleeCritSuppression: attackTable.MeleeCritSuppression,
+			SpellCritSuppression: attackTable.SpellCritSuppression,
+		}
+
+		if mhAuto := character.AutoAttacks.MHAuto(); mhAuto != nil {
+			table.MainHand = mhAuto.whiteHitTable(attackTable)
+		}
+		if ohAuto := character.AutoAttacks.OHAuto(); ohAuto != nil && character.AutoAttacks.IsDualWielding {
+			table.OffHand = ohAuto.whiteHitTable(attackTable)
+		}
+
+		tables = append(tables, table)
+	}
+	return tables
+}
+
+// Resolves the single roll table used by outcomeMeleeWhite, with the same
+// chances as OutcomeExpectedMeleeWhite.
+func (spell *Spell) whiteHitTable(attackTable *AttackTable) *proto.WhiteHitTable {
+	inFront := spell.Unit.PseudoStats.InFrontOfTarget
+
+	table := &proto.WhiteHitTable{
+		Miss:   spell.GetPhysicalMissChance(attackTable),
+		Dodge:  TernaryFloat64(spell.Flags.Matches(SpellFlagCannotBeDodged), 0, max(0, attackTable.BaseDodgeChance-spell.DodgeSuppression())),
+		Parry:  TernaryFloat64(inFront, max(0, attackTable.BaseParryChance-spell.ParrySuppression(attackTable)), 0),
+		Glance: attackTable.BaseGlanceChance,
+		Block:  TernaryFloat64(inFront, attackTable.BaseBlockChance, 0),
+	}
+
+	// Each outcome only covers what is left of the roll after the ones before it.
+	remaining := 1.0
+	for _, chance := range []*float64{&table.Miss, &table.Dodge, &table.Parry, &table.Glance} {
+		*chance = min(*chance, remaining)
+		remaining -= *chance
+	}
+	table.Crit = min(spell.PhysicalCritChance(attackTable), remaining)
+	table.Hit = remaining - table.Crit
+
+	return table
+}
@@ -0,0 +1,50 @@
+package core
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestPetMetricsModes(t *testing.T) {
+	raid := SinglePlayerRaidProto(newRotationTestPlayer(newFillerRotation()), &proto.PartyBuffs{}, &proto.RaidBuffs{}, &proto.Debuffs{})
+
+	runSim := func(mode proto.PetMetricsMode) *proto.RaidMetrics {
+		result := RunRaidSim(&proto.RaidSimRequest{
+			Raid:       raid,
+			Encounter:  MakeSingleTargetEncounter(0),
+			SimOptions: &proto.SimOptions{Iterations: 10, IsTest: true, RandomSeed: 101, PetMetricsMode: mode},
+		})
+		if result.Error != nil {
+			t.Fatalf("Sim failed: %s", result.Error.Message)
+		}
+		return result.RaidMetrics
+	}
+
+	both := runSim(proto.PetMetricsMode_PetMetricsBoth)
+	rollup := runSim(proto.PetMetricsMode_PetMetricsRollup)
+	separate := runSim(proto.PetMetricsMode_PetMetricsSeparate)
+
+	bothPlayer := both.Parties[0].Players[0]
+	rollupPlayer := rollup.Parties[0].Players[0]
+	separatePlayer := separate.Parties[0].Players[0]
+
+	petDps := 0.0
+	for _, pet := range separatePlayer.Pets {
+		petDps += pet.Dps.Avg
+	}
+	if petDps <= 0 {
+		t.Fatalf("Expected the pet to deal damage")
+	}
+
+	if len(rollupPlayer.Pets) != 0 || rollupPlayer.Dps.Avg != bothPlayer.Dps.Avg {
+		t.Fatalf("Expected rolled up pets to only be included in the owner's DPS")
+	}
+	if math.Abs(separatePlayer.Dps.Avg+petDps-bothPlayer.Dps.Avg) > 0.01 {
+		t.Fatalf("Expected separate owner DPS %f plus pet DPS %f to match %f", separatePlayer.Dps.Avg, petDps, bothPlayer.Dps.Avg)
+	}
+	if math.Abs(rollup.Dps.Avg-both.Dps.Avg) > 0.01 || math.Abs(separate.Dps.Avg-both.Dps.Avg) > 0.01 {
+		t.Fatalf("Expected raid DPS to include pets regardless of the mode")
+	}
+}
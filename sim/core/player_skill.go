@@ -0,0 +1,182 @@
+package core
+
+import (
+	"time"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+// Rough approximations of real players, for raid sims that shouldn't assume
+// every member plays perfectly. Custom profiles start from an empty profile
+// and only use the values supplied in the request.
+var playerSkillLibrary = map[proto.PlayerSkillLevel]*proto.PlayerSkillProfile{
+	proto.PlayerSkillLevel_PlayerSkillPerfect: {},
+	proto.PlayerSkillLevel_PlayerSkillGood: {
+		AddedLatencyMs:      50,
+		CooldownDelayChance: 0.1,
+		CooldownDelay:       3,
+		MissedProcChance:    0.02,
+	},
+	proto.PlayerSkillLevel_PlayerSkillAverage: {
+		AddedLatencyMs:      150,
+		CooldownDelayChance: 0.25,
+		CooldownDelay:       8,
+		MissedProcChance:    0.08,
+	},
+	proto.PlayerSkillLevel_PlayerSkillPoor: {
+		AddedLatencyMs:      300,
+		CooldownDelayChance: 0.5,
+		CooldownDelay:       20,
+		MissedProcChance:    0.2,
+	},
+}
+
+func GetPlayerSkillProfile(level proto.PlayerSkillLevel) *proto.PlayerSkillProfile {
+	return playerSkillLibrary[level]
+}
+
+func resolvePlayerSkillProfile(config *proto.PlayerSkillProfile) *proto.PlayerSkillProfile {
+	if config == nil {
+		return nil
+	}
+
+	resolved := &proto.PlayerSkillProfile{Level: config.Level}
+	if preset := GetPlayerSkillProfile(config.Level); preset != nil {
+		resolved = googleProto.Clone(preset).(*proto.PlayerSkillProfile)
+		resolved.Level = config.Level
+	}
+
+	if config.AddedLatencyMs > 0 {
+		resolved.AddedLatencyMs = config.AddedLatencyMs
+	}
+	if config.CooldownDelayChance > 0 {
+		resolved.CooldownDelayChance = min(config.CooldownDelayChance, 1)
+	}
+	if config.CooldownDelay > 0 {
+		resolved.CooldownDelay = config.CooldownDelay
+	}
+	if config.MissedProcChance > 0 {
+		resolved.MissedProcChance = min(config.MissedProcChance, 1)
+	}
+	return resolved
+}
+
+type cooldownDelayState struct {
+	readyAt   time.Duration
+	heldUntil time.Duration
+}
+
+type procReactionState struct {
+	startedAt time.Duration
+	missed    bool
+}
+
+type playerSkill struct {
+	profile *proto.PlayerSkillProfile
+
+	cooldownDelays []*cooldownDelayState
+	procReactions  map[*Aura]procReactionState
+}
+
+// Returns nil for perfect play, so callers can skip all skill checks.
+func newPlayerSkill(config *proto.PlayerSkillProfile) *playerSkill {
+	profile := resolvePlayerSkillProfile(config)
+	if profile == nil {
+		return nil
+	}
+	if profile.AddedLatencyMs <= 0 && profile.CooldownDelayChance <= 0 && profile.MissedProcChance <= 0 {
+		return nil
+	}
+
+	return &playerSkill{
+		profile:       profile,
+		procReactions: make(map[*Aura]procReactionState),
+	}
+}
+
+func (skill *playerSkill) addedLatency() time.Duration {
+	return time.Duration(max(skill.profile.AddedLatencyMs, 0)) * time.Millisecond
+}
+
+// Occasionally holds major cooldowns for a while after they come off
+// cooldown. Called once the character's spells are all registered.
+func (character *Character) applyCooldownDelays() {
+	skill := character.skill
+	if (skill == nil) || (skill.profile.CooldownDelayChance <= 0) || (skill.profile.CooldownDelay <= 0) {
+		return
+	}
+
+	maxDelay := DurationFromSeconds(skill.profile.CooldownDelay)
+	delayed := make(map[*Spell]bool)
+
+	delay := func(spell *Spell) {
+		if delayed[spell] {
+			return
+		}
+		delayed[spell] = true
+
+		state := &cooldownDelayState{readyAt: -NeverExpires}
+		skill.cooldownDelays = append(skill.cooldownDelays, state)
+
+		oldExtraCastCondition := spell.ExtraCastCondition
+		spell.ExtraCastCondition = func(sim *Simulation, target *Unit) bool {
+			// Prepull timings are planned ahead, so never delay those.
+			if sim.CurrentTime < 0 {
+				return (oldExtraCastCondition == nil) || oldExtraCastCondition(sim, target)
+			}
+
+			// Roll once each time the cooldown comes up.
+			if readyAt := spell.ReadyAt(); readyAt != state.readyAt {
+				state.readyAt = readyAt
+				state.heldUntil = readyAt
+				if sim.Proc(skill.profile.CooldownDelayChance, "Cooldown Delay") {
+					state.heldUntil += time.Duration(sim.RandomFloat("Cooldown Delay Length") * float64(maxDelay))
+				}
+			}
+
+			if sim.CurrentTime < state.heldUntil {
+				return false
+			}
+			return (oldExtraCastCondition == nil) || oldExtraCastCondition(sim, target)
+		}
+	}
+
+	for i := range character.initialMajorCooldowns {
+		mcd := &character.initialMajorCooldowns[i]
+		if mcd.Priority != CooldownPriorityBloodlust {
+			delay(mcd.Spell)
+		}
+	}
+	for _, spell := range character.Spellbook {
+		if isPlannedAPLCooldown(spell) {
+			delay(spell)
+		}
+	}
+}
+
+// Whether the player failed to notice the current activation of a proc.
+// Rolled once per activation.
+func (skill *playerSkill) missedProc(sim *Simulation, aura *Aura) bool {
+	if (skill == nil) || (skill.profile.MissedProcChance <= 0) || !aura.IsActive() {
+		return false
+	}
+
+	state, ok := skill.procReactions[aura]
+	if !ok || state.startedAt != aura.StartedAt() {
+		state = procReactionState{
+			startedAt: aura.StartedAt(),
+			missed:    sim.Proc(skill.profile.MissedProcChance, "Missed Proc"),
+		}
+		skill.procReactions[aura] = state
+	}
+	return state.missed
+}
+
+func (skill *playerSkill) reset() {
+	for _, state := range skill.cooldownDelays {
+		state.readyAt = -NeverExpires
+		state.heldUntil = 0
+	}
+	clear(skill.procReactions)
+}
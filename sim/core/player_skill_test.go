@@ -0,0 +1,38 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestResolvePlayerSkillProfileOverridesPreset(t *testing.T) {
+	profile := resolvePlayerSkillProfile(&proto.PlayerSkillProfile{
+		Level:          proto.PlayerSkillLevel_PlayerSkillAverage,
+		AddedLatencyMs: 400,
+	})
+
+	if profile.AddedLatencyMs != 400 {
+		t.Fatalf("Expected added latency override of 400 but found %d", profile.AddedLatencyMs)
+	}
+	if profile.CooldownDelayChance != 0.25 {
+		t.Fatalf("Expected preset cooldown delay chance of 0.25 but found %f", profile.CooldownDelayChance)
+	}
+	if preset := GetPlayerSkillProfile(proto.PlayerSkillLevel_PlayerSkillAverage); preset.AddedLatencyMs != 150 {
+		t.Fatalf("Preset profile was modified while resolving a request profile")
+	}
+}
+
+func TestNewPlayerSkillPerfect(t *testing.T) {
+	if newPlayerSkill(nil) != nil {
+		t.Fatalf("Expected no skill model when no profile is requested")
+	}
+	if newPlayerSkill(&proto.PlayerSkillProfile{Level: proto.PlayerSkillLevel_PlayerSkillPerfect}) != nil {
+		t.Fatalf("Expected no skill model for perfect play")
+	}
+
+	skill := newPlayerSkill(&proto.PlayerSkillProfile{MissedProcChance: 2})
+	if skill == nil || skill.profile.MissedProcChance != 1 {
+		t.Fatalf("Expected missed proc chance to be capped at 1, found %v", skill)
+	}
+}
@@ -0,0 +1,72 @@
+package core
+
+import (
+	"math"
+)
+
+// A point on the ground of the encounter, in yards. Targets stand at the
+// origin, and every other unit starts on the positive X axis at its starting
+// distance from them.
+type Position struct {
+	X float64
+	Y float64
+}
+
+// Where the encounter's targets stand.
+var TargetPosition = Position{}
+
+func (position Position) DistanceTo(other Position) float64 {
+	return math.Hypot(other.X-position.X, other.Y-position.Y)
+}
+
+// Returns the direction from this position to the other, in radians
+// counterclockwise from the positive X axis.
+func (position Position) AngleTo(other Position) float64 {
+	return math.Atan2(other.Y-position.Y, other.X-position.X)
+}
+
+// Returns the position at the given distance from this one, in the direction
+// of the given angle.
+func (position Position) Offset(distance float64, angle float64) Position {
+	return Position{
+		X: position.X + distance*math.Cos(angle),
+		Y: position.Y + distance*math.Sin(angle),
+	}
+}
+
+// Returns the position the given fraction of the way from this one to the other.
+func (position Position) Lerp(other Position, fraction float64) Position {
+	return Position{
+		X: position.X + (other.X-position.X)*fraction,
+		Y: position.Y + (other.Y-position.Y)*fraction,
+	}
+}
+
+// Returns the direction from the targets to the unit, which is the positive
+// X axis for units standing on top of them.
+func (unit *Unit) BearingFromTarget() float64 {
+	return TargetPosition.AngleTo(unit.Position)
+}
+
+// Turns the unit towards the given position. Units standing exactly on it
+// keep their current facing.
+func (unit *Unit) FaceTowards(position Position) {
+	if unit.Position != position {
+		unit.Facing = unit.Position.AngleTo(position)
+	}
+}
+
+// Some effects place a unit by setting DistanceFromTarget directly, so this
+// moves its position onto the same bearing at that distance.
+func (unit *Unit) syncPositionToDistance() {
+	if unit.Position.DistanceTo(TargetPosition) != unit.DistanceFromTarget {
+		unit.Position = TargetPosition.Offset(unit.DistanceFromTarget, unit.BearingFromTarget())
+	}
+}
+
+func (unit *Unit) resetPosition() {
+	unit.DistanceFromTarget = unit.StartDistanceFromTarget
+	unit.Position = TargetPosition.Offset(unit.StartDistanceFromTarget, 0)
+	unit.Facing = 0
+	unit.FaceTowards(TargetPosition)
+}
@@ -0,0 +1,49 @@
+package core
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPositionMath(t *testing.T) {
+	position := Position{X: 3}
+	other := position.Offset(4, math.Pi/2)
+
+	if distance := position.DistanceTo(other); math.Abs(distance-4) > 1e-9 {
+		t.Fatalf("Expected a distance of 4, found %f", distance)
+	}
+	if distance := TargetPosition.DistanceTo(other); math.Abs(distance-5) > 1e-9 {
+		t.Fatalf("Expected a distance of 5 from the targets, found %f", distance)
+	}
+	if angle := position.AngleTo(other); math.Abs(angle-math.Pi/2) > 1e-9 {
+		t.Fatalf("Expected an angle of pi/2, found %f", angle)
+	}
+	if midpoint := position.Lerp(other, 0.5); math.Abs(midpoint.X-3) > 1e-9 || math.Abs(midpoint.Y-2) > 1e-9 {
+		t.Fatalf("Expected the midpoint at (3, 2), found %v", midpoint)
+	}
+}
+
+func TestUnitPosition(t *testing.T) {
+	unit := &Unit{StartDistanceFromTarget: 20}
+	unit.resetPosition()
+
+	if unit.Position != (Position{X: 20}) || unit.DistanceFromTarget != 20 {
+		t.Fatalf("Expected the unit to start 20 yards along the X axis, found %v", unit.Position)
+	}
+	if math.Abs(unit.Facing-math.Pi) > 1e-9 {
+		t.Fatalf("Expected the unit to face the targets, found %f", unit.Facing)
+	}
+
+	unit.Position = Position{X: 0, Y: 10}
+	unit.DistanceFromTarget = 5
+	unit.syncPositionToDistance()
+	if math.Abs(unit.Position.X) > 1e-9 || math.Abs(unit.Position.Y-5) > 1e-9 {
+		t.Fatalf("Expected the unit to be placed 5 yards along its bearing, found %v", unit.Position)
+	}
+
+	target := &Unit{}
+	target.resetPosition()
+	if target.Position != TargetPosition || target.Facing != 0 {
+		t.Fatalf("Expected targets to stand at the origin facing the raid, found %v facing %f", target.Position, target.Facing)
+	}
+}
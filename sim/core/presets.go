@@ -0,0 +1,183 @@
+package core
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	defaultPresetsUIDir = "ui"
+	gearSetPresetSuffix = ".gear.json"
+	aplPresetSuffix     = ".apl.json"
+)
+
+// Preset files may still carry fields the sim no longer knows about, which the
+// UI ignores as well.
+var presetUnmarshalOptions = protojson.UnmarshalOptions{DiscardUnknown: true}
+
+// Enumerates the gear set and APL presets shipped with each spec UI, i.e. the
+// ui/<class>/<spec>/gear_sets/*.gear.json and ui/<class>/<spec>/apls/*.apl.json
+// files that the spec test suites load through GetGearSet and GetAplRotation.
+func listPresets(request *proto.ListPresetsRequest) *proto.ListPresetsResult {
+	uiDir := request.UiDir
+	if uiDir == "" {
+		uiDir = defaultPresetsUIDir
+	}
+
+	classDirs, err := os.ReadDir(uiDir)
+	if err != nil {
+		return &proto.ListPresetsResult{Error: &proto.ErrorOutcome{Message: err.Error()}}
+	}
+
+	result := &proto.ListPresetsResult{}
+	for _, classDir := range classDirs {
+		if !classDir.IsDir() {
+			continue
+		}
+		class, ok := presetDirClass(classDir.Name())
+		if !ok {
+			continue
+		}
+
+		specDirs, err := os.ReadDir(filepath.Join(uiDir, classDir.Name()))
+		if err != nil {
+			return &proto.ListPresetsResult{Error: &proto.ErrorOutcome{Message: err.Error()}}
+		}
+
+		for _, specDir := range specDirs {
+			if !specDir.IsDir() {
+				continue
+			}
+			spec, ok := presetDirSpec(classDir.Name(), specDir.Name())
+			if !ok || (request.Spec != proto.Spec_SpecUnknown && request.Spec != spec) {
+				continue
+			}
+
+			specPresets, err := loadSpecPresets(uiDir, filepath.Join(classDir.Name(), specDir.Name()), request.IncludeContents)
+			if err != nil {
+				return &proto.ListPresetsResult{Error: &proto.ErrorOutcome{Message: err.Error()}}
+			}
+			if len(specPresets.GearSets) == 0 && len(specPresets.Rotations) == 0 {
+				continue
+			}
+
+			specPresets.Class = class
+			specPresets.Spec = spec
+			result.Specs = append(result.Specs, specPresets)
+		}
+	}
+
+	sort.Slice(result.Specs, func(i, j int) bool {
+		return result.Specs[i].Spec < result.Specs[j].Spec
+	})
+
+	return result
+}
+
+func loadSpecPresets(uiDir string, specDir string, includeContents bool) (*proto.SpecPresets, error) {
+	specPresets := &proto.SpecPresets{
+		Dir: filepath.ToSlash(specDir),
+	}
+
+	err := forEachPresetFile(filepath.Join(uiDir, specDir, "gear_sets"), gearSetPresetSuffix, func(name string, path string, data []byte) error {
+		gear := &proto.EquipmentSpec{}
+		if err := presetUnmarshalOptions.Unmarshal(data, gear); err != nil {
+			return fmt.Errorf("parsing gear set %s: %w", path, err)
+		}
+
+		preset := &proto.GearSetPreset{
+			Name:     name,
+			Path:     filepath.ToSlash(path),
+			NumItems: int32(len(gear.Items)),
+		}
+		if includeContents {
+			preset.Gear = gear
+		}
+		specPresets.GearSets = append(specPresets.GearSets, preset)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = forEachPresetFile(filepath.Join(uiDir, specDir, "apls"), aplPresetSuffix, func(name string, path string, data []byte) error {
+		rotation := &proto.APLRotation{}
+		if err := presetUnmarshalOptions.Unmarshal(data, rotation); err != nil {
+			return fmt.Errorf("parsing rotation %s: %w", path, err)
+		}
+
+		preset := &proto.APLPreset{
+			Name:               name,
+			Path:               filepath.ToSlash(path),
+			Type:               rotation.Type,
+			NumPrepullActions:  int32(len(rotation.PrepullActions)),
+			NumPriorityActions: int32(len(rotation.PriorityList)),
+		}
+		if includeContents {
+			preset.Rotation = rotation
+		}
+		specPresets.Rotations = append(specPresets.Rotations, preset)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return specPresets, nil
+}
+
+// Calls handler for every file in dir ending with suffix, in name order. A
+// missing directory just means the spec has no presets of that kind.
+func forEachPresetFile(dir string, suffix string, handler func(name string, path string, data []byte) error) error {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), suffix) {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if err := handler(strings.TrimSuffix(entry.Name(), suffix), path, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Converts a snake_case UI directory name to the CamelCase used by the proto enums.
+func presetDirEnumName(dir string) string {
+	var sb strings.Builder
+	for _, part := range strings.Split(dir, "_") {
+		if part == "" {
+			continue
+		}
+		sb.WriteString(strings.ToUpper(part[:1]))
+		sb.WriteString(part[1:])
+	}
+	return sb.String()
+}
+
+func presetDirClass(classDir string) (proto.Class, bool) {
+	class, ok := proto.Class_value["Class"+presetDirEnumName(classDir)]
+	return proto.Class(class), ok
+}
+
+func presetDirSpec(classDir string, specDir string) (proto.Spec, bool) {
+	spec, ok := proto.Spec_value["Spec"+presetDirEnumName(specDir)+presetDirEnumName(classDir)]
+	return proto.Spec(spec), ok
+}
@@ -0,0 +1,57 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestListPresets(t *testing.T) {
+	result := ListPresets(&proto.ListPresetsRequest{UiDir: "../../ui"})
+	if result.Error != nil {
+		t.Fatalf("Unexpected error: %s", result.Error.Message)
+	}
+
+	var affliction *proto.SpecPresets
+	for _, specPresets := range result.Specs {
+		if specPresets.Spec == proto.Spec_SpecAfflictionWarlock {
+			affliction = specPresets
+		}
+	}
+	if affliction == nil {
+		t.Fatalf("Expected presets for Affliction Warlock")
+	}
+	if affliction.Class != proto.Class_ClassWarlock || affliction.Dir != "warlock/affliction" {
+		t.Fatalf("Unexpected class %s or dir %s", affliction.Class, affliction.Dir)
+	}
+
+	// Every preset must be loadable the same way the test suites load them.
+	for _, preset := range affliction.GearSets {
+		gearSet := GetGearSet("../../ui/warlock/affliction/gear_sets", preset.Name)
+		if int32(len(gearSet.GearSet.Items)) != preset.NumItems || preset.Gear != nil {
+			t.Fatalf("Unexpected gear set preset %s", preset.Name)
+		}
+	}
+	foundDefault := false
+	for _, preset := range affliction.Rotations {
+		foundDefault = foundDefault || preset.Name == "default"
+		if preset.Type != proto.APLRotation_TypeAPL || preset.NumPriorityActions == 0 {
+			t.Fatalf("Unexpected rotation preset %s", preset.Name)
+		}
+	}
+	if !foundDefault {
+		t.Fatalf("Expected a default rotation preset")
+	}
+
+	filtered := ListPresets(&proto.ListPresetsRequest{
+		UiDir:           "../../ui",
+		Spec:            proto.Spec_SpecDemonologyWarlock,
+		IncludeContents: true,
+	})
+	if len(filtered.Specs) != 1 || filtered.Specs[0].Spec != proto.Spec_SpecDemonologyWarlock {
+		t.Fatalf("Expected only Demonology Warlock presets, got %d specs", len(filtered.Specs))
+	}
+	if filtered.Specs[0].GearSets[0].Gear == nil || filtered.Specs[0].Rotations[0].Rotation == nil {
+		t.Fatalf("Expected preset contents to be included")
+	}
+}
@@ -1,6 +1,8 @@
 package core
 
 import (
+	"time"
+
 	"github.com/wowsims/mop/sim/core/proto"
 )
 
@@ -42,6 +44,31 @@ func (dpm *DynamicProcManager) Chance(procMask ProcMask, sim *Simulation) float6
 	return 0
 }
 
+// Returns the earliest expected time until one of the RPPM procs of this manager
+// triggers, or false if none of them are RPPM based.
+func (dpm *DynamicProcManager) ExpectedTimeToNextRPPMProc(sim *Simulation, delay time.Duration) (time.Duration, bool) {
+	expectedTime := NeverExpires
+	found := false
+	for _, proc := range dpm.procChances {
+		if rppmProc, ok := proc.(*RPPMProc); ok {
+			expectedTime = min(expectedTime, rppmProc.ExpectedTimeToNextProc(sim, delay))
+			found = true
+		}
+	}
+
+	return expectedTime, found
+}
+
+func (dpm *DynamicProcManager) hasRPPMProc() bool {
+	for _, proc := range dpm.procChances {
+		if _, ok := proc.(*RPPMProc); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
 // PPMManager for static ProcMasks
 func (character *Character) NewLegacyPPMManager(ppm float64, procMask ProcMask) *DynamicProcManager {
 	dpm := character.newDynamicWeaponProcManager(ppm, 0, procMask)
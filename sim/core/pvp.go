@@ -0,0 +1,71 @@
+package core
+
+import (
+	"math"
+
+	"github.com/wowsims/mop/sim/core/stats"
+)
+
+// Combat rating conversions at level 90.
+const PvpPowerRatingPerPvpPowerPercent = 400.0
+const ResilienceRatingPerResiliencePercent = 310.0
+
+// Damage reduction against players that every player has without any
+// resilience rating, as of patch 5.4.
+const BaseResiliencePercent = 65.0
+
+// Healing done by players in PvP combat is reduced by Battle Fatigue.
+const BattleFatigueHealingMultiplier = 0.4
+
+// Whether the unit counts as a player for PvP stats: players, their pets and
+// player-like targets.
+func (unit *Unit) IsPlayerLike() bool {
+	return (unit.Type != EnemyUnit) || unit.PlayerLike
+}
+
+// Damage multiplier from PvP Power against players.
+func (unit *Unit) PvpPowerDamageMultiplier() float64 {
+	return 1 + unit.GetStat(stats.PvpPowerRating)/PvpPowerRatingPerPvpPowerPercent/100
+}
+
+// Damage taken multiplier from resilience against players. Each percent of
+// resilience from rating reduces the remaining damage by 1%, so rating has
+// diminishing returns on top of the base resilience.
+func (unit *Unit) ResilienceDamageTakenMultiplier() float64 {
+	resiliencePercent := unit.GetStat(stats.PvpResilienceRating) / ResilienceRatingPerResiliencePercent
+	return (1 - BaseResiliencePercent/100) * math.Pow(0.99, resiliencePercent)
+}
+
+// Damage multiplier for attacks between two players, 1 outside of PvP.
+func pvpDamageMultiplier(attacker *Unit, defender *Unit) float64 {
+	if !attacker.IsPlayerLike() || !defender.IsPlayerLike() {
+		return 1
+	}
+	return attacker.PvpPowerDamageMultiplier() * defender.ResilienceDamageTakenMultiplier()
+}
+
+// Applies Battle Fatigue to all players when fighting player-like targets.
+func (env *Environment) registerBattleFatigue() {
+	if !env.Encounter.HasPlayerLikeTargets() {
+		return
+	}
+
+	for _, party := range env.Raid.Parties {
+		for _, player := range party.Players {
+			character := player.GetCharacter()
+			MakePermanent(character.RegisterAura(Aura{
+				Label:    "Battle Fatigue",
+				ActionID: ActionID{SpellID: 134735},
+			}).AttachMultiplicativePseudoStatBuff(&character.PseudoStats.HealingDealtMultiplier, BattleFatigueHealingMultiplier))
+		}
+	}
+}
+
+func (encounter *Encounter) HasPlayerLikeTargets() bool {
+	for _, target := range encounter.AllTargets {
+		if target.PlayerLike {
+			return true
+		}
+	}
+	return false
+}
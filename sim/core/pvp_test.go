@@ -0,0 +1,29 @@
+package core
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/stats"
+)
+
+func TestPvpDamageMultiplier(t *testing.T) {
+	sim := SetupFakeSim()
+	fa := sim.Raid.Parties[0].Players[0].(*FakeAgent)
+	target := sim.Encounter.ActiveTargetUnits[0]
+	attackTable := fa.AttackTables[target.UnitIndex]
+
+	fa.stats[stats.PvpPowerRating] = 4000
+	target.stats[stats.PvpResilienceRating] = 3100
+
+	pveMultiplier := fa.Spell.TargetDamageMultiplier(sim, attackTable, false)
+
+	target.PlayerLike = true
+	pvpMultiplier := fa.Spell.TargetDamageMultiplier(sim, attackTable, false)
+
+	// 10% PvP power, 65% base resilience and 10% resilience from rating.
+	expected := 1.1 * 0.35 * math.Pow(0.99, 10)
+	if math.Abs(pvpMultiplier/pveMultiplier-expected) > 1e-9 {
+		t.Fatalf("Expected a PvP damage multiplier of %f, found %f", expected, pvpMultiplier/pveMultiplier)
+	}
+}
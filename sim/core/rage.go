@@ -243,6 +243,7 @@ func (rc *RageCost) SpendCost(sim *Simulation, spell *Spell) {
 func (rc *RageCost) IssueRefund(sim *Simulation, spell *Spell) {
 	if rc.Refund > 0 && spell.CurCast.Cost > 0 {
 		spell.Unit.AddRage(sim, rc.Refund*spell.CurCast.Cost, rc.RefundMetrics)
+		spell.RecordRefund(rc.Refund * spell.CurCast.Cost)
 	}
 }
 
@@ -82,9 +82,15 @@ func (party *Party) reset(sim *Simulation) {
 
 func (party *Party) doneIteration(sim *Simulation) {
 	for _, agent := range party.Players {
-		agent.GetCharacter().doneIteration(sim)
-		party.dpsMetrics.Total += agent.GetCharacter().Metrics.dps.Total
-		party.hpsMetrics.Total += agent.GetCharacter().Metrics.hps.Total
+		character := agent.GetCharacter()
+		character.doneIteration(sim)
+		party.dpsMetrics.Total += character.Metrics.dps.Total
+		if sim.Options.PetMetricsMode == proto.PetMetricsMode_PetMetricsSeparate {
+			for _, pet := range character.Pets {
+				party.dpsMetrics.Total += pet.Metrics.dps.Total
+			}
+		}
+		party.hpsMetrics.Total += character.Metrics.hps.Total
 	}
 
 	party.dpsMetrics.doneIteration(sim)
@@ -183,6 +189,9 @@ func NewRaid(raidConfig *proto.Raid) *Raid {
 	for i := 0; i < raid.NumTargetDummies; i++ {
 		party, partyIndex := raid.GetFirstEmptyRaidIndex()
 		dummy := NewTargetDummy(i, party, partyIndex)
+		if profile := raidConfig.DamageProfile; profile != nil && (profile.RaidDps > 0 || profile.TankDps > 0) {
+			dummy.applyDamageProfile(profile, i == 0)
+		}
 		party.Players = append(party.Players, dummy)
 	}
 
@@ -0,0 +1,265 @@
+package core
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/core/simsignals"
+	googleProto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Classes which can provide each RaidBuffs and Debuffs field. Hunter pets
+// count as hunters.
+var raidBuffProviders = map[string][]proto.Class{
+	"horn_of_winter":              {proto.Class_ClassDeathKnight},
+	"trueshot_aura":               {proto.Class_ClassHunter},
+	"battle_shout":                {proto.Class_ClassWarrior},
+	"unholy_aura":                 {proto.Class_ClassDeathKnight},
+	"cackling_howl":               {proto.Class_ClassHunter},
+	"serpents_swiftness":          {proto.Class_ClassHunter},
+	"swiftblades_cunning":         {proto.Class_ClassRogue},
+	"unleashed_rage":              {proto.Class_ClassShaman},
+	"still_water":                 {proto.Class_ClassHunter},
+	"arcane_brilliance":           {proto.Class_ClassMage},
+	"burning_wrath":               {proto.Class_ClassShaman},
+	"dark_intent":                 {proto.Class_ClassWarlock},
+	"moonkin_aura":                {proto.Class_ClassDruid},
+	"mind_quickening":             {proto.Class_ClassHunter},
+	"shadow_form":                 {proto.Class_ClassPriest},
+	"elemental_oath":              {proto.Class_ClassShaman},
+	"leader_of_the_pack":          {proto.Class_ClassDruid},
+	"terrifying_roar":             {proto.Class_ClassHunter},
+	"furious_howl":                {proto.Class_ClassHunter},
+	"legacy_of_the_white_tiger":   {proto.Class_ClassMonk},
+	"roar_of_courage":             {proto.Class_ClassHunter},
+	"spirit_beast_blessing":       {proto.Class_ClassHunter},
+	"blessing_of_might":           {proto.Class_ClassPaladin},
+	"grace_of_air":                {proto.Class_ClassShaman},
+	"mark_of_the_wild":            {proto.Class_ClassDruid},
+	"embrace_of_the_shale_spider": {proto.Class_ClassHunter},
+	"legacy_of_the_emperor":       {proto.Class_ClassMonk},
+	"blessing_of_kings":           {proto.Class_ClassPaladin},
+	"qiraji_fortitude":            {proto.Class_ClassHunter},
+	"power_word_fortitude":        {proto.Class_ClassPriest},
+	"commanding_shout":            {proto.Class_ClassWarrior},
+	"bloodlust":                   {proto.Class_ClassShaman, proto.Class_ClassMage, proto.Class_ClassHunter},
+	"mana_tide_totem_count":       {proto.Class_ClassShaman},
+	"stormlash_totem_count":       {proto.Class_ClassShaman},
+	"skull_banner_count":          {proto.Class_ClassWarrior},
+
+	"weakened_blows":         {proto.Class_ClassDeathKnight, proto.Class_ClassDruid, proto.Class_ClassHunter, proto.Class_ClassMonk, proto.Class_ClassPaladin, proto.Class_ClassShaman, proto.Class_ClassWarlock, proto.Class_ClassWarrior},
+	"physical_vulnerability": {proto.Class_ClassDeathKnight, proto.Class_ClassHunter, proto.Class_ClassPaladin, proto.Class_ClassWarrior},
+	"weakened_armor":         {proto.Class_ClassDruid, proto.Class_ClassHunter, proto.Class_ClassRogue, proto.Class_ClassWarrior},
+	"mortal_wounds":          {proto.Class_ClassHunter, proto.Class_ClassMonk, proto.Class_ClassRogue, proto.Class_ClassWarrior},
+	"fire_breath":            {proto.Class_ClassHunter},
+	"lightning_breath":       {proto.Class_ClassHunter},
+	"master_poisoner":        {proto.Class_ClassRogue},
+	"curse_of_elements":      {proto.Class_ClassWarlock},
+	"necrotic_strike":        {proto.Class_ClassDeathKnight},
+	"lava_breath":            {proto.Class_ClassHunter},
+	"spore_cloud":            {proto.Class_ClassHunter},
+	"slow":                   {proto.Class_ClassMage},
+	"mind_numbing_poison":    {proto.Class_ClassRogue},
+	"curse_of_enfeeblement":  {proto.Class_ClassWarlock},
+}
+
+// Returns the enabled bool and count fields of a RaidBuffs or Debuffs message,
+// including those only forced on by an uptime override.
+func enabledBuffFields(buffs protoreflect.Message, overrides []*proto.BuffUptimeOverride) []string {
+	var enabled []string
+	fields := buffs.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		name := string(field.Name())
+		isSet := false
+		switch field.Kind() {
+		case protoreflect.BoolKind:
+			isSet = buffs.Get(field).Bool()
+		case protoreflect.Int32Kind:
+			isSet = buffs.Get(field).Int() > 0
+		default:
+			continue
+		}
+
+		for _, override := range overrides {
+			if override.Field == name {
+				isSet = override.Uptime > 0
+			}
+		}
+		if isSet {
+			enabled = append(enabled, name)
+		}
+	}
+	return enabled
+}
+
+// Turns off a field of a RaidBuffs or Debuffs message. Count fields are
+// lowered by countReduction instead, or cleared if it is 0. Returns the
+// remaining uptime overrides.
+func disableBuffField(buffs protoreflect.Message, overrides []*proto.BuffUptimeOverride, name string, countReduction int64) []*proto.BuffUptimeOverride {
+	field := buffs.Descriptor().Fields().ByName(protoreflect.Name(name))
+	if field == nil {
+		return overrides
+	}
+
+	if field.Kind() == protoreflect.Int32Kind && countReduction > 0 {
+		buffs.Set(field, protoreflect.ValueOfInt32(int32(max(0, buffs.Get(field).Int()-countReduction))))
+	} else if field.Kind() == protoreflect.Int32Kind {
+		buffs.Set(field, protoreflect.ValueOfInt32(0))
+	} else {
+		buffs.Set(field, protoreflect.ValueOfBool(false))
+	}
+	return slices.DeleteFunc(overrides, func(override *proto.BuffUptimeOverride) bool {
+		return override.Field == name
+	})
+}
+
+func raidClassCounts(raid *proto.Raid) map[proto.Class]int64 {
+	counts := make(map[proto.Class]int64)
+	for partyIdx, party := range raid.Parties {
+		if raid.NumActiveParties > 0 && int32(partyIdx) >= raid.NumActiveParties {
+			break
+		}
+		for _, player := range party.Players {
+			if player != nil && player.Class != proto.Class_ClassUnknown {
+				counts[player.Class]++
+			}
+		}
+	}
+	return counts
+}
+
+// Sims the raid without each of its raid buffs and debuffs, and optionally
+// without everything each of its classes brings. RNG is fixed across sims, so
+// per-iteration deltas have very low variance.
+func runRaidBuffContributions(request *proto.RaidBuffContributionRequest, signals simsignals.Signals) *proto.RaidBuffContributionResult {
+	baseRequest := request.RaidSimRequest
+	if baseRequest == nil || baseRequest.Raid == nil {
+		return &proto.RaidBuffContributionResult{Error: &proto.ErrorOutcome{Message: "No raid to attribute buffs for"}}
+	}
+	if baseRequest.SimOptions == nil {
+		return &proto.RaidBuffContributionResult{Error: &proto.ErrorOutcome{Message: "No sim options"}}
+	}
+
+	// Work on a copy, so the caller's request is left untouched.
+	baseRequest = googleProto.Clone(baseRequest).(*proto.RaidSimRequest)
+	if baseRequest.Raid.Buffs == nil {
+		baseRequest.Raid.Buffs = &proto.RaidBuffs{}
+	}
+	if baseRequest.Raid.Debuffs == nil {
+		baseRequest.Raid.Debuffs = &proto.Debuffs{}
+	}
+	baseRequest.SimOptions.SaveAllValues = true
+	baseRequest.SimOptions.UseLabeledRands = true
+	if baseRequest.SimOptions.RandomSeed == 0 {
+		baseRequest.SimOptions.RandomSeed = time.Now().UnixNano()
+	}
+
+	simFunc := runSimConcurrent
+	// Don't use go threads in wasm, it just adds more overhead and makes the worker more unresponsive.
+	if IsRunningInWasm() {
+		simFunc = RunSim
+	}
+
+	baseResult := simFunc(baseRequest, nil, signals)
+	if baseResult.Error != nil {
+		return &proto.RaidBuffContributionResult{Error: baseResult.Error}
+	}
+	baseDps := baseResult.RaidMetrics.Dps
+
+	result := &proto.RaidBuffContributionResult{
+		BaseRaidDps: baseDps.Avg,
+	}
+
+	// Resims with the given fields disabled, see disableBuffField.
+	simWithout := func(name string, class proto.Class, fields []string, countReduction int64) *proto.ErrorOutcome {
+		simRequest := googleProto.Clone(baseRequest).(*proto.RaidSimRequest)
+		buffs := simRequest.Raid.Buffs
+		debuffs := simRequest.Raid.Debuffs
+		for _, field := range fields {
+			buffs.UptimeOverrides = disableBuffField(buffs.ProtoReflect(), buffs.UptimeOverrides, field, countReduction)
+			debuffs.UptimeOverrides = disableBuffField(debuffs.ProtoReflect(), debuffs.UptimeOverrides, field, countReduction)
+		}
+
+		simResult := simFunc(simRequest, nil, signals)
+		if simResult.Error != nil {
+			return simResult.Error
+		}
+		dps := simResult.RaidMetrics.Dps
+
+		var dpsDelta aggregator
+		for i := range baseDps.AllValues {
+			dpsDelta.add(baseDps.AllValues[i] - dps.AllValues[i])
+		}
+		dpsDeltaMean, dpsDeltaStdev := dpsDelta.meanAndStdDev()
+
+		contribution := &proto.RaidBuffContribution{
+			Name:              name,
+			Class:             class,
+			Fields:            fields,
+			RaidDps:           dps.Avg,
+			RaidDpsDelta:      dpsDeltaMean,
+			RaidDpsDeltaStdev: dpsDeltaStdev,
+		}
+		if class == proto.Class_ClassUnknown {
+			result.Buffs = append(result.Buffs, contribution)
+		} else {
+			result.Classes = append(result.Classes, contribution)
+		}
+		return nil
+	}
+
+	enabledFields := append(
+		enabledBuffFields(baseRequest.Raid.Buffs.ProtoReflect(), baseRequest.Raid.Buffs.UptimeOverrides),
+		enabledBuffFields(baseRequest.Raid.Debuffs.ProtoReflect(), baseRequest.Raid.Debuffs.UptimeOverrides)...,
+	)
+	for _, field := range enabledFields {
+		if err := simWithout(field, proto.Class_ClassUnknown, []string{field}, 0); err != nil {
+			return &proto.RaidBuffContributionResult{Error: err}
+		}
+	}
+
+	if request.IncludeClasses {
+		classCounts := raidClassCounts(baseRequest.Raid)
+		classes := make([]proto.Class, 0, len(classCounts))
+		for class := range classCounts {
+			classes = append(classes, class)
+		}
+		slices.Sort(classes)
+
+		for _, class := range classes {
+			// Without this class, the raid loses what no other class present can provide.
+			var fields []string
+			for _, field := range enabledFields {
+				providers := raidBuffProviders[field]
+				if !slices.Contains(providers, class) {
+					continue
+				}
+				// Every banner or totem is its own, so counts drop even if other classes are present.
+				countField := baseRequest.Raid.Buffs.ProtoReflect().Descriptor().Fields().ByName(protoreflect.Name(field))
+				isCount := countField != nil && countField.Kind() == protoreflect.Int32Kind
+				if isCount || !slices.ContainsFunc(providers, func(other proto.Class) bool { return other != class && classCounts[other] > 0 }) {
+					fields = append(fields, field)
+				}
+			}
+			if len(fields) == 0 {
+				continue
+			}
+
+			if err := simWithout(class.String(), class, fields, classCounts[class]); err != nil {
+				return &proto.RaidBuffContributionResult{Error: err}
+			}
+		}
+	}
+
+	byDelta := func(a, b *proto.RaidBuffContribution) int {
+		return cmp.Compare(b.RaidDpsDelta, a.RaidDpsDelta)
+	}
+	slices.SortStableFunc(result.Buffs, byDelta)
+	slices.SortStableFunc(result.Classes, byDelta)
+
+	return result
+}
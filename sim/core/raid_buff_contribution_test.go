@@ -0,0 +1,57 @@
+package core
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestRaidBuffContributions(t *testing.T) {
+	request := &proto.RaidSimRequest{
+		Raid: SinglePlayerRaidProto(newRotationTestPlayer(newFillerRotation()), &proto.PartyBuffs{}, &proto.RaidBuffs{
+			ArcaneBrilliance: true,
+		}, &proto.Debuffs{
+			CurseOfElements: true,
+			MasterPoisoner:  true,
+		}),
+		Encounter:  MakeSingleTargetEncounter(0),
+		SimOptions: &proto.SimOptions{Iterations: 20, IsTest: true, RandomSeed: 101},
+	}
+	result := ComputeRaidBuffContributions(&proto.RaidBuffContributionRequest{
+		RaidSimRequest: request,
+		IncludeClasses: true,
+	})
+	if result.Error != nil {
+		t.Fatalf("Attribution failed: %s", result.Error.Message)
+	}
+	if !request.Raid.Buffs.ArcaneBrilliance || request.SimOptions.SaveAllValues {
+		t.Fatalf("The request should be left untouched")
+	}
+
+	deltas := make(map[string]float64)
+	for _, contribution := range result.Buffs {
+		deltas[contribution.Name] = contribution.RaidDpsDelta
+	}
+	if len(deltas) != 3 {
+		t.Fatalf("Expected 3 buff contributions, got %v", result.Buffs)
+	}
+	if result.Buffs[0].Name != "arcane_brilliance" || deltas["arcane_brilliance"] <= 0 {
+		t.Fatalf("Expected arcane brilliance to contribute the most, got %v", result.Buffs)
+	}
+	// Either debuff alone is enough, so neither contributes on its own.
+	for _, name := range []string{"curse_of_elements", "master_poisoner"} {
+		if math.Abs(deltas[name]) > 1e-6 {
+			t.Fatalf("Expected no contribution from %s, got %0.3f", name, deltas[name])
+		}
+	}
+
+	// The warlock only takes away the curse, which the poison still covers.
+	if len(result.Classes) != 1 {
+		t.Fatalf("Expected 1 class contribution, got %v", result.Classes)
+	}
+	warlock := result.Classes[0]
+	if warlock.Class != proto.Class_ClassWarlock || len(warlock.Fields) != 1 || warlock.Fields[0] != "curse_of_elements" {
+		t.Fatalf("Unexpected warlock contribution: %v", warlock)
+	}
+}
@@ -0,0 +1,46 @@
+package core
+
+import (
+	"time"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/core/stats"
+)
+
+// Gives the dummy a health pool and has it take the profile's damage, so
+// healers have something to heal. The first dummy also takes the tank damage.
+func (td *TargetDummy) applyDamageProfile(profile *proto.RaidDamageProfile, isTank bool) {
+	if profile.DummyHealth > 0 {
+		td.AddStat(stats.Health, profile.DummyHealth-td.baseStats[stats.Health])
+		td.baseStats[stats.Health] = profile.DummyHealth
+	}
+	td.EnableHealthBar()
+
+	dps := profile.RaidDps
+	if isTank {
+		dps += profile.TankDps
+	}
+	if dps <= 0 {
+		return
+	}
+
+	cadence := DurationFromSeconds(profile.CadenceSeconds)
+	if cadence <= 0 {
+		cadence = time.Millisecond * 1500
+	}
+	avgHit := dps * cadence.Seconds()
+	spread := Clamp(profile.DamageSpread, 0, 1)
+
+	td.RegisterResetEffect(func(sim *Simulation) {
+		StartPeriodicAction(sim, PeriodicActionOptions{
+			Period: cadence,
+			OnAction: func(sim *Simulation) {
+				damage := avgHit
+				if spread > 0 {
+					damage *= sim.RollWithLabel(1-spread, 1+spread, "Raid Damage Profile")
+				}
+				td.RemoveHealth(sim, min(damage, td.CurrentHealth()))
+			},
+		})
+	})
+}
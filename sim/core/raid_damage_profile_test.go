@@ -0,0 +1,104 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/core/simsignals"
+)
+
+func setupDamageProfileSim() *Simulation {
+	sim := NewSim(&proto.RaidSimRequest{
+		SimOptions: &proto.SimOptions{
+			RandomSeed: 100,
+		},
+		Raid: &proto.Raid{
+			Parties: []*proto.Party{
+				{
+					Players: []*proto.Player{
+						{
+							Name:      "Healer",
+							Class:     proto.Class_ClassShaman,
+							Buffs:     &proto.IndividualBuffs{},
+							Spec:      &proto.Player_ElementalShaman{},
+							Equipment: &proto.EquipmentSpec{},
+						},
+					},
+					Buffs: &proto.PartyBuffs{},
+				},
+			},
+			TargetDummies: 2,
+			DamageProfile: &proto.RaidDamageProfile{
+				DummyHealth:    100000,
+				RaidDps:        1000,
+				TankDps:        2000,
+				CadenceSeconds: 1,
+			},
+		},
+		Encounter: &proto.Encounter{
+			Targets: []*proto.Target{
+				{Name: "target", Level: 93, MobType: proto.MobType_MobTypeDemon},
+			},
+			Duration: 180,
+		},
+	}, simsignals.CreateSignals())
+	sim.Reset()
+
+	return sim
+}
+
+func runDamageProfileSimUntil(sim *Simulation, until time.Duration) {
+	sim.AddPendingAction(&PendingAction{
+		NextActionAt: until,
+		OnAction:     func(_ *Simulation) {},
+	})
+	for sim.CurrentTime < until {
+		sim.Step()
+	}
+}
+
+func TestRaidDamageProfile(t *testing.T) {
+	sim := setupDamageProfileSim()
+	dummies := sim.Raid.GetTargetDummies()
+	if len(dummies) != 2 {
+		t.Fatalf("Expected 2 target dummies, found %d", len(dummies))
+	}
+
+	runDamageProfileSimUntil(sim, time.Millisecond*10500)
+
+	tank, raider := dummies[0], dummies[1]
+	if tank.MaxHealth() != 100000 || raider.MaxHealth() != 100000 {
+		t.Fatalf("Expected dummies with 100000 health, found %f and %f", tank.MaxHealth(), raider.MaxHealth())
+	}
+	if missing := raider.MaxHealth() - raider.CurrentHealth(); missing != 10000 {
+		t.Fatalf("Expected the raid dummy to be missing 10000 health, found %f", missing)
+	}
+	if missing := tank.MaxHealth() - tank.CurrentHealth(); missing != 30000 {
+		t.Fatalf("Expected the tank dummy to be missing 30000 health, found %f", missing)
+	}
+}
+
+func TestOverhealing(t *testing.T) {
+	sim := setupDamageProfileSim()
+	healer := &sim.Raid.Parties[0].Players[0].GetCharacter().Unit
+	dummy := &sim.Raid.GetTargetDummies()[1].Unit
+
+	runDamageProfileSimUntil(sim, time.Millisecond*5500)
+
+	spell := &Spell{
+		ActionID:     ActionID{SpellID: 17},
+		Unit:         healer,
+		SpellMetrics: make([]SpellMetrics, dummy.UnitIndex+1),
+	}
+	spell.DealHealing(sim, &SpellResult{Target: dummy, Damage: 3000})
+	spell.DealHealing(sim, &SpellResult{Target: dummy, Damage: 3000})
+
+	metrics := spell.SpellMetrics[dummy.UnitIndex]
+	if metrics.TotalHealing != 6000 || metrics.TotalOverhealing != 1000 {
+		t.Fatalf("Expected 6000 healing with 1000 overhealing, found %f and %f", metrics.TotalHealing, metrics.TotalOverhealing)
+	}
+	if dummy.CurrentHealth() != dummy.MaxHealth() {
+		t.Fatalf("Expected the dummy to be healed to full, found %f", dummy.CurrentHealth())
+	}
+}
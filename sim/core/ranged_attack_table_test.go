@@ -0,0 +1,173 @@
+package core
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/core/simsignals"
+	"github.com/wowsims/mop/sim/core/stats"
+)
+
+// Characterizes the MoP ranged attack table, which hunter stat weights depend
+// on: ranged attacks can miss and be dodged, from any position, but are never
+// parried or blocked. Expertise reduces their dodge chance like it does for
+// melee attacks.
+
+const rangedTableRolls = 200000
+
+type rangedTableOutcomes struct {
+	miss, dodge, parry, block, crit float64
+}
+
+func setupRangedAttackTableSim(inFront bool) (*Simulation, *Unit, *AttackTable) {
+	sim := NewSim(&proto.RaidSimRequest{
+		SimOptions: &proto.SimOptions{
+			RandomSeed: 101,
+		},
+		Raid: &proto.Raid{
+			Parties: []*proto.Party{
+				{
+					Players: []*proto.Player{
+						{
+							Name:            "Hunter",
+							Class:           proto.Class_ClassShaman,
+							Buffs:           &proto.IndividualBuffs{},
+							Spec:            &proto.Player_ElementalShaman{},
+							Equipment:       &proto.EquipmentSpec{},
+							InFrontOfTarget: inFront,
+						},
+					},
+					Buffs: &proto.PartyBuffs{},
+				},
+			},
+		},
+		Encounter: &proto.Encounter{
+			Targets: []*proto.Target{
+				{Name: "target", Level: 93, MobType: proto.MobType_MobTypeDemon},
+			},
+			Duration: 180,
+		},
+	}, simsignals.CreateSignals())
+	sim.Reset()
+
+	unit := &sim.Raid.Parties[0].Players[0].GetCharacter().Unit
+	return sim, unit, unit.AttackTables[sim.Encounter.ActiveTargetUnits[0].UnitIndex]
+}
+
+func newRangedSpecial(unit *Unit, numTargets int) *Spell {
+	return &Spell{
+		ActionID:       ActionID{SpellID: 3044},
+		Unit:           unit,
+		ProcMask:       ProcMaskRangedSpecial,
+		CritMultiplier: 2,
+		SpellMetrics:   make([]SpellMetrics, numTargets),
+	}
+}
+
+func rollRangedOutcomes(sim *Simulation, spell *Spell, attackTable *AttackTable, outcomeApplier OutcomeApplier) rangedTableOutcomes {
+	var counts rangedTableOutcomes
+	for i := 0; i < rangedTableRolls; i++ {
+		result := &SpellResult{Target: attackTable.Defender, Damage: 1}
+		outcomeApplier(sim, result, attackTable)
+
+		switch {
+		case result.Outcome.Matches(OutcomeMiss):
+			counts.miss++
+		case result.Outcome.Matches(OutcomeDodge):
+			counts.dodge++
+		case result.Outcome.Matches(OutcomeParry):
+			counts.parry++
+		case result.Outcome.Matches(OutcomeCrit):
+			counts.crit++
+		}
+		if result.Outcome.Matches(OutcomeBlock) {
+			counts.block++
+		}
+	}
+
+	counts.miss /= rangedTableRolls
+	counts.dodge /= rangedTableRolls
+	counts.parry /= rangedTableRolls
+	counts.block /= rangedTableRolls
+	counts.crit /= rangedTableRolls
+	return counts
+}
+
+func expectRangedChance(t *testing.T, name string, expected float64, actual float64) {
+	t.Helper()
+	if math.Abs(expected-actual) > 0.003 {
+		t.Errorf("Expected %s chance of %0.2f%%, found %0.2f%%", name, expected*100, actual*100)
+	}
+}
+
+func TestRangedAttackTableAgainstBoss(t *testing.T) {
+	for _, inFront := range []bool{true, false} {
+		sim, unit, attackTable := setupRangedAttackTableSim(inFront)
+		spell := newRangedSpecial(unit, len(unit.AttackTables))
+
+		for _, outcomeApplier := range []OutcomeApplier{spell.OutcomeRangedHitAndCrit, spell.OutcomeRangedHit} {
+			outcomes := rollRangedOutcomes(sim, spell, attackTable, outcomeApplier)
+
+			// Boss level targets dodge as often as they avoid being hit,
+			// whether or not the hunter stands in front of them.
+			expectRangedChance(t, "miss", 0.075, outcomes.miss)
+			expectRangedChance(t, "dodge", 0.075, outcomes.dodge)
+			expectRangedChance(t, "parry", 0, outcomes.parry)
+			expectRangedChance(t, "block", 0, outcomes.block)
+		}
+	}
+}
+
+func TestRangedAttackTableExpertise(t *testing.T) {
+	sim, unit, attackTable := setupRangedAttackTableSim(false)
+	spell := newRangedSpecial(unit, len(unit.AttackTables))
+	dodgeCap := attackTable.BaseDodgeChance * 400 * ExpertisePerQuarterPercentReduction
+
+	// Half of the dodge cap halves the chance to be dodged, even from behind.
+	unit.stats[stats.ExpertiseRating] = dodgeCap / 2
+	outcomes := rollRangedOutcomes(sim, spell, attackTable, spell.OutcomeRangedHitAndCrit)
+	expectRangedChance(t, "dodge", 0.0375, outcomes.dodge)
+	expectRangedChance(t, "miss", 0.075, outcomes.miss)
+
+	// Bonus expertise on the spell itself stacks with the unit's.
+	spell.BonusExpertiseRating = dodgeCap / 2
+	outcomes = rollRangedOutcomes(sim, spell, attackTable, spell.OutcomeRangedHitAndCrit)
+	expectRangedChance(t, "dodge", 0, outcomes.dodge)
+
+	// Expertise past the dodge cap does nothing for ranged attacks, since
+	// they can't be parried.
+	spell.BonusExpertiseRating = dodgeCap
+	outcomes = rollRangedOutcomes(sim, spell, attackTable, spell.OutcomeRangedHitAndCrit)
+	expectRangedChance(t, "dodge", 0, outcomes.dodge)
+	expectRangedChance(t, "parry", 0, outcomes.parry)
+}
+
+func TestRangedAttackTableHit(t *testing.T) {
+	sim, unit, attackTable := setupRangedAttackTableSim(false)
+	spell := newRangedSpecial(unit, len(unit.AttackTables))
+
+	// Ranged attacks use the physical hit cap, and hunters never take the
+	// dual wield penalty.
+	unit.stats[stats.PhysicalHitPercent] = 5
+	unit.AutoAttacks.IsDualWielding = true
+	outcomes := rollRangedOutcomes(sim, spell, attackTable, spell.OutcomeRangedHitAndCrit)
+	expectRangedChance(t, "miss", 0.025, outcomes.miss)
+	expectRangedChance(t, "dodge", 0.075, outcomes.dodge)
+}
+
+func TestRangedAttackTableCannotBeDodged(t *testing.T) {
+	sim, unit, attackTable := setupRangedAttackTableSim(true)
+	spell := newRangedSpecial(unit, len(unit.AttackTables))
+	spell.Flags |= SpellFlagCannotBeDodged
+
+	outcomes := rollRangedOutcomes(sim, spell, attackTable, spell.OutcomeRangedHitAndCrit)
+	expectRangedChance(t, "miss", 0.075, outcomes.miss)
+	expectRangedChance(t, "dodge", 0, outcomes.dodge)
+
+	// Ranged effects without a dodge roll, like Explosive Trap, can only miss.
+	spell.Flags &^= SpellFlagCannotBeDodged
+	outcomes = rollRangedOutcomes(sim, spell, attackTable, spell.OutcomeRangedHitAndCritNoBlock)
+	expectRangedChance(t, "miss", 0.075, outcomes.miss)
+	expectRangedChance(t, "dodge", 0, outcomes.dodge)
+}
@@ -0,0 +1,214 @@
+package core
+
+import (
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+// Version of the exported result format, see docs/result_export.md. Fields may
+// be added within a version, but renaming, removing or changing the meaning of
+// a field always increments it.
+const ResultExportVersion = 1
+
+// Stable JSON representation of a RaidSimResult, for external tools. Unlike the
+// protojson output of RaidSimResult it is not tied to the proto definitions, so
+// proto changes don't affect it. Described by schemas/result_export.schema.json.
+type ResultExport struct {
+	Version                     int             `json:"version"`
+	Iterations                  int32           `json:"iterations"`
+	AvgIterationDurationSeconds float64         `json:"avgIterationDurationSeconds"`
+	Raid                        ExportedGroup   `json:"raid"`
+	Parties                     []ExportedGroup `json:"parties"`
+	Players                     []ExportedUnit  `json:"players"`
+	Targets                     []ExportedUnit  `json:"targets"`
+}
+
+type ExportedGroup struct {
+	Dps ExportedDistribution `json:"dps"`
+	Hps ExportedDistribution `json:"hps"`
+}
+
+type ExportedDistribution struct {
+	Avg   float64 `json:"avg"`
+	Stdev float64 `json:"stdev"`
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"`
+}
+
+type ExportedUnit struct {
+	Name      string `json:"name"`
+	UnitIndex int32  `json:"unitIndex"`
+
+	Dps           ExportedDistribution `json:"dps"`
+	Hps           ExportedDistribution `json:"hps"`
+	Tps           ExportedDistribution `json:"tps"`
+	Dtps          ExportedDistribution `json:"dtps"`
+	Tmi           ExportedDistribution `json:"tmi"`
+	OomSecondsAvg float64              `json:"oomSecondsAvg"`
+
+	ChanceOfDeath float64 `json:"chanceOfDeath"`
+
+	Actions []ExportedAction `json:"actions"`
+	Auras   []ExportedAura   `json:"auras"`
+	Pets    []ExportedUnit   `json:"pets"`
+
+	ModelingStatus *ExportedModelingStatus `json:"modelingStatus,omitempty"`
+}
+
+type ExportedActionID struct {
+	SpellID int32  `json:"spellId,omitempty"`
+	ItemID  int32  `json:"itemId,omitempty"`
+	OtherID string `json:"otherId,omitempty"`
+	Tag     int32  `json:"tag,omitempty"`
+	Name    string `json:"name,omitempty"`
+}
+
+// Metrics of an action summed over all its targets, averaged per iteration.
+type ExportedAction struct {
+	ID        ExportedActionID `json:"id"`
+	Casts     float64          `json:"casts"`
+	Hits      float64          `json:"hits"`
+	Crits     float64          `json:"crits"`
+	Ticks     float64          `json:"ticks"`
+	CritTicks float64          `json:"critTicks"`
+	Misses    float64          `json:"misses"`
+	Damage    float64          `json:"damage"`
+	Healing   float64          `json:"healing"`
+	Shielding float64          `json:"shielding"`
+	Threat    float64          `json:"threat"`
+}
+
+type ExportedAura struct {
+	ID            ExportedActionID `json:"id"`
+	UptimeSeconds float64          `json:"uptimeSecondsAvg"`
+	Procs         float64          `json:"procsAvg"`
+}
+
+// Statuses are names of ModelingStatus values, e.g. ModelingStatusApproximated.
+type ExportedModelingStatus struct {
+	Status    string                   `json:"status"`
+	Mechanics []ExportedMechanicStatus `json:"mechanics"`
+}
+
+type ExportedMechanicStatus struct {
+	Mechanic string `json:"mechanic"`
+	Status   string `json:"status"`
+	Notes    string `json:"notes,omitempty"`
+}
+
+// Converts a sim result into the stable export format.
+func ExportRaidSimResult(result *proto.RaidSimResult) *ResultExport {
+	iterations := max(result.IterationsDone, 1)
+
+	export := &ResultExport{
+		Version:                     ResultExportVersion,
+		Iterations:                  result.IterationsDone,
+		AvgIterationDurationSeconds: result.AvgIterationDuration,
+		Raid:                        ExportedGroup{Dps: exportDistribution(result.RaidMetrics.GetDps()), Hps: exportDistribution(result.RaidMetrics.GetHps())},
+		Parties:                     []ExportedGroup{},
+		Players:                     []ExportedUnit{},
+		Targets:                     []ExportedUnit{},
+	}
+
+	for _, party := range result.RaidMetrics.GetParties() {
+		export.Parties = append(export.Parties, ExportedGroup{Dps: exportDistribution(party.Dps), Hps: exportDistribution(party.Hps)})
+		for _, player := range party.Players {
+			// Empty party slots are filled with blank metrics.
+			if player.Name == "" {
+				continue
+			}
+			export.Players = append(export.Players, exportUnit(player, iterations))
+		}
+	}
+
+	for _, target := range result.EncounterMetrics.GetTargets() {
+		export.Targets = append(export.Targets, exportUnit(target, iterations))
+	}
+
+	return export
+}
+
+func exportDistribution(metrics *proto.DistributionMetrics) ExportedDistribution {
+	return ExportedDistribution{
+		Avg:   metrics.GetAvg(),
+		Stdev: metrics.GetStdev(),
+		Min:   metrics.GetMin(),
+		Max:   metrics.GetMax(),
+	}
+}
+
+func exportActionID(id *proto.ActionID, name string) ExportedActionID {
+	exported := ExportedActionID{
+		SpellID: id.GetSpellId(),
+		ItemID:  id.GetItemId(),
+		Tag:     id.GetTag(),
+		Name:    name,
+	}
+	if _, ok := id.GetRawId().(*proto.ActionID_OtherId); ok {
+		exported.OtherID = id.GetOtherId().String()
+	}
+	return exported
+}
+
+func exportUnit(unit *proto.UnitMetrics, iterations int32) ExportedUnit {
+	perIteration := 1 / float64(iterations)
+
+	exported := ExportedUnit{
+		Name:          unit.Name,
+		UnitIndex:     unit.UnitIndex,
+		Dps:           exportDistribution(unit.Dps),
+		Hps:           exportDistribution(unit.Hps),
+		Tps:           exportDistribution(unit.Threat),
+		Dtps:          exportDistribution(unit.Dtps),
+		Tmi:           exportDistribution(unit.Tmi),
+		OomSecondsAvg: unit.SecondsOomAvg,
+		ChanceOfDeath: unit.ChanceOfDeath,
+		Actions:       []ExportedAction{},
+		Auras:         []ExportedAura{},
+		Pets:          []ExportedUnit{},
+	}
+
+	for _, action := range unit.Actions {
+		exportedAction := ExportedAction{ID: exportActionID(action.Id, action.Name)}
+		for _, target := range action.Targets {
+			exportedAction.Casts += float64(target.Casts) * perIteration
+			exportedAction.Hits += float64(target.Hits) * perIteration
+			exportedAction.Crits += float64(target.Crits) * perIteration
+			exportedAction.Ticks += float64(target.Ticks) * perIteration
+			exportedAction.CritTicks += float64(target.CritTicks) * perIteration
+			exportedAction.Misses += float64(target.Misses) * perIteration
+			exportedAction.Damage += target.Damage * perIteration
+			exportedAction.Healing += target.Healing * perIteration
+			exportedAction.Shielding += target.Shielding * perIteration
+			exportedAction.Threat += target.Threat * perIteration
+		}
+		exported.Actions = append(exported.Actions, exportedAction)
+	}
+
+	for _, aura := range unit.Auras {
+		exported.Auras = append(exported.Auras, ExportedAura{
+			ID:            exportActionID(aura.Id, aura.Name),
+			UptimeSeconds: aura.UptimeSecondsAvg,
+			Procs:         aura.ProcsAvg,
+		})
+	}
+
+	for _, pet := range unit.Pets {
+		exported.Pets = append(exported.Pets, exportUnit(pet, iterations))
+	}
+
+	if unit.ModelingStatus != nil {
+		exported.ModelingStatus = &ExportedModelingStatus{
+			Status:    unit.ModelingStatus.Status.String(),
+			Mechanics: []ExportedMechanicStatus{},
+		}
+		for _, mechanic := range unit.ModelingStatus.Mechanics {
+			exported.ModelingStatus.Mechanics = append(exported.ModelingStatus.Mechanics, ExportedMechanicStatus{
+				Mechanic: mechanic.Mechanic,
+				Status:   mechanic.Status.String(),
+				Notes:    mechanic.Notes,
+			})
+		}
+	}
+
+	return exported
+}
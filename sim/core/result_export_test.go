@@ -0,0 +1,113 @@
+package core
+
+import (
+	"encoding/json"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestExportRaidSimResult(t *testing.T) {
+	result := &proto.RaidSimResult{
+		IterationsDone: 2,
+		RaidMetrics: &proto.RaidMetrics{
+			Dps: &proto.DistributionMetrics{Avg: 100},
+			Parties: []*proto.PartyMetrics{{
+				Players: []*proto.UnitMetrics{
+					{},
+					{
+						Name: "Player",
+						Dps:  &proto.DistributionMetrics{Avg: 100},
+						Actions: []*proto.ActionMetrics{{
+							Id: ActionID{OtherID: proto.OtherAction_OtherActionAttack, Tag: 1}.ToProto(),
+							Targets: []*proto.TargetedActionMetrics{
+								{Casts: 4, Damage: 200},
+								{Casts: 2, Damage: 100},
+							},
+						}},
+						Pets: []*proto.UnitMetrics{{Name: "Pet"}},
+					},
+				},
+			}},
+		},
+		EncounterMetrics: &proto.EncounterMetrics{
+			Targets: []*proto.UnitMetrics{{Name: "Target"}},
+		},
+	}
+
+	export := ExportRaidSimResult(result)
+	if export.Version != ResultExportVersion || export.Raid.Dps.Avg != 100 {
+		t.Fatalf("Unexpected export header: %v", export)
+	}
+	if len(export.Players) != 1 || export.Players[0].Name != "Player" || len(export.Players[0].Pets) != 1 || len(export.Targets) != 1 {
+		t.Fatalf("Expected the player with its pet and the target, got %v", export)
+	}
+
+	action := export.Players[0].Actions[0]
+	if action.ID.OtherID != "OtherActionAttack" || action.ID.Tag != 1 || action.Casts != 3 || action.Damage != 150 {
+		t.Fatalf("Expected action metrics summed over targets and averaged per iteration, got %v", action)
+	}
+}
+
+// The schema is the contract for external consumers, so it has to list exactly
+// the exported fields.
+func TestResultExportMatchesSchema(t *testing.T) {
+	data, err := os.ReadFile("../../schemas/result_export.schema.json")
+	if err != nil {
+		t.Fatalf("Failed to read schema: %s", err)
+	}
+
+	type schemaObject struct {
+		Properties map[string]json.RawMessage `json:"properties"`
+		Required   []string                   `json:"required"`
+	}
+	var schema struct {
+		schemaObject
+		Definitions map[string]schemaObject `json:"definitions"`
+	}
+	if err := json.Unmarshal(data, &schema); err != nil {
+		t.Fatalf("Failed to parse schema: %s", err)
+	}
+
+	checkFields := func(name string, object schemaObject, exportType reflect.Type) {
+		var fields, required []string
+		for i := 0; i < exportType.NumField(); i++ {
+			tag, omitEmpty := strings.CutSuffix(exportType.Field(i).Tag.Get("json"), ",omitempty")
+			fields = append(fields, tag)
+			if !omitEmpty {
+				required = append(required, tag)
+			}
+		}
+
+		var schemaFields []string
+		for field := range object.Properties {
+			schemaFields = append(schemaFields, field)
+		}
+
+		slices.Sort(fields)
+		slices.Sort(schemaFields)
+		slices.Sort(required)
+		slices.Sort(object.Required)
+		if !slices.Equal(fields, schemaFields) || !slices.Equal(required, object.Required) {
+			t.Errorf("Schema of %s does not match %s: fields %v, schema fields %v, required %v, schema required %v", name, exportType.Name(), fields, schemaFields, required, object.Required)
+		}
+	}
+
+	checkFields("result", schema.schemaObject, reflect.TypeOf(ResultExport{}))
+	for name, exportType := range map[string]reflect.Type{
+		"distribution":   reflect.TypeOf(ExportedDistribution{}),
+		"group":          reflect.TypeOf(ExportedGroup{}),
+		"unit":           reflect.TypeOf(ExportedUnit{}),
+		"actionId":       reflect.TypeOf(ExportedActionID{}),
+		"action":         reflect.TypeOf(ExportedAction{}),
+		"aura":           reflect.TypeOf(ExportedAura{}),
+		"modelingStatus": reflect.TypeOf(ExportedModelingStatus{}),
+		"mechanicStatus": reflect.TypeOf(ExportedMechanicStatus{}),
+	} {
+		checkFields(name, schema.Definitions[name], exportType)
+	}
+}
@@ -0,0 +1,91 @@
+package core
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/core/simsignals"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+// Sims the same character with each of the requested rotations. RNG is fixed
+// across sims, so per-iteration deltas against the first rotation only reflect
+// the rotation changes.
+func runRotationComparison(request *proto.RotationComparisonRequest, signals simsignals.Signals) *proto.RotationComparisonResult {
+	if len(request.Rotations) == 0 {
+		return &proto.RotationComparisonResult{Error: &proto.ErrorOutcome{Message: "No rotations to compare"}}
+	}
+
+	simOptions := googleProto.Clone(request.SimOptions).(*proto.SimOptions)
+	simOptions.SaveAllValues = true
+	simOptions.UseLabeledRands = true
+	if simOptions.RandomSeed == 0 {
+		simOptions.RandomSeed = time.Now().UnixNano()
+	}
+
+	newRequest := func(rotation *proto.APLRotation) *proto.RaidSimRequest {
+		player := googleProto.Clone(request.Player).(*proto.Player)
+		player.Rotation = rotation
+
+		raidProto := SinglePlayerRaidProto(player, request.PartyBuffs, request.RaidBuffs, request.Debuffs)
+		raidProto.Tanks = request.Tanks
+
+		return &proto.RaidSimRequest{
+			Raid:       raidProto,
+			Encounter:  request.Encounter,
+			SimOptions: simOptions,
+		}
+	}
+
+	simFunc := runSimConcurrent
+	// Don't use go threads in wasm, it just adds more overhead and makes the worker more unresponsive.
+	if IsRunningInWasm() {
+		simFunc = RunSim
+	}
+
+	result := &proto.RotationComparisonResult{}
+	var basePlayer *proto.UnitMetrics
+	for i, namedRotation := range request.Rotations {
+		simResult := simFunc(newRequest(namedRotation.Rotation), nil, signals)
+		if simResult.Error != nil {
+			return &proto.RotationComparisonResult{Error: simResult.Error}
+		}
+		player := simResult.RaidMetrics.Parties[0].Players[0]
+		if basePlayer == nil {
+			basePlayer = player
+		}
+
+		var dpsDelta aggregator
+		for j := range basePlayer.Dps.AllValues {
+			dpsDelta.add(player.Dps.AllValues[j] - basePlayer.Dps.AllValues[j])
+		}
+		dpsDeltaMean, dpsDeltaStdev := dpsDelta.meanAndStdDev()
+
+		name := namedRotation.Name
+		if name == "" {
+			name = fmt.Sprintf("Rotation %d", i+1)
+		}
+
+		result.Comparisons = append(result.Comparisons, &proto.RotationComparison{
+			Name:          name,
+			Index:         int32(i),
+			Dps:           player.Dps.Avg,
+			DpsStdev:      player.Dps.Stdev,
+			DpsDelta:      dpsDeltaMean,
+			DpsDeltaStdev: dpsDeltaStdev,
+			Hps:           player.Hps.Avg,
+			HpsDelta:      player.Hps.Avg - basePlayer.Hps.Avg,
+			Tps:           player.Threat.Avg,
+			TpsDelta:      player.Threat.Avg - basePlayer.Threat.Avg,
+		})
+	}
+
+	slices.SortStableFunc(result.Comparisons, func(a, b *proto.RotationComparison) int {
+		return cmp.Compare(b.Dps, a.Dps)
+	})
+
+	return result
+}
@@ -0,0 +1,38 @@
+package core
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestCompareRotations(t *testing.T) {
+	result := CompareRotations(&proto.RotationComparisonRequest{
+		Player:     newRotationTestPlayer(nil),
+		RaidBuffs:  &proto.RaidBuffs{},
+		PartyBuffs: &proto.PartyBuffs{},
+		Debuffs:    &proto.Debuffs{},
+		Encounter:  MakeSingleTargetEncounter(0),
+		SimOptions: &proto.SimOptions{Iterations: 20, IsTest: true, RandomSeed: 101},
+		Rotations: []*proto.NamedRotation{
+			{Name: "Filler", Rotation: newFillerRotation()},
+			{Name: "Generated", Rotation: &proto.APLRotation{Type: proto.APLRotation_TypeAuto}},
+		},
+	})
+	if result.Error != nil {
+		t.Fatalf("Comparison failed: %s", result.Error.Message)
+	}
+	if len(result.Comparisons) != 2 {
+		t.Fatalf("Expected 2 comparisons, got %d", len(result.Comparisons))
+	}
+
+	// Deltas are against the first rotation, even when it doesn't rank first.
+	best, worst := result.Comparisons[0], result.Comparisons[1]
+	if worst.Name != "Filler" || worst.Index != 0 || worst.DpsDelta != 0 {
+		t.Fatalf("Expected the filler rotation to rank last with no delta, got %v", worst)
+	}
+	if best.Name != "Generated" || best.DpsDelta <= 0 || math.Abs(best.DpsDelta-(best.Dps-worst.Dps)) > 1 {
+		t.Fatalf("Unexpected delta for the generated rotation: %v", best)
+	}
+}
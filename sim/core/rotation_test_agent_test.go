@@ -0,0 +1,174 @@
+package core
+
+import (
+	"time"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/core/stats"
+)
+
+func init() {
+	RegisterAgentFactory(
+		proto.Player_AfflictionWarlock{},
+		proto.Spec_SpecAfflictionWarlock,
+		NewFakeRotationAgent,
+		func(player *proto.Player, spec interface{}) {
+			playerSpec, ok := spec.(*proto.Player_AfflictionWarlock)
+			if !ok {
+				panic("Invalid spec value for Affliction Warlock!")
+			}
+			player.Spec = playerSpec
+		},
+	)
+}
+
+var fakeRotationDotID = ActionID{SpellID: 43}
+var fakeRotationFillerID = ActionID{SpellID: 44}
+
+// A caster with a DoT, a filler and a pet, for tests that run full sims with
+// an APL.
+type FakeRotationAgent struct {
+	Character
+	Pet *fakeRotationPet
+}
+
+func (fa *FakeRotationAgent) GetCharacter() *Character {
+	return &fa.Character
+}
+
+func (fa *FakeRotationAgent) Initialize() {
+	fa.RegisterSpell(SpellConfig{
+		ActionID:    fakeRotationDotID,
+		SpellSchool: SpellSchoolShadow,
+		ProcMask:    ProcMaskSpellDamage,
+		Flags:       SpellFlagAPL,
+
+		Cast: CastConfig{
+			DefaultCast: Cast{
+				GCD: GCDDefault,
+			},
+		},
+
+		DamageMultiplier: 1,
+		ThreatMultiplier: 1,
+
+		Dot: DotConfig{
+			Aura: Aura{
+				Label: "fakerotationdot",
+			},
+			NumberOfTicks:    6,
+			TickLength:       time.Second * 2,
+			BonusCoefficient: 1,
+
+			OnSnapshot: func(sim *Simulation, target *Unit, dot *Dot, isRollover bool) {
+				dot.Snapshot(target, 1000)
+			},
+			OnTick: func(sim *Simulation, target *Unit, dot *Dot) {
+				dot.CalcAndDealPeriodicSnapshotDamage(sim, target, dot.OutcomeTick)
+			},
+		},
+
+		ApplyEffects: func(sim *Simulation, target *Unit, spell *Spell) {
+			result := spell.CalcOutcome(sim, target, spell.OutcomeMagicHit)
+			if result.Landed() {
+				spell.Dot(target).Apply(sim)
+			}
+			spell.DealOutcome(sim, result)
+		},
+	})
+
+	fa.RegisterSpell(SpellConfig{
+		ActionID:    fakeRotationFillerID,
+		SpellSchool: SpellSchoolShadow,
+		ProcMask:    ProcMaskSpellDamage,
+		Flags:       SpellFlagAPL,
+
+		Cast: CastConfig{
+			DefaultCast: Cast{
+				GCD:      GCDDefault,
+				CastTime: time.Millisecond * 2000,
+			},
+		},
+
+		DamageMultiplier: 1,
+		CritMultiplier:   fa.DefaultCritMultiplier(),
+		ThreatMultiplier: 1,
+		BonusCoefficient: 0.5,
+
+		ApplyEffects: func(sim *Simulation, target *Unit, spell *Spell) {
+			spell.CalcAndDealDamage(sim, target, 500, spell.OutcomeMagicHitAndCrit)
+		},
+	})
+}
+
+func (fa *FakeRotationAgent) ApplyTalents()                  {}
+func (fa *FakeRotationAgent) Reset(_ *Simulation)            {}
+func (fa *FakeRotationAgent) OnEncounterStart(_ *Simulation) {}
+
+func NewFakeRotationAgent(char *Character, _ *proto.Player) Agent {
+	fa := &FakeRotationAgent{
+		Character: *char,
+	}
+	fa.EnableManaBar()
+	fa.AddStat(stats.Intellect, 5000)
+
+	fa.Pet = &fakeRotationPet{
+		Pet: NewPet(PetConfig{
+			Name:           "Fake Pet",
+			Owner:          &fa.Character,
+			EnabledOnStart: true,
+			NonHitExpStatInheritance: func(_ stats.Stats) stats.Stats {
+				return stats.Stats{}
+			},
+		}),
+	}
+	fa.Pet.EnableAutoAttacks(fa.Pet, AutoAttackOptions{
+		MainHand: Weapon{
+			BaseDamageMin:        1000,
+			BaseDamageMax:        1000,
+			SwingSpeed:           2,
+			NormalizedSwingSpeed: 2,
+			CritMultiplier:       2,
+			SpellSchool:          SpellSchoolPhysical,
+		},
+		AutoSwingMelee: true,
+	})
+	fa.AddPet(fa.Pet)
+
+	return fa
+}
+
+type fakeRotationPet struct {
+	Pet
+}
+
+func (pet *fakeRotationPet) GetPet() *Pet {
+	return &pet.Pet
+}
+
+func (pet *fakeRotationPet) Initialize()                         {}
+func (pet *fakeRotationPet) Reset(_ *Simulation)                 {}
+func (pet *fakeRotationPet) OnEncounterStart(_ *Simulation)      {}
+func (pet *fakeRotationPet) ExecuteCustomRotation(_ *Simulation) {}
+
+func newRotationTestPlayer(rotation *proto.APLRotation) *proto.Player {
+	return &proto.Player{
+		Name:               "Rotation",
+		Race:               proto.Race_RaceOrc,
+		Class:              proto.Class_ClassWarlock,
+		Equipment:          &proto.EquipmentSpec{},
+		Spec:               &proto.Player_AfflictionWarlock{},
+		Rotation:           rotation,
+		Buffs:              &proto.IndividualBuffs{},
+		DistanceFromTarget: 25,
+	}
+}
+
+// Casts the filler on cooldown.
+func newFillerRotation() *proto.APLRotation {
+	return &proto.APLRotation{PriorityList: []*proto.APLListItem{
+		{Action: &proto.APLAction{Action: &proto.APLAction_CastSpell{
+			CastSpell: &proto.APLActionCastSpell{SpellId: fakeRotationFillerID.ToProto()},
+		}}},
+	}}
+}
@@ -240,6 +240,50 @@ func (proc *RPPMProc) Chance(sim *Simulation) float64 {
 	return proc.getProcChance(sim)
 }
 
+// Returns the expected time until the next proc, assuming the proc is checked
+// continuously, which is close enough for procs checked on every hit. No proc
+// can happen within the given delay, e.g. an ICD, but bad luck protection
+// keeps building up.
+func (proc *RPPMProc) ExpectedTimeToNextProc(sim *Simulation, delay time.Duration) time.Duration {
+	realPPM := proc.ppm * proc.coefficient
+	for _, mod := range proc.mods {
+		realPPM *= mod.GetCoefficient(proc)
+	}
+	if realPPM <= 0 {
+		return NeverExpires
+	}
+
+	procRate := realPPM / 60
+	timeSinceProc := min(RppmLastProcCap, sim.CurrentTime+delay-proc.lastProc).Seconds()
+
+	// The proc rate is constant until bad luck protection starts at 1.5 times
+	// the average interval between procs.
+	badLuckStart := 1.5 / procRate
+	expectedTime := 0.0
+	noProcChance := 1.0
+	if timeSinceProc < badLuckStart {
+		noProcChance = math.Exp(-procRate * (badLuckStart - timeSinceProc))
+		expectedTime = (1 - noProcChance) / procRate
+		timeSinceProc = badLuckStart
+	}
+
+	// Afterwards it increases linearly, rate(t) = startRate + 3*procRate^2*t.
+	startRate := procRate * (3*procRate*timeSinceProc - 3.5)
+	sqrtSlope := math.Sqrt(1.5) * procRate
+	expectedTime += noProcChance * scaledErfc(startRate/(2*sqrtSlope)) / (2 * sqrtSlope)
+
+	return delay + DurationFromSeconds(expectedTime)
+}
+
+// Returns sqrt(pi) * exp(x^2) * erfc(x), without overflowing for large x.
+func scaledErfc(x float64) float64 {
+	if x > 10 {
+		x2 := x * x
+		return (1 - 1/(2*x2) + 3/(4*x2*x2)) / x
+	}
+	return math.SqrtPi * math.Exp(x*x) * math.Erfc(x)
+}
+
 func (proc *RPPMProc) Reset() {
 	proc.lastCheck = -RppmLastCheckCap
 	proc.lastProc = -RppmLastProcResetValue
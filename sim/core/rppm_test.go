@@ -3,6 +3,7 @@ package core
 import (
 	"math"
 	"testing"
+	"time"
 
 	"github.com/wowsims/mop/sim/core/proto"
 	"github.com/wowsims/mop/sim/core/stats"
@@ -318,3 +319,57 @@ func GetFakeCharacter(slots []proto.ItemSlot, withRangedWeapon bool) *Character
 
 	return character
 }
+
+func TestExpectedTimeToNextProc(t *testing.T) {
+	sim := SetupFakeSim()
+	char := GetFakeCharacter([]proto.ItemSlot{proto.ItemSlot_ItemSlotTrinket1}, false)
+
+	const ppm = 1.2
+	proc := NewRPPMProc(char, RPPMConfig{PPM: ppm}).(*RPPMProc)
+
+	// Integrates the chance to not have procced yet, with the proc rate
+	// including bad luck protection.
+	numericExpectedTime := func(timeSinceProc float64) float64 {
+		const step = 0.001
+		procRate := ppm / 60
+		noProcChance := 1.0
+		expectedTime := 0.0
+		for noProcChance > 1e-9 {
+			expectedTime += noProcChance * step
+			noProcChance *= math.Exp(-procRate * max(1, 3*procRate*timeSinceProc-3.5) * step)
+			timeSinceProc += step
+		}
+		return expectedTime
+	}
+
+	for _, timeSinceProc := range []time.Duration{0, time.Second * 30, time.Second * 75, time.Second * 120} {
+		proc.lastProc = sim.CurrentTime - timeSinceProc
+		for _, delay := range []time.Duration{0, time.Second * 10} {
+			expectedTime := proc.ExpectedTimeToNextProc(sim, delay).Seconds()
+			numericTime := delay.Seconds() + numericExpectedTime((timeSinceProc + delay).Seconds())
+			if math.Abs(expectedTime-numericTime) > 0.01 {
+				t.Fatalf("Expected time to next proc wrong after %s with delay %s. Expected %f, got %f", timeSinceProc, delay, numericTime, expectedTime)
+			}
+		}
+	}
+}
+
+func TestExpectedTimeToNextProcScalesWithHaste(t *testing.T) {
+	sim := SetupFakeSim()
+	char := GetFakeCharacter([]proto.ItemSlot{proto.ItemSlot_ItemSlotTrinket1}, false)
+	char.PseudoStats.AttackSpeedMultiplier = 1
+	char.PseudoStats.RangedHasteMultiplier = 1
+
+	proc := NewRPPMProc(char, RPPMConfig{PPM: 1.2}.WithHasteMod()).(*RPPMProc)
+	proc.lastProc = sim.CurrentTime - time.Second*20
+	baseTime := proc.ExpectedTimeToNextProc(sim, 0).Seconds()
+
+	// Bad luck protection is relative to the proc interval, so the whole
+	// expected time shrinks with real haste, e.g. from Bloodlust.
+	char.PseudoStats.RangedHasteMultiplier = 1.3
+	proc.lastProc = sim.CurrentTime - DurationFromSeconds(20/1.3)
+	hastedTime := proc.ExpectedTimeToNextProc(sim, 0).Seconds()
+	if math.Abs(hastedTime-baseTime/1.3) > 0.01 {
+		t.Fatalf("Expected time to next proc should scale with haste. Expected %f, got %f", baseTime/1.3, hastedTime)
+	}
+}
@@ -3,6 +3,8 @@
 package core
 
 import (
+	"time"
+
 	"github.com/wowsims/mop/sim/core/proto"
 )
 
@@ -19,6 +21,13 @@ type SecondaryResourceBar interface {
 	Value() float64                                                    // Returns the current amount of resource
 	RegisterOnGain(callback OnGainCallback)                            // Registers a callback that will be called. Gain = amount gained, realGain = actual amount gained due to caps
 	RegisterOnSpend(callback OnSpendCallback)                          // Registers a callback that will be called when the resource was spend
+	TrackAura(aura *Aura)                                              // Reports the resource held when gaining the aura, and its uptime
+}
+
+// Implemented by bars built on DefaultSecondaryResourceBarImpl, to report
+// their economy in UnitMetrics.
+type secondaryResourceEconomy interface {
+	economyToProto(numIterations float64) *proto.SecondaryResourceMetrics
 }
 
 type SecondaryResourceConfig struct {
@@ -36,6 +45,21 @@ type DefaultSecondaryResourceBarImpl struct {
 	metrics map[ActionID]*ResourceMetrics
 	onGain  []OnGainCallback
 	onSpend []OnSpendCallback
+
+	// Totals over all iterations, excluding the resources granted on reset or
+	// at encounter start.
+	totalGenerated float64
+	totalWasted    float64
+	totalSpent     float64
+
+	trackedAuras []*secondaryResourceAuraTracker
+}
+
+type secondaryResourceAuraTracker struct {
+	aura                 *Aura
+	entries              int
+	uptime               time.Duration
+	totalResourceOnEntry float64
 }
 
 // CanSpend implements SecondaryResourceBar.
@@ -54,6 +78,10 @@ func (bar *DefaultSecondaryResourceBarImpl) Gain(sim *Simulation, amount float64
 	amountGained := bar.value - oldValue
 	metrics := bar.GetMetric(action)
 	metrics.AddEvent(float64(amount), float64(amountGained))
+	if !bar.isResetAction(action) {
+		bar.totalGenerated += amount
+		bar.totalWasted += amount - amountGained
+	}
 	if sim.Log != nil {
 		bar.unit.Log(
 			sim,
@@ -74,10 +102,18 @@ func (bar *DefaultSecondaryResourceBarImpl) Gain(sim *Simulation, amount float64
 func (bar *DefaultSecondaryResourceBarImpl) Reset(sim *Simulation) {
 	bar.value = 0
 	if bar.config.Default > 0 {
-		bar.Gain(sim, bar.config.Default, ActionID{SpellID: int32(bar.config.Type)})
+		bar.Gain(sim, bar.config.Default, bar.resetActionID())
 	}
 }
 
+func (bar *DefaultSecondaryResourceBarImpl) resetActionID() ActionID {
+	return ActionID{SpellID: int32(bar.config.Type)}
+}
+
+func (bar *DefaultSecondaryResourceBarImpl) isResetAction(action ActionID) bool {
+	return (action == bar.resetActionID()) || (action == encounterStartActionID)
+}
+
 var encounterStartActionID = ActionID{OtherID: proto.OtherAction_OtherActionEncounterStart}
 
 func (bar *DefaultSecondaryResourceBarImpl) ResetBarTo(sim *Simulation, resourcesToKeep float64) {
@@ -113,6 +149,9 @@ func (bar *DefaultSecondaryResourceBarImpl) Spend(sim *Simulation, amount float6
 	}
 
 	metrics.AddEvent(float64(-amount), float64(-amount))
+	if !bar.isResetAction(action) {
+		bar.totalSpent += amount
+	}
 	bar.invokeOnSpend(sim, amount, action)
 	bar.value -= amount
 }
@@ -148,6 +187,43 @@ func (bar *DefaultSecondaryResourceBarImpl) GetMetric(action ActionID) *Resource
 	return metric
 }
 
+// TrackAura implements SecondaryResourceBar.
+func (bar *DefaultSecondaryResourceBarImpl) TrackAura(aura *Aura) {
+	tracker := &secondaryResourceAuraTracker{aura: aura}
+	bar.trackedAuras = append(bar.trackedAuras, tracker)
+
+	aura.ApplyOnGain(func(aura *Aura, sim *Simulation) {
+		tracker.entries++
+		tracker.totalResourceOnEntry += bar.value
+	})
+	aura.ApplyOnExpire(func(aura *Aura, sim *Simulation) {
+		tracker.uptime += min(sim.CurrentTime, aura.ExpiresAt()) - max(aura.StartedAt(), 0)
+	})
+}
+
+func (bar *DefaultSecondaryResourceBarImpl) economyToProto(numIterations float64) *proto.SecondaryResourceMetrics {
+	metrics := &proto.SecondaryResourceMetrics{
+		Type:         bar.config.Type,
+		GeneratedAvg: bar.totalGenerated / numIterations,
+		WastedAvg:    bar.totalWasted / numIterations,
+		SpentAvg:     bar.totalSpent / numIterations,
+	}
+
+	for _, tracker := range bar.trackedAuras {
+		auraMetrics := &proto.SecondaryResourceAuraMetrics{
+			Id:               tracker.aura.ActionID.ToProto(),
+			EntriesAvg:       float64(tracker.entries) / numIterations,
+			UptimeSecondsAvg: tracker.uptime.Seconds() / numIterations,
+		}
+		if tracker.entries > 0 {
+			auraMetrics.ResourceOnEntryAvg = tracker.totalResourceOnEntry / float64(tracker.entries)
+		}
+		metrics.Auras = append(metrics.Auras, auraMetrics)
+	}
+
+	return metrics
+}
+
 func (bar *DefaultSecondaryResourceBarImpl) RegisterOnGain(callback OnGainCallback) {
 	if callback == nil {
 		panic("Can not register nil callback")
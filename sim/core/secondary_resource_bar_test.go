@@ -0,0 +1,37 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestSecondaryResourceEconomy(t *testing.T) {
+	sim := &Simulation{}
+	unit := &Unit{
+		Type:    PlayerUnit,
+		Level:   CharacterLevel,
+		Metrics: NewUnitMetrics(),
+	}
+
+	bar := unit.NewDefaultSecondaryResourceBar(SecondaryResourceConfig{
+		Type:    proto.SecondaryResourceType_SecondaryResourceTypeSoulShards,
+		Max:     4,
+		Default: 3,
+	})
+
+	// Resources granted on reset and at encounter start are not part of the economy.
+	bar.Reset(sim)
+	bar.ResetBarTo(sim, 1)
+
+	bar.Gain(sim, 2, ActionID{SpellID: 1})
+	bar.Spend(sim, 1, ActionID{SpellID: 2})
+	bar.Gain(sim, 3, ActionID{SpellID: 1})
+	bar.Spend(sim, 4, ActionID{SpellID: 2})
+
+	economy := bar.economyToProto(1)
+	if economy.GeneratedAvg != 5 || economy.WastedAvg != 1 || economy.SpentAvg != 5 {
+		t.Fatalf("Unexpected economy: generated %f, wasted %f, spent %f",
+			economy.GeneratedAvg, economy.WastedAvg, economy.SpentAvg)
+	}
+}
@@ -7,6 +7,16 @@ type ShieldConfig struct {
 
 	Spell *Spell
 
+	// Set to true for the shield to absorb damage taken by its target, up to
+	// its remaining Strength. Shields without it handle absorption themselves.
+	AbsorbDamage bool
+
+	// Optional filter for which hits are absorbed.
+	ShouldAbsorb ShieldShouldApplyCondition
+
+	// Called when an absorbing shield has been fully consumed.
+	OnDepleted func(sim *Simulation, shield *Shield)
+
 	Aura
 }
 
@@ -16,23 +26,56 @@ type Shield struct {
 
 	// Embed Aura so we can use IsActive/Refresh/etc directly.
 	*Aura
+
+	// Remaining absorb of shields created with AbsorbDamage.
+	Strength float64
+	absorbs  bool
 }
 
+// Replaces any existing shield from this spell on the target, wasting
+// whatever it had left.
 func (shield *Shield) Apply(sim *Simulation, shieldAmount float64) {
-	caster := shield.Spell.Unit
-	target := shield.Aura.Unit
-	//attackTable := caster.AttackTables[target.UnitIndex]
-
 	// Shields are not affected by healing pseudostats the same way heals are.
 	// So we only apply the spell-specific multiplier.
 	shieldAmount *= shield.Spell.DamageMultiplier
 
 	shield.Aura.Deactivate(sim)
 	shield.Aura.Activate(sim)
+	shield.Strength = shieldAmount
 	if shield.Aura.MaxStacks > 0 {
 		shield.Aura.SetStacks(sim, int32(shieldAmount))
 	}
 
+	shield.recordShielding(sim, shieldAmount)
+}
+
+// Adds to the shield's remaining Strength instead of replacing it, up to
+// maxStrength, and refreshes its duration. Used by absorbing shields which
+// accumulate, e.g. Spirit Shell.
+func (shield *Shield) Stack(sim *Simulation, shieldAmount float64, maxStrength float64) {
+	shieldAmount *= shield.Spell.DamageMultiplier
+
+	remaining := TernaryFloat64(shield.Aura.IsActive(), shield.Strength, 0)
+	added := max(0, min(remaining+shieldAmount, maxStrength)-remaining)
+	shield.Spell.SpellMetrics[shield.Aura.Unit.UnitIndex].TotalShieldingWasted += shieldAmount - added
+	if added <= 0 {
+		return
+	}
+
+	shield.Aura.Activate(sim)
+	shield.Strength = remaining + added
+	if shield.Aura.MaxStacks > 0 {
+		shield.Aura.SetStacks(sim, int32(shield.Strength))
+	}
+
+	shield.recordShielding(sim, added)
+}
+
+func (shield *Shield) recordShielding(sim *Simulation, shieldAmount float64) {
+	caster := shield.Spell.Unit
+	target := shield.Aura.Unit
+	//attackTable := caster.AttackTables[target.UnitIndex]
+
 	threat := 0.0 // TODO
 	shield.Spell.SpellMetrics[target.UnitIndex].TotalThreat += threat
 	shield.Spell.SpellMetrics[target.UnitIndex].TotalShielding += shieldAmount
@@ -43,6 +86,29 @@ func (shield *Shield) Apply(sim *Simulation, shieldAmount float64) {
 	}
 }
 
+func (shield *Shield) absorb(sim *Simulation, result *SpellResult, onDepleted func(*Simulation, *Shield)) {
+	absorbed := min(shield.Strength, result.Damage)
+	result.Damage -= absorbed
+	shield.Strength -= absorbed
+	shield.Spell.SpellMetrics[shield.Aura.Unit.UnitIndex].TotalAbsorbed += absorbed
+
+	if sim.Log != nil {
+		shield.Aura.Unit.Log(sim, "%s absorbed %.1f damage, new shield strength: %.1f", shield.Aura.Label, absorbed, shield.Strength)
+	}
+
+	if shield.Strength > 0 {
+		if shield.Aura.MaxStacks > 0 {
+			shield.Aura.SetStacks(sim, int32(shield.Strength))
+		}
+		return
+	}
+
+	shield.Aura.Deactivate(sim)
+	if onDepleted != nil {
+		onDepleted(sim, shield)
+	}
+}
+
 func newShield(config Shield) *Shield {
 	shield := &Shield{}
 	*shield = config
@@ -65,7 +131,8 @@ func (spell *Spell) createShields(config ShieldConfig) {
 		config.Spell = spell
 	}
 	shield := Shield{
-		Spell: config.Spell,
+		Spell:   config.Spell,
+		absorbs: config.AbsorbDamage,
 	}
 
 	auraConfig := config.Aura
@@ -77,6 +144,7 @@ func (spell *Spell) createShields(config ShieldConfig) {
 	if config.SelfOnly {
 		shield.Aura = caster.GetOrRegisterAura(auraConfig)
 		spell.selfShield = newShield(shield)
+		spell.selfShield.registerAbsorption(config)
 	} else {
 		auraConfig.Label += "-" + strconv.Itoa(int(caster.UnitIndex))
 		if spell.shields == nil {
@@ -86,7 +154,29 @@ func (spell *Spell) createShields(config ShieldConfig) {
 			if !caster.IsOpponent(target) {
 				shield.Aura = target.GetOrRegisterAura(auraConfig)
 				spell.shields[target.UnitIndex] = newShield(shield)
+				spell.shields[target.UnitIndex].registerAbsorption(config)
 			}
 		}
 	}
 }
+
+func (shield *Shield) registerAbsorption(config ShieldConfig) {
+	if !shield.absorbs {
+		return
+	}
+
+	shield.Aura.ApplyOnExpire(func(aura *Aura, sim *Simulation) {
+		shield.Spell.SpellMetrics[aura.Unit.UnitIndex].TotalShieldingWasted += shield.Strength
+		shield.Strength = 0
+	})
+
+	shield.Aura.Unit.AddDynamicDamageTakenModifier(func(sim *Simulation, spell *Spell, result *SpellResult, isPeriodic bool) {
+		if !shield.Aura.IsActive() || (result.Damage <= 0) || spell.Flags.Matches(SpellFlagBypassAbsorbs) {
+			return
+		}
+		if (config.ShouldAbsorb != nil) && !config.ShouldAbsorb(sim, spell, result, isPeriodic) {
+			return
+		}
+		shield.absorb(sim, result, config.OnDepleted)
+	})
+}
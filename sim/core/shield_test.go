@@ -0,0 +1,72 @@
+package core
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestShieldAbsorbMetrics(t *testing.T) {
+	sim := &Simulation{}
+
+	unit := Unit{
+		Type:        PlayerUnit,
+		Level:       CharacterLevel,
+		auraTracker: newAuraTracker(),
+	}
+	spell := &Spell{
+		ActionID:         ActionID{SpellID: 17},
+		Unit:             &unit,
+		DamageMultiplier: 1,
+		SpellMetrics:     make([]SpellMetrics, 1),
+	}
+
+	numDepleted := 0
+	shield := newShield(Shield{
+		Spell: spell,
+		Aura: unit.RegisterAura(Aura{
+			Label:     "Test Shield",
+			Duration:  time.Second * 15,
+			MaxStacks: math.MaxInt32,
+		}),
+		absorbs: true,
+	})
+	shield.registerAbsorption(ShieldConfig{
+		AbsorbDamage: true,
+		OnDepleted: func(_ *Simulation, _ *Shield) {
+			numDepleted++
+		},
+	})
+
+	hit := func(damage float64) float64 {
+		result := &SpellResult{Damage: damage}
+		for _, modifier := range unit.DynamicDamageTakenModifiers {
+			modifier(sim, &Spell{}, result, false)
+		}
+		return result.Damage
+	}
+
+	shield.Apply(sim, 100)
+	if taken := hit(60); taken != 0 {
+		t.Fatalf("Expected the shield to absorb the whole hit, but %f damage was taken", taken)
+	}
+
+	// Overwriting the shield wastes the 40 it had left.
+	shield.Apply(sim, 100)
+	if taken := hit(150); taken != 50 {
+		t.Fatalf("Expected 50 damage to get through the shield, but %f was taken", taken)
+	}
+	if shield.IsActive() || numDepleted != 1 {
+		t.Fatalf("Expected the shield to be consumed")
+	}
+
+	// Stacking is capped, and the overflow is wasted too.
+	shield.Stack(sim, 80, 100)
+	shield.Stack(sim, 80, 100)
+
+	metrics := spell.SpellMetrics[0]
+	if metrics.TotalShielding != 300 || metrics.TotalAbsorbed != 160 || metrics.TotalShieldingWasted != 100 {
+		t.Fatalf("Unexpected shield metrics: shielding %f, absorbed %f, wasted %f",
+			metrics.TotalShielding, metrics.TotalAbsorbed, metrics.TotalShieldingWasted)
+	}
+}
@@ -1,7 +1,9 @@
 package core
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"log"
 	"math"
 	"math/rand"
@@ -26,6 +28,9 @@ type Simulation struct {
 
 	Options *proto.SimOptions
 
+	// Locale of the action and aura names in the results, names are left empty if unset.
+	Locale string
+
 	rand        Rand
 	rseed       int64
 	currentSeed int64
@@ -46,6 +51,10 @@ type Simulation struct {
 
 	Log func(string, ...interface{})
 
+	// When set, logs are streamed to this writer as a compact event log instead
+	// of being kept in the results.
+	LogWriter io.Writer
+
 	executePhase int32 // 20, 25, 35, 45 or 90 for the respective execute range, 100 otherwise
 
 	executePhaseCallbacks []func(*Simulation, int32) // 2nd parameter is 90 for 90%, 45 for 45%, 35 for 35%, 25 for 25% and 20 for 20%
@@ -112,10 +121,10 @@ func (sim *Simulation) RemoveTask(task Task) {
 }
 
 func RunSim(rsr *proto.RaidSimRequest, progress chan *proto.ProgressMetrics, signals simsignals.Signals) *proto.RaidSimResult {
-	return runSim(rsr, progress, false, signals)
+	return runSim(rsr, progress, false, signals, nil)
 }
 
-func runSim(rsr *proto.RaidSimRequest, progress chan *proto.ProgressMetrics, skipPresim bool, signals simsignals.Signals) (result *proto.RaidSimResult) {
+func runSim(rsr *proto.RaidSimRequest, progress chan *proto.ProgressMetrics, skipPresim bool, signals simsignals.Signals, logWriter io.Writer) (result *proto.RaidSimResult) {
 	if !rsr.SimOptions.IsTest {
 		defer func() {
 			if err := recover(); err != nil {
@@ -144,6 +153,7 @@ func runSim(rsr *proto.RaidSimRequest, progress chan *proto.ProgressMetrics, ski
 	}
 
 	sim := NewSim(rsr, signals)
+	sim.LogWriter = logWriter
 
 	if !skipPresim {
 		if progress != nil {
@@ -189,7 +199,23 @@ func runSim(rsr *proto.RaidSimRequest, progress chan *proto.ProgressMetrics, ski
 
 func NewSim(rsr *proto.RaidSimRequest, signals simsignals.Signals) *Simulation {
 	env, _, _ := NewEnvironment(rsr.Raid, rsr.Encounter, false)
-	return newSimWithEnv(env, rsr.SimOptions, signals)
+	if rsr.SimOptions.PlanCooldowns {
+		for _, party := range env.Raid.Parties {
+			for _, player := range party.Players {
+				player.GetCharacter().enableCooldownPlanner()
+			}
+		}
+	}
+	if rsr.SimOptions.TraceRotation {
+		for _, party := range env.Raid.Parties {
+			for _, player := range party.Players {
+				player.GetCharacter().enableRotationTrace()
+			}
+		}
+	}
+	sim := newSimWithEnv(env, rsr.SimOptions, signals)
+	sim.Locale = rsr.Locale
+	return sim
 }
 
 func newSimWithEnv(env *Environment, simOptions *proto.SimOptions, signals simsignals.Signals) *Simulation {
@@ -299,9 +325,22 @@ func (sim *Simulation) run() *proto.RaidSimResult {
 	t0 := time.Now()
 
 	logsBuffer := &strings.Builder{}
+	compactLogsBuffer := &bytes.Buffer{}
+	var eventLog *EventLogWriter
 	if sim.Options.Debug || sim.Options.DebugFirstIteration {
-		sim.Log = func(message string, vals ...interface{}) {
-			logsBuffer.WriteString(fmt.Sprintf("[%0.2f] "+message+"\n", append([]interface{}{sim.CurrentTime.Seconds()}, vals...)...))
+		if sim.LogWriter != nil || sim.Options.CompactLogs {
+			if sim.LogWriter != nil {
+				eventLog = NewEventLogWriter(sim.LogWriter)
+			} else {
+				eventLog = NewEventLogWriter(compactLogsBuffer)
+			}
+			sim.Log = func(message string, vals ...interface{}) {
+				eventLog.Write(sim.CurrentTime, fmt.Sprintf(message, vals...))
+			}
+		} else {
+			sim.Log = func(message string, vals ...interface{}) {
+				logsBuffer.WriteString(fmt.Sprintf("[%0.2f] "+message+"\n", append([]interface{}{sim.CurrentTime.Seconds()}, vals...)...))
+			}
 		}
 	}
 
@@ -312,6 +351,9 @@ func (sim *Simulation) run() *proto.RaidSimResult {
 	// }
 
 	sim.runOnce(true)
+	if eventLog != nil && sim.LogWriter != nil {
+		eventLog.Flush()
+	}
 	firstIterationDuration := sim.Duration
 	if sim.Encounter.EndFightAtHealth != 0 {
 		firstIterationDuration = sim.CurrentTime
@@ -348,21 +390,37 @@ func (sim *Simulation) run() *proto.RaidSimResult {
 		sim.reseedRands(int64(i))
 
 		sim.runOnce(false)
+		if eventLog != nil && sim.LogWriter != nil {
+			eventLog.Flush()
+		}
 		iterDuration := sim.Duration
 		if sim.Encounter.EndFightAtHealth != 0 {
 			iterDuration = sim.CurrentTime
 		}
 		totalDuration += iterDuration
 	}
+	if eventLog != nil {
+		if err := eventLog.Close(); err != nil {
+			log.Printf("Failed to write the event log: %s", err)
+		}
+	}
+
 	result := &proto.RaidSimResult{
 		RaidMetrics:      sim.Raid.GetMetrics(),
 		EncounterMetrics: sim.Encounter.GetMetricsProto(),
 
 		Logs:                   logsBuffer.String(),
+		CompactLogs:            compactLogsBuffer.Bytes(),
 		FirstIterationDuration: firstIterationDuration.Seconds(),
 		AvgIterationDuration:   totalDuration.Seconds() / float64(sim.Options.Iterations),
 		IterationsDone:         sim.Options.Iterations,
 	}
+	if sim.Options.PetMetricsMode == proto.PetMetricsMode_PetMetricsRollup {
+		removePetMetrics(result.RaidMetrics)
+	}
+	if sim.Locale != "" {
+		localizeMetricNames(result.RaidMetrics, result.EncounterMetrics, sim.Locale)
+	}
 
 	// Final progress report
 	if sim.ProgressReport != nil {
@@ -383,7 +441,7 @@ func (sim *Simulation) runOnce(firstIteration bool) {
 
 	if firstIteration {
 		for _, action := range sim.Environment.prepullActions {
-			action.doAtTime = action.DoAt.GetDuration(sim)
+			action.doAtTime = sim.Environment.alignPrepullTime(action.DoAt.GetDuration(sim))
 		}
 
 		sim.Environment.prepullActions = FilterSlice(sim.Environment.prepullActions, func(action *PrepullAction) bool {
@@ -449,6 +507,7 @@ func (sim *Simulation) reset() {
 	sim.tasks = sim.tasks[:0]
 	sim.minTaskTime = NeverExpires
 
+	sim.Encounter.resetScheduledEvents(sim)
 	sim.Environment.reset(sim)
 
 	sim.initManaTickAction()
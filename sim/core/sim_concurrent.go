@@ -81,15 +81,43 @@ func (rsrc *raidSimResultCombiner) newUnitMetrics(baseUnit *proto.UnitMetrics) *
 		Auras:     make([]*proto.AuraMetrics, len(baseUnit.Auras)),
 		Resources: make([]*proto.ResourceMetrics, 0, len(baseUnit.Resources)),
 		Pets:      make([]*proto.UnitMetrics, len(baseUnit.Pets)),
+
+		ModelingStatus: baseUnit.ModelingStatus,
 	}
 
 	for i, aura := range baseUnit.Auras {
 		newUm.Auras[i] = &proto.AuraMetrics{
 			Id:             aura.Id,
+			Name:           aura.Name,
 			AggregatorData: &proto.AggregatorData{},
 		}
 	}
 
+	if baseUnit.SecondaryResource != nil {
+		newUm.SecondaryResource = &proto.SecondaryResourceMetrics{
+			Type: baseUnit.SecondaryResource.Type,
+		}
+		for _, aura := range baseUnit.SecondaryResource.Auras {
+			newUm.SecondaryResource.Auras = append(newUm.SecondaryResource.Auras, &proto.SecondaryResourceAuraMetrics{
+				Id: aura.Id,
+			})
+		}
+	}
+
+	if baseUnit.ActiveMitigation != nil {
+		newUm.ActiveMitigation = &proto.ActiveMitigationMetrics{}
+	}
+
+	if baseUnit.RotationWaits != nil {
+		newUm.RotationWaits = &proto.APLWaitMetrics{}
+	}
+
+	for _, ms := range baseUnit.ManaSustainability {
+		newUm.ManaSustainability = append(newUm.ManaSustainability, &proto.ManaSustainability{
+			DurationSeconds: ms.DurationSeconds,
+		})
+	}
+
 	for i, pet := range baseUnit.Pets {
 		newUm.Pets[i] = rsrc.newUnitMetrics(pet)
 	}
@@ -153,6 +181,7 @@ func (rsrc *raidSimResultCombiner) addActionMetrics(unit *proto.UnitMetrics, add
 	if am == nil {
 		am = &proto.ActionMetrics{
 			Id:          add.Id,
+			Name:        add.Name,
 			IsMelee:     add.IsMelee,
 			IsPassive:   add.IsPassive,
 			Targets:     make([]*proto.TargetedActionMetrics, len(add.Targets)),
@@ -194,7 +223,13 @@ func (rsrc *raidSimResultCombiner) addActionMetrics(unit *proto.UnitMetrics, add
 		baseTgt.Threat += addTgt.Threat
 		baseTgt.Healing += addTgt.Healing
 		baseTgt.CritHealing += addTgt.CritHealing
+		baseTgt.Overhealing += addTgt.Overhealing
+		baseTgt.Interrupts += addTgt.Interrupts
 		baseTgt.Shielding += addTgt.Shielding
+		baseTgt.Absorbed += addTgt.Absorbed
+		baseTgt.ShieldingWasted += addTgt.ShieldingWasted
+		baseTgt.ResourceCost += addTgt.ResourceCost
+		baseTgt.ResourceRefunded += addTgt.ResourceRefunded
 		baseTgt.CastTimeMs += addTgt.CastTimeMs
 	}
 }
@@ -202,6 +237,10 @@ func (rsrc *raidSimResultCombiner) addActionMetrics(unit *proto.UnitMetrics, add
 func (rsrc *raidSimResultCombiner) combineAuraMetrics(base *proto.AuraMetrics, add *proto.AuraMetrics, weight float64, isLast bool) {
 	base.UptimeSecondsAvg += add.UptimeSecondsAvg * weight
 	base.ProcsAvg += add.ProcsAvg * weight
+	base.StacksGainedAvg += add.StacksGainedAvg * weight
+	base.StacksConsumedAvg += add.StacksConsumedAvg * weight
+	base.StacksOvercappedAvg += add.StacksOvercappedAvg * weight
+	base.StacksExpiredAvg += add.StacksExpiredAvg * weight
 
 	base.AggregatorData.N += add.AggregatorData.N
 	base.AggregatorData.SumSq += add.AggregatorData.SumSq
@@ -247,6 +286,54 @@ func (rsrc *raidSimResultCombiner) combineUnitMetrics(base *proto.UnitMetrics, a
 
 	base.SecondsOomAvg += add.SecondsOomAvg * weight
 	base.ChanceOfDeath += add.ChanceOfDeath * weight
+	base.ManaSpentAvg += add.ManaSpentAvg * weight
+
+	// Healing per mana is relative to mana spent rather than to iterations.
+	base.HealingPerMana += add.HealingPerMana * add.ManaSpentAvg * weight
+	if isLast && base.ManaSpentAvg > 0 {
+		base.HealingPerMana /= base.ManaSpentAvg
+	}
+
+	if add.SecondaryResource != nil {
+		base.SecondaryResource.GeneratedAvg += add.SecondaryResource.GeneratedAvg * weight
+		base.SecondaryResource.WastedAvg += add.SecondaryResource.WastedAvg * weight
+		base.SecondaryResource.SpentAvg += add.SecondaryResource.SpentAvg * weight
+
+		// Entry resource is averaged over entries rather than iterations.
+		for i, addAura := range add.SecondaryResource.Auras {
+			baseAura := base.SecondaryResource.Auras[i]
+			baseAura.EntriesAvg += addAura.EntriesAvg * weight
+			baseAura.UptimeSecondsAvg += addAura.UptimeSecondsAvg * weight
+			baseAura.ResourceOnEntryAvg += addAura.ResourceOnEntryAvg * addAura.EntriesAvg * weight
+			if isLast && baseAura.EntriesAvg > 0 {
+				baseAura.ResourceOnEntryAvg /= baseAura.EntriesAvg
+			}
+		}
+	}
+
+	if add.ActiveMitigation != nil {
+		base.ActiveMitigation.UptimeAvg += add.ActiveMitigation.UptimeAvg * weight
+		base.ActiveMitigation.DamageTakenAvg += add.ActiveMitigation.DamageTakenAvg * weight
+
+		// Unmitigated damage is a fraction of damage taken rather than of
+		// iterations.
+		base.ActiveMitigation.UnmitigatedDamageTaken += add.ActiveMitigation.UnmitigatedDamageTaken * add.ActiveMitigation.DamageTakenAvg * weight
+		if isLast && base.ActiveMitigation.DamageTakenAvg > 0 {
+			base.ActiveMitigation.UnmitigatedDamageTaken /= base.ActiveMitigation.DamageTakenAvg
+		}
+	}
+
+	if add.RotationWaits != nil {
+		base.RotationWaits.ExplicitWaitSecondsAvg += add.RotationWaits.ExplicitWaitSecondsAvg * weight
+		base.RotationWaits.PoolingSecondsAvg += add.RotationWaits.PoolingSecondsAvg * weight
+		base.RotationWaits.ResourceStarvedSecondsAvg += add.RotationWaits.ResourceStarvedSecondsAvg * weight
+		base.RotationWaits.IdleSecondsAvg += add.RotationWaits.IdleSecondsAvg * weight
+	}
+
+	for i, addMs := range add.ManaSustainability {
+		base.ManaSustainability[i].ManaRemainingAvg += addMs.ManaRemainingAvg * weight
+		base.ManaSustainability[i].ChanceOfOom += addMs.ChanceOfOom * weight
+	}
 
 	if add.DeathSeeds != nil {
 		base.DeathSeeds = append(base.DeathSeeds, add.DeathSeeds...)
@@ -268,6 +355,34 @@ func (rsrc *raidSimResultCombiner) combineUnitMetrics(base *proto.UnitMetrics, a
 	for i, addPet := range add.Pets {
 		rsrc.combineUnitMetrics(base.Pets[i], addPet, isLast, weight)
 	}
+
+	// Every sim computes the same breakpoints, so only their applications
+	// need to be added up.
+	if len(base.DotBreakpoints) == 0 {
+		for _, breakpoint := range add.DotBreakpoints {
+			base.DotBreakpoints = append(base.DotBreakpoints, googleProto.Clone(breakpoint).(*proto.DotBreakpoint))
+		}
+	} else {
+		for i, breakpoint := range add.DotBreakpoints {
+			base.DotBreakpoints[i].Applications += breakpoint.Applications
+			base.DotBreakpoints[i].HastedApplications += breakpoint.HastedApplications
+		}
+	}
+
+	// Only the first iteration of each sim is traced, so keep the first sim's.
+	if len(base.RotationTrace) == 0 {
+		base.RotationTrace = add.RotationTrace
+	}
+
+	if add.CooldownPlan != nil {
+		if base.CooldownPlan == nil {
+			base.CooldownPlan = &proto.CooldownPlan{}
+		}
+		combineCooldownPlans(base.CooldownPlan, add.CooldownPlan)
+		if isLast {
+			finalizeCooldownPlan(base.CooldownPlan)
+		}
+	}
 }
 
 func (rsrc *raidSimResultCombiner) AddResult(result *proto.RaidSimResult, isLast bool, weight float64) {
@@ -292,6 +407,7 @@ func (rsrc *raidSimResultCombiner) AddResult(result *proto.RaidSimResult, isLast
 
 	if rsrc.Debug {
 		rsrc.Combined.Logs += "-SIMSTART-\n" + result.Logs
+		rsrc.Combined.CompactLogs = append(rsrc.Combined.CompactLogs, result.CompactLogs...)
 	}
 }
 
@@ -310,6 +426,7 @@ func (rsrc *raidSimResultCombiner) SetBaseResult(baseRsr *proto.RaidSimResult) {
 
 	if !rsrc.Debug {
 		newRsr.Logs = baseRsr.Logs
+		newRsr.CompactLogs = baseRsr.CompactLogs
 	}
 
 	for i, party := range baseRsr.RaidMetrics.Parties {
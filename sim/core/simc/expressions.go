@@ -0,0 +1,291 @@
+package simc
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+type tokenKind int
+
+const (
+	tokenNumber tokenKind = iota
+	tokenIdentifier
+	tokenOperator
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+// Longer operators come first, so they win over their prefixes. Operators we
+// can't translate are still tokenized so they can be reported by name.
+var simcOperators = []string{"<=", ">=", "!=", "==", "<?", ">?", "%%", "<", ">", "=", "&", "|", "^", "!", "+", "-", "*", "%", "@", "(", ")"}
+
+func tokenize(expression string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(expression); {
+		c := rune(expression[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case unicode.IsDigit(c) || c == '.':
+			start := i
+			for i < len(expression) && (unicode.IsDigit(rune(expression[i])) || expression[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: expression[start:i]})
+		case unicode.IsLetter(c) || c == '_':
+			start := i
+			for i < len(expression) && isIdentifierChar(rune(expression[i])) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenIdentifier, text: expression[start:i]})
+		default:
+			matched := false
+			for _, op := range simcOperators {
+				if strings.HasPrefix(expression[i:], op) {
+					tokens = append(tokens, token{kind: tokenOperator, text: op})
+					i += len(op)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("unexpected character %q", c)
+			}
+		}
+	}
+	return tokens, nil
+}
+
+func isIdentifierChar(c rune) bool {
+	return unicode.IsLetter(c) || unicode.IsDigit(c) || c == '_' || c == '.'
+}
+
+// Recursive descent parser for SimC conditions, which builds the equivalent
+// APL value as it goes. From loosest to tightest, the precedence levels are
+// |, &, comparisons, + and -, * and %, and finally the unary operators.
+type expressionParser struct {
+	names  *nameIndex
+	tokens []token
+	pos    int
+
+	// The spell of the action the condition belongs to, for expressions like
+	// "remains" which implicitly refer to it. Nil if the action isn't a spell.
+	actionSpell *proto.ActionID
+}
+
+func (names *nameIndex) translateExpression(expression string, actionSpell *proto.ActionID) (*proto.APLValue, error) {
+	tokens, err := tokenize(expression)
+	if err != nil {
+		return nil, err
+	}
+	parser := &expressionParser{
+		names:       names,
+		tokens:      tokens,
+		actionSpell: actionSpell,
+	}
+
+	value, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if next := parser.peek(); next != nil {
+		return nil, unexpectedToken(next)
+	}
+	return value, nil
+}
+
+func (parser *expressionParser) peek() *token {
+	if parser.pos >= len(parser.tokens) {
+		return nil
+	}
+	return &parser.tokens[parser.pos]
+}
+
+// Consumes the next token if it is one of the given operators.
+func (parser *expressionParser) acceptOperator(ops ...string) (string, bool) {
+	next := parser.peek()
+	if next == nil || next.kind != tokenOperator {
+		return "", false
+	}
+	for _, op := range ops {
+		if next.text == op {
+			parser.pos++
+			return op, true
+		}
+	}
+	return "", false
+}
+
+func unexpectedToken(next *token) error {
+	if next.kind == tokenOperator && !strings.Contains("()", next.text) {
+		return fmt.Errorf("unsupported operator %q", next.text)
+	}
+	return fmt.Errorf("unexpected %q", next.text)
+}
+
+func (parser *expressionParser) parseOr() (*proto.APLValue, error) {
+	lhs, err := parser.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if _, ok := parser.acceptOperator("|"); !ok {
+			return lhs, nil
+		}
+		rhs, err := parser.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		lhs = &proto.APLValue{Value: &proto.APLValue_Or{Or: &proto.APLValueOr{Vals: []*proto.APLValue{lhs, rhs}}}}
+	}
+}
+
+func (parser *expressionParser) parseAnd() (*proto.APLValue, error) {
+	lhs, err := parser.parseComparison()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if _, ok := parser.acceptOperator("&"); !ok {
+			return lhs, nil
+		}
+		rhs, err := parser.parseComparison()
+		if err != nil {
+			return nil, err
+		}
+		lhs = &proto.APLValue{Value: &proto.APLValue_And{And: &proto.APLValueAnd{Vals: []*proto.APLValue{lhs, rhs}}}}
+	}
+}
+
+var comparisonOperators = map[string]proto.APLValueCompare_ComparisonOperator{
+	"=":  proto.APLValueCompare_OpEq,
+	"==": proto.APLValueCompare_OpEq,
+	"!=": proto.APLValueCompare_OpNe,
+	"<":  proto.APLValueCompare_OpLt,
+	"<=": proto.APLValueCompare_OpLe,
+	">":  proto.APLValueCompare_OpGt,
+	">=": proto.APLValueCompare_OpGe,
+}
+
+func (parser *expressionParser) parseComparison() (*proto.APLValue, error) {
+	lhs, err := parser.parseSum()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := parser.acceptOperator("=", "==", "!=", "<", "<=", ">", ">=")
+		if !ok {
+			return lhs, nil
+		}
+		rhs, err := parser.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		lhs = &proto.APLValue{Value: &proto.APLValue_Cmp{Cmp: &proto.APLValueCompare{Op: comparisonOperators[op], Lhs: lhs, Rhs: rhs}}}
+	}
+}
+
+var mathOperators = map[string]proto.APLValueMath_MathOperator{
+	"+": proto.APLValueMath_OpAdd,
+	"-": proto.APLValueMath_OpSub,
+	"*": proto.APLValueMath_OpMul,
+	// SimC divides with % since / separates actions.
+	"%": proto.APLValueMath_OpDiv,
+}
+
+func (parser *expressionParser) parseSum() (*proto.APLValue, error) {
+	lhs, err := parser.parseProduct()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := parser.acceptOperator("+", "-")
+		if !ok {
+			return lhs, nil
+		}
+		rhs, err := parser.parseProduct()
+		if err != nil {
+			return nil, err
+		}
+		lhs = mathValue(mathOperators[op], lhs, rhs)
+	}
+}
+
+func (parser *expressionParser) parseProduct() (*proto.APLValue, error) {
+	lhs, err := parser.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := parser.acceptOperator("*", "%")
+		if !ok {
+			return lhs, nil
+		}
+		rhs, err := parser.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		lhs = mathValue(mathOperators[op], lhs, rhs)
+	}
+}
+
+func (parser *expressionParser) parseUnary() (*proto.APLValue, error) {
+	if op, ok := parser.acceptOperator("!", "-"); ok {
+		inner, err := parser.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if op == "!" {
+			return &proto.APLValue{Value: &proto.APLValue_Not{Not: &proto.APLValueNot{Val: inner}}}, nil
+		}
+		return mathValue(proto.APLValueMath_OpSub, constValue("0"), inner), nil
+	}
+	return parser.parsePrimary()
+}
+
+func (parser *expressionParser) parsePrimary() (*proto.APLValue, error) {
+	next := parser.peek()
+	if next == nil {
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+
+	switch next.kind {
+	case tokenNumber:
+		parser.pos++
+		return constValue(next.text), nil
+	case tokenIdentifier:
+		parser.pos++
+		return parser.names.translateIdentifier(next.text, parser.actionSpell)
+	}
+
+	if _, ok := parser.acceptOperator("("); !ok {
+		return nil, unexpectedToken(next)
+	}
+	value, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := parser.acceptOperator(")"); !ok {
+		return nil, fmt.Errorf("missing closing parenthesis")
+	}
+	return value, nil
+}
+
+func constValue(val string) *proto.APLValue {
+	return &proto.APLValue{Value: &proto.APLValue_Const{Const: &proto.APLValueConst{Val: val}}}
+}
+
+func mathValue(op proto.APLValueMath_MathOperator, lhs *proto.APLValue, rhs *proto.APLValue) *proto.APLValue {
+	return &proto.APLValue{Value: &proto.APLValue_Math{Math: &proto.APLValueMath{Op: op, Lhs: lhs, Rhs: rhs}}}
+}
+
+// Our percentages are fractions, while SimC's go from 0 to 100.
+func percentValue(fraction *proto.APLValue) *proto.APLValue {
+	return mathValue(proto.APLValueMath_OpMul, fraction, constValue("100"))
+}
@@ -0,0 +1,302 @@
+// Package simc translates SimulationCraft action lists into APL rotations, so
+// rotations maintained for SimC can be brought over without rewriting them.
+package simc
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/wowsims/mop/sim/core"
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+// Spacing between translated precombat actions, about one GCD.
+const precombatSpacing = time.Millisecond * 1500
+
+// Actions which the sim handles through its settings rather than the rotation.
+var ignoredActions = []string{"snapshot_stats", "auto_attack", "auto_shot", "flask", "food", "augmentation"}
+
+var itemSlotsBySimcName = map[string]proto.ItemSlot{
+	"trinket1": proto.ItemSlot_ItemSlotTrinket1,
+	"trinket2": proto.ItemSlot_ItemSlotTrinket2,
+	"hands":    proto.ItemSlot_ItemSlotHands,
+	"waist":    proto.ItemSlot_ItemSlotWaist,
+	"back":     proto.ItemSlot_ItemSlotBack,
+}
+
+type simcAction struct {
+	line    int32
+	text    string
+	name    string
+	options map[string]string
+}
+
+type simcActionList struct {
+	name    string
+	actions []simcAction
+}
+
+// Translates the action list in the request, looking up spells and auras among
+// those of the request's player.
+func ImportActionList(request *proto.SimcImportRequest) *proto.SimcImportResult {
+	if request.Player == nil {
+		return &proto.SimcImportResult{Error: &proto.ErrorOutcome{Message: "No player to look up spells and auras for"}}
+	}
+
+	statsResult := core.ComputeStats(&proto.ComputeStatsRequest{
+		Raid: core.SinglePlayerRaidProto(request.Player, &proto.PartyBuffs{}, &proto.RaidBuffs{}, &proto.Debuffs{}),
+	})
+	var targets []*proto.UnitMetadata
+	for _, target := range statsResult.EncounterStats.Targets {
+		targets = append(targets, target.Metadata)
+	}
+	names := newNameIndex(statsResult.RaidStats.Parties[0].Players[0].Metadata, targets)
+
+	return names.importActionList(request.ActionList)
+}
+
+func (names *nameIndex) importActionList(text string) *proto.SimcImportResult {
+	lists, unsupported := parseActionLists(text)
+	report := func(action simcAction, err error) {
+		unsupported = append(unsupported, &proto.SimcUnsupportedConstruct{Line: action.line, Text: action.text, Reason: err.Error()})
+	}
+
+	listNames := make(map[string]bool, len(lists))
+	for _, list := range lists {
+		listNames[list.name] = true
+	}
+
+	rotation := &proto.APLRotation{Type: proto.APLRotation_TypeAPL}
+	var precombatActions []*proto.APLAction
+	for _, list := range lists {
+		var listItems []*proto.APLListItem
+		for _, action := range list.actions {
+			if action.name == "variable" {
+				variable, err := names.translateVariable(action, rotation.ValueVariables)
+				if err != nil {
+					report(action, err)
+				} else {
+					rotation.ValueVariables = append(rotation.ValueVariables, variable)
+				}
+				continue
+			}
+
+			aplActions, err := names.translateAction(action, listNames)
+			if err == nil && list.name == "precombat" {
+				if _, hasCondition := action.options["if"]; hasCondition {
+					err = fmt.Errorf("conditions are not supported before combat")
+				} else if action.name == "call_action_list" || action.name == "run_action_list" {
+					err = fmt.Errorf("action lists can't be called before combat")
+				}
+			}
+			if err != nil {
+				report(action, err)
+				continue
+			}
+
+			if list.name == "precombat" {
+				precombatActions = append(precombatActions, aplActions...)
+			}
+			for _, aplAction := range aplActions {
+				listItems = append(listItems, &proto.APLListItem{Action: aplAction})
+			}
+		}
+
+		switch list.name {
+		case "precombat":
+		case "":
+			rotation.PriorityList = listItems
+		default:
+			rotation.Groups = append(rotation.Groups, &proto.APLGroup{Name: list.name, Actions: listItems})
+		}
+	}
+
+	// Precombat actions are spread out so they finish right as combat starts.
+	for i, action := range precombatActions {
+		doAt := -time.Duration(len(precombatActions)-i) * precombatSpacing
+		rotation.PrepullActions = append(rotation.PrepullActions, &proto.APLPrepullAction{
+			Action:    action,
+			DoAtValue: constValue(doAt.String()),
+		})
+	}
+
+	result := &proto.SimcImportResult{Unsupported: unsupported}
+	if len(rotation.PriorityList) == 0 && len(rotation.PrepullActions) == 0 {
+		result.Error = &proto.ErrorOutcome{Message: "No actions could be imported"}
+	} else {
+		result.Rotation = rotation
+	}
+	return result
+}
+
+// Splits the action list into its named sublists, in order of appearance, with
+// the default list named "". Lines which are not part of an action list, like
+// the rest of a SimC profile, are skipped.
+func parseActionLists(text string) ([]*simcActionList, []*proto.SimcUnsupportedConstruct) {
+	var lists []*simcActionList
+	var unsupported []*proto.SimcUnsupportedConstruct
+
+	for i, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		lhs, rhs, ok := strings.Cut(line, "=")
+		appending := strings.HasSuffix(lhs, "+")
+		lhs = strings.TrimSuffix(lhs, "+")
+		if !ok || (lhs != "actions" && !strings.HasPrefix(lhs, "actions.")) {
+			continue
+		}
+		listName := strings.TrimPrefix(strings.TrimPrefix(lhs, "actions"), ".")
+
+		listIdx := slices.IndexFunc(lists, func(list *simcActionList) bool { return list.name == listName })
+		if listIdx == -1 {
+			lists = append(lists, &simcActionList{name: listName})
+			listIdx = len(lists) - 1
+		}
+		list := lists[listIdx]
+		if !appending {
+			list.actions = nil
+		}
+
+		// Multiple actions can be given at once, separated by slashes.
+		for _, actionText := range strings.Split(rhs, "/") {
+			actionText = strings.TrimSpace(actionText)
+			if actionText == "" {
+				continue
+			}
+
+			fields := strings.Split(actionText, ",")
+			action := simcAction{
+				line:    int32(i + 1),
+				text:    actionText,
+				name:    fields[0],
+				options: map[string]string{},
+			}
+			if slices.Contains(ignoredActions, action.name) {
+				continue
+			}
+
+			malformed := false
+			for _, field := range fields[1:] {
+				key, value, ok := strings.Cut(field, "=")
+				if !ok {
+					unsupported = append(unsupported, &proto.SimcUnsupportedConstruct{Line: action.line, Text: actionText, Reason: fmt.Sprintf("malformed option %q", field)})
+					malformed = true
+					break
+				}
+				action.options[key] = value
+			}
+			if !malformed {
+				list.actions = append(list.actions, action)
+			}
+		}
+	}
+
+	return lists, unsupported
+}
+
+func (names *nameIndex) translateAction(action simcAction, listNames map[string]bool) ([]*proto.APLAction, error) {
+	options := maps.Clone(action.options)
+	takeOption := func(key string) (string, bool) {
+		value, ok := options[key]
+		delete(options, key)
+		return value, ok
+	}
+
+	var actionSpell *proto.ActionID
+	var actions []*proto.APLAction
+	switch action.name {
+	// Our groups fall through to the rest of the list when none of their
+	// actions are ready, so run_action_list behaves like call_action_list.
+	case "call_action_list", "run_action_list":
+		listName, _ := takeOption("name")
+		if !listNames[listName] || listName == "" || listName == "precombat" {
+			return nil, fmt.Errorf("unknown action list %q", listName)
+		}
+		actions = append(actions, &proto.APLAction{Action: &proto.APLAction_GroupReference{GroupReference: &proto.APLActionGroupReference{GroupName: listName}}})
+	case "use_item":
+		slotName, _ := takeOption("slot")
+		slot, ok := itemSlotsBySimcName[slotName]
+		if !ok {
+			return nil, fmt.Errorf("items can only be used by slot, one of trinket1, trinket2, hands, waist or back")
+		}
+		actions = append(actions, useItemInSlot(slot))
+	case "use_items":
+		actions = append(actions, useItemInSlot(proto.ItemSlot_ItemSlotTrinket1), useItemInSlot(proto.ItemSlot_ItemSlotTrinket2))
+	case "potion":
+		// The potion comes from the consumables settings.
+		takeOption("name")
+		takeOption("type")
+		actions = append(actions, castSpell(&proto.ActionID{RawId: &proto.ActionID_OtherId{OtherId: proto.OtherAction_OtherActionPotion}}))
+	case "wait":
+		sec, ok := takeOption("sec")
+		if !ok {
+			return nil, fmt.Errorf("wait needs a duration")
+		}
+		duration, err := names.translateExpression(sec, nil)
+		if err != nil {
+			return nil, err
+		}
+		actions = append(actions, &proto.APLAction{Action: &proto.APLAction_Wait{Wait: &proto.APLActionWait{Duration: duration}}})
+	default:
+		spellID, err := names.spell(action.name)
+		if err != nil {
+			return nil, err
+		}
+		actionSpell = spellID
+		actions = append(actions, castSpell(spellID))
+	}
+
+	var condition *proto.APLValue
+	if conditionText, ok := takeOption("if"); ok {
+		var err error
+		if condition, err = names.translateExpression(conditionText, actionSpell); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(options) > 0 {
+		return nil, fmt.Errorf("unsupported option %q", slices.Sorted(maps.Keys(options))[0])
+	}
+
+	for _, aplAction := range actions {
+		aplAction.Condition = condition
+	}
+	return actions, nil
+}
+
+// Only unconditional variables are supported, since ours can't change value
+// depending on where they are set.
+func (names *nameIndex) translateVariable(action simcAction, existing []*proto.APLValueVariable) (*proto.APLValueVariable, error) {
+	name := action.options["name"]
+	if name == "" {
+		return nil, fmt.Errorf("variable needs a name")
+	}
+	for key, value := range action.options {
+		if key != "name" && key != "value" && !(key == "op" && value == "set") {
+			return nil, fmt.Errorf("unsupported variable option %q", key)
+		}
+	}
+	if slices.ContainsFunc(existing, func(variable *proto.APLValueVariable) bool { return variable.Name == name }) {
+		return nil, fmt.Errorf("variable %q is set more than once", name)
+	}
+
+	value, err := names.translateExpression(action.options["value"], nil)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.APLValueVariable{Name: name, Value: value}, nil
+}
+
+func castSpell(spellID *proto.ActionID) *proto.APLAction {
+	return &proto.APLAction{Action: &proto.APLAction_CastSpell{CastSpell: &proto.APLActionCastSpell{SpellId: spellID}}}
+}
+
+func useItemInSlot(slot proto.ItemSlot) *proto.APLAction {
+	return &proto.APLAction{Action: &proto.APLAction_UseItemInSlot{UseItemInSlot: &proto.APLActionUseItemInSlot{ItemSlot: slot}}}
+}
@@ -0,0 +1,112 @@
+package simc
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+func testNameIndex() *nameIndex {
+	return &nameIndex{
+		spells: map[string]*proto.ActionID{
+			"lava_burst":  {RawId: &proto.ActionID_SpellId{SpellId: 51505}},
+			"flame_shock": {RawId: &proto.ActionID_SpellId{SpellId: 8050}},
+			"earth_shock": {RawId: &proto.ActionID_SpellId{SpellId: 8042}},
+		},
+		auras: map[string]*proto.ActionID{
+			"lightning_shield": {RawId: &proto.ActionID_SpellId{SpellId: 324}},
+		},
+	}
+}
+
+func TestSimcName(t *testing.T) {
+	if name := simcName("Hand of Gul'dan"); name != "hand_of_guldan" {
+		t.Fatalf("Expected hand_of_guldan, found %s", name)
+	}
+	if name := simcName("Soul Swap: Exhale"); name != "soul_swap_exhale" {
+		t.Fatalf("Expected soul_swap_exhale, found %s", name)
+	}
+}
+
+func TestTranslateExpression(t *testing.T) {
+	names := testNameIndex()
+
+	value, err := names.translateExpression("!ticking|remains<2*1.5&buff.lightning_shield.stack>=7", names.spells["flame_shock"])
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	flameShock := names.spells["flame_shock"]
+	target := &proto.UnitReference{Type: proto.UnitReference_CurrentTarget}
+	expected := &proto.APLValue{Value: &proto.APLValue_Or{Or: &proto.APLValueOr{Vals: []*proto.APLValue{
+		{Value: &proto.APLValue_Not{Not: &proto.APLValueNot{
+			Val: &proto.APLValue{Value: &proto.APLValue_DotIsActive{DotIsActive: &proto.APLValueDotIsActive{TargetUnit: target, SpellId: flameShock}}},
+		}}},
+		{Value: &proto.APLValue_And{And: &proto.APLValueAnd{Vals: []*proto.APLValue{
+			{Value: &proto.APLValue_Cmp{Cmp: &proto.APLValueCompare{
+				Op:  proto.APLValueCompare_OpLt,
+				Lhs: &proto.APLValue{Value: &proto.APLValue_DotRemainingTime{DotRemainingTime: &proto.APLValueDotRemainingTime{TargetUnit: target, SpellId: flameShock}}},
+				Rhs: mathValue(proto.APLValueMath_OpMul, constValue("2"), constValue("1.5")),
+			}}},
+			{Value: &proto.APLValue_Cmp{Cmp: &proto.APLValueCompare{
+				Op: proto.APLValueCompare_OpGe,
+				Lhs: &proto.APLValue{Value: &proto.APLValue_AuraNumStacks{AuraNumStacks: &proto.APLValueAuraNumStacks{
+					SourceUnit: &proto.UnitReference{Type: proto.UnitReference_Self},
+					AuraId:     names.auras["lightning_shield"],
+				}}},
+				Rhs: constValue("7"),
+			}}},
+		}}}},
+	}}}}
+	if !googleProto.Equal(value, expected) {
+		t.Fatalf("Unexpected translation: %v", value)
+	}
+
+	for _, unsupported := range []string{"buff.unknown_aura.up", "talent.unleashed_fury.enabled", "energy>?50", "(time>5"} {
+		if _, err := names.translateExpression(unsupported, nil); err == nil {
+			t.Fatalf("Expected %q to be unsupported", unsupported)
+		}
+	}
+}
+
+func TestImportActionList(t *testing.T) {
+	result := testNameIndex().importActionList(`
+# Comments and profile lines are skipped
+level=90
+actions.precombat=flask,type=warm_sun
+actions.precombat+=/potion,name=jade_serpent
+actions=use_item,slot=trinket1
+actions+=/call_action_list,name=aoe,if=active_enemies>2
+actions+=/flame_shock,if=!ticking
+actions+=/lava_burst,line_cd=2
+actions+=/earth_shock,if=buff.lightning_shield.react>=7
+actions.aoe=lava_burst,if=dot.flame_shock.remains>cast_time
+actions.aoe+=/fire_nova
+`)
+
+	if result.Error != nil {
+		t.Fatalf("Unexpected error: %s", result.Error.Message)
+	}
+	rotation := result.Rotation
+
+	if len(rotation.PrepullActions) != 1 || rotation.PrepullActions[0].DoAtValue.GetConst().Val != "-1.5s" {
+		t.Fatalf("Expected only the potion before combat, found %v", rotation.PrepullActions)
+	}
+	if len(rotation.PriorityList) != 4 {
+		t.Fatalf("Expected 4 actions in the priority list, found %d", len(rotation.PriorityList))
+	}
+	if rotation.PriorityList[1].Action.GetGroupReference().GetGroupName() != "aoe" {
+		t.Fatalf("Expected call_action_list to reference the aoe group, found %v", rotation.PriorityList[1].Action)
+	}
+	if len(rotation.Groups) != 1 || len(rotation.Groups[0].Actions) != 1 {
+		t.Fatalf("Expected an aoe group with only the lava burst, found %v", rotation.Groups)
+	}
+
+	if len(result.Unsupported) != 2 {
+		t.Fatalf("Expected line_cd and fire_nova to be unsupported, found %v", result.Unsupported)
+	}
+	if unsupported := result.Unsupported[0]; unsupported.Line != 9 || unsupported.Text != "lava_burst,line_cd=2" {
+		t.Fatalf("Expected the line_cd action on line 9, found %v", unsupported)
+	}
+}
@@ -0,0 +1,316 @@
+package simc
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/wowsims/mop/sim/core"
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+// SimC names spells and auras by their English name in snake case, e.g.
+// "Hand of Gul'dan" is hand_of_guldan.
+func simcName(name string) string {
+	var sb strings.Builder
+	for _, c := range strings.ToLower(name) {
+		switch {
+		case c == ' ' || c == '-':
+			sb.WriteRune('_')
+		case unicode.IsLetter(c) || unicode.IsDigit(c) || c == '_':
+			sb.WriteRune(c)
+		}
+	}
+	return sb.String()
+}
+
+// The action IDs of a player's spells and auras, by SimC name.
+type nameIndex struct {
+	spells map[string]*proto.ActionID
+	auras  map[string]*proto.ActionID
+}
+
+func newNameIndex(player *proto.UnitMetadata, targets []*proto.UnitMetadata) *nameIndex {
+	names := &nameIndex{
+		spells: map[string]*proto.ActionID{},
+		auras:  map[string]*proto.ActionID{},
+	}
+	for _, spell := range player.GetSpells() {
+		names.add(names.spells, spell.Id)
+	}
+	for _, aura := range player.GetAuras() {
+		names.add(names.auras, aura.Id)
+	}
+	for _, target := range targets {
+		for _, aura := range target.GetAuras() {
+			names.add(names.auras, aura.Id)
+		}
+	}
+	return names
+}
+
+// Untagged IDs win over tagged ones, so a spell's name refers to the spell
+// rather than e.g. its overloads.
+func (names *nameIndex) add(index map[string]*proto.ActionID, id *proto.ActionID) {
+	name := simcName(core.ProtoToActionID(id).LocalizedName(core.DefaultLocale))
+	if name == "" {
+		return
+	}
+	if existing, ok := index[name]; ok && (existing.Tag == 0 || id.Tag != 0) {
+		return
+	}
+	index[name] = id
+}
+
+func (names *nameIndex) spell(name string) (*proto.ActionID, error) {
+	if id, ok := names.spells[name]; ok {
+		return id, nil
+	}
+	return nil, fmt.Errorf("unknown spell %q", name)
+}
+
+// Buffs and debuffs are often named after the spell that applies them, so fall
+// back to the spells when there is no aura with the name.
+func (names *nameIndex) aura(name string) (*proto.ActionID, error) {
+	if id, ok := names.auras[name]; ok {
+		return id, nil
+	}
+	if id, ok := names.spells[name]; ok {
+		return id, nil
+	}
+	return nil, fmt.Errorf("unknown aura %q", name)
+}
+
+var (
+	selfUnit   = &proto.UnitReference{Type: proto.UnitReference_Self}
+	targetUnit = &proto.UnitReference{Type: proto.UnitReference_CurrentTarget}
+)
+
+// Translates a SimC expression identifier, e.g. buff.bloodlust.up, into the
+// equivalent APL value.
+func (names *nameIndex) translateIdentifier(identifier string, actionSpell *proto.ActionID) (*proto.APLValue, error) {
+	parts := strings.Split(identifier, ".")
+	switch parts[0] {
+	case "buff", "debuff":
+		if len(parts) == 3 {
+			unit := core.Ternary(parts[0] == "buff", selfUnit, targetUnit)
+			return names.translateAuraExpression(unit, parts[1], parts[2])
+		}
+	case "dot":
+		if len(parts) == 3 {
+			spellID, err := names.spell(parts[1])
+			if err != nil {
+				return nil, err
+			}
+			return translateDotExpression(spellID, parts[2])
+		}
+	case "active_dot":
+		if len(parts) == 2 {
+			spellID, err := names.spell(parts[1])
+			if err != nil {
+				return nil, err
+			}
+			return &proto.APLValue{Value: &proto.APLValue_DotNumActiveTargets{DotNumActiveTargets: &proto.APLValueDotNumActiveTargets{SpellId: spellID}}}, nil
+		}
+	case "cooldown", "action":
+		if len(parts) == 3 {
+			spellID, err := names.spell(parts[1])
+			if err != nil {
+				return nil, err
+			}
+			return translateSpellExpression(spellID, parts[2])
+		}
+	case "prev_gcd":
+		if len(parts) == 3 && parts[1] == "1" {
+			spellID, err := names.spell(parts[2])
+			if err != nil {
+				return nil, err
+			}
+			return &proto.APLValue{Value: &proto.APLValue_PreviousGcdSpellIs{PreviousGcdSpellIs: &proto.APLValuePreviousGcdSpellIs{SpellId: spellID}}}, nil
+		}
+	case "variable":
+		if len(parts) == 2 {
+			return &proto.APLValue{Value: &proto.APLValue_VariableRef{VariableRef: &proto.APLValueVariableRef{Name: parts[1]}}}, nil
+		}
+	}
+
+	if value := translateResourceExpression(identifier); value != nil {
+		return value, nil
+	}
+
+	switch identifier {
+	case "time":
+		return &proto.APLValue{Value: &proto.APLValue_CurrentTime{CurrentTime: &proto.APLValueCurrentTime{}}}, nil
+	case "fight_remains", "time_to_die", "target.time_to_die":
+		return &proto.APLValue{Value: &proto.APLValue_RemainingTime{RemainingTime: &proto.APLValueRemainingTime{}}}, nil
+	case "active_enemies", "spell_targets":
+		return &proto.APLValue{Value: &proto.APLValue_NumberTargets{NumberTargets: &proto.APLValueNumberTargets{}}}, nil
+	case "gcd.remains":
+		return &proto.APLValue{Value: &proto.APLValue_GcdTimeToReady{GcdTimeToReady: &proto.APLValueGCDTimeToReady{}}}, nil
+	case "health.pct":
+		return percentValue(&proto.APLValue{Value: &proto.APLValue_CurrentHealthPercent{CurrentHealthPercent: &proto.APLValueCurrentHealthPercent{SourceUnit: selfUnit}}}), nil
+	case "target.health.pct":
+		return percentValue(&proto.APLValue{Value: &proto.APLValue_CurrentHealthPercent{CurrentHealthPercent: &proto.APLValueCurrentHealthPercent{SourceUnit: targetUnit}}}), nil
+	}
+
+	// Expressions without a spell name refer to the action's own spell.
+	if actionSpell != nil && len(parts) == 1 {
+		switch identifier {
+		case "ticking", "remains", "refreshable":
+			return translateDotExpression(actionSpell, identifier)
+		case "cast_time", "execute_time", "charges", "in_flight":
+			return translateSpellExpression(actionSpell, identifier)
+		}
+	}
+	if strings.HasPrefix(identifier, "spell_targets.") {
+		return &proto.APLValue{Value: &proto.APLValue_NumberTargets{NumberTargets: &proto.APLValueNumberTargets{}}}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported expression %q", identifier)
+}
+
+func (names *nameIndex) translateAuraExpression(unit *proto.UnitReference, auraName string, property string) (*proto.APLValue, error) {
+	auraID, err := names.aura(auraName)
+	if err != nil {
+		return nil, err
+	}
+
+	switch property {
+	case "up":
+		return &proto.APLValue{Value: &proto.APLValue_AuraIsActive{AuraIsActive: &proto.APLValueAuraIsActive{SourceUnit: unit, AuraId: auraID}}}, nil
+	case "down":
+		return &proto.APLValue{Value: &proto.APLValue_AuraIsInactive{AuraIsInactive: &proto.APLValueAuraIsInactive{SourceUnit: unit, AuraId: auraID}}}, nil
+	case "remains":
+		return &proto.APLValue{Value: &proto.APLValue_AuraRemainingTime{AuraRemainingTime: &proto.APLValueAuraRemainingTime{SourceUnit: unit, AuraId: auraID}}}, nil
+	case "stack":
+		return &proto.APLValue{Value: &proto.APLValue_AuraNumStacks{AuraNumStacks: &proto.APLValueAuraNumStacks{SourceUnit: unit, AuraId: auraID}}}, nil
+	case "react":
+		return &proto.APLValue{Value: &proto.APLValue_AuraNumStacks{AuraNumStacks: &proto.APLValueAuraNumStacks{SourceUnit: unit, AuraId: auraID, IncludeReactionTime: true}}}, nil
+	}
+	return nil, fmt.Errorf("unsupported aura property %q", property)
+}
+
+func translateDotExpression(spellID *proto.ActionID, property string) (*proto.APLValue, error) {
+	switch property {
+	case "ticking":
+		return &proto.APLValue{Value: &proto.APLValue_DotIsActive{DotIsActive: &proto.APLValueDotIsActive{TargetUnit: targetUnit, SpellId: spellID}}}, nil
+	case "remains":
+		return &proto.APLValue{Value: &proto.APLValue_DotRemainingTime{DotRemainingTime: &proto.APLValueDotRemainingTime{TargetUnit: targetUnit, SpellId: spellID}}}, nil
+	case "refreshable":
+		return &proto.APLValue{Value: &proto.APLValue_DotInPandemicWindow{DotInPandemicWindow: &proto.APLValueDotInPandemicWindow{TargetUnit: targetUnit, SpellId: spellID}}}, nil
+	case "tick_time":
+		return &proto.APLValue{Value: &proto.APLValue_DotTickFrequency{DotTickFrequency: &proto.APLValueDotTickFrequency{TargetUnit: targetUnit, SpellId: spellID}}}, nil
+	}
+	return nil, fmt.Errorf("unsupported dot property %q", property)
+}
+
+func translateSpellExpression(spellID *proto.ActionID, property string) (*proto.APLValue, error) {
+	switch property {
+	case "up", "ready":
+		return &proto.APLValue{Value: &proto.APLValue_SpellIsReady{SpellIsReady: &proto.APLValueSpellIsReady{SpellId: spellID}}}, nil
+	case "remains":
+		return &proto.APLValue{Value: &proto.APLValue_SpellTimeToReady{SpellTimeToReady: &proto.APLValueSpellTimeToReady{SpellId: spellID}}}, nil
+	case "charges":
+		return &proto.APLValue{Value: &proto.APLValue_SpellNumCharges{SpellNumCharges: &proto.APLValueSpellNumCharges{SpellId: spellID}}}, nil
+	case "recharge_time":
+		return &proto.APLValue{Value: &proto.APLValue_SpellTimeToCharge{SpellTimeToCharge: &proto.APLValueSpellTimeToCharge{SpellId: spellID}}}, nil
+	case "cast_time", "execute_time":
+		return &proto.APLValue{Value: &proto.APLValue_SpellCastTime{SpellCastTime: &proto.APLValueSpellCastTime{SpellId: spellID}}}, nil
+	case "in_flight":
+		return &proto.APLValue{Value: &proto.APLValue_SpellInFlight{SpellInFlight: &proto.APLValueSpellInFlight{SpellId: spellID}}}, nil
+	}
+	return nil, fmt.Errorf("unsupported spell property %q", property)
+}
+
+type resourceValues struct {
+	current func() *proto.APLValue
+	max     func() *proto.APLValue
+	regen   func() *proto.APLValue
+}
+
+var simcResources = map[string]resourceValues{
+	"energy": {
+		current: func() *proto.APLValue {
+			return &proto.APLValue{Value: &proto.APLValue_CurrentEnergy{CurrentEnergy: &proto.APLValueCurrentEnergy{}}}
+		},
+		max: func() *proto.APLValue {
+			return &proto.APLValue{Value: &proto.APLValue_MaxEnergy{MaxEnergy: &proto.APLValueMaxEnergy{}}}
+		},
+		regen: func() *proto.APLValue {
+			return &proto.APLValue{Value: &proto.APLValue_EnergyRegenPerSecond{EnergyRegenPerSecond: &proto.APLValueEnergyRegenPerSecond{}}}
+		},
+	},
+	"focus": {
+		current: func() *proto.APLValue {
+			return &proto.APLValue{Value: &proto.APLValue_CurrentFocus{CurrentFocus: &proto.APLValueCurrentFocus{}}}
+		},
+		max: func() *proto.APLValue {
+			return &proto.APLValue{Value: &proto.APLValue_MaxFocus{MaxFocus: &proto.APLValueMaxFocus{}}}
+		},
+		regen: func() *proto.APLValue {
+			return &proto.APLValue{Value: &proto.APLValue_FocusRegenPerSecond{FocusRegenPerSecond: &proto.APLValueFocusRegenPerSecond{}}}
+		},
+	},
+	"rage": {
+		current: func() *proto.APLValue {
+			return &proto.APLValue{Value: &proto.APLValue_CurrentRage{CurrentRage: &proto.APLValueCurrentRage{}}}
+		},
+		max: func() *proto.APLValue {
+			return &proto.APLValue{Value: &proto.APLValue_MaxRage{MaxRage: &proto.APLValueMaxRage{}}}
+		},
+	},
+	"runic_power": {
+		current: func() *proto.APLValue {
+			return &proto.APLValue{Value: &proto.APLValue_CurrentRunicPower{CurrentRunicPower: &proto.APLValueCurrentRunicPower{}}}
+		},
+		max: func() *proto.APLValue {
+			return &proto.APLValue{Value: &proto.APLValue_MaxRunicPower{MaxRunicPower: &proto.APLValueMaxRunicPower{}}}
+		},
+	},
+	"combo_points": {
+		current: func() *proto.APLValue {
+			return &proto.APLValue{Value: &proto.APLValue_CurrentComboPoints{CurrentComboPoints: &proto.APLValueCurrentComboPoints{}}}
+		},
+		max: func() *proto.APLValue {
+			return &proto.APLValue{Value: &proto.APLValue_MaxComboPoints{MaxComboPoints: &proto.APLValueMaxComboPoints{}}}
+		},
+	},
+	"chi": {
+		current: func() *proto.APLValue {
+			return &proto.APLValue{Value: &proto.APLValue_MonkCurrentChi{MonkCurrentChi: &proto.APLValueMonkCurrentChi{}}}
+		},
+		max: func() *proto.APLValue {
+			return &proto.APLValue{Value: &proto.APLValue_MonkMaxChi{MonkMaxChi: &proto.APLValueMonkMaxChi{}}}
+		},
+	},
+}
+
+// Translates resource expressions like energy, energy.max and energy.deficit,
+// returning nil for anything else.
+func translateResourceExpression(identifier string) *proto.APLValue {
+	if identifier == "mana" {
+		return &proto.APLValue{Value: &proto.APLValue_CurrentMana{CurrentMana: &proto.APLValueCurrentMana{SourceUnit: selfUnit}}}
+	} else if identifier == "mana.pct" {
+		return percentValue(&proto.APLValue{Value: &proto.APLValue_CurrentManaPercent{CurrentManaPercent: &proto.APLValueCurrentManaPercent{SourceUnit: selfUnit}}})
+	}
+
+	resourceName, property, _ := strings.Cut(identifier, ".")
+	resource, ok := simcResources[resourceName]
+	if !ok {
+		return nil
+	}
+
+	switch property {
+	case "":
+		return resource.current()
+	case "max":
+		return resource.max()
+	case "deficit":
+		return mathValue(proto.APLValueMath_OpSub, resource.max(), resource.current())
+	case "regen":
+		if resource.regen != nil {
+			return resource.regen()
+		}
+	}
+	return nil
+}
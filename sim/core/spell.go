@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/wowsims/mop/sim/core/proto"
 	"github.com/wowsims/mop/sim/core/stats"
 )
 
@@ -23,6 +24,11 @@ type SpellConfig struct {
 	MetricSplits   int
 	ClassSpellMask int64
 
+	// Reports the spell's metrics under this action instead of its own, to
+	// merge variants of a spell, e.g. from tier bonuses or procs, into a
+	// single row of the results.
+	MetricsActionID ActionID
+
 	ManaCost   ManaCostOptions
 	EnergyCost EnergyCostOptions
 	RageCost   RageCostOptions
@@ -98,6 +104,12 @@ type Spell struct {
 	ResourceMetrics *ResourceMetrics
 	healthMetrics   []*ResourceMetrics
 
+	// Records use times when the sim is planning cooldowns.
+	cooldownPlan *cooldownPlanTracker
+
+	// Records cast times for cast history lookups.
+	castHistory *castHistoryTracker
+
 	Cost               *SpellCost // Cost for the spell.
 	DefaultCast        Cast       // Default cast parameters with all static effects applied.
 	CD                 Cooldown
@@ -121,7 +133,9 @@ type Spell struct {
 
 	SpellMetrics      []SpellMetrics
 	splitSpellMetrics [][]SpellMetrics // Used to split metrics by some condition.
+	metricsActionID   ActionID         // Action the metrics are reported under, if not the spell's own.
 	casts             int              // Sum of casts on all targets, for efficient CPM calculation
+	costTarget        *Unit            // Target of the latest cast that spent resources, for refund metrics.
 
 	// Performs the actions of this spell.
 	ApplyEffects ApplySpellResults
@@ -158,6 +172,9 @@ type Spell struct {
 	dots   DotArray
 	aoeDot *Dot
 
+	// Breakpoint results for this spell's DoT, which count its applications.
+	dotBreakpoint *proto.DotBreakpoint
+
 	shields    ShieldArray
 	selfShield *Shield
 
@@ -165,6 +182,9 @@ type Spell struct {
 	RelatedAuraArrays LabeledAuraArrays
 	RelatedDotSpell   *Spell
 	RelatedSelfBuff   *Aura
+
+	// Spells cast in place of this one while an aura is active.
+	substitutions []spellSubstitution
 }
 
 func (unit *Unit) OnSpellRegistered(handler SpellRegisteredHandler) {
@@ -252,6 +272,7 @@ func (unit *Unit) RegisterSpell(config SpellConfig) *Spell {
 		FlatThreatBonus:  config.FlatThreatBonus,
 
 		splitSpellMetrics: make([][]SpellMetrics, max(1, config.MetricSplits)),
+		metricsActionID:   config.MetricsActionID,
 
 		RelatedAuraArrays: config.RelatedAuraArrays,
 		RelatedDotSpell:   config.RelatedDotSpell,
@@ -502,6 +523,9 @@ func (spell *Spell) reset(sim *Simulation) {
 		}
 	}
 	spell.casts = 0
+	if spell.castHistory != nil {
+		spell.castHistory.reset()
+	}
 	if spell.rechargeTimer != nil {
 		spell.rechargeTimer.Cancel(sim)
 		spell.rechargeTimer = nil
@@ -536,14 +560,22 @@ func (spell *Spell) doneIteration() {
 	}
 
 	if len(spell.splitSpellMetrics) == 1 {
-		spell.Unit.Metrics.addSpellMetrics(spell, spell.ActionID, spell.SpellMetrics)
+		spell.Unit.Metrics.addSpellMetrics(spell, spell.MetricsActionID(), spell.SpellMetrics)
 	} else {
 		for i, spellMetrics := range spell.splitSpellMetrics {
-			spell.Unit.Metrics.addSpellMetrics(spell, spell.ActionID.WithTag(int32(i)), spellMetrics)
+			spell.Unit.Metrics.addSpellMetrics(spell, spell.MetricsActionID().WithTag(int32(i)), spellMetrics)
 		}
 	}
 }
 
+// Returns the action the spell's metrics are reported under.
+func (spell *Spell) MetricsActionID() ActionID {
+	if spell.metricsActionID.IsEmptyAction() {
+		return spell.ActionID
+	}
+	return spell.metricsActionID
+}
+
 func (spell *Spell) HealthMetrics(target *Unit) *ResourceMetrics {
 	if spell.healthMetrics == nil {
 		spell.healthMetrics = make([]*ResourceMetrics, len(spell.Unit.AttackTables))
@@ -595,6 +627,11 @@ func (spell *Spell) CanCast(sim *Simulation, target *Unit) bool {
 		return false
 	}
 
+	// Interrupts lock out every spell of the interrupted school
+	if spell.Unit.IsLockedOut(sim, spell.SpellSchool) {
+		return false
+	}
+
 	// While casting or channeling, no other action is possible
 	if (spell.Unit.Hardcast.Expires > sim.CurrentTime) || (spell.Unit.IsCastingDuringChannel() && !spell.CanCastDuringChannel(sim)) {
 		//if sim.Log != nil {
@@ -720,6 +757,13 @@ func (spell *Spell) applyEffects(sim *Simulation, target *Unit) {
 	spell.SpellMetrics[target.UnitIndex].Casts++
 	spell.casts++
 
+	if spell.cooldownPlan != nil {
+		spell.cooldownPlan.record(sim)
+	}
+	if spell.castHistory != nil {
+		spell.castHistory.record(sim)
+	}
+
 	// Not sure if we want to split this flag into its own?
 	// Both are used to optimize away unneccesery calls and 99%
 	// of the time are gonna be used together. For now just in one
@@ -758,6 +802,29 @@ func (spell *Spell) ExpectedTickDamageFromCurrentSnapshot(sim *Simulation, targe
 	return result.Damage
 }
 
+func (spell *Spell) HasExpectedDamage() bool {
+	return spell.expectedInitialDamageInternal != nil || spell.expectedTickDamageInternal != nil
+}
+
+// Average damage of a new cast on the target with the current stats and
+// multipliers, including all ticks of the DoT it applies.
+func (spell *Spell) ExpectedDamage(sim *Simulation, target *Unit) float64 {
+	damage := 0.0
+	if spell.expectedInitialDamageInternal != nil {
+		damage += spell.ExpectedInitialDamage(sim, target)
+	}
+	if spell.expectedTickDamageInternal != nil {
+		tickCount := int32(1)
+		if dot := spell.Dot(target); dot != nil {
+			tickCount = dot.ExpectedTickCount()
+		} else if spell.aoeDot != nil {
+			tickCount = spell.aoeDot.ExpectedTickCount()
+		}
+		damage += spell.ExpectedTickDamage(sim, target) * float64(tickCount)
+	}
+	return damage
+}
+
 func (spell *Spell) CritDamageMultiplier() float64 {
 	return ((spell.CritMultiplier*spell.Unit.PseudoStats.CritDamageMultiplier)-1)*(spell.CritMultiplierAdditive+1) + 1
 }
@@ -828,6 +895,23 @@ func (spell *Spell) IssueRefund(sim *Simulation) {
 	spell.Cost.IssueRefund(sim, spell)
 }
 
+// Spends the cost of the current cast, recording it in the spell's metrics.
+func (spell *Spell) spendCost(sim *Simulation, target *Unit) {
+	spell.Cost.SpendCost(sim, spell)
+	if target != nil {
+		spell.SpellMetrics[target.UnitIndex].TotalCost += max(0, spell.CurCast.Cost)
+		spell.costTarget = target
+	}
+}
+
+// Records resources refunded to the spell's latest cast in its metrics. Called
+// by cost implementations when issuing refunds.
+func (spell *Spell) RecordRefund(amount float64) {
+	if spell.costTarget != nil && amount > 0 {
+		spell.SpellMetrics[spell.costTarget.UnitIndex].TotalRefunds += amount
+	}
+}
+
 func (spell *Spell) ConsumeCharge(sim *Simulation) {
 	if spell.MaxCharges == 0 {
 		return
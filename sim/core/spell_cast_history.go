@@ -0,0 +1,46 @@
+package core
+
+import (
+	"sort"
+	"time"
+)
+
+// Records the time of each cast of a spell in the current iteration. Only
+// allocated for spells that something asked the history of, e.g. APL values.
+type castHistoryTracker struct {
+	times []time.Duration
+}
+
+func (tracker *castHistoryTracker) record(sim *Simulation) {
+	// Spells cast on every target still only count as a single cast.
+	if n := len(tracker.times); n > 0 && tracker.times[n-1] == sim.CurrentTime {
+		return
+	}
+	tracker.times = append(tracker.times, sim.CurrentTime)
+}
+
+func (tracker *castHistoryTracker) reset() {
+	tracker.times = tracker.times[:0]
+}
+
+// Starts recording the casts of this spell, so CastsInWindow can be used.
+func (spell *Spell) TrackCastHistory() {
+	if spell.castHistory == nil {
+		spell.castHistory = &castHistoryTracker{}
+	}
+}
+
+// Returns the number of casts of this spell within the last window, including
+// casts at the current time. TrackCastHistory must have been called first.
+func (spell *Spell) CastsInWindow(sim *Simulation, window time.Duration) int {
+	times := spell.castHistory.times
+	windowStart := sim.CurrentTime - window
+	return len(times) - sort.Search(len(times), func(i int) bool {
+		return times[i] >= windowStart
+	})
+}
+
+// Returns the most recent spell that triggered the GCD, or nil if none was cast yet.
+func (unit *Unit) PreviousGCDSpell() *Spell {
+	return unit.previousGCDSpell
+}
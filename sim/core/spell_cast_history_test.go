@@ -0,0 +1,37 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCastsInWindow(t *testing.T) {
+	sim := &Simulation{}
+	spell := &Spell{}
+	spell.TrackCastHistory()
+
+	for _, castTime := range []time.Duration{time.Second, time.Second * 4, time.Second * 4, time.Second * 9} {
+		sim.CurrentTime = castTime
+		spell.castHistory.record(sim)
+	}
+
+	sim.CurrentTime = time.Second * 10
+	for _, tc := range []struct {
+		window   time.Duration
+		expected int
+	}{
+		{time.Second, 1},
+		{time.Second * 6, 2},
+		{time.Second * 9, 3},
+		{time.Second * 30, 3},
+	} {
+		if casts := spell.CastsInWindow(sim, tc.window); casts != tc.expected {
+			t.Errorf("Expected %d casts within %s, found %d", tc.expected, tc.window, casts)
+		}
+	}
+
+	spell.castHistory.reset()
+	if casts := spell.CastsInWindow(sim, time.Minute); casts != 0 {
+		t.Fatalf("Expected no casts after reset, found %d", casts)
+	}
+}
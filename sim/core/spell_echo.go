@@ -0,0 +1,89 @@
+package core
+
+import (
+	"time"
+)
+
+// Configures an effect that recasts spells for free after they land, like
+// Echo of the Elements.
+type SpellEchoConfig struct {
+	Label string
+
+	// Added to every echo, so echoes can't echo again and other effects can
+	// choose to ignore them.
+	Flag SpellFlag
+
+	// Returns the action ID tag for the echoes of a spell. Echoes need a tag of
+	// their own so they are listed separately from the original in metrics.
+	Tag func(spell *Spell) int32
+
+	// Returns the chance for a landed spell to echo, or 0 if it can't echo.
+	ProcChance func(spell *Spell) float64
+
+	// Optional, for changes to the config of an echo before it is registered.
+	ModifyEcho func(spell *Spell, config *SpellConfig)
+}
+
+// Registers a permanent aura which echoes spells as configured. A spell can
+// echo at most once per timestamp, so spells which hit several targets at once
+// only echo on one of them. Echoes are not counted as casts in the metrics.
+func (unit *Unit) RegisterSpellEcho(config SpellEchoConfig) *Aura {
+	if config.Flag == SpellFlagNone || config.Tag == nil || config.ProcChance == nil {
+		panic("Spell echo " + config.Label + " needs a flag, tag and proc chance")
+	}
+
+	echoes := map[*Spell]*Spell{}
+	echoedAt := map[*Spell]time.Duration{}
+
+	return MakePermanent(unit.GetOrRegisterAura(Aura{
+		Label: config.Label,
+		OnReset: func(aura *Aura, sim *Simulation) {
+			clear(echoedAt)
+		},
+		OnSpellHitDealt: func(aura *Aura, sim *Simulation, spell *Spell, result *SpellResult) {
+			if !result.Landed() || spell.Flags.Matches(config.Flag) {
+				return
+			}
+			if lastEcho, ok := echoedAt[spell]; ok && lastEcho == sim.CurrentTime {
+				return
+			}
+
+			procChance := config.ProcChance(spell)
+			if procChance <= 0 || !sim.Proc(procChance, config.Label) {
+				return
+			}
+			echoedAt[spell] = sim.CurrentTime
+
+			echo := echoes[spell]
+			if echo == nil {
+				echo = unit.registerEchoSpell(spell, config)
+				echoes[spell] = echo
+			}
+			echo.SpellMetrics[result.Target.UnitIndex].Casts--
+			echo.Cast(sim, result.Target)
+		},
+	}))
+}
+
+func (unit *Unit) registerEchoSpell(spell *Spell, config SpellEchoConfig) *Spell {
+	echoConfig := SpellConfig{
+		ActionID:                 spell.ActionID.WithTag(config.Tag(spell)),
+		SpellSchool:              spell.SpellSchool,
+		ProcMask:                 ProcMaskSpellProc,
+		Flags:                    spell.Flags&^SpellFlagAPL | config.Flag,
+		ClassSpellMask:           spell.ClassSpellMask,
+		MissileSpeed:             spell.MissileSpeed,
+		DamageMultiplier:         1,
+		DamageMultiplierAdditive: 1,
+		CritMultiplier:           spell.CritMultiplier,
+		BonusCritPercent:         spell.BonusCritPercent,
+		BonusCoefficient:         spell.BonusCoefficient,
+		ThreatMultiplier:         spell.ThreatMultiplier,
+		RelatedDotSpell:          spell.RelatedDotSpell,
+		ApplyEffects:             spell.ApplyEffects,
+	}
+	if config.ModifyEcho != nil {
+		config.ModifyEcho(spell, &echoConfig)
+	}
+	return unit.RegisterSpell(echoConfig)
+}
@@ -0,0 +1,54 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestRegisterEchoSpell(t *testing.T) {
+	unit := Unit{
+		Type:        PlayerUnit,
+		Level:       CharacterLevel,
+		auraTracker: newAuraTracker(),
+	}
+	spell := unit.RegisterSpell(SpellConfig{
+		ActionID:         ActionID{SpellID: 1},
+		SpellSchool:      SpellSchoolNature,
+		ProcMask:         ProcMaskSpellDamage,
+		Flags:            SpellFlagAPL | SpellFlagAgentReserved1,
+		ClassSpellMask:   4,
+		DamageMultiplier: 1,
+		CritMultiplier:   2,
+		BonusCoefficient: 0.5,
+	})
+
+	config := SpellEchoConfig{
+		Label: "Test Echo",
+		Flag:  SpellFlagAgentReserved2,
+		Tag: func(spell *Spell) int32 {
+			return 7
+		},
+		ProcChance: func(spell *Spell) float64 {
+			return 1
+		},
+		ModifyEcho: func(spell *Spell, config *SpellConfig) {
+			config.DamageMultiplier = 0.75
+		},
+	}
+	echo := unit.registerEchoSpell(spell, config)
+
+	if echo.ActionID != spell.ActionID.WithTag(7) {
+		t.Fatalf("Expected the echo to be tagged, found %s", echo.ActionID)
+	}
+	if echo.Flags.Matches(SpellFlagAPL) || !echo.Flags.Matches(SpellFlagAgentReserved1|SpellFlagAgentReserved2) {
+		t.Fatalf("Expected the echo to keep the spell's flags except APL, and gain the echo flag")
+	}
+	if echo.ProcMask != ProcMaskSpellProc {
+		t.Fatalf("Expected the echo to be a proc, found %s", echo.ProcMask)
+	}
+	if echo.ClassSpellMask != spell.ClassSpellMask || echo.CritMultiplier != 2 || echo.BonusCoefficient != 0.5 {
+		t.Fatalf("Expected the echo to copy the spell's class mask, crit multiplier and coefficient")
+	}
+	if echo.DamageMultiplier != 0.75 {
+		t.Fatalf("Expected ModifyEcho to apply, found damage multiplier %f", echo.DamageMultiplier)
+	}
+}
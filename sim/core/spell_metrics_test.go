@@ -0,0 +1,79 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestMetricsActionID(t *testing.T) {
+	sim := SetupFakeSim()
+	unit := &sim.Raid.Parties[0].Players[0].(*FakeAgent).Unit
+
+	newSpell := func(actionID ActionID, metricsActionID ActionID, flags SpellFlag, damage float64) *Spell {
+		spell := &Spell{
+			ActionID:        actionID,
+			Unit:            unit,
+			Flags:           flags,
+			metricsActionID: metricsActionID,
+			SpellMetrics:    make([]SpellMetrics, len(unit.AttackTables)),
+		}
+		spell.splitSpellMetrics = [][]SpellMetrics{spell.SpellMetrics}
+		spell.SpellMetrics[0].Casts = 1
+		spell.SpellMetrics[0].TotalDamage = damage
+		return spell
+	}
+
+	parent := ActionID{SpellID: 1}
+	newSpell(ActionID{SpellID: 2}, parent, SpellFlagPassiveSpell, 100).doneIteration()
+	newSpell(parent, ActionID{}, 0, 200).doneIteration()
+	newSpell(ActionID{SpellID: 3}, ActionID{}, 0, 300).doneIteration()
+
+	if _, ok := unit.Metrics.actions[ActionID{SpellID: 2}]; ok {
+		t.Fatalf("Expected no metrics under the aliased spell's own action")
+	}
+	merged := unit.Metrics.actions[parent]
+	if merged == nil || merged.IsPassive {
+		t.Fatalf("Expected the merged metrics to be reported as a cast action")
+	}
+	if tam := merged.Targets[0]; tam.Damage != 300 || tam.Casts != 1 {
+		t.Fatalf("Expected 300 damage and 1 cast for the merged action, found %f and %d", tam.Damage, tam.Casts)
+	}
+	if tam := unit.Metrics.actions[ActionID{SpellID: 3}].Targets[0]; tam.Damage != 300 {
+		t.Fatalf("Expected other spells to be unaffected")
+	}
+}
+
+type fakeResourceCost struct{}
+
+func (fakeResourceCost) MeetsRequirement(_ *Simulation, _ *Spell) bool    { return true }
+func (fakeResourceCost) CostFailureReason(_ *Simulation, _ *Spell) string { return "" }
+func (fakeResourceCost) SpendCost(_ *Simulation, _ *Spell)                {}
+func (fakeResourceCost) IssueRefund(_ *Simulation, spell *Spell) {
+	spell.RecordRefund(spell.CurCast.Cost * 0.8)
+}
+
+func TestResourceCostMetrics(t *testing.T) {
+	sim := SetupFakeSim()
+	unit := &sim.Raid.Parties[0].Players[0].(*FakeAgent).Unit
+	target := sim.Encounter.ActiveTargetUnits[0]
+
+	spell := &Spell{
+		ActionID:     ActionID{SpellID: 1},
+		Unit:         unit,
+		SpellMetrics: make([]SpellMetrics, len(sim.AllUnits)),
+	}
+	spell.splitSpellMetrics = [][]SpellMetrics{spell.SpellMetrics}
+	spell.Cost = &SpellCost{spell: spell, ResourceCostImpl: fakeResourceCost{}}
+
+	// A landed cast and a missed cast refunding 80% of its cost.
+	spell.CurCast.Cost = 30
+	spell.spendCost(sim, target)
+	spell.spendCost(sim, target)
+	spell.IssueRefund(sim)
+	spell.SpellMetrics[target.UnitIndex].Casts = 2
+	spell.doneIteration()
+
+	tam := unit.Metrics.actions[spell.ActionID].Targets[target.UnitIndex]
+	if tam.ResourceCost != 60 || tam.ResourceRefunded != 24 {
+		t.Fatalf("Expected 60 resources spent and 24 refunded, found %f and %f", tam.ResourceCost, tam.ResourceRefunded)
+	}
+}
@@ -67,14 +67,26 @@ func (unit *Unit) QueueSpell(sim *Simulation, spell *Spell, target *Unit, queueA
 	}
 }
 
+func (qs *QueuedSpell) isPending() bool {
+	return (qs != nil) && (qs.queueAction != nil) && !qs.queueAction.consumed && !qs.queueAction.cancelled
+}
+
+// How far ahead spells can be queued. Units without a spell queue window of
+// their own get the maximum window the game allows.
+func (unit *Unit) spellQueueLeniency() time.Duration {
+	if unit.SpellQueueWindow > 0 {
+		return unit.SpellQueueWindow
+	}
+	return MaxSpellQueueWindow
+}
+
 // Enforce only one queued spell per timestep
 func (unit *Unit) CanQueueSpell(sim *Simulation) bool {
 	return (unit.QueuedSpell == nil) || (unit.QueuedSpell.QueueInitiatedAt != sim.CurrentTime)
 }
 
 // Returns whether the spell could be queued by the player at the current time using the
-// game's spell queueing functionality. Assumes the maximum spell queue window of 400ms
-// that the game allows.
+// game's spell queueing functionality, within the unit's spell queue window.
 func (spell *Spell) CanQueue(sim *Simulation, target *Unit) bool {
 	if spell == nil {
 		return false
@@ -89,17 +101,17 @@ func (spell *Spell) CanQueue(sim *Simulation, target *Unit) bool {
 	}
 
 	// Apply SQW leniency to any pending hardcasts
-	if (spell.Unit.Hardcast.Expires > sim.CurrentTime+MaxSpellQueueWindow) || (spell.Unit.IsCastingDuringChannel() && !spell.CanCastDuringChannel(sim)) {
+	if (spell.Unit.Hardcast.Expires > sim.CurrentTime+spell.Unit.spellQueueLeniency()) || (spell.Unit.IsCastingDuringChannel() && !spell.CanCastDuringChannel(sim)) {
 		return false
 	}
 
 	// Apply SQW leniency to GCD timer
-	if spell.DefaultCast.GCD > 0 && spell.Unit.GCD.TimeToReady(sim) > MaxSpellQueueWindow {
+	if spell.DefaultCast.GCD > 0 && spell.Unit.GCD.TimeToReady(sim) > spell.Unit.spellQueueLeniency() {
 		return false
 	}
 
 	// Spells that are within one SQW of coming off cooldown can also be queued
-	if MaxTimeToReady(spell.CD.Timer, spell.SharedCD.Timer, sim) > MaxSpellQueueWindow {
+	if MaxTimeToReady(spell.CD.Timer, spell.SharedCD.Timer, sim) > spell.Unit.spellQueueLeniency() {
 		return false
 	}
 
@@ -0,0 +1,35 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSpellQueueLeniency(t *testing.T) {
+	unit := &Unit{}
+	if leniency := unit.spellQueueLeniency(); leniency != MaxSpellQueueWindow {
+		t.Fatalf("Expected units without a spell queue window to use the maximum window, found %s", leniency)
+	}
+
+	unit.SpellQueueWindow = time.Millisecond * 100
+	if leniency := unit.spellQueueLeniency(); leniency != time.Millisecond*100 {
+		t.Fatalf("Expected the unit's spell queue window of 100ms, found %s", leniency)
+	}
+}
+
+func TestQueuedSpellIsPending(t *testing.T) {
+	var qs *QueuedSpell
+	if qs.isPending() {
+		t.Fatalf("Expected no pending spell without a queue")
+	}
+
+	qs = &QueuedSpell{queueAction: &PendingAction{}}
+	if !qs.isPending() {
+		t.Fatalf("Expected the queued spell to be pending")
+	}
+
+	qs.queueAction.consumed = true
+	if qs.isPending() {
+		t.Fatalf("Expected a queued spell which went off to no longer be pending")
+	}
+}
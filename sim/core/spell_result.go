@@ -585,6 +585,8 @@ func (spell *Spell) dealHealingInternal(sim *Simulation, isPeriodic bool, result
 	spell.SpellMetrics[result.Target.UnitIndex].TotalHealing += result.Damage
 	spell.SpellMetrics[result.Target.UnitIndex].TotalThreat += result.Threat
 	if result.Target.HasHealthBar() {
+		missingHealth := result.Target.MaxHealth() - result.Target.CurrentHealth()
+		spell.SpellMetrics[result.Target.UnitIndex].TotalOverhealing += max(0, result.Damage-missingHealth)
 		result.Target.GainHealth(sim, result.Damage, spell.HealthMetrics(result.Target))
 	}
 
@@ -683,6 +685,9 @@ func (spell *Spell) TargetDamageMultiplier(sim *Simulation, attackTable *AttackT
 	if spell.Flags.Matches(SpellFlagIgnoreTargetModifiers) {
 		return 1
 	}
+	if attackTable.Defender.PseudoStats.ImmuneToDamage {
+		return 0
+	}
 
 	multiplier := attackTable.Defender.PseudoStats.DamageTakenMultiplier *
 		attackTable.Defender.PseudoStats.SchoolDamageTakenMultiplier[spell.SchoolIndex] *
@@ -700,6 +705,10 @@ func (spell *Spell) TargetDamageMultiplier(sim *Simulation, attackTable *AttackT
 		multiplier *= attackTable.RangedDamageTakenMultiplier
 	}
 
+	if attackTable.Defender.PlayerLike || attackTable.Attacker.PlayerLike {
+		multiplier *= pvpDamageMultiplier(attackTable.Attacker, attackTable.Defender)
+	}
+
 	if attackTable.DamageDoneByCasterMultiplier != nil {
 		multiplier *= attackTable.DamageDoneByCasterMultiplier(sim, spell, attackTable)
 	}
@@ -0,0 +1,32 @@
+package core
+
+// A spell that is cast in place of another while an aura is active, like the
+// action bar changing when entering a form or stance.
+type spellSubstitution struct {
+	aura  *Aura
+	spell *Spell
+}
+
+// Casts of this spell from the APL will cast substitute instead while aura is
+// active. Substitutions are checked in registration order.
+func (spell *Spell) RegisterSubstitution(aura *Aura, substitute *Spell) {
+	if aura == nil || substitute == nil {
+		panic("Spell substitution for " + spell.ActionID.String() + " needs an aura and a substitute spell")
+	}
+
+	spell.substitutions = append(spell.substitutions, spellSubstitution{
+		aura:  aura,
+		spell: substitute,
+	})
+}
+
+// Returns the spell that is currently cast in place of this one, or the spell
+// itself if no substitution is active.
+func (spell *Spell) Substituted() *Spell {
+	for _, substitution := range spell.substitutions {
+		if substitution.aura.IsActive() {
+			return substitution.spell
+		}
+	}
+	return spell
+}
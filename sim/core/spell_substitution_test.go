@@ -0,0 +1,37 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestSpellSubstitution(t *testing.T) {
+	sim := &Simulation{}
+
+	unit := Unit{
+		Type:        PlayerUnit,
+		Level:       CharacterLevel,
+		auraTracker: newAuraTracker(),
+	}
+	form := unit.RegisterAura(Aura{
+		Label:    "Test Form",
+		Duration: NeverExpires,
+	})
+
+	spell := &Spell{ActionID: ActionID{SpellID: 1}, Unit: &unit}
+	substitute := &Spell{ActionID: ActionID{SpellID: 2}, Unit: &unit}
+	spell.RegisterSubstitution(form, substitute)
+
+	if spell.Substituted() != spell {
+		t.Fatalf("Expected no substitution outside of the form")
+	}
+
+	form.Activate(sim)
+	if spell.Substituted() != substitute {
+		t.Fatalf("Expected %s to be substituted while in the form", spell.ActionID)
+	}
+
+	form.Deactivate(sim)
+	if spell.Substituted() != spell {
+		t.Fatalf("Expected the substitution to end with the form")
+	}
+}
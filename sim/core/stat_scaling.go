@@ -0,0 +1,135 @@
+package core
+
+import (
+	"time"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/core/simsignals"
+	"github.com/wowsims/mop/sim/core/stats"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+const defaultStatScalingStep = 1000.0
+const defaultStatScalingNumSteps = 2
+
+var defaultScalingSecondaryStats = []stats.Stat{
+	stats.HitRating,
+	stats.ExpertiseRating,
+	stats.CritRating,
+	stats.HasteRating,
+	stats.MasteryRating,
+}
+
+// Sims the player on a grid of bonus stats around their current stats, and
+// normalizes the base DPS by the player's final main stat and power. RNG is
+// fixed across sims, so the curves aren't distorted by iteration noise.
+func runStatScaling(request *proto.StatScalingRequest, signals simsignals.Signals) *proto.StatScalingResult {
+	step := request.Step
+	if step <= 0 {
+		step = defaultStatScalingStep
+	}
+	numSteps := int(request.NumSteps)
+	if numSteps <= 0 {
+		numSteps = defaultStatScalingNumSteps
+	}
+
+	simOptions := googleProto.Clone(request.SimOptions).(*proto.SimOptions)
+	simOptions.SaveAllValues = true
+	simOptions.UseLabeledRands = true
+	if simOptions.RandomSeed == 0 {
+		simOptions.RandomSeed = time.Now().UnixNano()
+	}
+
+	newRequest := func(stat stats.Stat, bonus float64) *proto.RaidSimRequest {
+		player := googleProto.Clone(request.Player).(*proto.Player)
+		if player.BonusStats == nil {
+			player.BonusStats = &proto.UnitStats{}
+		}
+		if player.BonusStats.Stats == nil {
+			player.BonusStats.Stats = make([]float64, stats.ProtoStatsLen)
+		}
+		player.BonusStats.Stats[stat] += bonus
+
+		raidProto := SinglePlayerRaidProto(player, request.PartyBuffs, request.RaidBuffs, request.Debuffs)
+		raidProto.Tanks = request.Tanks
+
+		return &proto.RaidSimRequest{
+			Raid:       raidProto,
+			Encounter:  request.Encounter,
+			SimOptions: simOptions,
+		}
+	}
+
+	raidProto := SinglePlayerRaidProto(googleProto.Clone(request.Player).(*proto.Player), request.PartyBuffs, request.RaidBuffs, request.Debuffs)
+	env, _, _ := NewEnvironment(raidProto, request.Encounter, false)
+	character := env.Raid.Parties[0].Players[0].GetCharacter()
+	mainStat := character.GetHighestStatType([]stats.Stat{stats.Strength, stats.Agility, stats.Intellect})
+	finalStats := character.GetStats()
+
+	simFunc := runSimConcurrent
+	// Don't use go threads in wasm, it just adds more overhead and makes the worker more unresponsive.
+	if IsRunningInWasm() {
+		simFunc = RunSim
+	}
+
+	baseResult := simFunc(newRequest(mainStat, 0), nil, signals)
+	if baseResult.Error != nil {
+		return &proto.StatScalingResult{Error: baseResult.Error}
+	}
+	basePlayer := baseResult.RaidMetrics.Parties[0].Players[0]
+	baseDps := basePlayer.Dps.Avg
+
+	attackPower := max(finalStats[stats.AttackPower], finalStats[stats.RangedAttackPower])
+	result := &proto.StatScalingResult{
+		BaseDps:           baseDps,
+		BaseDpsStdev:      basePlayer.Dps.Stdev,
+		MainStat:          proto.Stat(mainStat),
+		MainStatValue:     finalStats[mainStat],
+		DpsPerMainStat:    baseDps / max(finalStats[mainStat], 1),
+		SpellPower:        finalStats[stats.SpellPower],
+		DpsPerSpellPower:  baseDps / max(finalStats[stats.SpellPower], 1),
+		AttackPower:       attackPower,
+		DpsPerAttackPower: baseDps / max(attackPower, 1),
+	}
+
+	scaledStats := stats.ProtoArrayToStatsList(request.Stats)
+	if len(scaledStats) == 0 {
+		scaledStats = append([]stats.Stat{mainStat}, defaultScalingSecondaryStats...)
+	}
+
+	for _, stat := range scaledStats {
+		statStep := step
+		// Main stats are worth about twice as much as secondary stats.
+		if stat <= stats.Intellect {
+			statStep /= 2
+		}
+
+		curve := &proto.StatScalingCurve{Stat: proto.Stat(stat)}
+		for i := -numSteps; i <= numSteps; i++ {
+			if i == 0 {
+				curve.Points = append(curve.Points, &proto.StatScalingPoint{
+					Dps:      baseDps,
+					DpsStdev: basePlayer.Dps.Stdev,
+				})
+				continue
+			}
+
+			bonus := statStep * float64(i)
+			simResult := simFunc(newRequest(stat, bonus), nil, signals)
+			if simResult.Error != nil {
+				return &proto.StatScalingResult{Error: simResult.Error}
+			}
+			player := simResult.RaidMetrics.Parties[0].Players[0]
+
+			curve.Points = append(curve.Points, &proto.StatScalingPoint{
+				Bonus:       bonus,
+				Dps:         player.Dps.Avg,
+				DpsStdev:    player.Dps.Stdev,
+				DpsPerPoint: (player.Dps.Avg - baseDps) / bonus,
+			})
+		}
+		result.Curves = append(result.Curves, curve)
+	}
+
+	return result
+}
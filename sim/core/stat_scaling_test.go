@@ -0,0 +1,40 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestComputeStatScaling(t *testing.T) {
+	result := ComputeStatScaling(&proto.StatScalingRequest{
+		Player:     newRotationTestPlayer(newFillerRotation()),
+		RaidBuffs:  &proto.RaidBuffs{},
+		PartyBuffs: &proto.PartyBuffs{},
+		Debuffs:    &proto.Debuffs{},
+		Encounter:  MakeSingleTargetEncounter(0),
+		SimOptions: &proto.SimOptions{Iterations: 20, IsTest: true, RandomSeed: 101},
+		Stats:      []proto.Stat{proto.Stat_StatIntellect, proto.Stat_StatHasteRating},
+		NumSteps:   1,
+	})
+	if result.Error != nil {
+		t.Fatalf("Stat scaling failed: %s", result.Error.Message)
+	}
+
+	if result.MainStat != proto.Stat_StatIntellect || result.DpsPerMainStat <= 0 || result.DpsPerSpellPower <= 0 {
+		t.Fatalf("Expected DPS normalized by intellect and spell power, got %v", result)
+	}
+	if len(result.Curves) != 2 || len(result.Curves[0].Points) != 3 {
+		t.Fatalf("Expected 2 curves with 3 points each, got %v", result.Curves)
+	}
+
+	for _, curve := range result.Curves {
+		lower, current, higher := curve.Points[0], curve.Points[1], curve.Points[2]
+		if current.Bonus != 0 || current.Dps != result.BaseDps || lower.Bonus >= 0 || higher.Bonus <= 0 {
+			t.Fatalf("Expected points sorted around the current stats, got %v", curve.Points)
+		}
+		if lower.DpsPerPoint <= 0 || higher.DpsPerPoint <= 0 {
+			t.Fatalf("Expected %s to increase DPS, got %v", curve.Stat, curve.Points)
+		}
+	}
+}
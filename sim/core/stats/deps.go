@@ -101,6 +101,11 @@ func (dep *StatDependency) UpdateValue(newAmount float64) {
 type StatDependencyManager struct {
 	deps      []*StatDependency
 	finalized bool
+
+	// Subset of deps which are currently enabled, in the same order. Kept up
+	// to date whenever a dep is added or toggled, since applying deps is far
+	// more frequent than toggling them.
+	activeDeps []*StatDependency
 }
 
 func NewStatDependencyManager() StatDependencyManager {
@@ -123,6 +128,7 @@ func (sdm *StatDependencyManager) AddStatDependency(src Stat, dst Stat, amount f
 		dst:     dst,
 		amount:  amount,
 	})
+	sdm.updateActiveDeps()
 }
 
 func (sdm *StatDependencyManager) MultiplyStat(s Stat, amount float64) {
@@ -138,6 +144,7 @@ func (sdm *StatDependencyManager) MultiplyStat(s Stat, amount float64) {
 		dst:     s,
 		amount:  amount,
 	})
+	sdm.updateActiveDeps()
 }
 
 func (sdm *StatDependencyManager) NewDynamicStatDependency(src Stat, dst Stat, amount float64) *StatDependency {
@@ -221,6 +228,16 @@ func (sdm *StatDependencyManager) sortDeps() {
 	}
 
 	sdm.deps = deps
+	sdm.updateActiveDeps()
+}
+
+func (sdm *StatDependencyManager) updateActiveDeps() {
+	sdm.activeDeps = sdm.activeDeps[:0]
+	for _, dep := range sdm.deps {
+		if dep.enabled {
+			sdm.activeDeps = append(sdm.activeDeps, dep)
+		}
+	}
 }
 
 func (sdm *StatDependencyManager) FinalizeStatDeps() {
@@ -237,6 +254,7 @@ func (sdm *StatDependencyManager) ResetStatDeps() {
 			dep.enabled = false
 		}
 	}
+	sdm.updateActiveDeps()
 }
 
 func (sdm *StatDependencyManager) IsFinalized() bool {
@@ -244,13 +262,17 @@ func (sdm *StatDependencyManager) IsFinalized() bool {
 }
 
 func (sdm *StatDependencyManager) ApplyStatDependencies(s Stats) Stats {
-	for _, dep := range sdm.deps {
-		if dep.enabled {
-			if dep.src == dep.dst {
-				s[dep.dst] *= dep.amount
-			} else {
-				s[dep.dst] += s[dep.src] * dep.amount
-			}
+	for _, dep := range sdm.activeDeps {
+		// Most dynamic bonuses only touch a few stats, so skip deps which
+		// can't change anything.
+		if s[dep.src] == 0 {
+			continue
+		}
+
+		if dep.src == dep.dst {
+			s[dep.dst] *= dep.amount
+		} else {
+			s[dep.dst] += s[dep.src] * dep.amount
 		}
 	}
 	return s
@@ -265,6 +287,7 @@ func (sdm *StatDependencyManager) SortAndApplyStatDependencies(s Stats) Stats {
 func (sdm *StatDependencyManager) EnableDynamicStatDep(dep *StatDependency) bool {
 	if !dep.enabled {
 		dep.enabled = true
+		sdm.updateActiveDeps()
 		return true
 	}
 	return false
@@ -274,6 +297,7 @@ func (sdm *StatDependencyManager) EnableDynamicStatDep(dep *StatDependency) bool
 func (sdm *StatDependencyManager) DisableDynamicStatDep(dep *StatDependency) bool {
 	if dep.enabled {
 		dep.enabled = false
+		sdm.updateActiveDeps()
 		return true
 	}
 	return false
@@ -108,3 +108,57 @@ func TestMultipleStatDep(t *testing.T) {
 		t.Fatalf("Stats do not match:\nActual: %s\nExpected: %s", result, expectedResult)
 	}
 }
+
+func TestDynamicStatDepToggleAfterFinalize(t *testing.T) {
+	sdm := NewStatDependencyManager()
+
+	sdm.MultiplyStat(Agility, 1.25)
+	sdm.AddStatDependency(Agility, AttackPower, 2)
+	dep := sdm.NewDynamicMultiplyStat(Agility, 1.5)
+	sdm.FinalizeStatDeps()
+
+	bonus := Stats{Agility: 100}
+
+	sdm.EnableDynamicStatDep(dep)
+	result := sdm.ApplyStatDependencies(bonus)
+	expectedResult := Stats{
+		Agility:     100 * 1.25 * 1.5,
+		AttackPower: 100 * 1.25 * 1.5 * 2,
+	}
+	if !result.Equals(expectedResult) {
+		t.Fatalf("Stats do not match:\nActual: %s\nExpected: %s", result, expectedResult)
+	}
+
+	sdm.DisableDynamicStatDep(dep)
+	result = sdm.ApplyStatDependencies(bonus)
+	expectedResult = Stats{
+		Agility:     100 * 1.25,
+		AttackPower: 100 * 1.25 * 2,
+	}
+	if !result.Equals(expectedResult) {
+		t.Fatalf("Stats do not match after disabling dep:\nActual: %s\nExpected: %s", result, expectedResult)
+	}
+
+	sdm.ResetStatDeps()
+	if result := sdm.ApplyStatDependencies(Stats{Spirit: 10}); !result.Equals(Stats{Spirit: 10}) {
+		t.Fatalf("Unrelated stats should be untouched, found %s", result)
+	}
+}
+
+func BenchmarkApplyStatDependencies(b *testing.B) {
+	sdm := NewStatDependencyManager()
+	sdm.MultiplyStat(Strength, 1.05)
+	sdm.MultiplyStat(Stamina, 1.1)
+	sdm.AddStatDependency(Strength, AttackPower, 2)
+	sdm.AddStatDependency(Agility, PhysicalCritPercent, 0.0003)
+	sdm.AddStatDependency(Intellect, SpellPower, 1)
+	for i := 1; i <= 12; i++ {
+		sdm.NewDynamicMultiplyStat(Strength, 1.0+0.01*float64(i))
+	}
+	sdm.FinalizeStatDeps()
+
+	bonus := Stats{HasteRating: 2500}
+	for i := 0; i < b.N; i++ {
+		sdm.ApplyStatDependencies(bonus)
+	}
+}
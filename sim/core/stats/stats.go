@@ -429,6 +429,7 @@ type PseudoStats struct {
 	BonusHealingTaken float64 // Talisman of Troll Divinity
 
 	DamageTakenMultiplier       float64            // All damage
+	ImmuneToDamage              bool               // Target immunity phases, overrides all damage taken multipliers
 	SchoolDamageTakenMultiplier [SchoolLen]float64 // For specific spell schools (arcane, fire, shadow, etc.)
 
 	DiseaseDamageTakenMultiplier          float64
@@ -515,6 +516,17 @@ func (s UnitStat) AddToStatsProto(p *proto.UnitStats, value float64) {
 	}
 }
 
+func (s UnitStat) GetFromStatsProto(p *proto.UnitStats) float64 {
+	if s.IsStat() {
+		if s.StatIdx() < len(p.GetStats()) {
+			return p.Stats[s.StatIdx()]
+		}
+	} else if s.PseudoStatIdx() < len(p.GetPseudoStats()) {
+		return p.PseudoStats[s.PseudoStatIdx()]
+	}
+	return 0
+}
+
 func UnitStatFromIdx(s int) UnitStat   { return UnitStat(s) }
 func UnitStatFromStat(s Stat) UnitStat { return UnitStat(s) }
 func UnitStatFromPseudoStat(s proto.PseudoStat) UnitStat {
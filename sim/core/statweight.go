@@ -62,27 +62,33 @@ func (s *UnitStats) ExportWeights() *proto.UnitStats {
 }
 
 type StatWeightValues struct {
-	Weights       UnitStats
-	WeightsStdev  UnitStats
-	EpValues      UnitStats
-	EpValuesStdev UnitStats
+	Weights         UnitStats
+	WeightsStdev    UnitStats
+	EpValues        UnitStats
+	EpValuesStdev   UnitStats
+	WeightsBelowCap UnitStats
+	WeightsAboveCap UnitStats
 }
 
 func NewStatWeightValues() StatWeightValues {
 	return StatWeightValues{
-		Weights:       NewUnitStats(),
-		WeightsStdev:  NewUnitStats(),
-		EpValues:      NewUnitStats(),
-		EpValuesStdev: NewUnitStats(),
+		Weights:         NewUnitStats(),
+		WeightsStdev:    NewUnitStats(),
+		EpValues:        NewUnitStats(),
+		EpValuesStdev:   NewUnitStats(),
+		WeightsBelowCap: NewUnitStats(),
+		WeightsAboveCap: NewUnitStats(),
 	}
 }
 
 func (swv *StatWeightValues) ToProto() *proto.StatWeightValues {
 	return &proto.StatWeightValues{
-		Weights:       swv.Weights.ExportWeights(),
-		WeightsStdev:  swv.WeightsStdev.ExportWeights(),
-		EpValues:      swv.EpValues.ExportWeights(),
-		EpValuesStdev: swv.EpValuesStdev.ExportWeights(),
+		Weights:         swv.Weights.ExportWeights(),
+		WeightsStdev:    swv.WeightsStdev.ExportWeights(),
+		EpValues:        swv.EpValues.ExportWeights(),
+		EpValuesStdev:   swv.EpValuesStdev.ExportWeights(),
+		WeightsBelowCap: swv.WeightsBelowCap.ToProto(),
+		WeightsAboveCap: swv.WeightsAboveCap.ToProto(),
 	}
 }
 
@@ -93,6 +99,8 @@ type StatWeightsResult struct {
 	Dtps   StatWeightValues
 	Tmi    StatWeightValues
 	PDeath StatWeightValues
+
+	Caps UnitStats
 }
 
 func NewStatWeightsResult() *StatWeightsResult {
@@ -103,6 +111,7 @@ func NewStatWeightsResult() *StatWeightsResult {
 		Dtps:   NewStatWeightValues(),
 		Tmi:    NewStatWeightValues(),
 		PDeath: NewStatWeightValues(),
+		Caps:   NewUnitStats(),
 	}
 }
 
@@ -114,6 +123,7 @@ func (swr *StatWeightsResult) ToProto() *proto.StatWeightsResult {
 		Dtps:   swr.Dtps.ToProto(),
 		Tmi:    swr.Tmi.ToProto(),
 		PDeath: swr.PDeath.ToProto(),
+		Caps:   swr.Caps.ToProto(),
 	}
 }
 
@@ -215,29 +225,81 @@ func buildStatWeightRequests(swr *proto.StatWeightsRequest) *proto.StatWeightReq
 
 	}
 
-	for i := range statModsLow {
-		stat := stats.UnitStatFromIdx(i)
-		if statModsLow[stat] == 0 {
-			continue
+	// Hybrid stat weights estimate the stats they have a model for from the
+	// baseline result, so those don't need sims of their own.
+	if swr.Hybrid {
+		for _, s := range statsToWeigh {
+			stat := stats.UnitStatFromStat(s)
+			if _, ok := analyticalStatGains[s]; !ok || s == stats.Stat(swr.EpReferenceStat) || statModsLow[stat] == 0 {
+				continue
+			}
+
+			statModsLow[stat] = 0
+			statModsHigh[stat] = 0
+			swBaseResponse.AnalyticalStats = append(swBaseResponse.AnalyticalStats, proto.Stat(s))
 		}
+	}
 
+	statCaps := map[stats.UnitStat]statCap{}
+	if statModsLow[stats.HitRating] != 0 || statModsLow[stats.ExpertiseRating] != 0 {
+		capsRequest := googleProto.Clone(swBaseResponse.BaseRequest).(*proto.RaidSimRequest)
+		env, _, _ := NewEnvironment(capsRequest.Raid, capsRequest.Encounter, false)
+		statCaps = env.Raid.Parties[0].Players[0].GetCharacter().getStatCaps()
+	}
+
+	addStatRequests := func(stat stats.UnitStat, statData *proto.StatWeightsStatData) {
 		lowSimRequest := googleProto.Clone(swBaseResponse.BaseRequest).(*proto.RaidSimRequest)
-		stat.AddToStatsProto(lowSimRequest.Raid.Parties[0].Players[0].BonusStats, statModsLow[stat])
+		stat.AddToStatsProto(lowSimRequest.Raid.Parties[0].Players[0].BonusStats, statData.ModLow)
 
 		highSimRequest := googleProto.Clone(swBaseResponse.BaseRequest).(*proto.RaidSimRequest)
-		stat.AddToStatsProto(highSimRequest.Raid.Parties[0].Players[0].BonusStats, statModsHigh[stat])
+		stat.AddToStatsProto(highSimRequest.Raid.Parties[0].Players[0].BonusStats, statData.ModHigh)
 
 		swBaseResponse.StatSimRequests = append(swBaseResponse.StatSimRequests, &proto.StatWeightsStatRequestData{
-			StatData: &proto.StatWeightsStatData{
-				UnitStat: int32(stat),
-				ModLow:   statModsLow[stat],
-				ModHigh:  statModsHigh[stat],
-			},
+			StatData:    statData,
 			RequestLow:  lowSimRequest,
 			RequestHigh: highSimRequest,
 		})
 	}
 
+	for i := range statModsLow {
+		stat := stats.UnitStatFromIdx(i)
+		if statModsLow[stat] == 0 {
+			continue
+		}
+
+		if statMod := stat.GetFromStatsProto(swr.Perturbations); statMod > 0 {
+			statModsHigh[stat] = statMod
+			statModsLow[stat] = -statMod
+		}
+
+		// Sims on both sides of a cap would average out the weights below and
+		// above it, so those are simmed separately instead, starting at the cap.
+		if statCap, ok := statCaps[stat]; ok && math.Abs(statCap.cap-statCap.current) < statModsHigh[stat] {
+			capOffset := statCap.cap - statCap.current
+			addStatRequests(stat, &proto.StatWeightsStatData{
+				UnitStat: int32(stat),
+				ModLow:   capOffset + statModsLow[stat],
+				ModHigh:  capOffset,
+				CapSide:  proto.StatCapSide_StatCapSideBelow,
+				Cap:      statCap.cap,
+			})
+			addStatRequests(stat, &proto.StatWeightsStatData{
+				UnitStat: int32(stat),
+				ModLow:   capOffset,
+				ModHigh:  capOffset + statModsHigh[stat],
+				CapSide:  proto.StatCapSide_StatCapSideAbove,
+				Cap:      statCap.cap,
+			})
+			continue
+		}
+
+		addStatRequests(stat, &proto.StatWeightsStatData{
+			UnitStat: int32(stat),
+			ModLow:   statModsLow[stat],
+			ModHigh:  statModsHigh[stat],
+		})
+	}
+
 	return swBaseResponse
 }
 
@@ -254,51 +316,99 @@ func computeStatWeights(swcr *proto.StatWeightsCalcRequest) *proto.StatWeightsRe
 	}
 
 	result := NewStatWeightsResult()
+	statsData := make([]*proto.StatWeightsStatData, 0, len(swcr.StatSimResults))
 	for _, statResult := range swcr.StatSimResults {
-		stat := stats.UnitStatFromIdx(int(statResult.StatData.UnitStat))
-
-		baselinePlayer := swcr.BaseResult.RaidMetrics.Parties[0].Players[0]
-		modPlayerLow := statResult.ResultLow.RaidMetrics.Parties[0].Players[0]
-		modPlayerHigh := statResult.ResultHigh.RaidMetrics.Parties[0].Players[0]
+		result.addStatWeights(swcr.BaseResult, statResult)
+		statsData = append(statsData, statResult.StatData)
+	}
+	statsData = append(statsData, result.addAllAnalyticalStatWeights(swcr.BaseResult, swcr.AnalyticalStats)...)
+	result.computeEpValues(swcr.EpReferenceStat, statsData)
 
-		// Check for hard caps. Hard caps will have results identical to the baseline because RNG is fixed.
-		// When we find a hard-capped stat, just skip it (will return 0).
-		if modPlayerHigh.Dps.Avg == baselinePlayer.Dps.Avg && modPlayerHigh.Hps.Avg == baselinePlayer.Hps.Avg && modPlayerHigh.Tmi.Avg == baselinePlayer.Tmi.Avg {
-			continue
-		}
+	return result.ToProto()
+}
 
-		calcWeightResults := func(baselineMetrics *proto.DistributionMetrics, modLowMetrics *proto.DistributionMetrics, modHighMetrics *proto.DistributionMetrics, weightResults *StatWeightValues) {
-			var lo, hi aggregator
-			for i := range baselineMetrics.AllValues {
-				lo.add(modLowMetrics.AllValues[i] - baselineMetrics.AllValues[i])
-			}
-			lo.scale(1 / statResult.StatData.ModLow)
-			for i := range baselineMetrics.AllValues {
-				hi.add(modHighMetrics.AllValues[i] - baselineMetrics.AllValues[i])
-			}
-			hi.scale(1 / statResult.StatData.ModHigh)
+// Adds the weights of a single stat, computed from its sims above and below
+// the baseline.
+func (result *StatWeightsResult) addStatWeights(baseResult *proto.RaidSimResult, statResult *proto.StatWeightsStatResultData) {
+	stat := stats.UnitStatFromIdx(int(statResult.StatData.UnitStat))
+
+	baselinePlayer := baseResult.RaidMetrics.Parties[0].Players[0]
+	modPlayerLow := statResult.ResultLow.RaidMetrics.Parties[0].Players[0]
+	modPlayerHigh := statResult.ResultHigh.RaidMetrics.Parties[0].Players[0]
+
+	// Check for hard caps. Hard caps will have results identical to the baseline because RNG is fixed.
+	// When we find a hard-capped stat, just skip it (will return 0). Stats
+	// simmed on each side of their cap are expected to match the baseline on
+	// one of them.
+	if statResult.StatData.CapSide == proto.StatCapSide_StatCapSideNone && modPlayerHigh.Dps.Avg == baselinePlayer.Dps.Avg && modPlayerHigh.Hps.Avg == baselinePlayer.Hps.Avg && modPlayerHigh.Tmi.Avg == baselinePlayer.Tmi.Avg {
+		return
+	}
+	if statResult.StatData.CapSide == proto.StatCapSide_StatCapSideBelow {
+		result.Caps.AddStat(stat, statResult.StatData.Cap)
+	}
 
-			mean, stdev := lo.merge(&hi).meanAndStdDev()
+	// The low and high sims share their RNG seeds, so each iteration is paired
+	// with the same iteration on the other side of the baseline. Their central
+	// difference cancels out most of the noise, and doesn't lean towards
+	// whichever side of the baseline a nearby cap is on.
+	modRange := statResult.StatData.ModHigh - statResult.StatData.ModLow
+	atBaseline := statDataIncludesBaseline(statResult.StatData)
+
+	addWeight := func(weightResults *StatWeightValues, mean float64, stdev float64) {
+		switch statResult.StatData.CapSide {
+		case proto.StatCapSide_StatCapSideBelow:
+			weightResults.WeightsBelowCap.AddStat(stat, mean)
+		case proto.StatCapSide_StatCapSideAbove:
+			weightResults.WeightsAboveCap.AddStat(stat, mean)
+		}
+		if atBaseline {
 			weightResults.Weights.AddStat(stat, mean)
 			weightResults.WeightsStdev.AddStat(stat, stdev)
 		}
+	}
+
+	calcWeightResults := func(modLowMetrics *proto.DistributionMetrics, modHighMetrics *proto.DistributionMetrics, weightResults *StatWeightValues) {
+		var diff aggregator
+		for i := range modHighMetrics.AllValues {
+			diff.add(modHighMetrics.AllValues[i] - modLowMetrics.AllValues[i])
+		}
+		diff.scale(1 / modRange)
+
+		mean, stdev := diff.meanAndStdDev()
+		addWeight(weightResults, mean, stdev)
+	}
 
-		calcWeightResults(baselinePlayer.Dps, modPlayerLow.Dps, modPlayerHigh.Dps, &result.Dps)
-		calcWeightResults(baselinePlayer.Hps, modPlayerLow.Hps, modPlayerHigh.Hps, &result.Hps)
-		calcWeightResults(baselinePlayer.Threat, modPlayerLow.Threat, modPlayerHigh.Threat, &result.Tps)
-		calcWeightResults(baselinePlayer.Dtps, modPlayerLow.Dtps, modPlayerHigh.Dtps, &result.Dtps)
-		calcWeightResults(baselinePlayer.Tmi, modPlayerLow.Tmi, modPlayerHigh.Tmi, &result.Tmi)
-		meanLow := (modPlayerLow.ChanceOfDeath - baselinePlayer.ChanceOfDeath) / statResult.StatData.ModLow
-		meanHigh := (modPlayerHigh.ChanceOfDeath - baselinePlayer.ChanceOfDeath) / statResult.StatData.ModHigh
-		result.PDeath.Weights.AddStat(stat, (meanLow+meanHigh)/2)
-		result.PDeath.WeightsStdev.AddStat(stat, 0)
+	calcWeightResults(modPlayerLow.Dps, modPlayerHigh.Dps, &result.Dps)
+	calcWeightResults(modPlayerLow.Hps, modPlayerHigh.Hps, &result.Hps)
+	calcWeightResults(modPlayerLow.Threat, modPlayerHigh.Threat, &result.Tps)
+	calcWeightResults(modPlayerLow.Dtps, modPlayerHigh.Dtps, &result.Dtps)
+	calcWeightResults(modPlayerLow.Tmi, modPlayerHigh.Tmi, &result.Tmi)
+	addWeight(&result.PDeath, (modPlayerHigh.ChanceOfDeath-modPlayerLow.ChanceOfDeath)/modRange, 0)
+}
+
+// Whether the sims of a stat are on the same side of its cap as the baseline,
+// so that they give the weight of the stat at the current gear. Always true
+// for stats without a cap.
+func statDataIncludesBaseline(statData *proto.StatWeightsStatData) bool {
+	switch statData.CapSide {
+	case proto.StatCapSide_StatCapSideBelow:
+		return statData.ModHigh > 0
+	case proto.StatCapSide_StatCapSideAbove:
+		return statData.ModLow <= 0
 	}
+	return true
+}
 
-	referenceStat := stats.Stat(swcr.EpReferenceStat)
+// Converts the weights of all stats into EP values, once the reference stat
+// has a weight.
+func (result *StatWeightsResult) computeEpValues(epReferenceStat proto.Stat, statsData []*proto.StatWeightsStatData) {
+	referenceStat := stats.Stat(epReferenceStat)
 
-	// Compute EP results.
-	for _, statData := range swcr.StatSimResults {
-		stat := stats.UnitStatFromIdx(int(statData.StatData.UnitStat))
+	for _, statData := range statsData {
+		if !statDataIncludesBaseline(statData) {
+			continue
+		}
+		stat := stats.UnitStatFromIdx(int(statData.UnitStat))
 
 		calcEpResults := func(weightResults *StatWeightValues, refStat stats.Stat) {
 			if weightResults.Weights.Stats[refStat] == 0 {
@@ -317,8 +427,6 @@ func computeStatWeights(swcr *proto.StatWeightsCalcRequest) *proto.StatWeightsRe
 		calcEpResults(&result.Tmi, DTPSReferenceStat)
 		calcEpResults(&result.PDeath, DTPSReferenceStat)
 	}
-
-	return result.ToProto()
 }
 
 // Run stat weight sims and compute weights.
@@ -372,7 +480,12 @@ func runStatWeights(request *proto.StatWeightsRequest, progress chan *proto.Prog
 		return &proto.StatWeightsResult{Error: baselineResult.Error}
 	}
 
-	statResults := []*proto.StatWeightsStatResultData{}
+	// Weights are computed as soon as the sims of a stat finish, so only the
+	// baseline result is kept in memory rather than the per-iteration values
+	// of every stat sim.
+	result := NewStatWeightsResult()
+	statsData := make([]*proto.StatWeightsStatData, 0, len(requestData.StatSimRequests))
+	haveRefStat := false
 
 	for _, reqData := range requestData.StatSimRequests {
 		lowProgress := make(chan *proto.ProgressMetrics, 100)
@@ -389,16 +502,20 @@ func runStatWeights(request *proto.StatWeightsRequest, progress chan *proto.Prog
 			return &proto.StatWeightsResult{Error: highRes.Error}
 		}
 
-		statResults = append(statResults, &proto.StatWeightsStatResultData{
+		result.addStatWeights(baselineResult, &proto.StatWeightsStatResultData{
 			StatData:   reqData.StatData,
 			ResultLow:  lowRes,
 			ResultHigh: highRes,
 		})
+		statsData = append(statsData, reqData.StatData)
+		haveRefStat = haveRefStat || reqData.StatData.UnitStat == int32(requestData.EpReferenceStat)
 	}
 
-	return computeStatWeights(&proto.StatWeightsCalcRequest{
-		BaseResult:      baselineResult,
-		EpReferenceStat: requestData.EpReferenceStat,
-		StatSimResults:  statResults,
-	})
+	if !haveRefStat {
+		return &proto.StatWeightsResult{Error: &proto.ErrorOutcome{Message: "No result for reference stat exists!"}}
+	}
+	statsData = append(statsData, result.addAllAnalyticalStatWeights(baselineResult, requestData.AnalyticalStats)...)
+	result.computeEpValues(requestData.EpReferenceStat, statsData)
+
+	return result.ToProto()
 }
@@ -0,0 +1,114 @@
+package core
+
+import (
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/core/stats"
+)
+
+// Estimates the extra damage and healing a single point of a stat would have
+// added to one action's results.
+type analyticalStatGain func(action *proto.ActionMetrics, target *proto.TargetedActionMetrics) (damage float64, healing float64)
+
+// Stats which hybrid stat weights estimate from the action metrics of the
+// baseline sim, rather than from sims of their own. Each of these converts
+// outcomes of an attack into better ones at a fixed rate, so small deltas
+// can be read off the recorded outcomes and spell mix.
+var analyticalStatGains = map[stats.Stat]analyticalStatGain{
+	stats.CritRating:      critRatingGain,
+	stats.HitRating:       hitRatingGain,
+	stats.ExpertiseRating: expertiseRatingGain,
+}
+
+// Extra total from turning every non-critical result into an average critical
+// one. Zero unless the action was seen both crit and not crit.
+func critGain(normals int32, normalTotal float64, crits int32, critTotal float64) float64 {
+	if normals == 0 || crits == 0 {
+		return 0
+	}
+	return float64(normals+crits) * (critTotal/float64(crits) - normalTotal/float64(normals))
+}
+
+func critRatingGain(_ *proto.ActionMetrics, target *proto.TargetedActionMetrics) (float64, float64) {
+	directNormalDamage := target.Damage - target.TickDamage - target.CritDamage
+	damage := critGain(target.Hits, directNormalDamage, target.Crits, target.CritDamage) +
+		critGain(target.Ticks, target.TickDamage-target.CritTickDamage, target.CritTicks, target.CritTickDamage)
+
+	// Healing isn't split into direct and periodic, so average over both.
+	healing := critGain(target.Hits+target.Ticks, target.Healing-target.CritHealing, target.Crits+target.CritTicks, target.CritHealing)
+
+	return damage / 100 / CritRatingPerCritPercent, healing / 100 / CritRatingPerCritPercent
+}
+
+// Extra damage from landing 1% more of an action's attempts, limited to the
+// avoided attempts there were to convert. Damage per landed attempt includes
+// any ticks of the dot or channel it applied, which only count as casts.
+func avoidanceGain(target *proto.TargetedActionMetrics, avoided int32) float64 {
+	allAvoided := target.Misses + target.Dodges + target.Parries
+	attempts := max(target.Casts, target.Hits+target.Crits+allAvoided)
+	landed := attempts - allAvoided
+	if avoided == 0 || landed <= 0 {
+		return 0
+	}
+
+	return min(float64(attempts)/100, float64(avoided)) * target.Damage / float64(landed)
+}
+
+func hitRatingGain(action *proto.ActionMetrics, target *proto.TargetedActionMetrics) (float64, float64) {
+	ratingPerPercent := SpellHitRatingPerHitPercent
+	if action.IsMelee {
+		ratingPerPercent = PhysicalHitRatingPerHitPercent
+	}
+	return avoidanceGain(target, target.Misses) / ratingPerPercent, 0
+}
+
+// Expertise suppresses dodge first and then parry, at the same rate.
+func expertiseRatingGain(_ *proto.ActionMetrics, target *proto.TargetedActionMetrics) (float64, float64) {
+	return avoidanceGain(target, target.Dodges+target.Parries) / (ExpertisePerQuarterPercentReduction * 4), 0
+}
+
+// Adds the estimated weights of a stat with an analytical model, using the
+// action metrics of the player and their pets in the baseline result. Passive
+// actions like Ignite are triggered by the others, so they're assumed to scale
+// with them rather than gain from the stat directly. Threat is assumed to
+// scale with damage. The estimates have no standard deviation of their own.
+func (result *StatWeightsResult) addAnalyticalStatWeights(baseResult *proto.RaidSimResult, stat stats.Stat) {
+	statGain := analyticalStatGains[stat]
+	unitStat := stats.UnitStatFromStat(stat)
+	player := baseResult.RaidMetrics.Parties[0].Players[0]
+
+	var totalDamage, totalHealing, damageGain, healingGain float64
+	for _, unit := range append([]*proto.UnitMetrics{player}, player.Pets...) {
+		for _, action := range unit.Actions {
+			if action.IsPassive {
+				continue
+			}
+			for _, target := range action.Targets {
+				totalDamage += target.Damage
+				totalHealing += target.Healing + target.Shielding
+
+				damage, healing := statGain(action, target)
+				damageGain += damage
+				healingGain += healing
+			}
+		}
+	}
+
+	if totalDamage > 0 {
+		result.Dps.Weights.AddStat(unitStat, player.Dps.Avg*damageGain/totalDamage)
+		result.Tps.Weights.AddStat(unitStat, player.Threat.Avg*damageGain/totalDamage)
+	}
+	if totalHealing > 0 {
+		result.Hps.Weights.AddStat(unitStat, player.Hps.Avg*healingGain/totalHealing)
+	}
+}
+
+// Adds the estimated weights of each of the given stats, returning their stat
+// data for computing EP values.
+func (result *StatWeightsResult) addAllAnalyticalStatWeights(baseResult *proto.RaidSimResult, analyticalStats []proto.Stat) []*proto.StatWeightsStatData {
+	statsData := make([]*proto.StatWeightsStatData, 0, len(analyticalStats))
+	for _, stat := range analyticalStats {
+		result.addAnalyticalStatWeights(baseResult, stats.Stat(stat))
+		statsData = append(statsData, &proto.StatWeightsStatData{UnitStat: int32(stats.UnitStatFromStat(stats.Stat(stat)))})
+	}
+	return statsData
+}
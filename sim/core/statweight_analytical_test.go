@@ -0,0 +1,80 @@
+package core
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/core/stats"
+)
+
+func TestHybridStatWeightRequests(t *testing.T) {
+	request := buildStatWeightRequests(&proto.StatWeightsRequest{
+		Player:          &proto.Player{},
+		SimOptions:      &proto.SimOptions{Iterations: 100},
+		StatsToWeigh:    []proto.Stat{proto.Stat_StatIntellect, proto.Stat_StatCritRating, proto.Stat_StatHasteRating},
+		EpReferenceStat: proto.Stat_StatCritRating,
+		Hybrid:          true,
+	})
+
+	if len(request.AnalyticalStats) != 0 {
+		t.Fatalf("Expected the reference stat to always be simmed, found %v", request.AnalyticalStats)
+	}
+
+	request = buildStatWeightRequests(&proto.StatWeightsRequest{
+		Player:          &proto.Player{},
+		SimOptions:      &proto.SimOptions{Iterations: 100},
+		StatsToWeigh:    []proto.Stat{proto.Stat_StatIntellect, proto.Stat_StatCritRating, proto.Stat_StatHasteRating},
+		EpReferenceStat: proto.Stat_StatIntellect,
+		Hybrid:          true,
+	})
+
+	if len(request.AnalyticalStats) != 1 || request.AnalyticalStats[0] != proto.Stat_StatCritRating {
+		t.Fatalf("Expected crit rating to be estimated, found %v", request.AnalyticalStats)
+	}
+	for _, statRequest := range request.StatSimRequests {
+		if statRequest.StatData.UnitStat == int32(stats.CritRating) {
+			t.Fatalf("Expected no sims for crit rating")
+		}
+	}
+}
+
+func TestAnalyticalStatWeights(t *testing.T) {
+	baseResult := &proto.RaidSimResult{
+		RaidMetrics: &proto.RaidMetrics{
+			Parties: []*proto.PartyMetrics{{
+				Players: []*proto.UnitMetrics{{
+					Dps:    &proto.DistributionMetrics{Avg: 1000},
+					Threat: &proto.DistributionMetrics{},
+					Hps:    &proto.DistributionMetrics{},
+					Actions: []*proto.ActionMetrics{{
+						Targets: []*proto.TargetedActionMetrics{{
+							// 100 attempts: 70 hits for 100 damage, 20 crits for 200 and 10 misses.
+							Casts:      100,
+							Hits:       70,
+							Crits:      20,
+							Misses:     10,
+							Damage:     11000,
+							CritDamage: 4000,
+						}},
+					}},
+				}},
+			}},
+		},
+	}
+
+	result := NewStatWeightsResult()
+	result.addAllAnalyticalStatWeights(baseResult, []proto.Stat{proto.Stat_StatCritRating, proto.Stat_StatHitRating})
+
+	// 1% crit turns 0.9 hits into crits, for 90 more damage out of 11000.
+	expectedCrit := 1000 * 90.0 / 11000 / CritRatingPerCritPercent
+	if crit := result.Dps.Weights.Stats[stats.CritRating]; math.Abs(crit-expectedCrit) > 1e-9 {
+		t.Fatalf("Expected crit rating weight of %f, found %f", expectedCrit, crit)
+	}
+
+	// 1% hit lands 1 more attempt, worth 11000/90 damage on average.
+	expectedHit := 1000 * (11000.0 / 90) / 11000 / SpellHitRatingPerHitPercent
+	if hit := result.Dps.Weights.Stats[stats.HitRating]; math.Abs(hit-expectedHit) > 1e-9 {
+		t.Fatalf("Expected hit rating weight of %f, found %f", expectedHit, hit)
+	}
+}
@@ -0,0 +1,54 @@
+package core
+
+import (
+	"github.com/wowsims/mop/sim/core/stats"
+)
+
+type statCap struct {
+	current float64
+	cap     float64
+}
+
+// Finds the values past which hit and expertise stop reducing misses, dodges
+// and parries against the character's target. Weights computed across one of
+// those caps are misleading on both sides of it.
+func (character *Character) getStatCaps() map[stats.UnitStat]statCap {
+	character.applyBuildPhaseAuras(CharacterBuildPhaseAll)
+	defer character.clearBuildPhaseAuras(CharacterBuildPhaseAll)
+
+	statCaps := make(map[stats.UnitStat]statCap)
+	if character.CurrentTarget == nil {
+		return statCaps
+	}
+	attackTable := character.AttackTables[character.CurrentTarget.UnitIndex]
+
+	hitRating := character.GetStat(stats.HitRating)
+	if character.AutoAttacks.AutoSwingMelee || character.AutoAttacks.AutoSwingRanged {
+		// Dual wield auto attacks keep missing past the special attack cap, but
+		// that's only a soft cap so isn't treated as one.
+		missChance := attackTable.BaseMissChance*100 - character.GetStat(stats.PhysicalHitPercent)
+		statCaps[stats.UnitStatFromStat(stats.HitRating)] = statCap{
+			current: hitRating,
+			cap:     hitRating + missChance*PhysicalHitRatingPerHitPercent,
+		}
+
+		// Encounter frontal phases still expose players behind the target to
+		// parries, which shows up as a weight above the dodge cap.
+		expertiseChance := attackTable.BaseDodgeChance
+		if character.PseudoStats.InFrontOfTarget {
+			expertiseChance += attackTable.BaseParryChance
+		}
+		statCaps[stats.UnitStatFromStat(stats.ExpertiseRating)] = statCap{
+			current: character.GetStat(stats.ExpertiseRating),
+			cap:     expertiseChance * 400 * ExpertisePerQuarterPercentReduction,
+		}
+	} else {
+		missChance := attackTable.BaseSpellMissChance*100 - character.GetStat(stats.SpellHitPercent)
+		statCaps[stats.UnitStatFromStat(stats.HitRating)] = statCap{
+			current: hitRating,
+			cap:     hitRating + missChance*SpellHitRatingPerHitPercent,
+		}
+	}
+
+	return statCaps
+}
@@ -0,0 +1,140 @@
+package core
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/core/stats"
+)
+
+func TestStatWeightPerturbations(t *testing.T) {
+	perturbations := &proto.UnitStats{Stats: make([]float64, stats.ProtoStatsLen)}
+	perturbations.Stats[stats.MasteryRating] = 50
+
+	request := buildStatWeightRequests(&proto.StatWeightsRequest{
+		Player:          &proto.Player{},
+		SimOptions:      &proto.SimOptions{Iterations: 100},
+		StatsToWeigh:    []proto.Stat{proto.Stat_StatMasteryRating, proto.Stat_StatHasteRating},
+		EpReferenceStat: proto.Stat_StatHasteRating,
+		Perturbations:   perturbations,
+	})
+
+	for _, statRequest := range request.StatSimRequests {
+		expectedMod := 320.0
+		if statRequest.StatData.UnitStat == int32(stats.MasteryRating) {
+			expectedMod = 50
+		}
+		if statRequest.StatData.ModLow != -expectedMod || statRequest.StatData.ModHigh != expectedMod {
+			t.Fatalf("Expected mods of +/-%f for stat %d, found %f and %f", expectedMod, statRequest.StatData.UnitStat, statRequest.StatData.ModLow, statRequest.StatData.ModHigh)
+		}
+	}
+}
+
+func TestCentralDifferenceStatWeights(t *testing.T) {
+	// Iterations vary a lot between each other, but in each of them the stat is
+	// worth 2 DPS per point below the baseline and 1 above it, past a soft cap.
+	result := NewStatWeightsResult()
+	result.addStatWeights(statWeightPlayerResult(1000, 2000, 3000), &proto.StatWeightsStatResultData{
+		StatData:   &proto.StatWeightsStatData{UnitStat: int32(stats.HitRating), ModLow: -100, ModHigh: 100},
+		ResultLow:  statWeightPlayerResult(800, 1800, 2800),
+		ResultHigh: statWeightPlayerResult(1100, 2100, 3100),
+	})
+
+	expectedWeight := 300.0 / 200
+	if weight := result.Dps.Weights.Stats[stats.HitRating]; math.Abs(weight-expectedWeight) > 1e-9 {
+		t.Fatalf("Expected hit rating weight of %f, found %f", expectedWeight, weight)
+	}
+	if stdev := result.Dps.WeightsStdev.Stats[stats.HitRating]; stdev > 1e-6 {
+		t.Fatalf("Expected paired iterations to have no spread, found stdev %f", stdev)
+	}
+}
+
+func TestStatWeightCapRequests(t *testing.T) {
+	bonusStats := &proto.UnitStats{Stats: make([]float64, stats.ProtoStatsLen)}
+	bonusStats.Stats[stats.HitRating] = 15*SpellHitRatingPerHitPercent - 100
+
+	request := buildStatWeightRequests(&proto.StatWeightsRequest{
+		Player: &proto.Player{
+			Name:       "Caster",
+			Class:      proto.Class_ClassShaman,
+			Spec:       &proto.Player_ElementalShaman{},
+			Equipment:  &proto.EquipmentSpec{},
+			Buffs:      &proto.IndividualBuffs{},
+			BonusStats: bonusStats,
+		},
+		PartyBuffs: &proto.PartyBuffs{},
+		RaidBuffs:  &proto.RaidBuffs{},
+		Debuffs:    &proto.Debuffs{},
+		Encounter: &proto.Encounter{
+			Duration: 180,
+			Targets:  []*proto.Target{{Name: "target", Level: 93, MobType: proto.MobType_MobTypeDemon}},
+		},
+		SimOptions:      &proto.SimOptions{Iterations: 100},
+		StatsToWeigh:    []proto.Stat{proto.Stat_StatHitRating, proto.Stat_StatHasteRating},
+		EpReferenceStat: proto.Stat_StatHasteRating,
+	})
+
+	var capSides []proto.StatCapSide
+	for _, statRequest := range request.StatSimRequests {
+		statData := statRequest.StatData
+		if statData.UnitStat != int32(stats.HitRating) {
+			continue
+		}
+		if statData.Cap != 15*SpellHitRatingPerHitPercent {
+			t.Fatalf("Expected the spell hit cap of %f, found %f", 15*SpellHitRatingPerHitPercent, statData.Cap)
+		}
+		capSides = append(capSides, statData.CapSide)
+	}
+
+	if len(capSides) != 2 || capSides[0] != proto.StatCapSide_StatCapSideBelow || capSides[1] != proto.StatCapSide_StatCapSideAbove {
+		t.Fatalf("Expected hit rating to be simmed below and above its cap, found %v", capSides)
+	}
+}
+
+func TestStatWeightsAroundCap(t *testing.T) {
+	// Hit is worth 2 DPS per point up to a cap 100 past the baseline, and
+	// nothing above it.
+	result := NewStatWeightsResult()
+	baseResult := statWeightPlayerResult(1000, 2000)
+	result.addStatWeights(baseResult, &proto.StatWeightsStatResultData{
+		StatData:   &proto.StatWeightsStatData{UnitStat: int32(stats.HitRating), ModLow: -220, ModHigh: 100, CapSide: proto.StatCapSide_StatCapSideBelow, Cap: 5100},
+		ResultLow:  statWeightPlayerResult(560, 1560),
+		ResultHigh: statWeightPlayerResult(1200, 2200),
+	})
+	result.addStatWeights(baseResult, &proto.StatWeightsStatResultData{
+		StatData:   &proto.StatWeightsStatData{UnitStat: int32(stats.HitRating), ModLow: 100, ModHigh: 420, CapSide: proto.StatCapSide_StatCapSideAbove, Cap: 5100},
+		ResultLow:  statWeightPlayerResult(1200, 2200),
+		ResultHigh: statWeightPlayerResult(1200, 2200),
+	})
+
+	if weight := result.Dps.Weights.Stats[stats.HitRating]; math.Abs(weight-2) > 1e-9 {
+		t.Fatalf("Expected the weight below the cap to be used, found %f", weight)
+	}
+	if below, above := result.Dps.WeightsBelowCap.Stats[stats.HitRating], result.Dps.WeightsAboveCap.Stats[stats.HitRating]; math.Abs(below-2) > 1e-9 || above != 0 {
+		t.Fatalf("Expected weights of 2 below and 0 above the cap, found %f and %f", below, above)
+	}
+	if statCap := result.Caps.Stats[stats.HitRating]; statCap != 5100 {
+		t.Fatalf("Expected a cap of 5100, found %f", statCap)
+	}
+}
+
+func statWeightPlayerResult(dpsValues ...float64) *proto.RaidSimResult {
+	dps := &proto.DistributionMetrics{AllValues: dpsValues}
+	for _, value := range dpsValues {
+		dps.Avg += value / float64(len(dpsValues))
+	}
+	return &proto.RaidSimResult{
+		RaidMetrics: &proto.RaidMetrics{
+			Parties: []*proto.PartyMetrics{{
+				Players: []*proto.UnitMetrics{{
+					Dps:    dps,
+					Hps:    &proto.DistributionMetrics{AllValues: make([]float64, len(dpsValues))},
+					Threat: &proto.DistributionMetrics{AllValues: make([]float64, len(dpsValues))},
+					Dtps:   &proto.DistributionMetrics{AllValues: make([]float64, len(dpsValues))},
+					Tmi:    &proto.DistributionMetrics{AllValues: make([]float64, len(dpsValues))},
+				}},
+			}},
+		},
+	}
+}
@@ -0,0 +1,114 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const NumTalentTiers = 6
+const NumTalentColumns = 3
+
+var talentTierLevels = [NumTalentTiers]int32{15, 30, 45, 60, 75, 90}
+
+var classTalentsProtos = map[proto.Class]func() protoreflect.ProtoMessage{
+	proto.Class_ClassDeathKnight: func() protoreflect.ProtoMessage { return &proto.DeathKnightTalents{} },
+	proto.Class_ClassDruid:       func() protoreflect.ProtoMessage { return &proto.DruidTalents{} },
+	proto.Class_ClassHunter:      func() protoreflect.ProtoMessage { return &proto.HunterTalents{} },
+	proto.Class_ClassMage:        func() protoreflect.ProtoMessage { return &proto.MageTalents{} },
+	proto.Class_ClassMonk:        func() protoreflect.ProtoMessage { return &proto.MonkTalents{} },
+	proto.Class_ClassPaladin:     func() protoreflect.ProtoMessage { return &proto.PaladinTalents{} },
+	proto.Class_ClassPriest:      func() protoreflect.ProtoMessage { return &proto.PriestTalents{} },
+	proto.Class_ClassRogue:       func() protoreflect.ProtoMessage { return &proto.RogueTalents{} },
+	proto.Class_ClassShaman:      func() protoreflect.ProtoMessage { return &proto.ShamanTalents{} },
+	proto.Class_ClassWarlock:     func() protoreflect.ProtoMessage { return &proto.WarlockTalents{} },
+	proto.Class_ClassWarrior:     func() protoreflect.ProtoMessage { return &proto.WarriorTalents{} },
+}
+
+// Returns one message per invalid tier of a talents string, or nil if the
+// string is valid. Shorter strings are allowed, missing tiers have no talent.
+func talentsStringErrors(talentsStr string) []string {
+	var errs []string
+	if len(talentsStr) > NumTalentTiers {
+		errs = append(errs, fmt.Sprintf("Talents string has %d tiers, but there are only %d", len(talentsStr), NumTalentTiers))
+	}
+	for tierIdx, talentChar := range talentsStr {
+		if tierIdx >= NumTalentTiers {
+			break
+		}
+		if talentChar < '0' || talentChar > '0'+NumTalentColumns {
+			errs = append(errs, fmt.Sprintf("Invalid talent '%c' in tier %d (level %d), must be 0-%d", talentChar, tierIdx+1, talentTierLevels[tierIdx], NumTalentColumns))
+		}
+	}
+	return errs
+}
+
+func ValidateTalentsString(talentsStr string) error {
+	if errs := talentsStringErrors(talentsStr); len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// Returns the talents picked by a talents string, using the field names of
+// the class talents proto.
+func DecodeTalentsString(class proto.Class, talentsStr string) ([]*proto.DecodedTalent, error) {
+	newTalents, ok := classTalentsProtos[class]
+	if !ok {
+		return nil, fmt.Errorf("No talents for class: %s", class)
+	}
+	if err := ValidateTalentsString(talentsStr); err != nil {
+		return nil, err
+	}
+
+	fieldDescriptors := newTalents().ProtoReflect().Descriptor().Fields()
+
+	var talents []*proto.DecodedTalent
+	for tierIdx, talentChar := range talentsStr {
+		column := int32(talentChar - '0')
+		if column == 0 {
+			continue
+		}
+
+		fd := fieldDescriptors.ByNumber(protowire.Number(tierIdx*NumTalentColumns + int(column)))
+		if fd == nil {
+			return nil, fmt.Errorf("No %s talent in tier %d, column %d", class, tierIdx+1, column)
+		}
+		talents = append(talents, &proto.DecodedTalent{
+			Tier:   int32(tierIdx + 1),
+			Column: column,
+			Level:  talentTierLevels[tierIdx],
+			Name:   string(fd.Name()),
+			Label:  talentLabel(string(fd.Name())),
+		})
+	}
+	return talents, nil
+}
+
+// Converts a talent field name like "heart_of_the_wild" to "Heart of the Wild".
+func talentLabel(fieldName string) string {
+	words := strings.Split(fieldName, "_")
+	for i, word := range words {
+		if i > 0 && (word == "of" || word == "the" || word == "and") {
+			continue
+		}
+		words[i] = strings.ToUpper(word[:1]) + word[1:]
+	}
+	return strings.Join(words, " ")
+}
+
+func decodeTalents(request *proto.DecodeTalentsRequest) *proto.DecodeTalentsResult {
+	if errs := talentsStringErrors(request.TalentsString); len(errs) > 0 {
+		return &proto.DecodeTalentsResult{ValidationErrors: errs}
+	}
+
+	talents, err := DecodeTalentsString(request.Class, request.TalentsString)
+	if err != nil {
+		return &proto.DecodeTalentsResult{Error: &proto.ErrorOutcome{Message: err.Error()}}
+	}
+	return &proto.DecodeTalentsResult{Talents: talents}
+}
@@ -0,0 +1,55 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestDecodeTalents(t *testing.T) {
+	result := DecodeTalents(&proto.DecodeTalentsRequest{
+		Class:         proto.Class_ClassMage,
+		TalentsString: "310003",
+	})
+	if result.Error != nil || len(result.ValidationErrors) > 0 {
+		t.Fatalf("Unexpected errors: %v %v", result.Error, result.ValidationErrors)
+	}
+	if len(result.Talents) != 3 {
+		t.Fatalf("Expected 3 talents, got %v", result.Talents)
+	}
+	if talent := result.Talents[0]; talent.Name != "ice_floes" || talent.Label != "Ice Floes" || talent.Tier != 1 || talent.Column != 3 || talent.Level != 15 {
+		t.Fatalf("Unexpected first talent: %v", talent)
+	}
+	if talent := result.Talents[2]; talent.Name != "incanters_ward" || talent.Level != 90 {
+		t.Fatalf("Unexpected last talent: %v", talent)
+	}
+
+	druidTalents, err := DecodeTalentsString(proto.Class_ClassDruid, "000001")
+	if err != nil || len(druidTalents) != 1 || druidTalents[0].Label != "Heart of the Wild" {
+		t.Fatalf("Unexpected druid talents: %v, %v", druidTalents, err)
+	}
+}
+
+func TestDecodeTalentsValidation(t *testing.T) {
+	result := DecodeTalents(&proto.DecodeTalentsRequest{
+		Class:         proto.Class_ClassMage,
+		TalentsString: "3x24000",
+	})
+	if len(result.ValidationErrors) != 3 {
+		t.Fatalf("Expected errors for the length and 2 tiers, got %v", result.ValidationErrors)
+	}
+	if len(result.Talents) != 0 {
+		t.Fatalf("Expected no talents for an invalid string")
+	}
+
+	if result := DecodeTalents(&proto.DecodeTalentsRequest{TalentsString: "111111"}); result.Error == nil {
+		t.Fatalf("Expected an error for an unknown class")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("Expected invalid talents to be rejected when building a character")
+		}
+	}()
+	FillTalentsProto((&proto.MageTalents{}).ProtoReflect(), "4")
+}
@@ -1,6 +1,8 @@
 package core
 
 import (
+	"cmp"
+	"math"
 	"slices"
 	"strconv"
 	"time"
@@ -32,6 +34,17 @@ type Encounter struct {
 
 	// Value to multiply by, for damage spells which are subject to the aoe cap.
 	aoeCapMultiplier float64
+
+	// Builds the base chances of every attack table.
+	OutcomeModel OutcomeModel
+
+	// Times of upcoming encounter events this iteration, keyed by event name.
+	scheduledEvents map[string][]time.Duration
+
+	// Active encounter script phases which keep players in front of the
+	// targets, and the units they moved there from behind.
+	numFrontalPhases int32
+	movedInFront     []*Unit
 }
 
 func NewEncounter(options *proto.Encounter) Encounter {
@@ -40,6 +53,11 @@ func NewEncounter(options *proto.Encounter) Encounter {
 	options.ExecuteProportion_45 = max(options.ExecuteProportion_45, options.ExecuteProportion_35)
 	totalTargetCount := max(len(options.Targets), 1)
 
+	outcomeModel, err := GetOutcomeModel(options.OutcomeModel)
+	if err != nil {
+		panic(err)
+	}
+
 	encounter := Encounter{
 		Duration:             DurationFromSeconds(options.Duration),
 		DurationVariation:    DurationFromSeconds(options.DurationVariation),
@@ -52,6 +70,7 @@ func NewEncounter(options *proto.Encounter) Encounter {
 		ActiveTargets:        make([]*Target, 0, totalTargetCount),
 		AllTargetUnits:       make([]*Unit, 0, totalTargetCount),
 		ActiveTargetUnits:    make([]*Unit, 0, totalTargetCount),
+		OutcomeModel:         outcomeModel,
 	}
 
 	for targetIndex, targetOptions := range options.Targets {
@@ -81,9 +100,7 @@ func NewEncounter(options *proto.Encounter) Encounter {
 
 	// If UseHealth is set, we use the sum of targets health. After creating the targets to make sure stat modifications are done
 	if options.UseHealth {
-		for _, t := range options.Targets {
-			encounter.EndFightAtHealth += t.Stats[stats.Health]
-		}
+		encounter.EndFightAtHealth = encounterHealth(options.Targets)
 		if encounter.EndFightAtHealth == 0 {
 			encounter.EndFightAtHealth = 1 // default to something so we don't instantly end without anything.
 		}
@@ -154,11 +171,64 @@ func (encounter *Encounter) GetMetricsProto() *proto.EncounterMetrics {
 	return metrics
 }
 
+// Sums the health of all targets, counting each linked health pool once. A
+// pool has the health of its healthiest member.
+func encounterHealth(targets []*proto.Target) float64 {
+	var health float64
+	poolHealth := map[int32]float64{}
+	var pools []int32
+	for _, target := range targets {
+		targetHealth := target.Stats[stats.Health]
+		if target.LinkedHealthGroup == 0 {
+			health += targetHealth
+			continue
+		}
+		if _, ok := poolHealth[target.LinkedHealthGroup]; !ok {
+			pools = append(pools, target.LinkedHealthGroup)
+		}
+		poolHealth[target.LinkedHealthGroup] = max(poolHealth[target.LinkedHealthGroup], targetHealth)
+	}
+	for _, pool := range pools {
+		health += poolHealth[pool]
+	}
+	return health
+}
+
 // Target is an enemy/boss that can be the target of player attacks/spells.
 type Target struct {
 	Unit
 
 	AI TargetAI
+
+	// Resolved incoming damage profile, nil if none was requested.
+	DamageProfile *proto.BossDamageProfile
+
+	// Auras of the phases in which this target takes reduced or no damage.
+	damagePhaseAuras []*Aura
+	immunityPhases   int32
+
+	// Yards between this target and the primary target, which it stands behind.
+	DistanceFromPrimary float64
+}
+
+// Base armor of NPCs by level. Raid bosses are CharacterLevel+3, heroic
+// dungeon and challenge mode bosses CharacterLevel+2.
+var targetArmorByLevel = map[int32]float64{
+	CharacterLevel + 3: 24835,
+	CharacterLevel + 2: 23115,
+}
+
+// Returns the base armor of an NPC of the given level. Levels without a
+// known value are extrapolated from the growth between the +2 and +3 values.
+func TargetArmorForLevel(level int32) float64 {
+	if armor, ok := targetArmorByLevel[level]; ok {
+		return armor
+	}
+
+	// TODO: verify the armor of lower level NPCs in game.
+	plus2Armor := targetArmorByLevel[CharacterLevel+2]
+	growthPerLevel := targetArmorByLevel[CharacterLevel+3] / plus2Armor
+	return math.Round(plus2Armor * math.Pow(growthPerLevel, float64(level-(CharacterLevel+2))))
 }
 
 func NewTarget(options *proto.Target, targetIndex int32) *Target {
@@ -174,6 +244,7 @@ func NewTarget(options *proto.Target, targetIndex int32) *Target {
 			Label:       "Target " + strconv.Itoa(int(targetIndex)+1),
 			Level:       options.Level,
 			MobType:     options.MobType,
+			PlayerLike:  options.PlayerLike,
 			auraTracker: newAuraTracker(),
 			stats:       unitStats,
 			PseudoStats: stats.NewPseudoStats(),
@@ -183,6 +254,7 @@ func NewTarget(options *proto.Target, targetIndex int32) *Target {
 			ReactionTime:          time.Millisecond * 1620,
 			enabled:               !options.DisabledAtStart,
 		},
+		DistanceFromPrimary: options.DistanceFromPrimary,
 	}
 	defaultRaidBossLevel := int32(CharacterLevel + 3)
 	target.GCD = target.NewTimer()
@@ -205,6 +277,11 @@ func NewTarget(options *proto.Target, targetIndex int32) *Target {
 	target.PseudoStats.InFrontOfTarget = true
 	target.PseudoStats.DamageSpread = options.DamageSpread
 
+	target.DamageProfile = resolveBossDamageProfile(options.DamageProfile)
+	if (target.DamageProfile != nil) && (target.DamageProfile.DamageSpread > 0) {
+		target.PseudoStats.DamageSpread = target.DamageProfile.DamageSpread
+	}
+
 	preset := GetPresetTargetWithID(options.Id)
 	if preset != nil && preset.AI != nil {
 		target.AI = preset.AI()
@@ -314,6 +391,12 @@ func (target *Target) PreviousActiveTarget() *Target {
 	}
 }
 
+// Returns the distance in yards between the unit and this target. The unit's
+// own distance is measured to the primary target.
+func (target *Target) DistanceFrom(unit *Unit) float64 {
+	return unit.DistanceFromTarget + target.DistanceFromPrimary
+}
+
 func (target *Target) GetMetricsProto() *proto.UnitMetrics {
 	metrics := target.Metrics.ToProto()
 	metrics.Name = target.Label
@@ -371,24 +454,11 @@ func NewAttackTable(attacker *Unit, defender *Unit) *AttackTable {
 		HealingDealtMultiplier:      1,
 	}
 
-	if defender.Type == EnemyUnit {
-		table.BaseSpellMissChance = UnitLevelFloat64(defender.Level, 0.06, 0.09, 0.12, 0.15)
-		table.BaseMissChance = UnitLevelFloat64(defender.Level, 0.03, 0.045, 0.06, 0.075)
-		table.BaseBlockChance = UnitLevelFloat64(defender.Level, 0.03, 0.045, 0.06, 0.075)
-		table.BaseDodgeChance = UnitLevelFloat64(defender.Level, 0.03, 0.045, 0.06, 0.075)
-		table.BaseParryChance = UnitLevelFloat64(defender.Level, 0.03, 0.045, 0.06, 0.075)
-		table.BaseGlanceChance = UnitLevelFloat64(defender.Level, 0.06, 0.12, 0.18, 0.24)
-
-		table.GlanceMultiplier = UnitLevelFloat64(defender.Level, 0.95, 0.95, 0.85, 0.75)
-		table.MeleeCritSuppression = UnitLevelFloat64(defender.Level, 0, 0.01, 0.02, 0.03)
-		table.SpellCritSuppression = UnitLevelFloat64(defender.Level, 0, 0.01, 0.02, 0.03)
-	} else {
-		table.BaseSpellMissChance = UnitLevelFloat64(attacker.Level, 0.06, 0.03, 0, -0.03)
-		table.BaseMissChance = UnitLevelFloat64(attacker.Level, 0.03, 0.015, 0, -0.015)
-		table.BaseBlockChance = UnitLevelFloat64(attacker.Level, 0, -0.015, -0.03, -0.045)
-		table.BaseDodgeChance = UnitLevelFloat64(attacker.Level, 0, -0.015, -0.03, -0.045)
-		table.BaseParryChance = UnitLevelFloat64(attacker.Level, 0, -0.015, -0.03, -0.045)
+	var model OutcomeModel = MopOutcomeModel{}
+	if env := cmp.Or(attacker.Env, defender.Env); env != nil && env.Encounter.OutcomeModel != nil {
+		model = env.Encounter.OutcomeModel
 	}
+	model.InitAttackTable(table)
 
 	return table
 }
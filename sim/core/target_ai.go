@@ -17,11 +17,24 @@ func (target *Target) initialize(config *proto.Target) {
 		return
 	}
 
-	if config.SwingSpeed > 0 {
+	swingSpeed, minBaseDamage := config.SwingSpeed, config.MinBaseDamage
+	if profile := target.DamageProfile; profile != nil {
+		if profile.SwingSpeed > 0 {
+			swingSpeed = profile.SwingSpeed
+		}
+		if profile.MinBaseDamage > 0 {
+			minBaseDamage = profile.MinBaseDamage
+		}
+		target.registerBossSpecials(profile)
+	}
+	target.registerDamagePhases(config.DamagePhases)
+	target.registerEncounterScript(config.Script)
+
+	if swingSpeed > 0 {
 		aaOptions := AutoAttackOptions{
 			MainHand: Weapon{
-				BaseDamageMin:  config.MinBaseDamage,
-				SwingSpeed:     config.SwingSpeed,
+				BaseDamageMin:  minBaseDamage,
+				SwingSpeed:     swingSpeed,
 				CritMultiplier: 2,
 				SpellSchool:    SpellSchoolFromProto(config.SpellSchool),
 			},
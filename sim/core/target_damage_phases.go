@@ -0,0 +1,95 @@
+package core
+
+import (
+	"time"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func (target *Target) registerDamagePhases(phases []*proto.TargetDamagePhase) {
+	for idx, config := range phases {
+		if config.Duration <= 0 {
+			continue
+		}
+
+		target.registerDamagePhase(config, int32(idx+1))
+	}
+}
+
+func (target *Target) registerDamagePhase(config *proto.TargetDamagePhase, tag int32) {
+	actionID := ActionID{OtherID: proto.OtherAction_OtherActionDamagePhase, Tag: tag}
+	if config.SpellId != 0 {
+		actionID = ActionID{SpellID: config.SpellId}
+	}
+
+	multiplier := max(config.DamageTakenMultiplier, 0)
+	isImmunity := multiplier == 0
+
+	aura := target.RegisterAura(Aura{
+		Label:    "Damage Phase " + config.Name,
+		ActionID: actionID,
+		Duration: DurationFromSeconds(config.Duration),
+
+		OnGain: func(aura *Aura, sim *Simulation) {
+			if isImmunity {
+				target.immunityPhases++
+				aura.Unit.PseudoStats.ImmuneToDamage = true
+			} else {
+				aura.Unit.PseudoStats.DamageTakenMultiplier *= multiplier
+			}
+		},
+		OnExpire: func(aura *Aura, sim *Simulation) {
+			if isImmunity {
+				target.immunityPhases--
+				aura.Unit.PseudoStats.ImmuneToDamage = target.immunityPhases > 0
+			} else {
+				aura.Unit.PseudoStats.DamageTakenMultiplier /= multiplier
+			}
+		},
+	})
+
+	// Phases that don't reduce damage are still tracked, so they show up in the
+	// timeline, but the APL doesn't treat them as reduced damage windows.
+	if multiplier < 1 {
+		target.damagePhaseAuras = append(target.damagePhaseAuras, aura)
+	}
+
+	target.RegisterResetEffect(func(sim *Simulation) {
+		pa := &PendingAction{
+			NextActionAt: DurationFromSeconds(config.Start),
+			Priority:     ActionPriorityDOT,
+		}
+
+		pa.OnAction = func(sim *Simulation) {
+			aura.Activate(sim)
+
+			if config.RepeatInterval > 0 {
+				pa.NextActionAt = sim.CurrentTime + DurationFromSeconds(config.RepeatInterval)
+				sim.AddPendingAction(pa)
+			}
+		}
+
+		sim.AddPendingAction(pa)
+	})
+}
+
+// Returns whether the target is in a phase where it takes reduced or no damage.
+func (target *Target) IsDamageReduced() bool {
+	for _, aura := range target.damagePhaseAuras {
+		if aura.IsActive() {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the time until the current reduced damage phases end, 0 if there are none.
+func (target *Target) DamageReducedRemainingTime(sim *Simulation) time.Duration {
+	var remaining time.Duration
+	for _, aura := range target.damagePhaseAuras {
+		if aura.IsActive() {
+			remaining = max(remaining, aura.RemainingDuration(sim))
+		}
+	}
+	return remaining
+}
@@ -0,0 +1,44 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestTargetDamagePhases(t *testing.T) {
+	sim := &Simulation{}
+
+	target := NewTarget(&proto.Target{}, 0)
+	target.registerDamagePhases([]*proto.TargetDamagePhase{
+		{Name: "Shield", Duration: 10, DamageTakenMultiplier: 0.5},
+		{Name: "Submerge", Duration: 5},
+		{Name: "Overlapping Submerge", Duration: 8},
+	})
+	shield := target.GetAura("Damage Phase Shield")
+	submerge := target.GetAura("Damage Phase Submerge")
+	overlappingSubmerge := target.GetAura("Damage Phase Overlapping Submerge")
+
+	if target.IsDamageReduced() {
+		t.Fatalf("Expected no reduced damage phase before any phase starts")
+	}
+
+	shield.Activate(sim)
+	if !target.IsDamageReduced() || target.PseudoStats.DamageTakenMultiplier != 0.5 || target.DamageReducedRemainingTime(sim) != time.Second*10 {
+		t.Fatalf("Expected 50%% damage taken for 10s during the shield phase, found multiplier %f", target.PseudoStats.DamageTakenMultiplier)
+	}
+
+	submerge.Activate(sim)
+	overlappingSubmerge.Activate(sim)
+	submerge.Deactivate(sim)
+	if !target.PseudoStats.ImmuneToDamage {
+		t.Fatalf("Expected the target to stay immune while an immunity phase is still active")
+	}
+
+	overlappingSubmerge.Deactivate(sim)
+	shield.Deactivate(sim)
+	if target.IsDamageReduced() || target.PseudoStats.ImmuneToDamage || target.PseudoStats.DamageTakenMultiplier != 1 {
+		t.Fatalf("Expected damage taken to be restored after all phases end")
+	}
+}
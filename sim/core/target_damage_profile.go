@@ -0,0 +1,377 @@
+package core
+
+import (
+	"time"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+// Canned incoming damage shapes, tuned against the default 93 raid target
+// (2.0s swing, 550k min base damage). Custom profiles start from an empty
+// profile and only use the values supplied in the request.
+var bossDamageProfileLibrary = map[proto.BossDamageProfileType]*proto.BossDamageProfile{
+	proto.BossDamageProfileType_BossDamageProfileSteadyMelee: {
+		SwingSpeed:    2.0,
+		MinBaseDamage: 550000,
+		DamageSpread:  0.4,
+	},
+	proto.BossDamageProfileType_BossDamageProfileSlowHeavyMelee: {
+		SwingSpeed:    3.0,
+		MinBaseDamage: 825000,
+		DamageSpread:  0.4,
+	},
+	proto.BossDamageProfileType_BossDamageProfileFastMelee: {
+		SwingSpeed:    1.2,
+		MinBaseDamage: 330000,
+		DamageSpread:  0.2,
+	},
+	proto.BossDamageProfileType_BossDamageProfilePhysicalSpecials: {
+		SwingSpeed:    2.0,
+		MinBaseDamage: 450000,
+		DamageSpread:  0.4,
+		Specials: []*proto.BossSpecialAttack{
+			{
+				Name:         "Crushing Strike",
+				SpellSchool:  proto.SpellSchool_SpellSchoolPhysical,
+				BaseDamage:   900000,
+				DamageSpread: 0.2,
+				Cooldown:     15,
+				InitialDelay: 8,
+				Target:       proto.BossSpecialTarget_BossSpecialTargetTank,
+				Avoidable:    true,
+			},
+		},
+	},
+	proto.BossDamageProfileType_BossDamageProfileMagicBursts: {
+		SwingSpeed:    2.0,
+		MinBaseDamage: 400000,
+		DamageSpread:  0.4,
+		Specials: []*proto.BossSpecialAttack{
+			{
+				Name:         "Shadow Burst",
+				SpellSchool:  proto.SpellSchool_SpellSchoolShadow,
+				BaseDamage:   90000,
+				DamageSpread: 0.1,
+				Cooldown:     30,
+				InitialDelay: 15,
+				NumHits:      4,
+				HitInterval:  1,
+				Target:       proto.BossSpecialTarget_BossSpecialTargetRaid,
+			},
+		},
+	},
+	proto.BossDamageProfileType_BossDamageProfileMixed: {
+		SwingSpeed:    2.0,
+		MinBaseDamage: 450000,
+		DamageSpread:  0.4,
+		Specials: []*proto.BossSpecialAttack{
+			{
+				Name:         "Crushing Strike",
+				SpellSchool:  proto.SpellSchool_SpellSchoolPhysical,
+				BaseDamage:   900000,
+				DamageSpread: 0.2,
+				Cooldown:     20,
+				InitialDelay: 10,
+				Target:       proto.BossSpecialTarget_BossSpecialTargetTank,
+				Avoidable:    true,
+			},
+			{
+				Name:         "Frost Bolt",
+				SpellSchool:  proto.SpellSchool_SpellSchoolFrost,
+				BaseDamage:   250000,
+				DamageSpread: 0.1,
+				Cooldown:     12,
+				InitialDelay: 6,
+				Target:       proto.BossSpecialTarget_BossSpecialTargetRandomPlayer,
+			},
+			{
+				Name:         "Shadow Burst",
+				SpellSchool:  proto.SpellSchool_SpellSchoolShadow,
+				BaseDamage:   60000,
+				DamageSpread: 0.1,
+				Cooldown:     45,
+				InitialDelay: 25,
+				NumHits:      3,
+				HitInterval:  1,
+				Target:       proto.BossSpecialTarget_BossSpecialTargetRaid,
+			},
+		},
+	},
+	proto.BossDamageProfileType_BossDamageProfileMortalStrikes: {
+		SwingSpeed:    2.0,
+		MinBaseDamage: 450000,
+		DamageSpread:  0.4,
+		Specials: []*proto.BossSpecialAttack{
+			{
+				Name:                     "Mortal Strike",
+				SpellSchool:              proto.SpellSchool_SpellSchoolPhysical,
+				BaseDamage:               700000,
+				DamageSpread:             0.2,
+				Cooldown:                 10,
+				InitialDelay:             5,
+				Target:                   proto.BossSpecialTarget_BossSpecialTargetTank,
+				Avoidable:                true,
+				HealingReduction:         0.5,
+				HealingReductionDuration: 10,
+			},
+		},
+	},
+	proto.BossDamageProfileType_BossDamageProfileRaidDebuffs: {
+		SwingSpeed:    2.0,
+		MinBaseDamage: 450000,
+		DamageSpread:  0.4,
+		Specials: []*proto.BossSpecialAttack{
+			{
+				Name:            "Lingering Shadows",
+				SpellSchool:     proto.SpellSchool_SpellSchoolShadow,
+				Cooldown:        10,
+				InitialDelay:    5,
+				Target:          proto.BossSpecialTarget_BossSpecialTargetRaid,
+				DotDamage:       15000,
+				DotTickInterval: 1,
+				DotDuration:     15,
+				DotMaxStacks:    5,
+			},
+			{
+				Name:          "Essence Drain",
+				SpellSchool:   proto.SpellSchool_SpellSchoolShadow,
+				BaseDamage:    100000,
+				DamageSpread:  0.1,
+				Cooldown:      20,
+				InitialDelay:  12,
+				Target:        proto.BossSpecialTarget_BossSpecialTargetRandomPlayer,
+				HealingAbsorb: 400000,
+			},
+		},
+	},
+}
+
+// Returns the preset damage profile for the given type, or nil for custom profiles.
+func GetBossDamageProfile(profileType proto.BossDamageProfileType) *proto.BossDamageProfile {
+	return bossDamageProfileLibrary[profileType]
+}
+
+// Merges the request profile on top of its preset. Auto attack values set on
+// the request win, and request specials are appended to the preset specials.
+func resolveBossDamageProfile(config *proto.BossDamageProfile) *proto.BossDamageProfile {
+	if config == nil {
+		return nil
+	}
+
+	resolved := &proto.BossDamageProfile{Type: config.Type}
+	if preset := GetBossDamageProfile(config.Type); preset != nil {
+		resolved = googleProto.Clone(preset).(*proto.BossDamageProfile)
+		resolved.Type = config.Type
+	}
+
+	if config.SwingSpeed > 0 {
+		resolved.SwingSpeed = config.SwingSpeed
+	}
+	if config.MinBaseDamage > 0 {
+		resolved.MinBaseDamage = config.MinBaseDamage
+	}
+	if config.DamageSpread > 0 {
+		resolved.DamageSpread = config.DamageSpread
+	}
+
+	resolved.Specials = append(resolved.Specials, config.Specials...)
+	return resolved
+}
+
+func (target *Target) registerBossSpecials(profile *proto.BossDamageProfile) {
+	for idx, config := range profile.Specials {
+		if (config.Cooldown <= 0) || (config.BaseDamage <= 0 && config.DotDamage <= 0 && config.HealingAbsorb <= 0) {
+			continue
+		}
+
+		target.registerBossSpecial(config, int32(idx+1))
+	}
+}
+
+func (target *Target) registerBossSpecial(config *proto.BossSpecialAttack, tag int32) {
+	useSpecial := target.newBossSpecial(config, tag)
+
+	target.RegisterResetEffect(func(sim *Simulation) {
+		pa := &PendingAction{
+			NextActionAt: DurationFromSeconds(config.InitialDelay),
+			Priority:     ActionPriorityDOT,
+		}
+
+		pa.OnAction = func(sim *Simulation) {
+			if !useSpecial(sim) {
+				// Wait for the current cast, so it isn't replaced.
+				pa.NextActionAt = target.Hardcast.Expires
+				sim.AddPendingAction(pa)
+				return
+			}
+
+			pa.NextActionAt = sim.CurrentTime + DurationFromSeconds(config.Cooldown)
+			sim.AddPendingAction(pa)
+		}
+
+		sim.AddPendingAction(pa)
+	})
+}
+
+// Registers the spell of a boss special, and returns a function which starts
+// a use of it. Specials with a cast time can't be used while the target is
+// casting something else, in which case the function returns false.
+func (target *Target) newBossSpecial(config *proto.BossSpecialAttack, tag int32) func(sim *Simulation) bool {
+	actionID := ActionID{OtherID: proto.OtherAction_OtherActionBossSpecial, Tag: tag}
+	if config.SpellId != 0 {
+		actionID = ActionID{SpellID: config.SpellId}
+	}
+
+	school := SpellSchoolFromProto(config.SpellSchool)
+	isPhysical := school == SpellSchoolPhysical
+	damageLabel := "Boss Special Damage " + config.Name
+
+	var healingReductionAuras AuraArray
+	if config.HealingReduction > 0 {
+		duration := TernaryDuration(config.HealingReductionDuration > 0, DurationFromSeconds(config.HealingReductionDuration), time.Second*10)
+		healingReductionAuras = target.NewAllyAuraArray(func(unit *Unit) *Aura {
+			return majorHealingReductionAura(unit, Aura{
+				Label:    config.Name,
+				ActionID: actionID,
+				Duration: duration,
+			}, min(config.HealingReduction, 1))
+		})
+	}
+
+	var healingAbsorbAuras []*HealingAbsorptionAura
+	if config.HealingAbsorb > 0 {
+		duration := TernaryDuration(config.HealingAbsorbDuration > 0, DurationFromSeconds(config.HealingAbsorbDuration), NeverExpires)
+		healingAbsorbAuras = make([]*HealingAbsorptionAura, len(target.Env.AllUnits))
+		for _, unit := range target.Env.AllUnits {
+			if target.IsOpponent(unit) {
+				healingAbsorbAuras[unit.UnitIndex] = unit.NewHealingAbsorptionAura(Aura{
+					Label:    config.Name + " Healing Absorb",
+					ActionID: actionID,
+					Duration: duration,
+				})
+			}
+		}
+	}
+
+	var dotConfig DotConfig
+	if config.DotDamage > 0 {
+		tickLength := TernaryDuration(config.DotTickInterval > 0, DurationFromSeconds(config.DotTickInterval), time.Second)
+		duration := TernaryDuration(config.DotDuration > 0, DurationFromSeconds(config.DotDuration), time.Second*10)
+		dotConfig = DotConfig{
+			Aura: Aura{
+				Label:     config.Name,
+				MaxStacks: max(config.DotMaxStacks, 1),
+			},
+			TickLength:    tickLength,
+			NumberOfTicks: int32(max(duration/tickLength, 1)),
+
+			OnTick: func(sim *Simulation, unit *Unit, dot *Dot) {
+				dot.Spell.CalcAndDealPeriodicDamage(sim, unit, config.DotDamage*float64(dot.GetStacks()), dot.Spell.OutcomeAlwaysHit)
+			},
+		}
+	}
+
+	spell := target.RegisterSpell(SpellConfig{
+		ActionID:         actionID,
+		SpellSchool:      school,
+		ProcMask:         Ternary(isPhysical, ProcMaskMeleeMHSpecial, ProcMaskSpellDamage),
+		Flags:            Ternary(isPhysical, SpellFlagMeleeMetrics, SpellFlagNone) | SpellFlagIgnoreAttackerModifiers,
+		DamageMultiplier: 1,
+
+		Dot: dotConfig,
+
+		ApplyEffects: func(sim *Simulation, unit *Unit, spell *Spell) {
+			baseDamage := config.BaseDamage * (1 + config.DamageSpread*sim.RandomFloat(damageLabel))
+			var result *SpellResult
+			if isPhysical && config.Avoidable {
+				result = spell.CalcAndDealDamage(sim, unit, baseDamage, spell.OutcomeEnemyMeleeWhite)
+			} else {
+				result = spell.CalcAndDealDamage(sim, unit, baseDamage, spell.OutcomeAlwaysHit)
+			}
+
+			if !result.Landed() {
+				return
+			}
+			if healingReductionAuras != nil {
+				healingReductionAuras.Get(unit).Activate(sim)
+			}
+			if healingAbsorbAuras != nil {
+				healingAbsorbAuras[unit.UnitIndex].AddAbsorb(sim, config.HealingAbsorb)
+			}
+			if config.DotDamage > 0 {
+				dot := spell.Dot(unit)
+				stacks := Ternary(dot.IsActive(), dot.GetStacks(), 0)
+				dot.Apply(sim)
+				dot.SetStacks(sim, min(stacks+1, dot.MaxStacks))
+			}
+		},
+	})
+
+	numHits := max(config.NumHits, 1)
+	hitInterval := DurationFromSeconds(config.HitInterval)
+	castTime := DurationFromSeconds(config.CastTime)
+
+	castOnTargets := func(sim *Simulation) {
+		if !target.IsEnabled() {
+			return
+		}
+
+		switch config.Target {
+		case proto.BossSpecialTarget_BossSpecialTargetTank:
+			if target.CurrentTarget != nil {
+				spell.Cast(sim, target.CurrentTarget)
+			}
+		case proto.BossSpecialTarget_BossSpecialTargetRaid:
+			for _, playerUnit := range sim.Raid.AllPlayerUnits {
+				spell.Cast(sim, playerUnit)
+			}
+		case proto.BossSpecialTarget_BossSpecialTargetRandomPlayer:
+			players := sim.Raid.AllPlayerUnits
+			if len(players) > 0 {
+				spell.Cast(sim, players[int(sim.RandomFloat("Boss Special Target "+config.Name)*float64(len(players)))])
+			}
+		}
+	}
+
+	useSpecial := func(sim *Simulation) {
+		castOnTargets(sim)
+
+		if numHits > 1 {
+			StartPeriodicAction(sim, PeriodicActionOptions{
+				Period:   max(hitInterval, time.Millisecond),
+				NumTicks: int(numHits - 1),
+				Priority: ActionPriorityDOT,
+				OnAction: castOnTargets,
+			})
+		}
+	}
+
+	return func(sim *Simulation) bool {
+		if castTime <= 0 {
+			useSpecial(sim)
+			return true
+		}
+
+		if target.Hardcast.Expires > sim.CurrentTime {
+			return false
+		}
+
+		if sim.Log != nil {
+			target.Log(sim, "Casting %s (Cast Time = %s)", actionID, castTime)
+		}
+		target.Hardcast = Hardcast{
+			Expires:  sim.CurrentTime + castTime,
+			ActionID: actionID,
+			OnComplete: func(sim *Simulation, _ *Unit) {
+				if target.IsEnabled() {
+					useSpecial(sim)
+				}
+			},
+			Target:          target.CurrentTarget,
+			Uninterruptible: !config.Interruptible,
+		}
+		target.newHardcastAction(sim)
+		return true
+	}
+}
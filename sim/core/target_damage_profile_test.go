@@ -0,0 +1,67 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/core/stats"
+)
+
+func TestResolveBossDamageProfileOverridesPreset(t *testing.T) {
+	profile := resolveBossDamageProfile(&proto.BossDamageProfile{
+		Type:       proto.BossDamageProfileType_BossDamageProfilePhysicalSpecials,
+		SwingSpeed: 2.5,
+		Specials: []*proto.BossSpecialAttack{
+			{Name: "Custom Bolt", BaseDamage: 1000, Cooldown: 10},
+		},
+	})
+
+	if profile.SwingSpeed != 2.5 {
+		t.Fatalf("Expected swing speed override of 2.5 but found %f", profile.SwingSpeed)
+	}
+	if profile.MinBaseDamage != 450000 {
+		t.Fatalf("Expected preset min base damage of 450000 but found %f", profile.MinBaseDamage)
+	}
+	if len(profile.Specials) != 2 || profile.Specials[1].Name != "Custom Bolt" {
+		t.Fatalf("Expected custom special to be appended to preset specials, found %v", profile.Specials)
+	}
+
+	// The preset itself must not be modified by the merge.
+	if preset := GetBossDamageProfile(proto.BossDamageProfileType_BossDamageProfilePhysicalSpecials); len(preset.Specials) != 1 || preset.SwingSpeed != 2.0 {
+		t.Fatalf("Preset profile was modified while resolving a request profile")
+	}
+}
+
+func TestResolveBossDamageProfileCustom(t *testing.T) {
+	if resolveBossDamageProfile(nil) != nil {
+		t.Fatalf("Expected nil profile when none is requested")
+	}
+
+	profile := resolveBossDamageProfile(&proto.BossDamageProfile{MinBaseDamage: 1234})
+	if profile.SwingSpeed != 0 || profile.MinBaseDamage != 1234 || len(profile.Specials) != 0 {
+		t.Fatalf("Custom profile should only contain requested values, found %v", profile)
+	}
+}
+
+func TestHealingAbsorptionAura(t *testing.T) {
+	sim := &Simulation{}
+
+	player := &Unit{
+		Type:        PlayerUnit,
+		Index:       0,
+		Level:       CharacterLevel,
+		auraTracker: newAuraTracker(),
+		PseudoStats: stats.NewPseudoStats(),
+	}
+	absorb := player.NewHealingAbsorptionAura(Aura{Label: "Healing Absorb", ActionID: ActionID{SpellID: 1}, Duration: NeverExpires})
+
+	absorb.AddAbsorb(sim, 300)
+	absorb.AddAbsorb(sim, 200)
+	if healing := player.absorbHealing(sim, 400); healing != 0 || absorb.AbsorbRemaining != 100 {
+		t.Fatalf("Expected reapplied absorbs to add up and consume the heal, found %f healing and %f remaining", healing, absorb.AbsorbRemaining)
+	}
+
+	if healing := player.absorbHealing(sim, 400); healing != 300 || absorb.IsActive() {
+		t.Fatalf("Expected the rest of the heal to land once the absorb is consumed, found %f healing", healing)
+	}
+}
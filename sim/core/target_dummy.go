@@ -35,6 +35,8 @@ func NewTargetDummy(dummyIndex int, party *Party, partyIndex int) *TargetDummy {
 		},
 	}
 
+	td.AddStats(td.baseStats)
+
 	td.Label = fmt.Sprintf("%s (#%d)", td.Name, td.Index+1)
 	td.GCD = td.NewTimer()
 	td.RotationTimer = td.NewTimer()
@@ -0,0 +1,58 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/core/stats"
+)
+
+func TestEncounterHealthLinkedPools(t *testing.T) {
+	targetWithHealth := func(health float64, linkedHealthGroup int32) *proto.Target {
+		targetStats := stats.Stats{}
+		targetStats[stats.Health] = health
+		return &proto.Target{Stats: targetStats.ToProtoArray(), LinkedHealthGroup: linkedHealthGroup}
+	}
+
+	encounter := NewEncounter(&proto.Encounter{
+		UseHealth: true,
+		Targets: []*proto.Target{
+			targetWithHealth(100, 0),
+			targetWithHealth(500, 1),
+			targetWithHealth(400, 1),
+			targetWithHealth(200, 2),
+			targetWithHealth(200, 2),
+		},
+	})
+
+	if encounter.EndFightAtHealth != 800 {
+		t.Fatalf("Expected each linked health pool to count once towards the encounter health, found %f", encounter.EndFightAtHealth)
+	}
+}
+
+func TestLowerLevelTargetAttackTable(t *testing.T) {
+	player := &Unit{Type: PlayerUnit, Level: CharacterLevel}
+	dungeonTrash := &Unit{Type: EnemyUnit, Level: CharacterLevel - 2}
+
+	attackTable := NewAttackTable(player, dungeonTrash)
+	if attackTable.BaseSpellMissChance != 0.06 || attackTable.BaseMissChance != 0.03 {
+		t.Fatalf("Expected same level miss chances against lower level targets, found %f and %f", attackTable.BaseSpellMissChance, attackTable.BaseMissChance)
+	}
+	if attackTable.MeleeCritSuppression != 0 || attackTable.GlanceMultiplier != 0.95 {
+		t.Fatalf("Expected no crit suppression and same level glancing against lower level targets, found %f and %f", attackTable.MeleeCritSuppression, attackTable.GlanceMultiplier)
+	}
+}
+
+func TestTargetArmorForLevel(t *testing.T) {
+	if armor := TargetArmorForLevel(CharacterLevel + 3); armor != 24835 {
+		t.Fatalf("Expected raid boss armor of 24835, found %f", armor)
+	}
+	if armor := TargetArmorForLevel(CharacterLevel + 2); armor != 23115 {
+		t.Fatalf("Expected dungeon boss armor of 23115, found %f", armor)
+	}
+
+	plus1Armor, plus0Armor := TargetArmorForLevel(CharacterLevel+1), TargetArmorForLevel(CharacterLevel)
+	if !(plus0Armor < plus1Armor && plus1Armor < 23115) {
+		t.Fatalf("Expected armor to decrease with level, found %f and %f", plus1Armor, plus0Armor)
+	}
+}
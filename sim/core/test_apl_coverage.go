@@ -0,0 +1,69 @@
+package core
+
+import (
+	"slices"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+// Utility spells from shared item effects which no default rotation uses.
+var defaultAPLCoverageExemptions = []ActionID{
+	{SpellID: 55004}, // Nitro Boosts
+}
+
+// Returns the APL spells of the first player which were never cast during the
+// sim, excluding exempted spells. Tags are ignored when matching exemptions.
+func uncoveredAPLSpells(rsr *proto.RaidSimRequest, exemptions []ActionID) []ActionID {
+	result := RunRaidSim(rsr)
+	if result.Error != nil {
+		panic("simulation failed to run: " + result.Error.Message)
+	}
+
+	castActions := make(map[ActionID]bool)
+	for _, actionMetrics := range result.RaidMetrics.Parties[0].Players[0].Actions {
+		for _, targetMetrics := range actionMetrics.Targets {
+			if targetMetrics.Casts > 0 {
+				castActions[ProtoToActionID(actionMetrics.Id)] = true
+				break
+			}
+		}
+	}
+
+	env, _, _ := NewEnvironment(rsr.Raid, rsr.Encounter, false)
+	character := env.Raid.Parties[0].Players[0].GetCharacter()
+
+	var uncovered []ActionID
+	for _, spell := range character.Spellbook {
+		// Casts of spells without metrics can't be observed.
+		if !spell.Flags.Matches(SpellFlagAPL) || spell.Flags.Matches(SpellFlagNoMetrics) {
+			continue
+		}
+
+		// Metrics only carry one of the spell, item and other IDs, so compare
+		// against the round-tripped ID.
+		if castActions[ProtoToActionID(spell.ActionID.ToProto())] {
+			continue
+		}
+
+		isExempt := func(exemption ActionID) bool {
+			return matchesAPLCoverageExemption(exemption, spell.ActionID)
+		}
+		if slices.ContainsFunc(exemptions, isExempt) || slices.ContainsFunc(defaultAPLCoverageExemptions, isExempt) {
+			continue
+		}
+
+		uncovered = append(uncovered, spell.ActionID)
+	}
+
+	return uncovered
+}
+
+// Exemptions are matched on the most specific ID they set, ignoring tags.
+func matchesAPLCoverageExemption(exemption ActionID, actionID ActionID) bool {
+	if exemption.SpellID != 0 {
+		return exemption.SpellID == actionID.SpellID
+	} else if exemption.ItemID != 0 {
+		return exemption.ItemID == actionID.ItemID
+	}
+	return exemption.OtherID == actionID.OtherID
+}
@@ -46,6 +46,21 @@ func (generator *SingleDpsTestGenerator) GetTest(_ int) (string, *proto.ComputeS
 	return generator.Name, nil, nil, generator.Request
 }
 
+// Runs the default APL and checks that every spell flagged SpellFlagAPL was
+// cast at least once, unless exempted.
+type APLCoverageTestGenerator struct {
+	Name       string
+	Request    *proto.RaidSimRequest
+	Exemptions []ActionID
+}
+
+func (generator *APLCoverageTestGenerator) NumTests() int {
+	return 1
+}
+func (generator *APLCoverageTestGenerator) GetTest(_ int) (string, *proto.ComputeStatsRequest, *proto.StatWeightsRequest, *proto.RaidSimRequest) {
+	return generator.Name, nil, nil, generator.Request
+}
+
 type RotationCastsTestGenerator struct {
 	SpecOptions []SpecOptionsCombo
 	PartyBuffs  *proto.PartyBuffs
@@ -485,6 +500,22 @@ func (generator *CombinedTestGenerator) GetTest(testIdx int) (string, *proto.Com
 	panic("Invalid testIdx")
 }
 
+// Returns the generator that produces the test with the given index, i.e. the
+// innermost generator of any combined generators.
+func getLeafTestGenerator(generator TestGenerator, testIdx int) TestGenerator {
+	if gen, ok := generator.(*CombinedTestGenerator); ok {
+		remaining := testIdx
+		for _, child := range gen.subgenerators {
+			numTests := child.generator.NumTests()
+			if remaining < numTests {
+				return getLeafTestGenerator(child.generator, remaining)
+			}
+			remaining -= numTests
+		}
+	}
+	return generator
+}
+
 type CharacterSuiteConfig struct {
 	Class proto.Class
 
@@ -527,6 +558,16 @@ type CharacterSuiteConfig struct {
 
 	ItemFilter ItemFilter
 
+	// APL spells which the default rotation is not expected to cast, e.g.
+	// defensives or spells only used on multiple targets. Coverage is only
+	// checked for the first config of a suite, using the exemptions of all
+	// configs.
+	APLCoverageExemptions []ActionID
+
+	// Statistics of a real log which the default rotation is compared against.
+	// Only used for the first config of a suite.
+	ReferenceLog *ReferenceLogStats
+
 	StatsToWeigh       []proto.Stat
 	PseudoStatsToWeigh []proto.PseudoStat
 	EPReferenceStat    proto.Stat
@@ -537,6 +578,12 @@ type CharacterSuiteConfig struct {
 // core.GetTestBuildFromJSON(proto.Class_ClassWarrior, "../../../ui/warrior/arms/builds", "default", ItemFilter, proto.Stat_StatStrength, nil)
 func FullCharacterTestSuiteGenerator(configs []CharacterSuiteConfig) []TestGenerator {
 	testIndex := 0
+
+	var aplCoverageExemptions []ActionID
+	for _, config := range configs {
+		aplCoverageExemptions = append(aplCoverageExemptions, config.APLCoverageExemptions...)
+	}
+
 	return MapSlice(configs, func(config CharacterSuiteConfig) TestGenerator {
 		allRaces := append(config.OtherRaces, config.Race)
 		allGearSets := append(config.OtherGearSets, config.GearSet)
@@ -663,6 +710,19 @@ func FullCharacterTestSuiteGenerator(configs []CharacterSuiteConfig) []TestGener
 			newRaid := googleProto.Clone(defaultRaid).(*proto.Raid)
 			newRaid.Parties[0].Players[0].InFrontOfTarget = !newRaid.Parties[0].Players[0].InFrontOfTarget
 
+			generator.subgenerators = append(generator.subgenerators, SubGenerator{
+				name: "APLCoverage",
+				generator: &APLCoverageTestGenerator{
+					Name: "Default",
+					Request: &proto.RaidSimRequest{
+						Raid:       defaultRaid,
+						Encounter:  Ternary(config.Encounter.Encounter != nil, config.Encounter.Encounter, MakeSingleTargetEncounter(0)),
+						SimOptions: DefaultSimTestOptions,
+					},
+					Exemptions: aplCoverageExemptions,
+				},
+			})
+
 			generator.subgenerators = append(generator.subgenerators, SubGenerator{
 				name: "SwitchInFrontOfTarget",
 				generator: &SingleDpsTestGenerator{
@@ -698,6 +758,35 @@ func FullCharacterTestSuiteGenerator(configs []CharacterSuiteConfig) []TestGener
 			})
 		}
 
+		if testIndex == 0 && config.ReferenceLog != nil {
+			generator.subgenerators = append(generator.subgenerators, SubGenerator{
+				name: "LogValidation",
+				generator: &LogValidationTestGenerator{
+					Name: "Default",
+					Request: &proto.RaidSimRequest{
+						Raid:       defaultRaid,
+						Encounter:  Ternary(config.ReferenceLog.Encounter != nil, config.ReferenceLog.Encounter, Ternary(config.Encounter.Encounter != nil, config.Encounter.Encounter, MakeSingleTargetEncounter(0))),
+						SimOptions: DefaultSimTestOptions,
+					},
+					Reference: config.ReferenceLog,
+				},
+			})
+		}
+
+		if testIndex == 0 {
+			generator.subgenerators = append(generator.subgenerators, SubGenerator{
+				name: "SmokeBenchmark",
+				generator: &SmokeBenchmarkTestGenerator{
+					Name: "Default",
+					Request: &proto.RaidSimRequest{
+						Raid:       defaultRaid,
+						Encounter:  Ternary(config.Encounter.Encounter != nil, config.Encounter.Encounter, MakeSingleTargetEncounter(0)),
+						SimOptions: SmokeBenchmarkSimOptions,
+					},
+				},
+			})
+		}
+
 		// Add this separately, so it's always last, which makes it easy to find in the
 		// displayed test results.
 		// We only run this for the first test
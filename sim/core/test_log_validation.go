@@ -0,0 +1,158 @@
+package core
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+// Statistics of a real combat log, e.g. a parse of a well played character,
+// which the default rotation of a spec is validated against. Tags are ignored
+// when matching actions, and pet actions are merged with player actions of
+// the same ID.
+type ReferenceLogStats struct {
+	// Where the statistics come from, e.g. the log URL.
+	Source string
+
+	// The fight of the log. Defaults to the encounter of the suite.
+	Encounter *proto.Encounter
+
+	// Casts per minute of each action. Rates below one cast per minute are
+	// compared absolutely rather than relatively.
+	CastsPerMinute map[ActionID]float64
+
+	// Fraction of the total damage done by each action. Actions which aren't
+	// listed are expected to do no damage.
+	DamageShare map[ActionID]float64
+
+	// Optional limits, the test fails if the sim diverges further.
+	MaxCastRateError    float64
+	MaxDamageShareError float64
+}
+
+// Runs the default APL and compares its casts and damage against reference
+// log statistics.
+type LogValidationTestGenerator struct {
+	Name      string
+	Request   *proto.RaidSimRequest
+	Reference *ReferenceLogStats
+}
+
+func (generator *LogValidationTestGenerator) NumTests() int {
+	return 1
+}
+func (generator *LogValidationTestGenerator) GetTest(_ int) (string, *proto.ComputeStatsRequest, *proto.StatWeightsRequest, *proto.RaidSimRequest) {
+	return generator.Name, nil, nil, generator.Request
+}
+
+func (testSuite *IndividualTestSuite) TestLogValidation(testName string, rsr *proto.RaidSimRequest, reference *ReferenceLogStats) *proto.LogValidationTestResult {
+	testSuite.testNames = append(testSuite.testNames, testName)
+
+	result := RunRaidSim(rsr)
+	if result.Error != nil {
+		panic("simulation failed to run: " + result.Error.Message)
+	}
+
+	validationResult := compareToReferenceLog(result, reference)
+	testSuite.testResults.LogValidationResults[testName] = validationResult
+	return validationResult
+}
+
+// Computes the divergence of the first player of the sim from the reference.
+func compareToReferenceLog(result *proto.RaidSimResult, reference *ReferenceLogStats) *proto.LogValidationTestResult {
+	casts := make(map[ActionID]float64)
+	damage := make(map[ActionID]float64)
+	totalDamage := 0.0
+	addUnitActions := func(unitMetrics *proto.UnitMetrics) {
+		for _, actionMetrics := range unitMetrics.Actions {
+			actionID := ProtoToActionID(actionMetrics.Id)
+			actionID.Tag = 0
+			for _, targetMetrics := range actionMetrics.Targets {
+				casts[actionID] += float64(targetMetrics.Casts)
+				damage[actionID] += targetMetrics.Damage
+				totalDamage += targetMetrics.Damage
+			}
+		}
+	}
+
+	player := result.RaidMetrics.Parties[0].Players[0]
+	addUnitActions(player)
+	for _, pet := range player.Pets {
+		addUnitActions(pet)
+	}
+
+	minutes := float64(result.IterationsDone) * result.AvgIterationDuration / 60
+	validationResult := &proto.LogValidationTestResult{
+		Source:  reference.Source,
+		Actions: make(map[string]*proto.LogValidationAction),
+	}
+	getAction := func(actionID ActionID) *proto.LogValidationAction {
+		actionID.Tag = 0
+		key := actionMetricsKey(actionID.ToProto())
+		action, ok := validationResult.Actions[key]
+		if !ok {
+			action = &proto.LogValidationAction{
+				CastsPerMinute: toFixed(casts[actionID]/minutes, storagePrecision),
+				DamageShare:    toFixed(damage[actionID]/max(totalDamage, 1), storagePrecision),
+			}
+			validationResult.Actions[key] = action
+		}
+		return action
+	}
+
+	castRateError := 0.0
+	for actionID, referenceRate := range reference.CastsPerMinute {
+		action := getAction(actionID)
+		action.ReferenceCastsPerMinute = referenceRate
+		castRateError += math.Abs(action.CastsPerMinute-referenceRate) / max(referenceRate, 1)
+	}
+	if len(reference.CastsPerMinute) > 0 {
+		validationResult.CastRateError = toFixed(castRateError/float64(len(reference.CastsPerMinute)), storagePrecision)
+	}
+
+	for actionID, referenceShare := range reference.DamageShare {
+		getAction(actionID).ReferenceDamageShare = referenceShare
+	}
+	if len(reference.DamageShare) > 0 {
+		for actionID, actionDamage := range damage {
+			if actionDamage > 0 {
+				getAction(actionID)
+			}
+		}
+		damageShareError := 0.0
+		for _, action := range validationResult.Actions {
+			damageShareError += math.Abs(action.DamageShare - action.ReferenceDamageShare)
+		}
+		validationResult.DamageShareError = toFixed(damageShareError/2, storagePrecision)
+	}
+
+	return validationResult
+}
+
+// Logs the actions of the result, most diverging first.
+func logReferenceDivergence(t *testing.T, result *proto.LogValidationTestResult) {
+	t.Logf("Divergence from %s: %0.01f%% cast rate error, %0.01f%% damage share error", result.Source, result.CastRateError*100, result.DamageShareError*100)
+
+	keys := make([]string, 0, len(result.Actions))
+	for key := range result.Actions {
+		keys = append(keys, key)
+	}
+	divergence := func(action *proto.LogValidationAction) float64 {
+		return math.Abs(action.DamageShare-action.ReferenceDamageShare) + math.Abs(action.CastsPerMinute-action.ReferenceCastsPerMinute)/max(action.ReferenceCastsPerMinute, 1)
+	}
+	slices.SortFunc(keys, func(a, b string) int {
+		divergenceA, divergenceB := divergence(result.Actions[a]), divergence(result.Actions[b])
+		if divergenceA != divergenceB {
+			return Ternary(divergenceA > divergenceB, -1, 1)
+		}
+		return strings.Compare(a, b)
+	})
+
+	for _, key := range keys {
+		action := result.Actions[key]
+		t.Logf("  %s: %0.02f casts/min (log %0.02f), %0.01f%% of damage (log %0.01f%%)", key, action.CastsPerMinute, action.ReferenceCastsPerMinute, action.DamageShare*100, action.ReferenceDamageShare*100)
+	}
+}
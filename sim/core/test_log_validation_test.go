@@ -0,0 +1,52 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestCompareToReferenceLog(t *testing.T) {
+	result := &proto.RaidSimResult{
+		IterationsDone:       2,
+		AvgIterationDuration: 120,
+		RaidMetrics: &proto.RaidMetrics{Parties: []*proto.PartyMetrics{{Players: []*proto.UnitMetrics{{
+			Actions: []*proto.ActionMetrics{
+				{Id: ActionID{SpellID: 1}.ToProto(), Targets: []*proto.TargetedActionMetrics{{Casts: 40, Damage: 600}}},
+				{Id: ActionID{SpellID: 2, Tag: 1}.ToProto(), Targets: []*proto.TargetedActionMetrics{{Casts: 4, Damage: 200}}},
+				{Id: ActionID{SpellID: 2, Tag: 2}.ToProto(), Targets: []*proto.TargetedActionMetrics{{Casts: 4, Damage: 0}}},
+			},
+			Pets: []*proto.UnitMetrics{{
+				Actions: []*proto.ActionMetrics{
+					{Id: ActionID{SpellID: 3}.ToProto(), Targets: []*proto.TargetedActionMetrics{{Casts: 8, Damage: 200}}},
+				},
+			}},
+		}}}}},
+	}
+
+	validationResult := compareToReferenceLog(result, &ReferenceLogStats{
+		Source:         "test",
+		CastsPerMinute: map[ActionID]float64{{SpellID: 1}: 8, {SpellID: 2}: 2},
+		DamageShare:    map[ActionID]float64{{SpellID: 1}: 0.6, {SpellID: 2}: 0.4},
+	})
+
+	spell1 := validationResult.Actions[actionMetricsKey(ActionID{SpellID: 1}.ToProto())]
+	spell2 := validationResult.Actions[actionMetricsKey(ActionID{SpellID: 2}.ToProto())]
+	spell3 := validationResult.Actions[actionMetricsKey(ActionID{SpellID: 3}.ToProto())]
+	if spell1 == nil || spell2 == nil || spell3 == nil || len(validationResult.Actions) != 3 {
+		t.Fatalf("Expected an entry per reference and damaging action, got %v", validationResult.Actions)
+	}
+
+	if spell1.CastsPerMinute != 10 || spell2.CastsPerMinute != 2 {
+		t.Fatalf("Expected casts per minute merged over tags, got %v and %v", spell1, spell2)
+	}
+	if spell1.DamageShare != 0.6 || spell2.DamageShare != 0.2 || spell3.DamageShare != 0.2 || spell3.ReferenceDamageShare != 0 {
+		t.Fatalf("Expected damage shares including pets, got %v, %v and %v", spell1, spell2, spell3)
+	}
+	if validationResult.CastRateError != 0.125 {
+		t.Fatalf("Expected average relative cast rate error of 0.125, got %f", validationResult.CastRateError)
+	}
+	if validationResult.DamageShareError != 0.2 {
+		t.Fatalf("Expected damage share error of 0.2, got %f", validationResult.DamageShareError)
+	}
+}
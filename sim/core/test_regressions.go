@@ -0,0 +1,133 @@
+package core
+
+import (
+	"errors"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Returns the largest deviation from the expected value that still passes.
+func allowedDeviation(expected float64, band *proto.ToleranceBand) float64 {
+	allowed := tolerance
+	if band != nil {
+		allowed = max(allowed, band.Absolute, band.Relative*math.Abs(expected))
+	}
+	return allowed
+}
+
+func actionMetricsKey(id *proto.ActionID) string {
+	return strings.ReplaceAll(id.String(), "  ", " ")
+}
+
+// The per-action damage breakdown makes results files many times larger, so it
+// is only recorded when this environment variable is set. Regenerate the
+// expected results with it set to get per-action regression reports.
+const damageByActionEnvVar = "RECORD_DAMAGE_BY_ACTION"
+
+func recordDamageByAction() bool {
+	record, _ := strconv.ParseBool(os.Getenv(damageByActionEnvVar))
+	return record
+}
+
+// Average damage per iteration for each action of the first player and their
+// pets. Pet actions are prefixed with the pet name.
+func damageByAction(result *proto.RaidSimResult, iterations int32) map[string]float64 {
+	damage := make(map[string]float64)
+	addUnitActions := func(unitMetrics *proto.UnitMetrics, prefix string) {
+		for _, actionMetrics := range unitMetrics.Actions {
+			total := 0.0
+			for _, targetMetrics := range actionMetrics.Targets {
+				total += targetMetrics.Damage
+			}
+			if total == 0 {
+				continue
+			}
+
+			key := prefix + actionMetricsKey(actionMetrics.Id)
+			damage[key] = toFixed(damage[key]+total/float64(iterations), storagePrecision)
+		}
+	}
+
+	player := result.RaidMetrics.Parties[0].Players[0]
+	addUnitActions(player, "")
+	for _, pet := range player.Pets {
+		addUnitActions(pet, pet.Name+": ")
+	}
+	return damage
+}
+
+func newTestRegression(testName string, metric string, expected float64, actual float64, allowed float64) *proto.TestRegression {
+	return &proto.TestRegression{
+		TestName:         testName,
+		Metric:           metric,
+		Expected:         expected,
+		Actual:           actual,
+		AllowedDeviation: allowed,
+	}
+}
+
+// Fills in the per-action breakdown of a dps regression, largest change first.
+func addActionRegressions(regression *proto.TestRegression, expected *proto.DpsTestResult, actual *proto.DpsTestResult) {
+	if len(expected.DamageByAction) == 0 {
+		return
+	}
+
+	actionNames := make([]string, 0, len(expected.DamageByAction))
+	for action := range expected.DamageByAction {
+		actionNames = append(actionNames, action)
+	}
+	for action := range actual.DamageByAction {
+		if _, ok := expected.DamageByAction[action]; !ok {
+			actionNames = append(actionNames, action)
+		}
+	}
+
+	for _, action := range actionNames {
+		expectedDamage := expected.DamageByAction[action]
+		actualDamage := actual.DamageByAction[action]
+		if math.Abs(actualDamage-expectedDamage) <= allowedDeviation(expectedDamage, expected.Tolerance) {
+			continue
+		}
+
+		regression.Actions = append(regression.Actions, &proto.ActionRegression{
+			Action:         action,
+			ExpectedDamage: expectedDamage,
+			ActualDamage:   actualDamage,
+		})
+	}
+
+	slices.SortFunc(regression.Actions, func(a, b *proto.ActionRegression) int {
+		deltaA := math.Abs(a.ActualDamage - a.ExpectedDamage)
+		deltaB := math.Abs(b.ActualDamage - b.ExpectedDamage)
+		if deltaA != deltaB {
+			return Ternary(deltaA > deltaB, -1, 1)
+		}
+		return strings.Compare(a.Action, b.Action)
+	})
+}
+
+// Writes the report as JSON next to the expected results, or removes a stale
+// report if there were no regressions.
+func writeRegressionReport(suiteName string, report *proto.TestRegressionReport) {
+	fileName := suiteName + ".regressions.json"
+	if len(report.Regressions) == 0 {
+		if err := os.Remove(fileName); err != nil && !errors.Is(err, os.ErrNotExist) {
+			panic(err)
+		}
+		return
+	}
+
+	data, err := protojson.MarshalOptions{Multiline: true}.Marshal(report)
+	if err != nil {
+		panic(err)
+	}
+	if err := os.WriteFile(fileName, data, 0644); err != nil {
+		panic(err)
+	}
+}
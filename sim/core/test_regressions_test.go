@@ -0,0 +1,40 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestAllowedDeviation(t *testing.T) {
+	if allowed := allowedDeviation(100000, nil); allowed != tolerance {
+		t.Fatalf("Expected default tolerance without a band, found %f", allowed)
+	}
+	if allowed := allowedDeviation(100000, &proto.ToleranceBand{Relative: 0.001}); allowed != 100 {
+		t.Fatalf("Expected relative band of 100, found %f", allowed)
+	}
+	if allowed := allowedDeviation(100000, &proto.ToleranceBand{Absolute: 250, Relative: 0.001}); allowed != 250 {
+		t.Fatalf("Expected the wider of both bands, found %f", allowed)
+	}
+}
+
+func TestActionRegressionsSortedByChange(t *testing.T) {
+	expected := &proto.DpsTestResult{
+		DamageByAction: map[string]float64{"a": 1000, "b": 2000, "c": 3000},
+	}
+	actual := &proto.DpsTestResult{
+		DamageByAction: map[string]float64{"a": 1100, "b": 2000, "d": 500},
+	}
+
+	regression := newTestRegression("test", "dps", 1, 2, tolerance)
+	addActionRegressions(regression, expected, actual)
+
+	if len(regression.Actions) != 3 {
+		t.Fatalf("Expected 3 changed actions, found %v", regression.Actions)
+	}
+	for i, action := range []string{"c", "d", "a"} {
+		if regression.Actions[i].Action != action {
+			t.Fatalf("Expected action %s at index %d, found %s", action, i, regression.Actions[i].Action)
+		}
+	}
+}
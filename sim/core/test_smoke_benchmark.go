@@ -0,0 +1,105 @@
+package core
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	"google.golang.org/protobuf/encoding/prototext"
+)
+
+// Smoke benchmarks are timing based, so they only run when this environment
+// variable is set, e.g. through 'make smoke-bench'.
+const smokeBenchmarkEnvVar = "SMOKE_BENCHMARK"
+
+const defaultAllowedSmokeRegression = 0.25
+
+// Standardized short sim used for the smoke benchmark of every spec. IsTest is
+// left off because it adds a lot of computation which doesn't reflect real use.
+var SmokeBenchmarkSimOptions = &proto.SimOptions{
+	Iterations: 200,
+	RandomSeed: 101,
+}
+
+type SmokeBenchmarkTestGenerator struct {
+	Name    string
+	Request *proto.RaidSimRequest
+}
+
+func (generator *SmokeBenchmarkTestGenerator) NumTests() int {
+	return 1
+}
+func (generator *SmokeBenchmarkTestGenerator) GetTest(_ int) (string, *proto.ComputeStatsRequest, *proto.StatWeightsRequest, *proto.RaidSimRequest) {
+	return generator.Name, nil, nil, generator.Request
+}
+
+func (testSuite *IndividualTestSuite) TestSmokeBenchmark(testName string, rsr *proto.RaidSimRequest) {
+	testSuite.testNames = append(testSuite.testNames, testName)
+
+	var simErr *proto.ErrorOutcome
+	benchResult := testing.Benchmark(func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if result := RunRaidSim(rsr); result.Error != nil {
+				simErr = result.Error
+				return
+			}
+		}
+	})
+	if simErr != nil {
+		panic("simulation failed to run: " + simErr.Message)
+	}
+
+	iterationsPerSecond := float64(benchResult.N) * float64(rsr.SimOptions.Iterations) / benchResult.T.Seconds()
+	testSuite.smokeBenchmarkResults.Results[testName] = &proto.SmokeBenchmarkResult{
+		IterationsPerSecond: toFixed(iterationsPerSecond, 1),
+	}
+}
+
+// Checks the measured iterations/sec against the baseline, carrying over any
+// hand-set allowed regression. Returns the lowest passing iterations/sec.
+func checkSmokeBenchmark(actual *proto.SmokeBenchmarkResult, baseline *proto.SmokeBenchmarkResult) (float64, bool) {
+	actual.AllowedRegression = baseline.AllowedRegression
+
+	allowedRegression := baseline.AllowedRegression
+	if allowedRegression <= 0 {
+		allowedRegression = defaultAllowedSmokeRegression
+	}
+
+	minIterationsPerSecond := baseline.IterationsPerSecond * (1 - allowedRegression)
+	return minIterationsPerSecond, actual.IterationsPerSecond >= minIterationsPerSecond
+}
+
+func newSmokeBenchmarkSuiteResult() *proto.SmokeBenchmarkSuiteResult {
+	return &proto.SmokeBenchmarkSuiteResult{
+		Results: make(map[string]*proto.SmokeBenchmarkResult),
+	}
+}
+
+func readSmokeBenchmarkBaseline(suiteName string) (*proto.SmokeBenchmarkSuiteResult, error) {
+	data, err := os.ReadFile(suiteName + ".perf")
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return newSmokeBenchmarkSuiteResult(), nil
+		}
+		return nil, err
+	}
+
+	results := newSmokeBenchmarkSuiteResult()
+	if err = prototext.Unmarshal(data, results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (testSuite *IndividualTestSuite) writeSmokeBenchmarkResults() {
+	if len(testSuite.smokeBenchmarkResults.Results) == 0 {
+		return
+	}
+
+	str := strings.ReplaceAll(prototext.Format(testSuite.smokeBenchmarkResults), "  ", " ")
+	if err := os.WriteFile(testSuite.Name+".perf.tmp", []byte(str), 0644); err != nil {
+		panic(err)
+	}
+}
@@ -26,12 +26,16 @@ type IndividualTestSuite struct {
 	testNames []string
 
 	testResults *proto.TestSuiteResult
+
+	smokeBenchmarkResults *proto.SmokeBenchmarkSuiteResult
 }
 
 func NewIndividualTestSuite(suiteName string) *IndividualTestSuite {
 	return &IndividualTestSuite{
 		Name:        suiteName,
 		testResults: newTestSuiteResult(),
+
+		smokeBenchmarkResults: newSmokeBenchmarkSuiteResult(),
 	}
 }
 
@@ -67,12 +71,16 @@ func (testSuite *IndividualTestSuite) TestDPS(testName string, rsr *proto.RaidSi
 	if result.Error != nil {
 		panic("simulation failed to run: " + result.Error.Message)
 	}
-	testSuite.testResults.DpsResults[testName] = &proto.DpsTestResult{
+	dpsResult := &proto.DpsTestResult{
 		Dps:  toFixed(result.RaidMetrics.Dps.Avg, storagePrecision),
 		Tps:  toFixed(result.RaidMetrics.Parties[0].Players[0].Threat.Avg, storagePrecision),
 		Dtps: toFixed(result.RaidMetrics.Parties[0].Players[0].Dtps.Avg, storagePrecision),
 		Hps:  toFixed(result.RaidMetrics.Parties[0].Players[0].Hps.Avg, storagePrecision),
 	}
+	if recordDamageByAction() {
+		dpsResult.DamageByAction = damageByAction(result, rsr.SimOptions.Iterations)
+	}
+	testSuite.testResults.DpsResults[testName] = dpsResult
 
 	return result
 }
@@ -99,8 +107,7 @@ func (testSuite *IndividualTestSuite) TestCasts(testName string, rsr *proto.Raid
 	}
 	castsByAction := make(map[string]float64, 0)
 	for _, metric := range result.RaidMetrics.Parties[0].Players[0].Actions {
-		name := metric.Id.String()
-		name = strings.ReplaceAll(name, "  ", " ")
+		name := actionMetricsKey(metric.Id)
 		for _, targetMetrics := range metric.Targets {
 			if val, ok := castsByAction[name]; ok {
 				castsByAction[name] = val + float64(targetMetrics.Casts)
@@ -118,6 +125,7 @@ func (testSuite *IndividualTestSuite) TestCasts(testName string, rsr *proto.Raid
 
 func (testSuite *IndividualTestSuite) Done(t *testing.T) {
 	testSuite.writeToFile()
+	testSuite.writeSmokeBenchmarkResults()
 }
 
 const tolerance = 0.00001
@@ -157,6 +165,7 @@ func newTestSuiteResult() *proto.TestSuiteResult {
 		StatWeightsResults:    make(map[string]*proto.StatWeightsTestResult),
 		DpsResults:            make(map[string]*proto.DpsTestResult),
 		CastsResults:          make(map[string]*proto.CastsTestResult),
+		LogValidationResults:  make(map[string]*proto.LogValidationTestResult),
 	}
 }
 
@@ -183,6 +192,18 @@ func RunTestSuite(t *testing.T, suiteName string, generators []TestGenerator) {
 		t.Logf("\n\n----- FAILURE LOADING RESULTS FILE TESTS WILL FAIL-----\n%s\n-----\n\n", err)
 		t.Fail()
 	}
+	regressionReport := &proto.TestRegressionReport{SuiteName: suiteName}
+
+	runSmokeBenchmarks, _ := strconv.ParseBool(os.Getenv(smokeBenchmarkEnvVar))
+	smokeBenchmarkBaseline := newSmokeBenchmarkSuiteResult()
+	if runSmokeBenchmarks {
+		if smokeBenchmarkBaseline, err = readSmokeBenchmarkBaseline(suiteName); err != nil {
+			t.Logf("\n\n----- FAILURE LOADING SMOKE BENCHMARK BASELINE -----\n%s\n-----\n\n", err)
+			t.Fail()
+			smokeBenchmarkBaseline = newSmokeBenchmarkSuiteResult()
+		}
+	}
+
 	Each(generators, func(generator TestGenerator) {
 		stopTest := false
 		numTests := generator.NumTests()
@@ -192,6 +213,7 @@ func RunTestSuite(t *testing.T, suiteName string, generators []TestGenerator) {
 			}
 
 			testName, csr, swr, rsr := generator.GetTest(i)
+			leafGenerator := getLeafTestGenerator(generator, i)
 			if strings.Contains(testName, "Average") && testing.Short() {
 				continue
 			}
@@ -235,36 +257,84 @@ func RunTestSuite(t *testing.T, suiteName string, generators []TestGenerator) {
 						t.Logf("Missing Result for test %s", fullTestName)
 						t.Fail()
 					}
+				} else if _, ok := leafGenerator.(*SmokeBenchmarkTestGenerator); ok {
+					if !runSmokeBenchmarks {
+						t.Skipf("Timing based, set %s=1 to run", smokeBenchmarkEnvVar)
+					}
+
+					testSuite.TestSmokeBenchmark(fullTestName, rsr)
+					actualResult := testSuite.smokeBenchmarkResults.Results[fullTestName]
+					if baseline, ok := smokeBenchmarkBaseline.Results[fullTestName]; ok {
+						if minIterationsPerSecond, ok := checkSmokeBenchmark(actualResult, baseline); !ok {
+							t.Logf("Expected at least %0.1f iterations/s (baseline %0.1f) but was %0.1f!", minIterationsPerSecond, baseline.IterationsPerSecond, actualResult.IterationsPerSecond)
+							t.Fail()
+						}
+					} else {
+						t.Logf("No smoke benchmark baseline for %s, measured %0.1f iterations/s. Record one on this machine with 'make smoke-bench-baseline'.", fullTestName, actualResult.IterationsPerSecond)
+						t.Fail()
+					}
+				} else if coverageGenerator, ok := leafGenerator.(*APLCoverageTestGenerator); ok {
+					for _, actionID := range uncoveredAPLSpells(rsr, coverageGenerator.Exemptions) {
+						t.Logf("APL spell %s was never cast by the default rotation. Fix the APL, or add it to APLCoverageExemptions if this is intended.", actionID)
+						t.Fail()
+					}
+				} else if logValidationGenerator, ok := leafGenerator.(*LogValidationTestGenerator); ok {
+					reference := logValidationGenerator.Reference
+					actualResult := testSuite.TestLogValidation(fullTestName, rsr, reference)
+					logReferenceDivergence(t, actualResult)
+
+					if reference.MaxCastRateError > 0 && actualResult.CastRateError > reference.MaxCastRateError {
+						t.Logf("Cast rate error of %0.03f exceeds the limit of %0.03f!", actualResult.CastRateError, reference.MaxCastRateError)
+						t.Fail()
+					}
+					if reference.MaxDamageShareError > 0 && actualResult.DamageShareError > reference.MaxDamageShareError {
+						t.Logf("Damage share error of %0.03f exceeds the limit of %0.03f!", actualResult.DamageShareError, reference.MaxDamageShareError)
+						t.Fail()
+					}
+
+					if expectedResult, ok := expectedResults.LogValidationResults[fullTestName]; ok {
+						if math.Abs(actualResult.CastRateError-expectedResult.CastRateError) > tolerance || math.Abs(actualResult.DamageShareError-expectedResult.DamageShareError) > tolerance {
+							t.Logf("Divergence from the reference log changed from %0.03f cast rate and %0.03f damage share error to %0.03f and %0.03f!", expectedResult.CastRateError, expectedResult.DamageShareError, actualResult.CastRateError, actualResult.DamageShareError)
+							t.Fail()
+						}
+					} else {
+						t.Logf("Unexpected test %s", fullTestName)
+						t.Fail()
+					}
 				} else if rsr != nil && !strings.Contains(testName, "Casts") {
 					simResult := testSuite.TestDPS(fullTestName, rsr)
 					if actualDpsResult, ok := testSuite.testResults.DpsResults[fullTestName]; ok {
 						if expectedDpsResult, ok := expectedResults.DpsResults[fullTestName]; ok {
-							// Check whichever of DPS/HPS is larger first, so we get better test diff printouts.
-							if actualDpsResult.Dps < actualDpsResult.Hps {
-								if actualDpsResult.Hps < expectedDpsResult.Hps-tolerance || actualDpsResult.Hps > expectedDpsResult.Hps+tolerance {
-									t.Logf("HPS expected %0.03f but was %0.03f!.", expectedDpsResult.Hps, actualDpsResult.Hps)
-									t.Fail()
+							// Keep hand-set tolerance bands when the results are regenerated.
+							actualDpsResult.Tolerance = expectedDpsResult.Tolerance
+
+							checkMetric := func(metric string, label string, expected float64, actual float64) {
+								allowed := allowedDeviation(expected, expectedDpsResult.Tolerance)
+								if math.Abs(actual-expected) <= allowed {
+									return
 								}
-							}
-							if actualDpsResult.Dps < expectedDpsResult.Dps-tolerance || actualDpsResult.Dps > expectedDpsResult.Dps+tolerance {
-								t.Logf("DPS expected %0.03f but was %0.03f!.", expectedDpsResult.Dps, actualDpsResult.Dps)
+
+								t.Logf("%s expected %0.03f but was %0.03f!.", label, expected, actual)
 								t.Fail()
-							}
-							if actualDpsResult.Dps >= actualDpsResult.Hps {
-								if actualDpsResult.Hps < expectedDpsResult.Hps-tolerance || actualDpsResult.Hps > expectedDpsResult.Hps+tolerance {
-									t.Logf("HPS expected %0.03f but was %0.03f!.", expectedDpsResult.Hps, actualDpsResult.Hps)
-									t.Fail()
+
+								regression := newTestRegression(fullTestName, metric, expected, actual, allowed)
+								if metric == "dps" {
+									addActionRegressions(regression, expectedDpsResult, actualDpsResult)
 								}
+								regressionReport.Regressions = append(regressionReport.Regressions, regression)
 							}
 
-							if actualDpsResult.Tps < expectedDpsResult.Tps-tolerance || actualDpsResult.Tps > expectedDpsResult.Tps+tolerance {
-								t.Logf("TPS expected %0.03f but was %0.03f!.", expectedDpsResult.Tps, actualDpsResult.Tps)
-								t.Fail()
+							// Check whichever of DPS/HPS is larger first, so we get better test diff printouts.
+							if actualDpsResult.Dps < actualDpsResult.Hps {
+								checkMetric("hps", "HPS", expectedDpsResult.Hps, actualDpsResult.Hps)
 							}
-							if actualDpsResult.Dtps < expectedDpsResult.Dtps-tolerance || actualDpsResult.Dtps > expectedDpsResult.Dtps+tolerance {
-								t.Logf("DTPS expected %0.03f but was %0.03f!.", expectedDpsResult.Dtps, actualDpsResult.Dtps)
-								t.Fail()
+							checkMetric("dps", "DPS", expectedDpsResult.Dps, actualDpsResult.Dps)
+							if actualDpsResult.Dps >= actualDpsResult.Hps {
+								checkMetric("hps", "HPS", expectedDpsResult.Hps, actualDpsResult.Hps)
 							}
+
+							checkMetric("tps", "TPS", expectedDpsResult.Tps, actualDpsResult.Tps)
+							checkMetric("dtps", "DTPS", expectedDpsResult.Dtps, actualDpsResult.Dtps)
 						} else {
 							t.Logf("Unexpected test %s with %0.03f DPS!", fullTestName, actualDpsResult.Dps)
 							t.Fail()
@@ -327,9 +397,13 @@ func RunTestSuite(t *testing.T, suiteName string, generators []TestGenerator) {
 	})
 
 	testSuite.Done(t)
+	writeRegressionReport(suiteName, regressionReport)
 
 	if t.Failed() {
 		t.Log("One or more tests failed! If the changes are intentional, update the expected results with 'make test && make update-tests'. Otherwise go fix your bugs!")
+		if len(regressionReport.Regressions) > 0 {
+			t.Logf("Per-metric and per-action differences were written to %s.regressions.json", suiteName)
+		}
 	}
 }
 
@@ -4,11 +4,14 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/wowsims/mop/sim/core/proto"
 	"github.com/wowsims/mop/sim/core/stats"
 	"google.golang.org/protobuf/encoding/protojson"
+	googleProto "google.golang.org/protobuf/proto"
 )
 
 var DefaultSimTestOptions = &proto.SimOptions{
@@ -252,31 +255,117 @@ func GenerateTalentVariations(baseTalents string, baseGlyphs *proto.Glyphs) []Ta
 }
 
 func GenerateTalentVariationsForRows(baseTalents string, baseGlyphs *proto.Glyphs, rowsToVary []int) []TalentsCombo {
+	return GenerateTalentVariationsWithOptions(baseTalents, baseGlyphs, TalentVariationOptions{Rows: rowsToVary})
+}
+
+type GlyphsCombo struct {
+	Label  string
+	Glyphs *proto.Glyphs
+}
+
+type TalentVariationOptions struct {
+	// Rows which are varied one at a time, keeping the base choice for all
+	// other rows.
+	Rows []int
+
+	// Rows whose choices are combined with each other, so every combination of
+	// these rows is generated.
+	CartesianRows []int
+
+	// Alternative glyphs, each of which is paired with the base talents and
+	// with every talent variation.
+	GlyphVariations []GlyphsCombo
+}
+
+// Generates talent and glyph variations of a base build. Variations which
+// repeat an earlier one, or the base build itself, are skipped.
+func GenerateTalentVariationsWithOptions(baseTalents string, baseGlyphs *proto.Glyphs, options TalentVariationOptions) []TalentsCombo {
 	if len(baseTalents) != 6 {
 		log.Fatalf("Expected 6-digit talent string, got: %s", baseTalents)
 	}
-
-	var combinations []TalentsCombo
-
-	baseRunes := []rune(baseTalents)
-	for _, row := range rowsToVary {
+	for _, row := range append(slices.Clone(options.Rows), options.CartesianRows...) {
 		if row < 0 || row >= 6 {
 			log.Fatalf("Invalid row index: %d, must be between 0 and 5", row)
 		}
+	}
 
+	type talentVariation struct {
+		label   string
+		talents string
+	}
+
+	seenTalents := map[string]bool{baseTalents: true}
+	var talentVariations []talentVariation
+	addTalents := func(label string, talents []rune) {
+		if talentString := string(talents); !seenTalents[talentString] {
+			seenTalents[talentString] = true
+			talentVariations = append(talentVariations, talentVariation{label: label, talents: talentString})
+		}
+	}
+
+	baseRunes := []rune(baseTalents)
+	for _, row := range options.Rows {
 		for choice := 1; choice <= 3; choice++ {
-			if int(baseRunes[row]-'0') == choice {
-				continue
+			variation := slices.Clone(baseRunes)
+			variation[row] = rune('0' + choice)
+			addTalents(fmt.Sprintf("Row%d_Talent%d", row+1, choice), variation)
+		}
+	}
+
+	if len(options.CartesianRows) > 0 {
+		choices := make([]int, len(options.CartesianRows))
+		for i := range choices {
+			choices[i] = 1
+		}
+
+		for {
+			variation := slices.Clone(baseRunes)
+			labels := make([]string, len(options.CartesianRows))
+			for i, row := range options.CartesianRows {
+				variation[row] = rune('0' + choices[i])
+				labels[i] = fmt.Sprintf("Row%d_Talent%d", row+1, choices[i])
 			}
+			addTalents(strings.Join(labels, "-"), variation)
 
-			variation := make([]rune, 6)
-			copy(variation, baseRunes)
-			variation[row] = rune('0' + choice)
+			// Advance to the next combination, like an odometer.
+			idx := len(choices) - 1
+			for idx >= 0 && choices[idx] == 3 {
+				choices[idx] = 1
+				idx--
+			}
+			if idx < 0 {
+				break
+			}
+			choices[idx]++
+		}
+	}
 
+	var combinations []TalentsCombo
+	for _, variation := range talentVariations {
+		combinations = append(combinations, TalentsCombo{
+			Label:   variation.label,
+			Talents: variation.talents,
+			Glyphs:  baseGlyphs,
+		})
+	}
+
+	seenGlyphs := []*proto.Glyphs{baseGlyphs}
+	for _, glyphs := range options.GlyphVariations {
+		if slices.ContainsFunc(seenGlyphs, func(seen *proto.Glyphs) bool { return googleProto.Equal(seen, glyphs.Glyphs) }) {
+			continue
+		}
+		seenGlyphs = append(seenGlyphs, glyphs.Glyphs)
+
+		combinations = append(combinations, TalentsCombo{
+			Label:   "DefaultTalents-" + glyphs.Label,
+			Talents: baseTalents,
+			Glyphs:  glyphs.Glyphs,
+		})
+		for _, variation := range talentVariations {
 			combinations = append(combinations, TalentsCombo{
-				Label:   fmt.Sprintf("Row%d_Talent%d", row+1, choice),
-				Talents: string(variation),
-				Glyphs:  baseGlyphs,
+				Label:   variation.label + "-" + glyphs.Label,
+				Talents: variation.talents,
+				Glyphs:  glyphs.Glyphs,
 			})
 		}
 	}
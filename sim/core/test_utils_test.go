@@ -0,0 +1,56 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestGenerateTalentVariationsForRowsUnchanged(t *testing.T) {
+	combos := GenerateTalentVariationsForRows("123123", nil, []int{4, 5})
+
+	expected := []string{"Row5_Talent1", "Row5_Talent3", "Row6_Talent1", "Row6_Talent2"}
+	if len(combos) != len(expected) {
+		t.Fatalf("Expected %d variations, found %d", len(expected), len(combos))
+	}
+	for i, label := range expected {
+		if combos[i].Label != label {
+			t.Fatalf("Expected label %s at index %d, found %s", label, i, combos[i].Label)
+		}
+	}
+}
+
+func TestGenerateTalentVariationsCartesianWithGlyphs(t *testing.T) {
+	baseGlyphs := &proto.Glyphs{Major1: 1}
+	combos := GenerateTalentVariationsWithOptions("111111", baseGlyphs, TalentVariationOptions{
+		Rows:          []int{0},
+		CartesianRows: []int{0, 1},
+		GlyphVariations: []GlyphsCombo{
+			{Label: "Same", Glyphs: &proto.Glyphs{Major1: 1}},
+			{Label: "Alt", Glyphs: &proto.Glyphs{Major1: 2}},
+		},
+	})
+
+	// 3x3 cartesian rows minus the base build, with the single row variations
+	// of row 1 already included. Each is then repeated with the alternative
+	// glyphs, along with the base talents.
+	if len(combos) != 8+9 {
+		t.Fatalf("Expected 17 variations, found %d", len(combos))
+	}
+
+	seen := make(map[string]bool)
+	for _, combo := range combos {
+		key := combo.Talents + combo.Glyphs.String()
+		if seen[key] {
+			t.Fatalf("Duplicate variation %s", combo.Label)
+		}
+		seen[key] = true
+	}
+
+	if combos[0].Label != "Row1_Talent2" || combos[2].Label != "Row1_Talent1-Row2_Talent2" {
+		t.Fatalf("Unexpected labels %s, %s", combos[0].Label, combos[2].Label)
+	}
+	if combos[8].Label != "DefaultTalents-Alt" || combos[8].Talents != "111111" {
+		t.Fatalf("Expected base talents with alternative glyphs, found %s %s", combos[8].Label, combos[8].Talents)
+	}
+}
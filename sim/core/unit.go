@@ -58,13 +58,23 @@ type Unit struct {
 
 	MobType proto.MobType
 
+	// Enemies fighting like players, for PvP stats and Battle Fatigue.
+	PlayerLike bool
+
 	// Amount of time it takes for the human agent to react to in-game events.
 	// Used by certain APL values and actions.
 	ReactionTime time.Duration
 
+	// Optional execution errors for player units, nil for perfect play.
+	skill *playerSkill
+
 	// Amount of time following a post-GCD channel tick, to when the next action can be performed.
 	ChannelClipDelay time.Duration
 
+	// How long before the GCD or a cast ends the rotation queues its next
+	// spell. 0 to only choose the next spell once the GCD has ended.
+	SpellQueueWindow time.Duration
+
 	// How far this unit is from its target(s). Measured in yards, this is used
 	// for calculating spell travel time for certain spells.
 	StartDistanceFromTarget float64
@@ -75,6 +85,12 @@ type Unit struct {
 	moveSpell               *Spell
 	movementAction          *MovementAction
 
+	// Where this unit stands, and the direction it faces in radians
+	// counterclockwise from the positive X axis. Kept in sync with
+	// DistanceFromTarget by movement.
+	Position Position
+	Facing   float64
+
 	// Environment in which this Unit exists. This will be nil until after the
 	// construction phase.
 	Env *Environment
@@ -123,6 +139,9 @@ type Unit struct {
 
 	secondaryResourceBar SecondaryResourceBar
 
+	// Debuffs absorbing incoming healing, see NewHealingAbsorptionAura.
+	healingAbsorptionAuras []*HealingAbsorptionAura
+
 	// All spells that can be cast by this unit.
 	Spellbook                 []*Spell
 	spellRegistrationHandlers []SpellRegisteredHandler
@@ -130,6 +149,9 @@ type Unit struct {
 	// Pets owned by this Unit.
 	PetAgents []PetAgent
 
+	// Effects which discharge once they accumulate enough charges.
+	capacitors []*Capacitor
+
 	DynamicStatsPets      []*Pet
 	DynamicMeleeSpeedPets []*Pet
 	DynamicCastSpeedPets  []*Pet
@@ -166,6 +188,10 @@ type Unit struct {
 	rotationAction *PendingAction
 	hardcastAction *PendingAction
 
+	// Times until which interrupts lock out each spell school, indexed by
+	// the school's bit.
+	schoolLockouts [8]time.Duration
+
 	// Cached mana return values per tick.
 	manaTickWhileCombat    float64
 	manaTickWhileNotCombat float64
@@ -185,6 +211,9 @@ type Unit struct {
 	// Data about the most recently queued spell, otherwise nil.
 	QueuedSpell *QueuedSpell
 
+	// The most recent spell that triggered the GCD, otherwise nil.
+	previousGCDSpell *Spell
+
 	// Used for reacting to mastery stat changes
 	OnMasteryStatChanged []OnMasteryStatChanged
 
@@ -411,24 +440,29 @@ func (unit *Unit) processDynamicBonus(sim *Simulation, bonus stats.Stats) {
 
 func (unit *Unit) EnableDynamicStatDep(sim *Simulation, dep *stats.StatDependency) {
 	if unit.StatDependencyManager.EnableDynamicStatDep(dep) {
-		oldStats := unit.stats
-		unit.stats = unit.ApplyStatDependencies(unit.statsWithoutDeps)
-		unit.processDynamicBonus(sim, unit.stats.Subtract(oldStats))
-
-		if sim.Log != nil {
-			unit.Log(sim, "Dynamic dep enabled (%s): %s", dep.String(), unit.stats.Subtract(oldStats).FlatString())
-		}
+		unit.recomputeStatDependencies(sim, "Dynamic dep enabled (%s): %s", dep)
 	}
 }
 func (unit *Unit) DisableDynamicStatDep(sim *Simulation, dep *stats.StatDependency) {
 	if unit.StatDependencyManager.DisableDynamicStatDep(dep) {
-		oldStats := unit.stats
-		unit.stats = unit.ApplyStatDependencies(unit.statsWithoutDeps)
-		unit.processDynamicBonus(sim, unit.stats.Subtract(oldStats))
+		unit.recomputeStatDependencies(sim, "Dynamic dep disabled (%s): %s", dep)
+	}
+}
 
-		if sim.Log != nil {
-			unit.Log(sim, "Dynamic dep disabled (%s): %s", dep.String(), unit.stats.Subtract(oldStats).FlatString())
-		}
+// Replaces one dynamic dep with another using a single stat recomputation.
+// Intended for auras which keep a separate dep per stack count. Either dep may
+// be nil.
+func (unit *Unit) SwapDynamicStatDep(sim *Simulation, oldDep *stats.StatDependency, newDep *stats.StatDependency) {
+	changed := false
+	if oldDep != nil {
+		changed = unit.StatDependencyManager.DisableDynamicStatDep(oldDep) || changed
+	}
+	if newDep != nil {
+		changed = unit.StatDependencyManager.EnableDynamicStatDep(newDep) || changed
+	}
+
+	if changed {
+		unit.recomputeStatDependencies(sim, "Dynamic dep swapped (%s): %s", Ternary(newDep != nil, newDep, oldDep))
 	}
 }
 
@@ -436,14 +470,18 @@ func (unit *Unit) UpdateDynamicStatDep(sim *Simulation, dep *stats.StatDependenc
 	dep.UpdateValue(newAmount)
 
 	if unit.Env.IsFinalized() {
-		oldStats := unit.stats
-		unit.stats = unit.ApplyStatDependencies(unit.statsWithoutDeps)
-		statsChange := unit.stats.Subtract(oldStats)
-		unit.processDynamicBonus(sim, statsChange)
+		unit.recomputeStatDependencies(sim, "Dynamic dep updated (%s): %s", dep)
+	}
+}
 
-		if sim.Log != nil {
-			unit.Log(sim, "Dynamic dep updated (%s): %s", dep.String(), statsChange.FlatString())
-		}
+func (unit *Unit) recomputeStatDependencies(sim *Simulation, logFormat string, dep *stats.StatDependency) {
+	oldStats := unit.stats
+	unit.stats = unit.ApplyStatDependencies(unit.statsWithoutDeps)
+	statsChange := unit.stats.Subtract(oldStats)
+	unit.processDynamicBonus(sim, statsChange)
+
+	if sim.Log != nil {
+		unit.Log(sim, logFormat, dep.String(), statsChange.FlatString())
 	}
 }
 
@@ -762,9 +800,13 @@ func (unit *Unit) reset(sim *Simulation, _ Agent) {
 
 	unit.resetCDs(sim)
 	unit.Hardcast.Expires = startingCDTime
+	for i := range unit.schoolLockouts {
+		unit.schoolLockouts[i] = startingCDTime
+	}
 	unit.ChanneledDot = nil
 	unit.QueuedSpell = nil
-	unit.DistanceFromTarget = unit.StartDistanceFromTarget
+	unit.previousGCDSpell = nil
+	unit.resetPosition()
 	unit.Metrics.reset()
 	unit.ResetStatDeps()
 	unit.statsWithoutDeps = unit.initialStatsWithoutDeps
@@ -868,17 +910,18 @@ func (unit *Unit) GetMetadata() *proto.UnitMetadata {
 		return &proto.SpellStats{
 			Id: spell.ActionID.ToProto(),
 
-			IsCastable:      spell.Flags.Matches(SpellFlagAPL),
-			IsChanneled:     spell.Flags.Matches(SpellFlagChanneled),
-			IsMajorCooldown: spell.Flags.Matches(SpellFlagMCD),
-			HasDot:          spell.dots != nil || spell.aoeDot != nil || (spell.RelatedDotSpell != nil && (spell.RelatedDotSpell.dots != nil || spell.RelatedDotSpell.aoeDot != nil)),
-			HasShield:       spell.shields != nil || spell.selfShield != nil,
-			PrepullOnly:     spell.Flags.Matches(SpellFlagPrepullOnly),
-			EncounterOnly:   spell.Flags.Matches(SpellFlagEncounterOnly),
-			HasCastTime:     spell.DefaultCast.CastTime > 0,
-			IsFriendly:      spell.Flags.Matches(SpellFlagHelpful),
-			HasExpectedTick: spell.expectedTickDamageInternal != nil,
-			HasMissileSpeed: spell.MissileSpeed > 0.0,
+			IsCastable:        spell.Flags.Matches(SpellFlagAPL),
+			IsChanneled:       spell.Flags.Matches(SpellFlagChanneled),
+			IsMajorCooldown:   spell.Flags.Matches(SpellFlagMCD),
+			HasDot:            spell.dots != nil || spell.aoeDot != nil || (spell.RelatedDotSpell != nil && (spell.RelatedDotSpell.dots != nil || spell.RelatedDotSpell.aoeDot != nil)),
+			HasShield:         spell.shields != nil || spell.selfShield != nil,
+			PrepullOnly:       spell.Flags.Matches(SpellFlagPrepullOnly),
+			EncounterOnly:     spell.Flags.Matches(SpellFlagEncounterOnly),
+			HasCastTime:       spell.DefaultCast.CastTime > 0,
+			IsFriendly:        spell.Flags.Matches(SpellFlagHelpful),
+			HasExpectedTick:   spell.expectedTickDamageInternal != nil,
+			HasMissileSpeed:   spell.MissileSpeed > 0.0,
+			HasExpectedDamage: spell.HasExpectedDamage(),
 		}
 	})
 
@@ -891,6 +934,7 @@ func (unit *Unit) GetMetadata() *proto.UnitMetadata {
 			MaxStacks:          aura.MaxStacks,
 			HasIcd:             aura.Icd != nil,
 			HasExclusiveEffect: len(aura.ExclusiveEffects) > 0,
+			HasRppm:            aura.Dpm != nil && aura.Dpm.hasRPPMProc(),
 		}
 	})
 
@@ -0,0 +1,144 @@
+package core
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/core/simsignals"
+)
+
+// Roughly this fraction of bonus rolls award gear, picked evenly from the boss's loot.
+const bonusRollGearChance = 0.15
+
+// Returns a candidate for each item the boss drops on the given difficulty, in
+// each slot it can be equipped in. Candidates keep the enchants of the item
+// they replace, but are simmed without gems or reforges.
+func lootTableCandidates(lootTable *LootTable, difficulty proto.DungeonDifficulty, equipment *proto.EquipmentSpec, isFuryWarrior bool) []*proto.UpgradeCandidate {
+	type lootDrop struct {
+		itemID int32
+		slots  []proto.ItemSlot
+	}
+	var drops []lootDrop
+	for _, itemID := range lootTable.ItemIDs(difficulty) {
+		item, ok := ItemsByID[itemID]
+		if !ok {
+			continue
+		}
+		if slots := eligibleSlotsForItem(&item, isFuryWarrior); len(slots) > 0 {
+			drops = append(drops, lootDrop{itemID: itemID, slots: slots})
+		}
+	}
+
+	bonusRolls := float64(len(drops)) / bonusRollGearChance
+	var candidates []*proto.UpgradeCandidate
+	for _, drop := range drops {
+		for _, slot := range drop.slots {
+			itemSpec := &proto.ItemSpec{Id: drop.itemID}
+			if equipment != nil && int(slot) < len(equipment.Items) && equipment.Items[slot] != nil {
+				itemSpec.Enchant = equipment.Items[slot].Enchant
+				itemSpec.Tinker = equipment.Items[slot].Tinker
+			}
+			candidates = append(candidates, &proto.UpgradeCandidate{
+				Slot:       slot,
+				Item:       itemSpec,
+				BonusRolls: bonusRolls,
+			})
+		}
+	}
+	return candidates
+}
+
+// Sorts upgrades into valor and bonus roll shopping lists, best value first.
+// Items which are not upgrades are dropped, and items which are both sold and
+// dropped appear in both lists.
+func buildShoppingLists(advice []*proto.UpgradeAdvice) ([]*proto.UpgradeAdvice, []*proto.UpgradeAdvice) {
+	var valorList, bonusRollList []*proto.UpgradeAdvice
+	for _, upgrade := range advice {
+		if upgrade.DpsDelta <= 0 {
+			continue
+		}
+		if upgrade.Candidate.ValorCost > 0 {
+			upgrade.DpsPerValor = upgrade.DpsDelta / float64(upgrade.Candidate.ValorCost)
+			valorList = append(valorList, upgrade)
+		}
+		if upgrade.Candidate.BonusRolls > 0 {
+			upgrade.DpsPerBonusRoll = upgrade.DpsDelta / upgrade.Candidate.BonusRolls
+			bonusRollList = append(bonusRollList, upgrade)
+		}
+	}
+
+	slices.SortStableFunc(valorList, func(a, b *proto.UpgradeAdvice) int {
+		return cmp.Compare(b.DpsPerValor, a.DpsPerValor)
+	})
+	slices.SortStableFunc(bonusRollList, func(a, b *proto.UpgradeAdvice) int {
+		return cmp.Compare(b.DpsPerBonusRoll, a.DpsPerBonusRoll)
+	})
+
+	return valorList, bonusRollList
+}
+
+// Sims each obtainable item as a replacement for the equipped one, and ranks
+// the upgrades by DPS gained per valor point and per bonus roll.
+func runUpgradeAdvisor(request *proto.UpgradeAdvisorRequest, signals simsignals.Signals) *proto.UpgradeAdvisorResult {
+	isFuryWarrior := PlayerProtoToSpec(request.Player) == proto.Spec_SpecFuryWarrior
+
+	candidates := request.Candidates
+	if request.BossName != "" {
+		lootTable := GetLootTableByName(request.BossName)
+		if lootTable == nil {
+			return &proto.UpgradeAdvisorResult{Error: &proto.ErrorOutcome{Message: fmt.Sprintf("No loot table for %s", request.BossName)}}
+		}
+		candidates = append(slices.Clone(candidates), lootTableCandidates(lootTable, proto.DungeonDifficulty(request.BossDifficulty), request.Player.Equipment, isFuryWarrior)...)
+	}
+	if len(candidates) == 0 {
+		return &proto.UpgradeAdvisorResult{Error: &proto.ErrorOutcome{Message: "No items to compare"}}
+	}
+
+	equipments := make([]*proto.EquipmentSpec, len(candidates))
+	for i, candidate := range candidates {
+		if candidate.Item == nil {
+			return &proto.UpgradeAdvisorResult{Error: &proto.ErrorOutcome{Message: fmt.Sprintf("No item for candidate %d", i)}}
+		}
+		if candidate.ValorCost <= 0 && candidate.BonusRolls <= 0 {
+			return &proto.UpgradeAdvisorResult{Error: &proto.ErrorOutcome{Message: fmt.Sprintf("Item %d has no valor cost or bonus rolls", candidate.Item.Id)}}
+		}
+
+		newEquipment, err := swapItemInEquipment(request.Player.Equipment, candidate.Slot, candidate.Item, isFuryWarrior)
+		if err != nil {
+			return &proto.UpgradeAdvisorResult{Error: &proto.ErrorOutcome{Message: err.Error()}}
+		}
+		equipments[i] = newEquipment
+	}
+
+	simGear := newPairedGearSim(request.Player, request.PartyBuffs, request.RaidBuffs, request.Debuffs, request.Tanks, request.Encounter, request.SimOptions, signals)
+
+	baseResult := simGear(request.Player.Equipment)
+	if baseResult.Error != nil {
+		return &proto.UpgradeAdvisorResult{Error: baseResult.Error}
+	}
+	basePlayer := baseResult.RaidMetrics.Parties[0].Players[0]
+
+	advice := make([]*proto.UpgradeAdvice, len(candidates))
+	for i, candidate := range candidates {
+		simResult := simGear(equipments[i])
+		if simResult.Error != nil {
+			return &proto.UpgradeAdvisorResult{Error: simResult.Error}
+		}
+
+		dpsDeltaMean, dpsDeltaStdev := pairedDpsDelta(basePlayer, simResult.RaidMetrics.Parties[0].Players[0])
+		advice[i] = &proto.UpgradeAdvice{
+			Candidate:     candidate,
+			DpsDelta:      dpsDeltaMean,
+			DpsDeltaStdev: dpsDeltaStdev,
+		}
+	}
+
+	valorList, bonusRollList := buildShoppingLists(advice)
+	return &proto.UpgradeAdvisorResult{
+		BaseDps:               basePlayer.Dps.Avg,
+		ValorShoppingList:     valorList,
+		BonusRollShoppingList: bonusRollList,
+	}
+}
@@ -0,0 +1,40 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestBuildShoppingLists(t *testing.T) {
+	vendorItem := &proto.UpgradeAdvice{Candidate: &proto.UpgradeCandidate{ValorCost: 2250}, DpsDelta: 450}
+	cheapVendorItem := &proto.UpgradeAdvice{Candidate: &proto.UpgradeCandidate{ValorCost: 1250}, DpsDelta: 300}
+	lootItem := &proto.UpgradeAdvice{Candidate: &proto.UpgradeCandidate{BonusRolls: 4}, DpsDelta: 200}
+	bothItem := &proto.UpgradeAdvice{Candidate: &proto.UpgradeCandidate{ValorCost: 1750, BonusRolls: 8}, DpsDelta: 700}
+	downgrade := &proto.UpgradeAdvice{Candidate: &proto.UpgradeCandidate{ValorCost: 1000, BonusRolls: 1}, DpsDelta: -50}
+
+	valorList, bonusRollList := buildShoppingLists([]*proto.UpgradeAdvice{vendorItem, cheapVendorItem, lootItem, bothItem, downgrade})
+
+	expectedValorList := []*proto.UpgradeAdvice{bothItem, cheapVendorItem, vendorItem}
+	if len(valorList) != len(expectedValorList) {
+		t.Fatalf("Expected %d valor upgrades, found %d", len(expectedValorList), len(valorList))
+	}
+	for i, upgrade := range expectedValorList {
+		if valorList[i] != upgrade {
+			t.Fatalf("Unexpected valor upgrade at rank %d: %v", i, valorList[i])
+		}
+	}
+	if valorList[0].DpsPerValor != 0.4 {
+		t.Fatalf("Expected 0.4 DPS per valor, found %f", valorList[0].DpsPerValor)
+	}
+
+	expectedBonusRollList := []*proto.UpgradeAdvice{bothItem, lootItem}
+	if len(bonusRollList) != len(expectedBonusRollList) {
+		t.Fatalf("Expected %d bonus roll upgrades, found %d", len(expectedBonusRollList), len(bonusRollList))
+	}
+	for i, upgrade := range expectedBonusRollList {
+		if bonusRollList[i] != upgrade {
+			t.Fatalf("Unexpected bonus roll upgrade at rank %d: %v", i, bonusRollList[i])
+		}
+	}
+}
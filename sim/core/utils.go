@@ -134,8 +134,11 @@ func TernaryDuration(condition bool, val1 time.Duration, val2 time.Duration) tim
 	}
 }
 
+// Returns the value for the level of the unit relative to CharacterLevel.
+// Units below CharacterLevel, e.g. dungeon trash, use the same level values,
+// and units above CharacterLevel+3 use the +3 values.
 func UnitLevelFloat64(unitLevel int32, maxLevelPlus0Val float64, maxLevelPlus1Val float64, maxLevelPlus2Val float64, maxLevelPlus3Val float64) float64 {
-	if unitLevel == CharacterLevel {
+	if unitLevel <= CharacterLevel {
 		return maxLevelPlus0Val
 	} else if unitLevel == CharacterLevel+1 {
 		return maxLevelPlus1Val
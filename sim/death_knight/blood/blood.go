@@ -22,6 +22,21 @@ func RegisterBloodDeathKnight() {
 			player.Spec = playerSpec
 		},
 	)
+	core.RegisterItemFilter(proto.Spec_SpecBloodDeathKnight, ItemFilter)
+}
+
+var ItemFilter = core.ItemFilter{
+	ArmorType: proto.ArmorType_ArmorTypePlate,
+
+	HandTypes: []proto.HandType{
+		proto.HandType_HandTypeTwoHand,
+	},
+	WeaponTypes: []proto.WeaponType{
+		proto.WeaponType_WeaponTypeAxe,
+		proto.WeaponType_WeaponTypeSword,
+		proto.WeaponType_WeaponTypeMace,
+	},
+	RangedWeaponTypes: []proto.RangedWeaponType{},
 }
 
 // Threat Done By Caster setup
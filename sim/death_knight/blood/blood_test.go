@@ -42,6 +42,20 @@ func TestBlood(t *testing.T) {
 			IsTank:          true,
 
 			ItemFilter: ItemFilter,
+
+			APLCoverageExemptions: []core.ActionID{
+				{SpellID: 123693}, // Plague Leech
+				{SpellID: 96268},  // Death's Advance
+				{SpellID: 48743},  // Death Pact
+				{SpellID: 48707},  // Anti-Magic Shell
+				{SpellID: 47541},  // Death Coil
+				{SpellID: 47568},  // Empower Rune Weapon
+				{SpellID: 45477},  // Icy Touch
+				{SpellID: 45462},  // Plague Strike
+				{SpellID: 48265},  // Unholy Presence
+				{SpellID: 48266},  // Frost Presence
+				{SpellID: 56222},  // Dark Command
+			},
 		},
 	}))
 }
@@ -78,17 +92,3 @@ var FullConsumesSpec = &proto.ConsumesSpec{
 	PotId:    76095, // Potion of Mogu Power
 	PrepotId: 76095, // Potion of Mogu Power
 }
-
-var ItemFilter = core.ItemFilter{
-	ArmorType: proto.ArmorType_ArmorTypePlate,
-
-	HandTypes: []proto.HandType{
-		proto.HandType_HandTypeTwoHand,
-	},
-	WeaponTypes: []proto.WeaponType{
-		proto.WeaponType_WeaponTypeAxe,
-		proto.WeaponType_WeaponTypeSword,
-		proto.WeaponType_WeaponTypeMace,
-	},
-	RangedWeaponTypes: []proto.RangedWeaponType{},
-}
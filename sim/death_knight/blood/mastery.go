@@ -83,6 +83,7 @@ func (bdk *BloodDeathKnight) registerMastery() {
 			}
 		},
 	})
+	core.ActiveMitigation(shieldSpell.SelfShield().Aura)
 
 	bdk.MakeProcTriggerAura(core.ProcTrigger{
 		Name:               "Mastery: Blood Shield" + bdk.Label,
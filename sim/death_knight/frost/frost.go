@@ -22,6 +22,20 @@ func RegisterFrostDeathKnight() {
 			player.Spec = playerSpec
 		},
 	)
+	core.RegisterItemFilter(proto.Spec_SpecFrostDeathKnight, ItemFilter)
+}
+
+// Covers both the dual wield and the two-handed setups.
+var ItemFilter = core.ItemFilter{
+	ArmorType: proto.ArmorType_ArmorTypePlate,
+
+	WeaponTypes: []proto.WeaponType{
+		proto.WeaponType_WeaponTypeAxe,
+		proto.WeaponType_WeaponTypeMace,
+		proto.WeaponType_WeaponTypePolearm,
+		proto.WeaponType_WeaponTypeSword,
+	},
+	RangedWeaponTypes: []proto.RangedWeaponType{},
 }
 
 type FrostDeathKnight struct {
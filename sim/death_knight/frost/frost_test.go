@@ -51,6 +51,18 @@ func TestFrostMasterfrost(t *testing.T) {
 				},
 				RangedWeaponTypes: []proto.RangedWeaponType{},
 			},
+
+			APLCoverageExemptions: []core.ActionID{
+				{SpellID: 48707}, // Anti-Magic Shell
+				{SpellID: 48721}, // Blood Boil
+				{SpellID: 47541}, // Death Coil
+				{SpellID: 49998}, // Death Strike
+				{SpellID: 48792}, // Icebound Fortitude
+				{SpellID: 45477}, // Icy Touch
+				{SpellID: 50842}, // Pestilence
+				{SpellID: 48263}, // Blood Presence
+				{SpellID: 48265}, // Unholy Presence
+			},
 		},
 	}))
 }
@@ -92,6 +104,19 @@ func TestFrostTwoHand(t *testing.T) {
 				},
 				RangedWeaponTypes: []proto.RangedWeaponType{},
 			},
+
+			APLCoverageExemptions: []core.ActionID{
+				{SpellID: 48707}, // Anti-Magic Shell
+				{SpellID: 48721}, // Blood Boil
+				{SpellID: 43265}, // Death and Decay
+				{SpellID: 47541}, // Death Coil
+				{SpellID: 49998}, // Death Strike
+				{SpellID: 48792}, // Icebound Fortitude
+				{SpellID: 45477}, // Icy Touch
+				{SpellID: 50842}, // Pestilence
+				{SpellID: 48263}, // Blood Presence
+				{SpellID: 48265}, // Unholy Presence
+			},
 		},
 	}))
 }
@@ -22,6 +22,26 @@ func RegisterUnholyDeathKnight() {
 			player.Spec = playerSpec
 		},
 	)
+	core.RegisterItemFilter(proto.Spec_SpecUnholyDeathKnight, ItemFilter)
+	core.RegisterModelingStatus(proto.Spec_SpecUnholyDeathKnight, proto.ModelingStatus_ModelingStatusApproximated,
+		core.MechanicModelingStatus{Mechanic: "Festering Strike", Status: proto.ModelingStatus_ModelingStatusApproximated, Notes: "Reaping of Unholy runes spent as Death runes is not researched."},
+	)
+}
+
+var ItemFilter = core.ItemFilter{
+	ArmorType: proto.ArmorType_ArmorTypePlate,
+
+	HandTypes: []proto.HandType{
+		proto.HandType_HandTypeTwoHand,
+	},
+
+	WeaponTypes: []proto.WeaponType{
+		proto.WeaponType_WeaponTypeAxe,
+		proto.WeaponType_WeaponTypeMace,
+		proto.WeaponType_WeaponTypePolearm,
+		proto.WeaponType_WeaponTypeSword,
+	},
+	RangedWeaponTypes: []proto.RangedWeaponType{},
 }
 
 type UnholyDeathKnight struct {
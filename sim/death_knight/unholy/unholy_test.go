@@ -52,20 +52,18 @@ func TestUnholy(t *testing.T) {
 			Profession1: proto.Profession_Engineering,
 			Profession2: proto.Profession_Herbalism,
 
-			ItemFilter: core.ItemFilter{
-				ArmorType: proto.ArmorType_ArmorTypePlate,
-
-				HandTypes: []proto.HandType{
-					proto.HandType_HandTypeTwoHand,
-				},
-
-				WeaponTypes: []proto.WeaponType{
-					proto.WeaponType_WeaponTypeAxe,
-					proto.WeaponType_WeaponTypeMace,
-					proto.WeaponType_WeaponTypePolearm,
-					proto.WeaponType_WeaponTypeSword,
-				},
-				RangedWeaponTypes: []proto.RangedWeaponType{},
+			ItemFilter: ItemFilter,
+
+			APLCoverageExemptions: []core.ActionID{
+				{SpellID: 48707}, // Anti-Magic Shell
+				{SpellID: 48721}, // Blood Boil
+				{SpellID: 49998}, // Death Strike
+				{SpellID: 48792}, // Icebound Fortitude
+				{SpellID: 45477}, // Icy Touch
+				{SpellID: 50842}, // Pestilence
+				{SpellID: 48263}, // Blood Presence
+				{SpellID: 48266}, // Frost Presence
+				{SpellID: 45902}, // Blood Strike
 			},
 		},
 	}))
@@ -27,6 +27,19 @@ func RegisterBalanceDruid() {
 			player.Spec = playerSpec
 		},
 	)
+	core.RegisterItemFilter(proto.Spec_SpecBalanceDruid, ItemFilter)
+}
+
+var ItemFilter = core.ItemFilter{
+	WeaponTypes: []proto.WeaponType{
+		proto.WeaponType_WeaponTypeDagger,
+		proto.WeaponType_WeaponTypeMace,
+		proto.WeaponType_WeaponTypeOffHand,
+		proto.WeaponType_WeaponTypeStaff,
+		proto.WeaponType_WeaponTypePolearm,
+	},
+	ArmorType:         proto.ArmorType_ArmorTypeLeather,
+	RangedWeaponTypes: []proto.RangedWeaponType{},
 }
 
 func NewBalanceDruid(character *core.Character, options *proto.Player) *BalanceDruid {
@@ -34,6 +34,15 @@ func TestBalance(t *testing.T) {
 			Rotation:       core.GetAplRotation("../../../ui/druid/balance/apls", "standard"),
 			OtherRotations: []core.RotationCombo{},
 			ItemFilter:     ItemFilter,
+
+			APLCoverageExemptions: []core.ActionID{
+				{SpellID: 16914},  // Hurricane
+				{SpellID: 770},    // Faerie Fire
+				{SpellID: 740},    // Tranquility
+				{SpellID: 774},    // Rejuvenation
+				{SpellID: 106996}, // Astral Storm
+				{SpellID: 88751},  // Wild Mushroom: Detonate
+			},
 		},
 	}))
 }
@@ -68,15 +77,3 @@ var FullConsumesSpec = &proto.ConsumesSpec{
 	PotId:    76093, // Potion of the Jade Serpent
 	PrepotId: 76093, // Potion of the Jade Serpent
 }
-
-var ItemFilter = core.ItemFilter{
-	WeaponTypes: []proto.WeaponType{
-		proto.WeaponType_WeaponTypeDagger,
-		proto.WeaponType_WeaponTypeMace,
-		proto.WeaponType_WeaponTypeOffHand,
-		proto.WeaponType_WeaponTypeStaff,
-		proto.WeaponType_WeaponTypePolearm,
-	},
-	ArmorType:         proto.ArmorType_ArmorTypeLeather,
-	RangedWeaponTypes: []proto.RangedWeaponType{},
-}
@@ -250,7 +250,7 @@ func (druid *Druid) RegisterBaselineSpells() {
 	druid.registerRejuvenationSpell()
 
 	// druid.registerRebirthSpell()
-	// druid.registerInnervateCD()
+	druid.registerInnervateCD()
 }
 
 func (druid *Druid) RegisterFeralCatSpells() {
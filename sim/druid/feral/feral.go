@@ -24,6 +24,19 @@ func RegisterFeralDruid() {
 			player.Spec = playerSpec
 		},
 	)
+	core.RegisterItemFilter(proto.Spec_SpecFeralDruid, ItemFilter)
+	core.RegisterModelingStatus(proto.Spec_SpecFeralDruid, proto.ModelingStatus_ModelingStatusApproximated,
+		core.MechanicModelingStatus{Mechanic: "Heart of the Wild", Status: proto.ModelingStatus_ModelingStatusApproximated, Notes: "Bear Form armor, crit immunity and Vengeance are not implemented."},
+	)
+}
+
+var ItemFilter = core.ItemFilter{
+	WeaponTypes: []proto.WeaponType{
+		proto.WeaponType_WeaponTypeStaff,
+		proto.WeaponType_WeaponTypePolearm,
+	},
+	ArmorType:         proto.ArmorType_ArmorTypeLeather,
+	RangedWeaponTypes: []proto.RangedWeaponType{},
 }
 
 func NewFeralDruid(character *core.Character, options *proto.Player) *FeralDruid {
@@ -13,15 +13,6 @@ func init() {
 	common.RegisterAllEffects()
 }
 
-var FeralItemFilter = core.ItemFilter{
-	WeaponTypes: []proto.WeaponType{
-		proto.WeaponType_WeaponTypeStaff,
-		proto.WeaponType_WeaponTypePolearm,
-	},
-	ArmorType:         proto.ArmorType_ArmorTypeLeather,
-	RangedWeaponTypes: []proto.RangedWeaponType{},
-}
-
 func TestFeral(t *testing.T) {
 	core.RunTestSuite(t, t.Name(), core.FullCharacterTestSuiteGenerator([]core.CharacterSuiteConfig{{
 		Class:      proto.Class_ClassDruid,
@@ -50,7 +41,20 @@ func TestFeral(t *testing.T) {
 		Consumables:      FullConsumesSpec,
 		SpecOptions:      core.SpecOptionsCombo{Label: "ExternalBleed", SpecOptions: PlayerOptionsMonoCat},
 		StartingDistance: 24,
-		ItemFilter:       FeralItemFilter,
+		ItemFilter:       ItemFilter,
+
+		APLCoverageExemptions: []core.ActionID{
+			{SpellID: 8921},  // Moonfire
+			{SpellID: 5176},  // Wrath
+			{SpellID: 16914}, // Hurricane
+			{SpellID: 740},   // Tranquility
+			{SpellID: 774},   // Rejuvenation
+			{SpellID: 1850},  // Dash
+			{SpellID: 5215},  // Prowl
+			{SpellID: 6785},  // Ravage
+			{SpellID: 779},   // Swipe
+			{SpellID: 62078}, // Swipe (Cat)
+		},
 	}}))
 }
 
@@ -65,7 +69,7 @@ func TestFeral(t *testing.T) {
 // 		Consumes:    FullConsumes,
 // 		SpecOptions: core.SpecOptionsCombo{Label: "Default", SpecOptions: PlayerOptionsMonoCat},
 // 		Rotation:    core.GetAplRotation("../../../ui/feral_druid/apls", "default"),
-// 		ItemFilter:  FeralItemFilter,
+// 		ItemFilter:  ItemFilter,
 // 	}))
 // }
 
@@ -48,6 +48,22 @@ func TestGuardian(t *testing.T) {
 			InFrontOfTarget: true,
 
 			ItemFilter: ItemFilter,
+
+			APLCoverageExemptions: []core.ActionID{
+				{SpellID: 138979}, // Soul Barrier
+				{SpellID: 8921},   // Moonfire
+				{SpellID: 5176},   // Wrath
+				{SpellID: 5185},   // Healing Touch
+				{SpellID: 16914},  // Hurricane
+				{SpellID: 740},    // Tranquility
+				{SpellID: 5487},   // Bear Form
+				{SpellID: 768},    // Cat Form
+				{SpellID: 33876},  // Mangle (Cat)
+				{SpellID: 1822},   // Rake
+				{SpellID: 1079},   // Rip
+				{SpellID: 779},    // Swipe
+				{SpellID: 126453}, // Elusive Brew
+			},
 		},
 	}))
 }
@@ -80,15 +96,6 @@ func TestGuardian(t *testing.T) {
 // 	core.RaidBenchmark(b, rsr)
 // }
 
-var ItemFilter = core.ItemFilter{
-	WeaponTypes: []proto.WeaponType{
-		proto.WeaponType_WeaponTypeStaff,
-		proto.WeaponType_WeaponTypePolearm,
-	},
-	ArmorType:         proto.ArmorType_ArmorTypeLeather,
-	RangedWeaponTypes: []proto.RangedWeaponType{},
-}
-
 var StandardTalents = "010101"
 var StandardGlyphs = &proto.Glyphs{
 	Major1: int32(proto.DruidMajorGlyph_GlyphOfMightOfUrsoc),
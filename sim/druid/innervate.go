@@ -0,0 +1,51 @@
+package druid
+
+import (
+	"github.com/wowsims/mop/sim/core"
+)
+
+// Innervate is cast on the player chosen in the druid's options, which may be
+// another raid member. Restores 10% of the druid's maximum mana to the target.
+func (druid *Druid) registerInnervateCD() {
+	innervateTarget := druid.GetUnit(druid.SelfBuffs.InnervateTarget)
+	if (innervateTarget == nil) || !innervateTarget.HasManaBar() {
+		return
+	}
+
+	actionID := core.ActionID{SpellID: 29166, Tag: druid.Index}
+	manaAmount := func() float64 {
+		return druid.MaxMana() * core.InnervateManaPercent
+	}
+	innervateAura := core.InnervateAura(innervateTarget, actionID.Tag, manaAmount)
+
+	innervateSpell := druid.RegisterSpell(Humanoid|Moonkin|Tree, core.SpellConfig{
+		ActionID:       actionID,
+		Flags:          core.SpellFlagHelpful | core.SpellFlagReadinessTrinket,
+		ClassSpellMask: DruidSpellInnervate,
+
+		Cast: core.CastConfig{
+			DefaultCast: core.Cast{
+				GCD: core.GCDDefault,
+			},
+			CD: core.Cooldown{
+				Timer:    druid.NewTimer(),
+				Duration: core.InnervateCD,
+			},
+		},
+		ExtraCastCondition: func(_ *core.Simulation, _ *core.Unit) bool {
+			// If target already has another innervate, don't cast.
+			return !innervateTarget.HasActiveAuraWithTag(core.InnervateAuraTag)
+		},
+		ApplyEffects: func(sim *core.Simulation, _ *core.Unit, _ *core.Spell) {
+			innervateAura.Activate(sim)
+		},
+	})
+
+	druid.AddMajorCooldown(core.MajorCooldown{
+		Spell: innervateSpell.Spell,
+		Type:  core.CooldownTypeMana,
+		ShouldActivate: func(_ *core.Simulation, _ *core.Character) bool {
+			return core.InnervateShouldActivate(innervateTarget, manaAmount())
+		},
+	})
+}
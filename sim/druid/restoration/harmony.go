@@ -0,0 +1,66 @@
+package restoration
+
+import (
+	"time"
+
+	"github.com/wowsims/mop/sim/core"
+	"github.com/wowsims/mop/sim/druid"
+)
+
+const (
+	HarmonyBaseBonus     = 0.1 // 8 base mastery points
+	HarmonyBonusPerPoint = 0.0125
+
+	harmonyDirectSpells = druid.DruidSpellHealingTouch | druid.DruidSpellSwiftmend
+)
+
+func harmonyBonus(masteryPoints float64) float64 {
+	return HarmonyBaseBonus + HarmonyBonusPerPoint*masteryPoints
+}
+
+// Harmony increases direct healing by the mastery bonus, and direct heals grant
+// the same bonus to periodic healing for 20 seconds. HoTs pick up the periodic
+// bonus when they are applied.
+func (resto *RestorationDruid) registerHarmony() {
+	directMod := resto.AddDynamicMod(core.SpellModConfig{
+		ClassMask:  harmonyDirectSpells,
+		Kind:       core.SpellMod_DamageDone_Pct,
+		FloatValue: harmonyBonus(resto.GetMasteryPoints()),
+	})
+	periodicMod := resto.AddDynamicMod(core.SpellModConfig{
+		ClassMask:  druid.DruidSpellHoT,
+		Kind:       core.SpellMod_DotDamageDone_Pct,
+		FloatValue: harmonyBonus(resto.GetMasteryPoints()),
+	})
+
+	resto.AddOnMasteryStatChanged(func(_ *core.Simulation, _ float64, newMasteryRating float64) {
+		bonus := harmonyBonus(core.MasteryRatingToMasteryPoints(newMasteryRating))
+		directMod.UpdateFloatValue(bonus)
+		periodicMod.UpdateFloatValue(bonus)
+	})
+
+	directMod.Activate()
+
+	resto.HarmonyAura = resto.RegisterAura(core.Aura{
+		Label:    "Harmony",
+		ActionID: core.ActionID{SpellID: 100977},
+		Duration: time.Second * 20,
+		OnGain: func(_ *core.Aura, _ *core.Simulation) {
+			periodicMod.Activate()
+		},
+		OnExpire: func(_ *core.Aura, _ *core.Simulation) {
+			periodicMod.Deactivate()
+		},
+	})
+
+	resto.MakeProcTriggerAura(core.ProcTrigger{
+		Name:               "Harmony Trigger",
+		Callback:           core.CallbackOnHealDealt,
+		ClassSpellMask:     harmonyDirectSpells,
+		TriggerImmediately: true,
+
+		Handler: func(sim *core.Simulation, _ *core.Spell, _ *core.SpellResult) {
+			resto.HarmonyAura.Activate(sim)
+		},
+	})
+}
@@ -0,0 +1,112 @@
+package restoration
+
+import (
+	"time"
+
+	"github.com/wowsims/mop/sim/core"
+	"github.com/wowsims/mop/sim/druid"
+)
+
+const (
+	LifebloomTickCoeff       = 0.285
+	LifebloomTickBonusCoeff  = 0.0234
+	LifebloomBloomCoeff      = 5.27
+	LifebloomBloomBonusCoeff = 0.752
+	LifebloomBaseCostPercent = 5.9
+)
+
+// Lifebloom stacks up to 3 times and can only be active on one target. Each
+// cast refreshes it, and when it expires it blooms for a heal per stack and
+// refunds half its cost per stack.
+func (resto *RestorationDruid) registerLifebloomSpell() {
+	manaMetrics := resto.NewManaMetrics(core.ActionID{SpellID: 33778})
+	var activeTarget *core.Unit
+	var bloomStacks int32
+
+	bloom := resto.RegisterSpell(druid.Any, core.SpellConfig{
+		ActionID:       core.ActionID{SpellID: 33778},
+		SpellSchool:    core.SpellSchoolNature,
+		ProcMask:       core.ProcMaskSpellHealing,
+		ClassSpellMask: druid.DruidSpellLifebloom,
+		Flags:          core.SpellFlagHelpful | core.SpellFlagPassiveSpell | core.SpellFlagNoOnCastComplete,
+
+		DamageMultiplier: 1,
+		CritMultiplier:   resto.DefaultCritMultiplier(),
+		ThreatMultiplier: 1,
+		BonusCoefficient: LifebloomBloomBonusCoeff,
+
+		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			result := spell.CalcHealing(sim, target, resto.CalcScalingSpellDmg(LifebloomBloomCoeff), spell.OutcomeHealingCrit)
+			result.Damage *= float64(bloomStacks)
+			spell.DealHealing(sim, result)
+			resto.AddMana(sim, 0.5*float64(bloomStacks)*LifebloomBaseCostPercent/100*resto.BaseMana, manaMetrics)
+		},
+	})
+
+	resto.Lifebloom = resto.RegisterSpell(druid.Humanoid|druid.Tree, core.SpellConfig{
+		ActionID:       core.ActionID{SpellID: 33763},
+		SpellSchool:    core.SpellSchoolNature,
+		ProcMask:       core.ProcMaskSpellHealing,
+		ClassSpellMask: druid.DruidSpellLifebloom,
+		Flags:          core.SpellFlagHelpful | core.SpellFlagAPL,
+
+		ManaCost: core.ManaCostOptions{
+			BaseCostPercent: LifebloomBaseCostPercent,
+		},
+		Cast: core.CastConfig{
+			DefaultCast: core.Cast{
+				GCD: core.GCDDefault,
+			},
+		},
+
+		DamageMultiplier: 1,
+		CritMultiplier:   resto.DefaultCritMultiplier(),
+		ThreatMultiplier: 1,
+
+		Hot: core.DotConfig{
+			Aura: core.Aura{
+				Label:     "Lifebloom",
+				MaxStacks: 3,
+				OnStacksChange: func(_ *core.Aura, _ *core.Simulation, oldStacks int32, newStacks int32) {
+					if newStacks == 0 {
+						bloomStacks = oldStacks
+					}
+				},
+				OnExpire: func(aura *core.Aura, sim *core.Simulation) {
+					// Only bloom when running out, not when moved to another target.
+					if aura.ExpiresAt() <= sim.CurrentTime {
+						bloom.Cast(sim, aura.Unit)
+					}
+				},
+			},
+			NumberOfTicks:       15,
+			TickLength:          time.Second,
+			AffectedByCastSpeed: true,
+			BonusCoefficient:    LifebloomTickBonusCoeff,
+
+			OnSnapshot: func(_ *core.Simulation, target *core.Unit, dot *core.Dot, _ bool) {
+				dot.SnapshotHeal(target, resto.CalcScalingSpellDmg(LifebloomTickCoeff))
+			},
+			OnTick: func(sim *core.Simulation, target *core.Unit, dot *core.Dot) {
+				result := dot.CalcSnapshotHealing(sim, target, dot.OutcomeSnapshotCrit)
+				result.Damage *= float64(dot.GetStacks())
+				dot.Spell.DealPeriodicHealing(sim, result)
+			},
+		},
+
+		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			if target.IsOpponent(&resto.Unit) {
+				target = &resto.Unit
+			}
+
+			if (activeTarget != nil) && (activeTarget != target) {
+				spell.Hot(activeTarget).Deactivate(sim)
+			}
+			activeTarget = target
+
+			hot := spell.Hot(target)
+			hot.Apply(sim)
+			hot.AddStack(sim)
+		},
+	})
+}
@@ -3,6 +3,7 @@ package restoration
 import (
 	"github.com/wowsims/mop/sim/core"
 	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/core/stats"
 	"github.com/wowsims/mop/sim/druid"
 )
 
@@ -21,6 +22,19 @@ func RegisterRestorationDruid() {
 			player.Spec = playerSpec
 		},
 	)
+	core.RegisterItemFilter(proto.Spec_SpecRestorationDruid, ItemFilter)
+}
+
+var ItemFilter = core.ItemFilter{
+	WeaponTypes: []proto.WeaponType{
+		proto.WeaponType_WeaponTypeDagger,
+		proto.WeaponType_WeaponTypeMace,
+		proto.WeaponType_WeaponTypeOffHand,
+		proto.WeaponType_WeaponTypeStaff,
+		proto.WeaponType_WeaponTypePolearm,
+	},
+	ArmorType:         proto.ArmorType_ArmorTypeLeather,
+	RangedWeaponTypes: []proto.RangedWeaponType{},
 }
 
 func NewRestorationDruid(character *core.Character, options *proto.Player) *RestorationDruid {
@@ -41,6 +55,12 @@ func NewRestorationDruid(character *core.Character, options *proto.Player) *Rest
 
 type RestorationDruid struct {
 	*druid.Druid
+
+	Lifebloom  *druid.DruidSpell
+	Swiftmend  *druid.DruidSpell
+	WildGrowth *druid.DruidSpell
+
+	HarmonyAura *core.Aura
 }
 
 func (resto *RestorationDruid) GetDruid() *druid.Druid {
@@ -49,9 +69,23 @@ func (resto *RestorationDruid) GetDruid() *druid.Druid {
 
 func (resto *RestorationDruid) Initialize() {
 	resto.Druid.Initialize()
+
+	resto.registerHarmony()
+	resto.registerLifebloomSpell()
+	resto.registerSwiftmendSpell()
+	resto.registerWildGrowthSpell()
 }
 
-func (resto *RestorationDruid) ApplyTalents() {}
+func (resto *RestorationDruid) ApplyTalents() {
+	resto.Druid.ApplyTalents()
+	resto.ApplyArmorSpecializationEffect(stats.Intellect, proto.ArmorType_ArmorTypeLeather, 86104)
+
+	// Meditation
+	resto.PseudoStats.SpiritRegenRateCombat = 0.5
+
+	// Natural Insight
+	resto.MultiplyStat(stats.Mana, 5)
+}
 
 func (resto *RestorationDruid) Reset(sim *core.Simulation) {
 	resto.Druid.Reset(sim)
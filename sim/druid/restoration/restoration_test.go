@@ -0,0 +1,68 @@
+package restoration
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/common" // imported to get caster sets included.
+	"github.com/wowsims/mop/sim/core"
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func init() {
+	RegisterRestorationDruid()
+	common.RegisterAllEffects()
+}
+
+func TestRestoration(t *testing.T) {
+	core.RunTestSuite(t, t.Name(), core.FullCharacterTestSuiteGenerator([]core.CharacterSuiteConfig{
+		{
+			Class:    proto.Class_ClassDruid,
+			Race:     proto.Race_RaceTauren,
+			IsHealer: true,
+
+			GearSet:     core.GetGearSet("../../../ui/druid/restoration/gear_sets", "p1"),
+			Talents:     StandardTalents,
+			Glyphs:      &proto.Glyphs{},
+			Consumables: FullConsumesSpec,
+			SpecOptions: core.SpecOptionsCombo{Label: "Standard", SpecOptions: PlayerOptionsStandard},
+			Rotation:    core.GetAplRotation("../../../ui/druid/restoration/apls", "default"),
+
+			APLCoverageExemptions: []core.ActionID{
+				{SpellID: 740},   // Tranquility
+				{SpellID: 770},   // Faerie Fire
+				{SpellID: 5176},  // Wrath
+				{SpellID: 8921},  // Moonfire
+				{SpellID: 16914}, // Hurricane
+			},
+
+			ItemFilter: ItemFilter,
+
+			EPReferenceStat: proto.Stat_StatSpellPower,
+			StatsToWeigh: []proto.Stat{
+				proto.Stat_StatIntellect,
+				proto.Stat_StatSpirit,
+				proto.Stat_StatSpellPower,
+				proto.Stat_StatHasteRating,
+				proto.Stat_StatCritRating,
+				proto.Stat_StatMasteryRating,
+			},
+		},
+	}))
+}
+
+var StandardTalents = "113123"
+
+var FullConsumesSpec = &proto.ConsumesSpec{
+	FlaskId:  76085, // Flask of the Warm Sun
+	FoodId:   74650, // Mogu Fish Stew
+	PotId:    76093, // Potion of the Jade Serpent
+	PrepotId: 76093, // Potion of the Jade Serpent
+}
+
+var PlayerOptionsStandard = &proto.Player_RestorationDruid{
+	RestorationDruid: &proto.RestorationDruid{
+		Options: &proto.RestorationDruid_Options{
+			ClassOptions: &proto.DruidOptions{},
+		},
+	},
+}
@@ -0,0 +1,55 @@
+package restoration
+
+import (
+	"time"
+
+	"github.com/wowsims/mop/sim/core"
+	"github.com/wowsims/mop/sim/druid"
+)
+
+const (
+	SwiftmendCoeff      = 11.54
+	SwiftmendBonusCoeff = 1.29
+)
+
+// Swiftmend can only be cast on a target with Rejuvenation.
+func (resto *RestorationDruid) registerSwiftmendSpell() {
+	resto.Swiftmend = resto.RegisterSpell(druid.Humanoid|druid.Tree, core.SpellConfig{
+		ActionID:       core.ActionID{SpellID: 18562},
+		SpellSchool:    core.SpellSchoolNature,
+		ProcMask:       core.ProcMaskSpellHealing,
+		ClassSpellMask: druid.DruidSpellSwiftmend,
+		Flags:          core.SpellFlagHelpful | core.SpellFlagAPL,
+
+		ManaCost: core.ManaCostOptions{
+			BaseCostPercent: 14.2,
+		},
+		Cast: core.CastConfig{
+			DefaultCast: core.Cast{
+				GCD: core.GCDDefault,
+			},
+			CD: core.Cooldown{
+				Timer:    resto.NewTimer(),
+				Duration: time.Second * 15,
+			},
+		},
+		ExtraCastCondition: func(_ *core.Simulation, target *core.Unit) bool {
+			if target.IsOpponent(&resto.Unit) {
+				target = &resto.Unit
+			}
+			return resto.Rejuvenation.Hot(target).IsActive()
+		},
+
+		DamageMultiplier: 1,
+		CritMultiplier:   resto.DefaultCritMultiplier(),
+		ThreatMultiplier: 1,
+		BonusCoefficient: SwiftmendBonusCoeff,
+
+		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			if target.IsOpponent(&resto.Unit) {
+				target = &resto.Unit
+			}
+			spell.CalcAndDealHealing(sim, target, resto.CalcScalingSpellDmg(SwiftmendCoeff), spell.OutcomeHealingCrit)
+		},
+	})
+}
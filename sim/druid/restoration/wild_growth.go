@@ -0,0 +1,76 @@
+package restoration
+
+import (
+	"time"
+
+	"github.com/wowsims/mop/sim/core"
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/druid"
+)
+
+const (
+	WildGrowthTickCoeff      = 0.94
+	WildGrowthTickBonusCoeff = 0.131
+	WildGrowthNumTargets     = 6
+)
+
+// Wild Growth is a smart heal, placing its HoT on the most injured allies
+// around the target.
+func (resto *RestorationDruid) registerWildGrowthSpell() {
+	resto.WildGrowth = resto.RegisterSpell(druid.Humanoid|druid.Tree, core.SpellConfig{
+		ActionID:       core.ActionID{SpellID: 48438},
+		SpellSchool:    core.SpellSchoolNature,
+		ProcMask:       core.ProcMaskSpellHealing,
+		ClassSpellMask: druid.DruidSpellWildGrowth,
+		Flags:          core.SpellFlagHelpful | core.SpellFlagAPL,
+
+		ManaCost: core.ManaCostOptions{
+			BaseCostPercent: 22.9,
+		},
+		Cast: core.CastConfig{
+			DefaultCast: core.Cast{
+				GCD: core.GCDDefault,
+			},
+			CD: core.Cooldown{
+				Timer:    resto.NewTimer(),
+				Duration: time.Second * 8,
+			},
+		},
+
+		DamageMultiplier: 1,
+		CritMultiplier:   resto.DefaultCritMultiplier(),
+		ThreatMultiplier: 1,
+
+		Hot: core.DotConfig{
+			Aura: core.Aura{
+				Label: "Wild Growth",
+			},
+			NumberOfTicks:       7,
+			TickLength:          time.Second,
+			AffectedByCastSpeed: true,
+			BonusCoefficient:    WildGrowthTickBonusCoeff,
+
+			OnSnapshot: func(_ *core.Simulation, target *core.Unit, dot *core.Dot, _ bool) {
+				dot.SnapshotHeal(target, resto.CalcScalingSpellDmg(WildGrowthTickCoeff))
+			},
+			OnTick: func(sim *core.Simulation, target *core.Unit, dot *core.Dot) {
+				dot.CalcAndDealPeriodicSnapshotHealing(sim, target, dot.OutcomeSnapshotCrit)
+			},
+		},
+
+		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			if target.IsOpponent(&resto.Unit) {
+				target = &resto.Unit
+			}
+
+			spell.Hot(target).Apply(sim)
+			numApplied := 1
+			for _, ally := range resto.SmartHealTargets(sim, proto.HealTargetStrategy_HealTargetLowestHealth, WildGrowthNumTargets) {
+				if (ally != target) && (numApplied < WildGrowthNumTargets) {
+					spell.Hot(ally).Apply(sim)
+					numApplied++
+				}
+			}
+		},
+	})
+}
@@ -151,6 +151,13 @@ func (ai *DynamicAddsAI) Reset(sim *core.Simulation) {
 		sim.DisableTargetUnit(addTarget, true)
 	}
 
+	for spawnTime := ai.spawnDelay; spawnTime < sim.Duration; spawnTime += ai.addLifetime + ai.respawnTime {
+		sim.Encounter.ScheduleEvent(core.EncounterEventAddSpawn, spawnTime)
+		if ai.respawnTime <= 0 {
+			break
+		}
+	}
+
 	if ai.spawnDelay > 0 {
 		pa := sim.GetConsumedPendingActionFromPool()
 		pa.NextActionAt = ai.spawnDelay
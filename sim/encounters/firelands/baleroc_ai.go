@@ -222,13 +222,7 @@ func (ai *BalerocAI) registerBlazeOfGlory() {
 				// Cache max HP prior to processing multipliers.
 				oldMaxHp := aura.Unit.MaxHealth()
 
-				if oldStacks > 0 {
-					aura.Unit.DisableDynamicStatDep(sim, hpDepByStackCount[oldStacks])
-				}
-
-				if newStacks > 0 {
-					aura.Unit.EnableDynamicStatDep(sim, hpDepByStackCount[newStacks])
-				}
+				aura.Unit.SwapDynamicStatDep(sim, hpDepByStackCount[oldStacks], hpDepByStackCount[newStacks])
 
 				hpGain := aura.Unit.MaxHealth() - oldMaxHp
 
@@ -1,5 +1,6 @@
 package hof
 
 func Register() {
+	addTayak("Heart of Fear")
 	addEmpress("Heart of Fear")
 }
@@ -0,0 +1,108 @@
+package hof
+
+import (
+	"fmt"
+
+	"github.com/wowsims/mop/sim/core"
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/core/stats"
+)
+
+const tayakBossID int32 = 62543
+
+func addTayak(raidPrefix string) {
+	createTayakHeroicPreset(raidPrefix, 25, 509_000_000, 450_000)
+}
+
+// Scripted approximation of the heroic encounter before Storm Unleashed.
+// Damage is scaled to the boss's auto attacks.
+func createTayakHeroicPreset(raidPrefix string, raidSize int32, bossHealth float64, bossMinBaseDamage float64) {
+	bossName := fmt.Sprintf("Blade Lord Ta'yak %d H", raidSize)
+
+	core.AddPresetTarget(&core.PresetTarget{
+		PathPrefix: raidPrefix,
+
+		Config: &proto.Target{
+			Id:        tayakBossID,
+			Name:      bossName,
+			Level:     93,
+			MobType:   proto.MobType_MobTypeHumanoid,
+			TankIndex: 0,
+
+			Stats: stats.Stats{
+				stats.Health:      bossHealth,
+				stats.Armor:       24835,
+				stats.AttackPower: 0, // actual value doesn't matter in MoP, as long as damage parameters are fit consistently
+			}.ToProtoArray(),
+
+			SpellSchool:   proto.SpellSchool_SpellSchoolPhysical,
+			SwingSpeed:    2.0,
+			MinBaseDamage: bossMinBaseDamage,
+			DamageSpread:  0.4,
+			TargetInputs:  []*proto.TargetInput{},
+
+			Script: []*proto.EncounterScriptEvent{
+				{
+					Name:           "Overwhelming Assault",
+					Start:          15,
+					RepeatInterval: 20.5,
+					Action: &proto.EncounterScriptEvent_Ability{Ability: &proto.BossSpecialAttack{
+						Name:         "Overwhelming Assault",
+						SpellSchool:  proto.SpellSchool_SpellSchoolPhysical,
+						BaseDamage:   800_000,
+						DamageSpread: 0.2,
+						Target:       proto.BossSpecialTarget_BossSpecialTargetTank,
+						Avoidable:    true,
+					}},
+				},
+				{
+					Name:           "Tempest Slash",
+					Start:          10,
+					RepeatInterval: 15.5,
+					Action: &proto.EncounterScriptEvent_Ability{Ability: &proto.BossSpecialAttack{
+						Name:         "Tempest Slash",
+						SpellSchool:  proto.SpellSchool_SpellSchoolPhysical,
+						BaseDamage:   150_000,
+						DamageSpread: 0.1,
+						Target:       proto.BossSpecialTarget_BossSpecialTargetRandomPlayer,
+					}},
+				},
+				{
+					Name:           "Unseen Strike",
+					Start:          30,
+					RepeatInterval: 53,
+					Action: &proto.EncounterScriptEvent_Ability{Ability: &proto.BossSpecialAttack{
+						Name:         "Unseen Strike",
+						SpellSchool:  proto.SpellSchool_SpellSchoolPhysical,
+						BaseDamage:   180_000,
+						DamageSpread: 0.1,
+						CastTime:     5,
+						Target:       proto.BossSpecialTarget_BossSpecialTargetRaid,
+					}},
+				},
+				{
+					// The raid gathers on the marked player to split the strike.
+					Name:           "Unseen Strike",
+					Start:          30,
+					RepeatInterval: 53,
+					Action: &proto.EncounterScriptEvent_RaidMovement{RaidMovement: &proto.EncounterScriptRaidMovement{
+						Yards:        25,
+						ReactionTime: 1,
+					}},
+				},
+				{
+					// Stacking in the strike's cone puts melee in front of the boss
+					// until it lands.
+					Name:           "Unseen Strike",
+					Start:          30,
+					RepeatInterval: 53,
+					Action: &proto.EncounterScriptEvent_FrontalPhase{FrontalPhase: &proto.EncounterScriptFrontalPhase{
+						Duration: 5,
+					}},
+				},
+			},
+		},
+	})
+
+	core.AddPresetEncounter(bossName, []string{raidPrefix + "/" + bossName})
+}
@@ -41,13 +41,13 @@ func addMovementAI() {
 				},
 				{
 					Label:       "Reaction Time",
-					Tooltip:     "How long the player can wait for casts to finish before moving in seconds",
+					Tooltip:     "How long the player can wait for casts to finish before moving in seconds. Longer casts are interrupted.",
 					InputType:   proto.InputType_Number,
 					NumberValue: 1.5,
 				},
 				{
 					Label:       "Yards",
-					Tooltip:     "How many yards the player moves",
+					Tooltip:     "How many yards the player moves, stepping aside and back. Melee leave melee range while moving.",
 					InputType:   proto.InputType_Number,
 					NumberValue: 5,
 				},
@@ -65,7 +65,7 @@ type MovementAI struct {
 	NextMoveTime time.Duration
 	MoveInterval time.Duration // How often moves happen
 	ReactionTime time.Duration // Time available to react before area should be cleared
-	MoveYards    float64       // Duration of the move
+	MoveYards    float64       // Total distance of the move
 }
 
 func NewMovementAI() core.AIFactory {
@@ -98,6 +98,12 @@ func (ai *MovementAI) Initialize(target *core.Target, config *proto.Target) {
 
 func (ai *MovementAI) Reset(sim *core.Simulation) {
 	ai.NextMoveTime = 0
+
+	if ai.MoveInterval > 0 {
+		for moveTime := time.Duration(0); moveTime < sim.Duration; moveTime += ai.MoveInterval {
+			sim.Encounter.ScheduleEvent(core.EncounterEventMovement, moveTime)
+		}
+	}
 }
 
 func (ai *MovementAI) ExecuteCustomRotation(sim *core.Simulation) {
@@ -109,34 +115,10 @@ func (ai *MovementAI) ExecuteCustomRotation(sim *core.Simulation) {
 
 	players := sim.Raid.AllPlayerUnits
 
-	for i := 0; i < len(players); i++ {
-		player := players[i]
-		duration := ai.TimeToMove(ai.MoveYards, player)
-		if player.Hardcast.Expires > sim.CurrentTime && !player.Hardcast.CanMove {
-			castEndsAt := player.Hardcast.Expires - sim.CurrentTime
-			// if castEndsAt < ai.ReactionTime {
-			pa := sim.GetConsumedPendingActionFromPool()
-			pa.NextActionAt = sim.CurrentTime + castEndsAt
-			pa.Priority = core.ActionPriorityHigh + 1
-
-			pa.OnAction = func(sim *core.Simulation) {
-				player.MoveDuration(duration, sim)
-			}
-
-			sim.AddPendingAction(pa)
-			// } else {
-			// 	// Cancel casted spell and move immediately
-			// 	// For now we do nothing in this scenario
-			// 	return
-			// }
-		} else {
-			player.MoveDuration(duration, sim)
-		}
+	for _, player := range players {
+		player.StepAside(ai.MoveYards, ai.ReactionTime, sim)
 	}
 
 	ai.NextMoveTime = sim.CurrentTime + ai.MoveInterval
 	ai.Target.WaitUntil(sim, ai.NextMoveTime)
 }
-func (ai *MovementAI) TimeToMove(distance float64, unit *core.Unit) time.Duration {
-	return core.DurationFromSeconds(distance / unit.GetMovementSpeed())
-}
@@ -0,0 +1,90 @@
+package msv
+
+import (
+	"fmt"
+
+	"github.com/wowsims/mop/sim/core"
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/core/stats"
+)
+
+const fengBossID int32 = 60009
+
+func addFeng(raidPrefix string) {
+	createFengHeroicPreset(raidPrefix, 25, 481_250_000, 450_000)
+}
+
+// Scripted approximation of the heroic encounter. Ability timers follow the
+// Lightning phase, and damage is scaled to the boss's auto attacks.
+func createFengHeroicPreset(raidPrefix string, raidSize int32, bossHealth float64, bossMinBaseDamage float64) {
+	bossName := fmt.Sprintf("Feng the Accursed %d H", raidSize)
+
+	core.AddPresetTarget(&core.PresetTarget{
+		PathPrefix: raidPrefix,
+
+		Config: &proto.Target{
+			Id:        fengBossID,
+			Name:      bossName,
+			Level:     93,
+			MobType:   proto.MobType_MobTypeHumanoid,
+			TankIndex: 0,
+
+			Stats: stats.Stats{
+				stats.Health:      bossHealth,
+				stats.Armor:       24835,
+				stats.AttackPower: 0, // actual value doesn't matter in MoP, as long as damage parameters are fit consistently
+			}.ToProtoArray(),
+
+			SpellSchool:   proto.SpellSchool_SpellSchoolPhysical,
+			SwingSpeed:    2.0,
+			MinBaseDamage: bossMinBaseDamage,
+			DamageSpread:  0.4,
+			TargetInputs:  []*proto.TargetInput{},
+
+			Script: []*proto.EncounterScriptEvent{
+				{
+					Name:           "Lightning Lash",
+					Start:          7,
+					RepeatInterval: 10,
+					Action: &proto.EncounterScriptEvent_Ability{Ability: &proto.BossSpecialAttack{
+						Name:            "Lightning Lash",
+						SpellSchool:     proto.SpellSchool_SpellSchoolNature,
+						BaseDamage:      500_000,
+						DamageSpread:    0.1,
+						Target:          proto.BossSpecialTarget_BossSpecialTargetTank,
+						DotDamage:       40_000,
+						DotTickInterval: 1,
+						DotDuration:     20,
+						DotMaxStacks:    3,
+					}},
+				},
+				{
+					Name:           "Epicenter",
+					Start:          18,
+					RepeatInterval: 30,
+					Action: &proto.EncounterScriptEvent_Ability{Ability: &proto.BossSpecialAttack{
+						Name:         "Epicenter",
+						SpellSchool:  proto.SpellSchool_SpellSchoolNature,
+						BaseDamage:   60_000,
+						DamageSpread: 0.1,
+						NumHits:      10,
+						HitInterval:  1,
+						CastTime:     2,
+						Target:       proto.BossSpecialTarget_BossSpecialTargetRaid,
+					}},
+				},
+				{
+					Name:           "Epicenter",
+					Start:          18,
+					RepeatInterval: 30,
+					Action: &proto.EncounterScriptEvent_RaidMovement{RaidMovement: &proto.EncounterScriptRaidMovement{
+						Yards:        20,
+						ReactionTime: 1.5,
+					}},
+				},
+			},
+		},
+	})
+
+	core.AddPresetEncounter(bossName, []string{raidPrefix + "/" + bossName})
+}
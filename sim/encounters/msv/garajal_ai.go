@@ -61,7 +61,7 @@ func createGarajalHeroicPreset(raidPrefix string, raidSize int32, bossHealth flo
 
 			Stats: stats.Stats{
 				stats.Health: addHealth,
-				stats.Armor:  24835, // TODO: verify add armor
+				stats.Armor:  core.TargetArmorForLevel(92),
 			}.ToProtoArray(),
 
 			TargetInputs:    []*proto.TargetInput{},
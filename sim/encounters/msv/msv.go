@@ -1,5 +1,6 @@
 package msv
 
 func Register() {
+	addFeng("Mogu'shan Vaults")
 	addGarajal("Mogu'shan Vaults")
 }
@@ -12,6 +12,7 @@ import (
 
 func init() {
 	AddDefaultPresetEncounter()
+	AddDungeonPresetEncounters()
 	addMovementAI()
 	addDynamicAddsAI()
 	msv.Register()
@@ -59,3 +60,55 @@ func AddDefaultPresetEncounter() {
 		"Default/Raid Target",
 	})
 }
+
+const (
+	dungeonBossID  int32 = 99997
+	dungeonTrashID int32 = 99996
+)
+
+// Targets for dungeon and challenge mode sims, which are below raid boss level.
+func AddDungeonPresetEncounters() {
+	AddSingleTargetBossEncounter(&core.PresetTarget{
+		PathPrefix: "Dungeon",
+		Config: &proto.Target{
+			Id:        dungeonBossID,
+			Name:      "Dungeon Boss",
+			Level:     core.CharacterLevel + 2,
+			MobType:   proto.MobType_MobTypeMechanical,
+			TankIndex: 0,
+
+			Stats: stats.Stats{
+				stats.Health: 40_000_000,
+				stats.Armor:  core.TargetArmorForLevel(core.CharacterLevel + 2),
+			}.ToProtoArray(),
+
+			SpellSchool:   proto.SpellSchool_SpellSchoolPhysical,
+			SwingSpeed:    2,
+			MinBaseDamage: 150000,
+			DamageSpread:  0.4,
+			TargetInputs:  []*proto.TargetInput{},
+		},
+	})
+
+	AddSingleTargetBossEncounter(&core.PresetTarget{
+		PathPrefix: "Dungeon",
+		Config: &proto.Target{
+			Id:        dungeonTrashID,
+			Name:      "Dungeon Trash",
+			Level:     core.CharacterLevel,
+			MobType:   proto.MobType_MobTypeMechanical,
+			TankIndex: 0,
+
+			Stats: stats.Stats{
+				stats.Health: 4_000_000,
+				stats.Armor:  core.TargetArmorForLevel(core.CharacterLevel),
+			}.ToProtoArray(),
+
+			SpellSchool:   proto.SpellSchool_SpellSchoolPhysical,
+			SwingSpeed:    2,
+			MinBaseDamage: 50000,
+			DamageSpread:  0.4,
+			TargetInputs:  []*proto.TargetInput{},
+		},
+	})
+}
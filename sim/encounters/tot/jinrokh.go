@@ -0,0 +1,103 @@
+package tot
+
+import (
+	"fmt"
+
+	"github.com/wowsims/mop/sim/core"
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/core/stats"
+)
+
+const jinrokhID int32 = 69465
+
+func addJinrokh(raidPrefix string) {
+	createJinrokhHeroicPreset(raidPrefix, 25, 1_186_000_000, 500_000)
+}
+
+// Scripted approximation of the heroic encounter, with the raid running into
+// a conductive pool for each Lightning Storm. Damage is scaled to the boss's
+// auto attacks.
+func createJinrokhHeroicPreset(raidPrefix string, raidSize int32, bossHealth float64, bossMinBaseDamage float64) {
+	bossName := fmt.Sprintf("Jin'rokh the Breaker %d H", raidSize)
+
+	core.AddPresetTarget(&core.PresetTarget{
+		PathPrefix: raidPrefix,
+
+		Config: &proto.Target{
+			Id:        jinrokhID,
+			Name:      bossName,
+			Level:     93,
+			MobType:   proto.MobType_MobTypeHumanoid,
+			TankIndex: 0,
+
+			Stats: stats.Stats{
+				stats.Health:      bossHealth,
+				stats.Armor:       24835,
+				stats.AttackPower: 0, // actual value doesn't matter in MoP, as long as damage parameters are fit consistently
+			}.ToProtoArray(),
+
+			SpellSchool:   proto.SpellSchool_SpellSchoolPhysical,
+			SwingSpeed:    2.0,
+			MinBaseDamage: bossMinBaseDamage,
+			DamageSpread:  0.4,
+			TargetInputs:  []*proto.TargetInput{},
+
+			Script: []*proto.EncounterScriptEvent{
+				{
+					Name:           "Static Burst",
+					Start:          13,
+					RepeatInterval: 19,
+					Action: &proto.EncounterScriptEvent_Ability{Ability: &proto.BossSpecialAttack{
+						Name:            "Static Burst",
+						SpellSchool:     proto.SpellSchool_SpellSchoolNature,
+						BaseDamage:      300_000,
+						DamageSpread:    0.1,
+						Target:          proto.BossSpecialTarget_BossSpecialTargetTank,
+						DotDamage:       50_000,
+						DotTickInterval: 1,
+						DotDuration:     25,
+						DotMaxStacks:    10,
+					}},
+				},
+				{
+					Name:           "Focused Lightning",
+					Start:          8,
+					RepeatInterval: 48,
+					Action: &proto.EncounterScriptEvent_Ability{Ability: &proto.BossSpecialAttack{
+						Name:         "Focused Lightning",
+						SpellSchool:  proto.SpellSchool_SpellSchoolNature,
+						BaseDamage:   400_000,
+						DamageSpread: 0.1,
+						Target:       proto.BossSpecialTarget_BossSpecialTargetRandomPlayer,
+					}},
+				},
+				{
+					Name:           "Lightning Storm",
+					Start:          90,
+					RepeatInterval: 90,
+					Action: &proto.EncounterScriptEvent_Ability{Ability: &proto.BossSpecialAttack{
+						Name:         "Lightning Storm",
+						SpellSchool:  proto.SpellSchool_SpellSchoolNature,
+						BaseDamage:   70_000,
+						DamageSpread: 0.1,
+						NumHits:      15,
+						HitInterval:  1,
+						CastTime:     3,
+						Target:       proto.BossSpecialTarget_BossSpecialTargetRaid,
+					}},
+				},
+				{
+					Name:           "Lightning Storm",
+					Start:          90,
+					RepeatInterval: 90,
+					Action: &proto.EncounterScriptEvent_RaidMovement{RaidMovement: &proto.EncounterScriptRaidMovement{
+						Yards:        30,
+						ReactionTime: 1,
+					}},
+				},
+			},
+		},
+	})
+
+	core.AddPresetEncounter(bossName, []string{raidPrefix + "/" + bossName})
+}
@@ -0,0 +1,140 @@
+package tot
+
+import (
+	"fmt"
+
+	"github.com/wowsims/mop/sim/core"
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/core/stats"
+)
+
+const tortosID int32 = 67977
+const whirlTurtleID int32 = 67966
+const numWhirlTurtles = 3
+
+func addTortos(raidPrefix string) {
+	createTortosHeroicPreset(raidPrefix, 25, 1_104_000_000, 500_000, 20_000_000)
+}
+
+// Scripted approximation of the heroic encounter. Each Call of Tortos brings in
+// a wave of Whirl Turtles to cleave until they're kicked back at the boss.
+// Damage is scaled to the boss's auto attacks.
+func createTortosHeroicPreset(raidPrefix string, raidSize int32, bossHealth float64, bossMinBaseDamage float64, turtleHealth float64) {
+	bossName := fmt.Sprintf("Tortos %d H", raidSize)
+	addName := fmt.Sprintf("Whirl Turtle %d H", raidSize)
+
+	turtleIndexes := make([]int32, numWhirlTurtles)
+	for idx := range turtleIndexes {
+		turtleIndexes[idx] = int32(idx + 1)
+	}
+
+	core.AddPresetTarget(&core.PresetTarget{
+		PathPrefix: raidPrefix,
+
+		Config: &proto.Target{
+			Id:        tortosID,
+			Name:      bossName,
+			Level:     93,
+			MobType:   proto.MobType_MobTypeBeast,
+			TankIndex: 0,
+
+			Stats: stats.Stats{
+				stats.Health:      bossHealth,
+				stats.Armor:       24835,
+				stats.AttackPower: 0, // actual value doesn't matter in MoP, as long as damage parameters are fit consistently
+			}.ToProtoArray(),
+
+			SpellSchool:   proto.SpellSchool_SpellSchoolPhysical,
+			SwingSpeed:    2.0,
+			MinBaseDamage: bossMinBaseDamage,
+			DamageSpread:  0.4,
+			TargetInputs:  []*proto.TargetInput{},
+
+			Script: []*proto.EncounterScriptEvent{
+				{
+					Name:           "Snapping Bite",
+					Start:          3,
+					RepeatInterval: 8,
+					Action: &proto.EncounterScriptEvent_Ability{Ability: &proto.BossSpecialAttack{
+						Name:         "Snapping Bite",
+						SpellSchool:  proto.SpellSchool_SpellSchoolPhysical,
+						BaseDamage:   600_000,
+						DamageSpread: 0.2,
+						Target:       proto.BossSpecialTarget_BossSpecialTargetTank,
+						Avoidable:    true,
+					}},
+				},
+				{
+					Name:           "Quake",
+					Start:          30,
+					RepeatInterval: 47,
+					Action: &proto.EncounterScriptEvent_Ability{Ability: &proto.BossSpecialAttack{
+						Name:         "Quake",
+						SpellSchool:  proto.SpellSchool_SpellSchoolPhysical,
+						BaseDamage:   150_000,
+						DamageSpread: 0.1,
+						CastTime:     2,
+						Target:       proto.BossSpecialTarget_BossSpecialTargetRaid,
+					}},
+				},
+				{
+					Name:           "Furious Stone Breath",
+					Start:          45,
+					RepeatInterval: 45,
+					Action: &proto.EncounterScriptEvent_Ability{Ability: &proto.BossSpecialAttack{
+						Name:         "Furious Stone Breath",
+						SpellSchool:  proto.SpellSchool_SpellSchoolNature,
+						BaseDamage:   50_000,
+						DamageSpread: 0.1,
+						NumHits:      8,
+						HitInterval:  0.5,
+						Target:       proto.BossSpecialTarget_BossSpecialTargetRaid,
+					}},
+				},
+				{
+					Name:           "Call of Tortos",
+					Start:          21,
+					RepeatInterval: 60,
+					Action: &proto.EncounterScriptEvent_SpawnAdds{SpawnAdds: &proto.EncounterScriptSpawnAdds{
+						TargetIndexes: turtleIndexes,
+						Duration:      20,
+					}},
+				},
+				{
+					Name:           "Rockfall",
+					Start:          5,
+					RepeatInterval: 10,
+					Action: &proto.EncounterScriptEvent_RaidMovement{RaidMovement: &proto.EncounterScriptRaidMovement{
+						Yards:        5,
+						ReactionTime: 1.5,
+					}},
+				},
+			},
+		},
+	})
+
+	core.AddPresetTarget(&core.PresetTarget{
+		PathPrefix: raidPrefix,
+
+		Config: &proto.Target{
+			Id:      whirlTurtleID,
+			Name:    addName,
+			Level:   92,
+			MobType: proto.MobType_MobTypeBeast,
+
+			Stats: stats.Stats{
+				stats.Health: turtleHealth,
+				stats.Armor:  core.TargetArmorForLevel(92),
+			}.ToProtoArray(),
+
+			TargetInputs:    []*proto.TargetInput{},
+			DisabledAtStart: true,
+		},
+	})
+
+	targetPathNames := []string{raidPrefix + "/" + bossName}
+	for range numWhirlTurtles {
+		targetPathNames = append(targetPathNames, raidPrefix+"/"+addName)
+	}
+	core.AddPresetEncounter(bossName, targetPathNames)
+}
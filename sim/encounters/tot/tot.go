@@ -1,5 +1,7 @@
 package tot
 
 func Register() {
+	addJinrokh("Throne of Thunder")
 	addHorridon("Throne of Thunder")
+	addTortos("Throne of Thunder")
 }
@@ -22,6 +22,17 @@ func RegisterBeastMasteryHunter() {
 			player.Spec = playerSpec
 		},
 	)
+	core.RegisterItemFilter(proto.Spec_SpecBeastMasteryHunter, ItemFilter)
+}
+
+var ItemFilter = core.ItemFilter{
+	ArmorType: proto.ArmorType_ArmorTypeMail,
+
+	RangedWeaponTypes: []proto.RangedWeaponType{
+		proto.RangedWeaponType_RangedWeaponTypeBow,
+		proto.RangedWeaponType_RangedWeaponTypeCrossbow,
+		proto.RangedWeaponType_RangedWeaponTypeGun,
+	},
 }
 
 func NewBeastMasteryHunter(character *core.Character, options *proto.Player) *BeastMasteryHunter {
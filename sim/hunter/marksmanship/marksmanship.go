@@ -21,7 +21,19 @@ func RegisterMarksmanshipHunter() {
 			player.Spec = playerSpec
 		},
 	)
+	core.RegisterItemFilter(proto.Spec_SpecMarksmanshipHunter, ItemFilter)
 }
+
+var ItemFilter = core.ItemFilter{
+	ArmorType: proto.ArmorType_ArmorTypeMail,
+
+	RangedWeaponTypes: []proto.RangedWeaponType{
+		proto.RangedWeaponType_RangedWeaponTypeBow,
+		proto.RangedWeaponType_RangedWeaponTypeCrossbow,
+		proto.RangedWeaponType_RangedWeaponTypeGun,
+	},
+}
+
 func (mm *MarksmanshipHunter) applyMastery() {
 	actionID := core.ActionID{SpellID: 76659}
 
@@ -31,6 +31,7 @@ func (hunter *Hunter) registerSilencingShotSpell() {
 		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
 			focusMetics := hunter.NewFocusMetrics(core.ActionID{SpellID: 34490})
 			hunter.AddFocus(sim, 10, focusMetics)
+			target.InterruptCast(sim, time.Second*3)
 		},
 	})
 }
@@ -22,6 +22,17 @@ func RegisterSurvivalHunter() {
 			player.Spec = playerSpec
 		},
 	)
+	core.RegisterItemFilter(proto.Spec_SpecSurvivalHunter, ItemFilter)
+}
+
+var ItemFilter = core.ItemFilter{
+	ArmorType: proto.ArmorType_ArmorTypeMail,
+
+	RangedWeaponTypes: []proto.RangedWeaponType{
+		proto.RangedWeaponType_RangedWeaponTypeBow,
+		proto.RangedWeaponType_RangedWeaponTypeCrossbow,
+		proto.RangedWeaponType_RangedWeaponTypeGun,
+	},
 }
 
 func (hunter *SurvivalHunter) Initialize() {
@@ -54,17 +54,14 @@ func TestSurvival(t *testing.T) {
 			Profession1: proto.Profession_Engineering,
 			Profession2: proto.Profession_Tailoring,
 
-			ItemFilter: core.ItemFilter{
-				ArmorType: proto.ArmorType_ArmorTypeMail,
-
-				RangedWeaponTypes: []proto.RangedWeaponType{
-					proto.RangedWeaponType_RangedWeaponTypeBow,
-					proto.RangedWeaponType_RangedWeaponTypeCrossbow,
-					proto.RangedWeaponType_RangedWeaponTypeGun,
-				},
-			},
+			ItemFilter: ItemFilter,
 
 			StartingDistance: 24,
+
+			APLCoverageExemptions: []core.ActionID{
+				{SpellID: 2643},  // Multi-Shot
+				{SpellID: 34490}, // Silencing Shot
+			},
 		},
 	}))
 }
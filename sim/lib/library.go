@@ -62,6 +62,40 @@ func computeStats(json *C.char) *C.char {
 	return C.CString(string(out))
 }
 
+//export computeCharacterSheet
+func computeCharacterSheet(json *C.char) *C.char {
+	input := &proto.ComputeCharacterSheetRequest{}
+	jsonString := C.GoString(json)
+	err := protojson.Unmarshal([]byte(jsonString), input)
+	if err != nil {
+		log.Fatalf("failed to load input json file: %s", err)
+	}
+	sim.RegisterAll()
+	result := core.ComputeCharacterSheet(input)
+	out, err := protojson.Marshal(result)
+	if err != nil {
+		panic(err)
+	}
+	return C.CString(string(out))
+}
+
+//export computeOutcomeTables
+func computeOutcomeTables(json *C.char) *C.char {
+	input := &proto.ComputeOutcomeTablesRequest{}
+	jsonString := C.GoString(json)
+	err := protojson.Unmarshal([]byte(jsonString), input)
+	if err != nil {
+		log.Fatalf("failed to load input json file: %s", err)
+	}
+	sim.RegisterAll()
+	result := core.ComputeOutcomeTables(input)
+	out, err := protojson.Marshal(result)
+	if err != nil {
+		panic(err)
+	}
+	return C.CString(string(out))
+}
+
 //export encodeSettings
 func encodeSettings(json *C.char) *C.char {
 	input := &proto.RaidSimRequest{}
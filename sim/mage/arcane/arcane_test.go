@@ -32,23 +32,26 @@ func TestArcane(t *testing.T) {
 			Rotation:    core.GetAplRotation("../../../ui/mage/arcane/apls", "arcane_t15_4pc"),
 
 			ItemFilter: ItemFilter,
+
+			APLCoverageExemptions: []core.ActionID{
+				{SpellID: 30482},  // Molten Armor
+				{SpellID: 6117},   // Mage Armor
+				{SpellID: 7302},   // Frost Armor
+				{SpellID: 1449},   // Arcane Explosion
+				{SpellID: 10},     // Blizzard
+				{SpellID: 120},    // Cone of Cold
+				{SpellID: 44572},  // Deep Freeze
+				{SpellID: 2120},   // Flamestrike
+				{SpellID: 30455},  // Ice Lance
+				{SpellID: 44614},  // Frostfire Bolt
+				{SpellID: 2136},   // Fire Blast
+				{SpellID: 122},    // Frost Nova
+				{SpellID: 108978}, // Alter Time
+			},
 		},
 	}))
 }
 
-var ItemFilter = core.ItemFilter{
-	WeaponTypes: []proto.WeaponType{
-		proto.WeaponType_WeaponTypeDagger,
-		proto.WeaponType_WeaponTypeSword,
-		proto.WeaponType_WeaponTypeOffHand,
-		proto.WeaponType_WeaponTypeStaff,
-	},
-	ArmorType: proto.ArmorType_ArmorTypeCloth,
-	RangedWeaponTypes: []proto.RangedWeaponType{
-		proto.RangedWeaponType_RangedWeaponTypeWand,
-	},
-}
-
 var ArcaneTalents = "311122"
 var ArcaneGlyphs = &proto.Glyphs{
 	Major1: int32(proto.MageMajorGlyph_GlyphOfArcanePower),
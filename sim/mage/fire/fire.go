@@ -26,6 +26,23 @@ func RegisterFireMage() {
 			player.Spec = playerSpec
 		},
 	)
+	core.RegisterItemFilter(proto.Spec_SpecFireMage, ItemFilter)
+}
+
+var ItemFilter = core.ItemFilter{
+	ArmorType: proto.ArmorType_ArmorTypeCloth,
+
+	WeaponTypes: []proto.WeaponType{
+		proto.WeaponType_WeaponTypeDagger,
+		proto.WeaponType_WeaponTypeSword,
+		proto.WeaponType_WeaponTypeStaff,
+	},
+	HandTypes: []proto.HandType{
+		proto.HandType_HandTypeOffHand,
+	},
+	RangedWeaponTypes: []proto.RangedWeaponType{
+		proto.RangedWeaponType_RangedWeaponTypeWand,
+	},
 }
 
 func NewFireMage(character *core.Character, options *proto.Player) *FireMage {
@@ -32,6 +32,23 @@ func TestFire(t *testing.T) {
 			Rotation:        core.GetAplRotation("../../../ui/mage/fire/apls", "fire"),
 
 			ItemFilter: ItemFilter,
+
+			APLCoverageExemptions: []core.ActionID{
+				{SpellID: 30482}, // Molten Armor
+				{SpellID: 6117},  // Mage Armor
+				{SpellID: 7302},  // Frost Armor
+				{SpellID: 1449},  // Arcane Explosion
+				{SpellID: 10},    // Blizzard
+				{SpellID: 120},   // Cone of Cold
+				{SpellID: 44572}, // Deep Freeze
+				{SpellID: 2120},  // Flamestrike
+				{SpellID: 30455}, // Ice Lance
+				{SpellID: 44614}, // Frostfire Bolt
+				{ItemID: 36799},  // Mana Gem
+				{SpellID: 122},   // Frost Nova
+				{SpellID: 31661}, // Dragon's Breath
+				{SpellID: 2948},  // Scorch
+			},
 		},
 	}))
 }
@@ -57,19 +74,3 @@ var FullFireConsumesSpec = &proto.ConsumesSpec{
 	PotId:    76093, // Potion of the Jade Serpent
 	PrepotId: 76093, // Potion of the Jade Serpent
 }
-
-var ItemFilter = core.ItemFilter{
-	ArmorType: proto.ArmorType_ArmorTypeCloth,
-
-	WeaponTypes: []proto.WeaponType{
-		proto.WeaponType_WeaponTypeDagger,
-		proto.WeaponType_WeaponTypeSword,
-		proto.WeaponType_WeaponTypeStaff,
-	},
-	HandTypes: []proto.HandType{
-		proto.HandType_HandTypeOffHand,
-	},
-	RangedWeaponTypes: []proto.RangedWeaponType{
-		proto.RangedWeaponType_RangedWeaponTypeWand,
-	},
-}
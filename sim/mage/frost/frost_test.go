@@ -34,6 +34,21 @@ func TestFrost(t *testing.T) {
 			},
 
 			ItemFilter: ItemFilter,
+
+			APLCoverageExemptions: []core.ActionID{
+				{SpellID: 30482},  // Molten Armor
+				{SpellID: 6117},   // Mage Armor
+				{SpellID: 7302},   // Frost Armor
+				{SpellID: 1449},   // Arcane Explosion
+				{SpellID: 10},     // Blizzard
+				{SpellID: 120},    // Cone of Cold
+				{SpellID: 44572},  // Deep Freeze
+				{SpellID: 2120},   // Flamestrike
+				{SpellID: 2136},   // Fire Blast
+				{ItemID: 36799},   // Mana Gem
+				{SpellID: 122},    // Frost Nova
+				{SpellID: 108978}, // Alter Time
+			},
 		},
 	}))
 }
@@ -60,14 +75,3 @@ var DefaultConsumables = &proto.ConsumesSpec{
 	PotId:    76093, // Potion of the Jade Serpent
 	PrepotId: 76093, // Potion of the Jade Serpent
 }
-
-var ItemFilter = core.ItemFilter{
-	ArmorType: proto.ArmorType_ArmorTypeCloth,
-
-	WeaponTypes: []proto.WeaponType{
-		proto.WeaponType_WeaponTypeDagger,
-		proto.WeaponType_WeaponTypeSword,
-		proto.WeaponType_WeaponTypeOffHand,
-		proto.WeaponType_WeaponTypeStaff,
-	},
-}
@@ -22,6 +22,20 @@ func RegisterBrewmasterMonk() {
 			player.Spec = playerSpec
 		},
 	)
+	core.RegisterItemFilter(proto.Spec_SpecBrewmasterMonk, ItemFilter)
+}
+
+var ItemFilter = core.ItemFilter{
+	ArmorType: proto.ArmorType_ArmorTypeLeather,
+
+	WeaponTypes: []proto.WeaponType{
+		proto.WeaponType_WeaponTypeStaff,
+		proto.WeaponType_WeaponTypePolearm,
+		proto.WeaponType_WeaponTypeAxe,
+		proto.WeaponType_WeaponTypeSword,
+		proto.WeaponType_WeaponTypeMace,
+		proto.WeaponType_WeaponTypeFist,
+	},
 }
 
 func NewBrewmasterMonk(character *core.Character, options *proto.Player) *BrewmasterMonk {
@@ -49,6 +49,23 @@ func TestBrewmaster(t *testing.T) {
 			InFrontOfTarget: true,
 
 			ItemFilter: ItemFilter,
+
+			APLCoverageExemptions: []core.ActionID{
+				{SpellID: 103985}, // Stance of the Fierce Tiger
+				{ItemID: 5512},    // Healthstone
+				{SpellID: 115464}, // Healing Sphere Stacks
+				{SpellID: 115460}, // Healing Sphere Stacks
+				{SpellID: 101546}, // Spinning Crane Kick
+				{SpellID: 115080}, // Touch of Death
+				{SpellID: 117952}, // Crackling Jade Lightning
+				{SpellID: 130320}, // Rising Sun Kick
+				{SpellID: 113656}, // Fists of Fury
+				{SpellID: 115073}, // Spinning Fire Blossom
+				{SpellID: 124507}, // Gift Of The Ox
+				{SpellID: 115213}, // Avert Harm
+				{SpellID: 115181}, // Breath of Fire
+				{SpellID: 115180}, // Dizzying Haze
+			},
 		},
 	}))
 }
@@ -75,16 +92,3 @@ var FullConsumesSpec = &proto.ConsumesSpec{
 	PotId:    76089, // Virmen's Bite
 	PrepotId: 76089, // Virmen's Bite
 }
-
-var ItemFilter = core.ItemFilter{
-	ArmorType: proto.ArmorType_ArmorTypeLeather,
-
-	WeaponTypes: []proto.WeaponType{
-		proto.WeaponType_WeaponTypeStaff,
-		proto.WeaponType_WeaponTypePolearm,
-		proto.WeaponType_WeaponTypeAxe,
-		proto.WeaponType_WeaponTypeSword,
-		proto.WeaponType_WeaponTypeMace,
-		proto.WeaponType_WeaponTypeFist,
-	},
-}
@@ -49,7 +49,7 @@ func (bm *BrewmasterMonk) registerGuard() {
 		},
 	})
 
-	aura.Aura.AttachMultiplicativePseudoStatBuff(&bm.PseudoStats.HealingTakenMultiplier, 1.3)
+	core.ActiveMitigation(aura.Aura).AttachMultiplicativePseudoStatBuff(&bm.PseudoStats.HealingTakenMultiplier, 1.3)
 
 	bm.Guard = bm.RegisterSpell(core.SpellConfig{
 		ActionID:       actionID,
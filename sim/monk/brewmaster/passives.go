@@ -44,11 +44,11 @@ func (bm *BrewmasterMonk) registerBrewmasterTraining() {
 	// After you Blackout Kick, you gain Shuffle, increasing your parry chance by 20%
 	// and your Stagger amount by an additional 20% for 6 sec.
 	// Stagger amount is implemented in stagger.go
-	bm.ShuffleAura = core.BlockPrepull(bm.RegisterAura(core.Aura{
+	bm.ShuffleAura = core.ActiveMitigation(core.BlockPrepull(bm.RegisterAura(core.Aura{
 		Label:    "Shuffle",
 		ActionID: core.ActionID{SpellID: 115307},
 		Duration: 6 * time.Second,
-	})).AttachAdditivePseudoStatBuff(&bm.PseudoStats.BaseParryChance, 0.2)
+	}))).AttachAdditivePseudoStatBuff(&bm.PseudoStats.BaseParryChance, 0.2)
 
 	bm.MakeProcTriggerAura(core.ProcTrigger{
 		Name:               "Shuffle Trigger",
@@ -22,6 +22,24 @@ func RegisterMistweaverMonk() {
 			player.Spec = playerSpec
 		},
 	)
+	core.RegisterItemFilter(proto.Spec_SpecMistweaverMonk, ItemFilter)
+	core.RegisterModelingStatus(proto.Spec_SpecMistweaverMonk, proto.ModelingStatus_ModelingStatusMissing,
+		core.MechanicModelingStatus{Mechanic: "Mastery: Gift of the Serpent", Status: proto.ModelingStatus_ModelingStatusMissing},
+	)
+}
+
+var ItemFilter = core.ItemFilter{
+	ArmorType: proto.ArmorType_ArmorTypeLeather,
+
+	WeaponTypes: []proto.WeaponType{
+		proto.WeaponType_WeaponTypeStaff,
+		proto.WeaponType_WeaponTypePolearm,
+		proto.WeaponType_WeaponTypeAxe,
+		proto.WeaponType_WeaponTypeSword,
+		proto.WeaponType_WeaponTypeMace,
+		proto.WeaponType_WeaponTypeFist,
+		proto.WeaponType_WeaponTypeOffHand,
+	},
 }
 
 func NewMistweaverMonk(character *core.Character, options *proto.Player) *MistweaverMonk {
@@ -22,6 +22,18 @@ func RegisterWindwalkerMonk() {
 			player.Spec = playerSpec
 		},
 	)
+	core.RegisterItemFilter(proto.Spec_SpecWindwalkerMonk, ItemFilter)
+}
+
+var ItemFilter = core.ItemFilter{
+	ArmorType: proto.ArmorType_ArmorTypeLeather,
+
+	WeaponTypes: []proto.WeaponType{
+		proto.WeaponType_WeaponTypeAxe,
+		proto.WeaponType_WeaponTypeSword,
+		proto.WeaponType_WeaponTypeMace,
+		proto.WeaponType_WeaponTypeFist,
+	},
 }
 
 func NewWindwalkerMonk(character *core.Character, options *proto.Player) *WindwalkerMonk {
@@ -36,6 +36,18 @@ func TestWindwalker(t *testing.T) {
 			Rotation:    core.GetAplRotation("../../../ui/monk/windwalker/apls", "default"),
 
 			ItemFilter: ItemFilter,
+
+			APLCoverageExemptions: []core.ActionID{
+				{SpellID: 138310}, // Energy Sphere
+				{SpellID: 122278}, // Dampen Harm
+				{SpellID: 115464}, // Healing Sphere Stacks
+				{SpellID: 115460}, // Healing Sphere Stacks
+				{SpellID: 115072}, // Expel Harm
+				{SpellID: 101546}, // Spinning Crane Kick
+				{SpellID: 117952}, // Crackling Jade Lightning
+				{SpellID: 138228}, // Storm, Earth, and Fire
+				{SpellID: 115073}, // Spinning Fire Blossom
+			},
 		},
 	}))
 }
@@ -66,14 +78,3 @@ var FullConsumesSpec = &proto.ConsumesSpec{
 	PotId:    76089, // Virmen's Bite
 	PrepotId: 76089, // Virmen's Bite
 }
-
-var ItemFilter = core.ItemFilter{
-	ArmorType: proto.ArmorType_ArmorTypeLeather,
-
-	WeaponTypes: []proto.WeaponType{
-		proto.WeaponType_WeaponTypeAxe,
-		proto.WeaponType_WeaponTypeSword,
-		proto.WeaponType_WeaponTypeMace,
-		proto.WeaponType_WeaponTypeFist,
-	},
-}
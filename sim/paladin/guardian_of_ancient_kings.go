@@ -165,13 +165,7 @@ func (paladin *Paladin) registerRetributionGuardian(duration time.Duration) {
 		MaxStacks: 12,
 
 		OnStacksChange: func(aura *core.Aura, sim *core.Simulation, oldStacks int32, newStacks int32) {
-			if oldStacks > 0 {
-				paladin.DisableDynamicStatDep(sim, strDepByStackCount[oldStacks])
-			}
-
-			if newStacks > 0 {
-				paladin.EnableDynamicStatDep(sim, strDepByStackCount[newStacks])
-			}
+			paladin.SwapDynamicStatDep(sim, strDepByStackCount[oldStacks], strDepByStackCount[newStacks])
 		},
 	})
 
@@ -22,6 +22,21 @@ func RegisterHolyPaladin() {
 			player.Spec = playerSpec
 		},
 	)
+	core.RegisterItemFilter(proto.Spec_SpecHolyPaladin, ItemFilter)
+	core.RegisterModelingStatus(proto.Spec_SpecHolyPaladin, proto.ModelingStatus_ModelingStatusMissing,
+		core.MechanicModelingStatus{Mechanic: "Healing rotation", Status: proto.ModelingStatus_ModelingStatusMissing, Notes: "The rotation only waits."},
+	)
+}
+
+var ItemFilter = core.ItemFilter{
+	WeaponTypes: []proto.WeaponType{
+		proto.WeaponType_WeaponTypeSword,
+		proto.WeaponType_WeaponTypePolearm,
+		proto.WeaponType_WeaponTypeMace,
+		proto.WeaponType_WeaponTypeShield,
+	},
+	ArmorType:         proto.ArmorType_ArmorTypePlate,
+	RangedWeaponTypes: []proto.RangedWeaponType{},
 }
 
 func NewHolyPaladin(character *core.Character, options *proto.Player) *HolyPaladin {
@@ -22,6 +22,23 @@ func RegisterProtectionPaladin() {
 			player.Spec = playerSpec
 		},
 	)
+	core.RegisterItemFilter(proto.Spec_SpecProtectionPaladin, ItemFilter)
+}
+
+var ItemFilter = core.ItemFilter{
+	WeaponTypes: []proto.WeaponType{
+		proto.WeaponType_WeaponTypeAxe,
+		proto.WeaponType_WeaponTypeSword,
+		proto.WeaponType_WeaponTypeMace,
+		proto.WeaponType_WeaponTypeShield,
+	},
+	HandTypes: []proto.HandType{
+		proto.HandType_HandTypeMainHand,
+		proto.HandType_HandTypeOneHand,
+		proto.HandType_HandTypeOffHand,
+	},
+	ArmorType:         proto.ArmorType_ArmorTypePlate,
+	RangedWeaponTypes: []proto.RangedWeaponType{},
 }
 
 func NewProtectionPaladin(character *core.Character, options *proto.Player) *ProtectionPaladin {
@@ -39,6 +39,18 @@ func TestProtection(t *testing.T) {
 			IsTank:          true,
 			InFrontOfTarget: true,
 			ItemFilter:      ItemFilter,
+
+			APLCoverageExemptions: []core.ActionID{
+				{SpellID: 146586}, // Stay of Execution
+				{SpellID: 31821},  // Devotion Aura
+				{SpellID: 19750},  // Flash of Light
+				{SpellID: 53595},  // Hammer of the Righteous
+				{SpellID: 633},    // Lay on Hands
+				{SpellID: 20165},  // Seal of Insight
+				{SpellID: 20154},  // Seal of Righteousness
+				{SpellID: 31801},  // Seal of Truth
+				{SpellID: 85673},  // Word of Glory
+			},
 		},
 	}))
 }
@@ -87,19 +99,3 @@ var FullConsumesSpec = &proto.ConsumesSpec{
 	PotId:    76095, // Potion of Mogu Power
 	PrepotId: 76095, // Potion of Mogu Power
 }
-
-var ItemFilter = core.ItemFilter{
-	WeaponTypes: []proto.WeaponType{
-		proto.WeaponType_WeaponTypeAxe,
-		proto.WeaponType_WeaponTypeSword,
-		proto.WeaponType_WeaponTypeMace,
-		proto.WeaponType_WeaponTypeShield,
-	},
-	HandTypes: []proto.HandType{
-		proto.HandType_HandTypeMainHand,
-		proto.HandType_HandTypeOneHand,
-		proto.HandType_HandTypeOffHand,
-	},
-	ArmorType:         proto.ArmorType_ArmorTypePlate,
-	RangedWeaponTypes: []proto.RangedWeaponType{},
-}
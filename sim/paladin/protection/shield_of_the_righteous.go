@@ -54,7 +54,7 @@ func (prot *ProtectionPaladin) registerShieldOfTheRighteous() {
 	})
 
 	var snapshotDmgReduction float64
-	shieldOfTheRighteousAura := core.BlockPrepull(prot.RegisterAura(core.Aura{
+	shieldOfTheRighteousAura := core.ActiveMitigation(core.BlockPrepull(prot.RegisterAura(core.Aura{
 		ActionID:  core.ActionID{SpellID: 132403},
 		Label:     "Shield of the Righteous" + prot.Label,
 		Duration:  time.Second * 3,
@@ -76,7 +76,7 @@ func (prot *ProtectionPaladin) registerShieldOfTheRighteous() {
 		OnExpire: func(aura *core.Aura, sim *core.Simulation) {
 			prot.PseudoStats.SchoolDamageTakenMultiplier[stats.SchoolIndexPhysical] /= snapshotDmgReduction
 		},
-	}))
+	})))
 
 	prot.AddDefensiveCooldownAura(shieldOfTheRighteousAura)
 
@@ -49,5 +49,9 @@ func (ret *RetributionPaladin) registerDivineStorm() {
 
 			spell.DealBatchedAoeDamage(sim)
 		},
+		ExpectedInitialDamage: func(sim *core.Simulation, target *core.Unit, spell *core.Spell, _ bool) *core.SpellResult {
+			baseDamage := ret.AutoAttacks.MH().CalculateAverageNormalizedWeaponDamage(spell.MeleeAttackPower())
+			return spell.CalcDamage(sim, target, baseDamage, spell.OutcomeExpectedMeleeWeaponSpecialHitAndCrit)
+		},
 	})
 }
@@ -22,6 +22,23 @@ func RegisterRetributionPaladin() {
 			player.Spec = playerSpec
 		},
 	)
+	core.RegisterItemFilter(proto.Spec_SpecRetributionPaladin, ItemFilter)
+}
+
+var ItemFilter = core.ItemFilter{
+	ArmorType: proto.ArmorType_ArmorTypePlate,
+
+	HandTypes: []proto.HandType{
+		proto.HandType_HandTypeTwoHand,
+	},
+
+	WeaponTypes: []proto.WeaponType{
+		proto.WeaponType_WeaponTypeAxe,
+		proto.WeaponType_WeaponTypeMace,
+		proto.WeaponType_WeaponTypePolearm,
+		proto.WeaponType_WeaponTypeSword,
+	},
+	RangedWeaponTypes: []proto.RangedWeaponType{},
 }
 
 func NewRetributionPaladin(character *core.Character, options *proto.Player) *RetributionPaladin {
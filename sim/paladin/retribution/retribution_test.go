@@ -55,20 +55,21 @@ func TestRetribution(t *testing.T) {
 			Profession1: proto.Profession_Engineering,
 			Profession2: proto.Profession_Herbalism,
 
-			ItemFilter: core.ItemFilter{
-				ArmorType: proto.ArmorType_ArmorTypePlate,
-
-				HandTypes: []proto.HandType{
-					proto.HandType_HandTypeTwoHand,
-				},
-
-				WeaponTypes: []proto.WeaponType{
-					proto.WeaponType_WeaponTypeAxe,
-					proto.WeaponType_WeaponTypeMace,
-					proto.WeaponType_WeaponTypePolearm,
-					proto.WeaponType_WeaponTypeSword,
-				},
-				RangedWeaponTypes: []proto.RangedWeaponType{},
+			ItemFilter: ItemFilter,
+
+			APLCoverageExemptions: []core.ActionID{
+				{SpellID: 146586}, // Stay of Execution
+				{SpellID: 31821},  // Devotion Aura
+				{SpellID: 498},    // Divine Protection
+				{SpellID: 19750},  // Flash of Light
+				{SpellID: 53595},  // Hammer of the Righteous
+				{SpellID: 633},    // Lay on Hands
+				{SpellID: 20165},  // Seal of Insight
+				{SpellID: 20154},  // Seal of Righteousness
+				{SpellID: 31801},  // Seal of Truth
+				{SpellID: 85673},  // Word of Glory
+				{SpellID: 53385},  // Divine Storm
+				{SpellID: 20164},  // Seal of Justice
 			},
 		},
 	}))
@@ -119,3 +120,51 @@ var SealOfTruth = &proto.Player_RetributionPaladin{
 		},
 	},
 }
+
+func TestRetributionExpectedDamage(t *testing.T) {
+	templarsVerdict := core.ActionID{SpellID: 85256}.ToProto()
+	divineStorm := core.ActionID{SpellID: 53385}.ToProto()
+	expectedDamage := func(spellID *proto.ActionID) *proto.APLValue {
+		return &proto.APLValue{Value: &proto.APLValue_SpellExpectedDamage{SpellExpectedDamage: &proto.APLValueSpellExpectedDamage{SpellId: spellID}}}
+	}
+
+	// Divine Storm only hits for a fraction of Templar's Verdict on a single target.
+	rotation := core.GetAplRotation("../../../ui/paladin/retribution/apls", "default").Rotation
+	rotation.PriorityList = append([]*proto.APLListItem{{Action: &proto.APLAction{
+		Condition: &proto.APLValue{Value: &proto.APLValue_Cmp{Cmp: &proto.APLValueCompare{
+			Op:  proto.APLValueCompare_OpGt,
+			Lhs: expectedDamage(divineStorm),
+			Rhs: expectedDamage(templarsVerdict),
+		}}},
+		Action: &proto.APLAction_CastSpell{CastSpell: &proto.APLActionCastSpell{SpellId: divineStorm}},
+	}}}, rotation.PriorityList...)
+
+	player := &proto.Player{
+		Race:          proto.Race_RaceBloodElf,
+		Class:         proto.Class_ClassPaladin,
+		Equipment:     core.GetGearSet("../../../ui/paladin/retribution/gear_sets", "p3").GearSet,
+		TalentsString: "000023",
+		Glyphs:        StandardGlyphs,
+		Spec:          SealOfTruth,
+		Rotation:      rotation,
+		Buffs:         &proto.IndividualBuffs{},
+	}
+	result := core.RunRaidSim(&proto.RaidSimRequest{
+		Raid:       core.SinglePlayerRaidProto(player, &proto.PartyBuffs{}, &proto.RaidBuffs{}, &proto.Debuffs{}),
+		Encounter:  core.MakeSingleTargetEncounter(0),
+		SimOptions: &proto.SimOptions{Iterations: 1, IsTest: true, RandomSeed: 101},
+	})
+	if result.Error != nil {
+		t.Fatalf("Sim failed: %s", result.Error.Message)
+	}
+
+	casts := make(map[int32]int32)
+	for _, action := range result.RaidMetrics.Parties[0].Players[0].Actions {
+		for _, target := range action.Targets {
+			casts[action.Id.GetSpellId()] += target.Casts
+		}
+	}
+	if casts[divineStorm.GetSpellId()] != 0 || casts[templarsVerdict.GetSpellId()] == 0 {
+		t.Fatalf("Expected Templar's Verdict to be preferred over Divine Storm, found %d and %d casts", casts[templarsVerdict.GetSpellId()], casts[divineStorm.GetSpellId()])
+	}
+}
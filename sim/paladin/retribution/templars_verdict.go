@@ -49,5 +49,9 @@ func (ret *RetributionPaladin) registerTemplarsVerdict() {
 
 			spell.DealDamage(sim, result)
 		},
+		ExpectedInitialDamage: func(sim *core.Simulation, target *core.Unit, spell *core.Spell, _ bool) *core.SpellResult {
+			baseDamage := ret.AutoAttacks.MH().CalculateAverageNormalizedWeaponDamage(spell.MeleeAttackPower()) + ret.CalcScalingSpellDmg(0.55000001192)
+			return spell.CalcDamage(sim, target, baseDamage, spell.OutcomeExpectedMeleeWeaponSpecialHitAndCrit)
+		},
 	})
 }
@@ -0,0 +1,52 @@
+package discipline
+
+import (
+	"github.com/wowsims/mop/sim/core"
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/priest"
+)
+
+const atonementSpells = priest.PriestSpellSmite | priest.PriestSpellHolyFire | priest.PriestSpellPenance
+
+// Damage from Smite, Holy Fire and Penance heals the most injured ally for 90%
+// of the damage dealt. While Spirit Shell is active the healing is banked as
+// an absorb instead.
+func (disc *DisciplinePriest) registerAtonement() {
+	healAmount := 0.0
+
+	atonementHeal := disc.RegisterSpell(core.SpellConfig{
+		ActionID:    core.ActionID{SpellID: 81751},
+		SpellSchool: core.SpellSchoolHoly,
+		ProcMask:    core.ProcMaskSpellHealing,
+		Flags:       core.SpellFlagHelpful | core.SpellFlagPassiveSpell | core.SpellFlagNoOnCastComplete,
+
+		DamageMultiplier: 0.9,
+		ThreatMultiplier: 1,
+
+		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			// Crits are inherited from the damage, so the heal itself never crits.
+			if disc.SpiritShell.IsActive() {
+				disc.spiritShellAbsorb.Shield(target).Stack(sim, healAmount*spell.DamageMultiplier, disc.MaxHealth()*spiritShellMaxHealthPercent)
+				return
+			}
+			spell.CalcAndDealHealing(sim, target, healAmount, spell.OutcomeHealing)
+		},
+	})
+
+	disc.MakeProcTriggerAura(core.ProcTrigger{
+		Name:               "Atonement",
+		ActionID:           core.ActionID{SpellID: 81749},
+		Callback:           core.CallbackOnSpellHitDealt | core.CallbackOnPeriodicDamageDealt,
+		ClassSpellMask:     atonementSpells,
+		Outcome:            core.OutcomeLanded,
+		RequireDamageDealt: true,
+		TriggerImmediately: true,
+
+		Handler: func(sim *core.Simulation, _ *core.Spell, result *core.SpellResult) {
+			healAmount = result.Damage
+			if target := disc.SmartHealTarget(sim, proto.HealTargetStrategy_HealTargetLowestHealth); target != nil {
+				atonementHeal.Cast(sim, target)
+			}
+		},
+	})
+}
@@ -21,12 +21,29 @@ func RegisterDisciplinePriest() {
 			player.Spec = playerSpec
 		},
 	)
+	core.RegisterItemFilter(proto.Spec_SpecDisciplinePriest, ItemFilter)
+	core.RegisterModelingStatus(proto.Spec_SpecDisciplinePriest, proto.ModelingStatus_ModelingStatusApproximated,
+		core.MechanicModelingStatus{Mechanic: "Power Infusion", Status: proto.ModelingStatus_ModelingStatusMissing, Notes: "The Power Infusion target option is ignored."},
+	)
+}
+
+var ItemFilter = core.ItemFilter{
+	WeaponTypes: []proto.WeaponType{
+		proto.WeaponType_WeaponTypeDagger,
+		proto.WeaponType_WeaponTypeMace,
+		proto.WeaponType_WeaponTypeOffHand,
+		proto.WeaponType_WeaponTypeStaff,
+	},
+	ArmorType: proto.ArmorType_ArmorTypeCloth,
 }
 
 type DisciplinePriest struct {
 	*priest.Priest
 
 	Options *proto.DisciplinePriest_Options
+
+	SpiritShell       *core.Aura
+	spiritShellAbsorb *core.Spell
 }
 
 func newDisciplinePriest(character *core.Character, options *proto.Player) *DisciplinePriest {
@@ -56,27 +73,24 @@ func (discPriest *DisciplinePriest) GetPriest() *priest.Priest {
 	return discPriest.Priest
 }
 
-func (discPriest *DisciplinePriest) GetMainTarget() *core.Unit {
-	target := discPriest.Env.Raid.GetFirstTargetDummy()
-	if target == nil {
-		return &discPriest.Unit
-	} else {
-		return &target.Unit
-	}
-}
-
+// Discipline heals through Atonement, so the current target stays on the
+// enemy and shields are cast on allies with friendly spell actions.
 func (discPriest *DisciplinePriest) Initialize() {
-	discPriest.CurrentTarget = discPriest.GetMainTarget()
 	discPriest.Priest.Initialize()
-	// discPriest.Priest.RegisterHealingSpells()
+	discPriest.registerSmiteSpell()
+	discPriest.registerHolyFireSpell()
+	discPriest.registerPenanceSpell()
+	discPriest.registerPowerWordShieldSpell()
+	discPriest.registerSpiritShellSpell()
+	discPriest.registerAtonement()
 
-	// // discPriest.ApplyRapture(discPriest.Options.RapturesPerMinute)
-	// discPriest.RegisterHymnOfHopeCD()
+	discPriest.RegisterHymnOfHopeCD()
 }
 
-func (holyPriest *DisciplinePriest) ApplyTalents() {
+func (discPriest *DisciplinePriest) ApplyTalents() {
+	discPriest.Priest.ApplyTalents()
 }
 
 func (discPriest *DisciplinePriest) Reset(sim *core.Simulation) {
-	//discPriest.Priest.Reset(sim)
+	discPriest.Priest.Reset(sim)
 }
@@ -0,0 +1,67 @@
+package discipline
+
+import (
+	"time"
+
+	"github.com/wowsims/mop/sim/core"
+	"github.com/wowsims/mop/sim/priest"
+)
+
+const holyFireScale = 1.08
+const holyFireCoeff = 1.11
+const holyFireVariance = 0.25
+const holyFireDotScale = 0.0312
+const holyFireDotCoeff = 0.0312
+
+func (disc *DisciplinePriest) registerHolyFireSpell() {
+	disc.HolyFire = disc.RegisterSpell(core.SpellConfig{
+		ActionID:       core.ActionID{SpellID: 14914},
+		SpellSchool:    core.SpellSchoolHoly,
+		ProcMask:       core.ProcMaskSpellDamage,
+		Flags:          core.SpellFlagAPL,
+		ClassSpellMask: priest.PriestSpellHolyFire,
+
+		ManaCost: core.ManaCostOptions{
+			BaseCostPercent: 1.8,
+		},
+		Cast: core.CastConfig{
+			DefaultCast: core.Cast{
+				GCD: core.GCDDefault,
+			},
+			CD: core.Cooldown{
+				Timer:    disc.NewTimer(),
+				Duration: time.Second * 10,
+			},
+		},
+
+		DamageMultiplier: 1,
+		CritMultiplier:   disc.DefaultCritMultiplier(),
+		ThreatMultiplier: 1,
+		BonusCoefficient: holyFireCoeff,
+
+		Dot: core.DotConfig{
+			Aura: core.Aura{
+				Label: "Holy Fire",
+			},
+			NumberOfTicks:    7,
+			TickLength:       time.Second,
+			BonusCoefficient: holyFireDotCoeff,
+
+			OnSnapshot: func(sim *core.Simulation, target *core.Unit, dot *core.Dot, _ bool) {
+				dot.Snapshot(target, disc.CalcScalingSpellDmg(holyFireDotScale))
+			},
+			OnTick: func(sim *core.Simulation, target *core.Unit, dot *core.Dot) {
+				dot.CalcAndDealPeriodicSnapshotDamage(sim, target, dot.OutcomeSnapshotCrit)
+			},
+		},
+
+		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			baseDamage := disc.CalcAndRollDamageRange(sim, holyFireScale, holyFireVariance)
+			result := spell.CalcDamage(sim, target, baseDamage, spell.OutcomeMagicHitAndCrit)
+			if result.Landed() {
+				spell.Dot(target).Apply(sim)
+			}
+			spell.DealDamage(sim, result)
+		},
+	})
+}
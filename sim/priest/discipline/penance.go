@@ -0,0 +1,67 @@
+package discipline
+
+import (
+	"time"
+
+	"github.com/wowsims/mop/sim/core"
+	"github.com/wowsims/mop/sim/priest"
+)
+
+const penanceScale = 0.9
+const penanceCoeff = 0.838
+
+// Channels three bolts over two seconds, the first one immediately.
+func (disc *DisciplinePriest) registerPenanceSpell() {
+	disc.Penance = disc.RegisterSpell(core.SpellConfig{
+		ActionID:       core.ActionID{SpellID: 47540},
+		SpellSchool:    core.SpellSchoolHoly,
+		ProcMask:       core.ProcMaskSpellDamage,
+		Flags:          core.SpellFlagChanneled | core.SpellFlagAPL,
+		ClassSpellMask: priest.PriestSpellPenance,
+
+		ManaCost: core.ManaCostOptions{
+			BaseCostPercent: 3.1,
+		},
+		Cast: core.CastConfig{
+			DefaultCast: core.Cast{
+				GCD: core.GCDDefault,
+			},
+			CD: core.Cooldown{
+				Timer:    disc.NewTimer(),
+				Duration: time.Second * 9,
+			},
+		},
+
+		DamageMultiplier: 1,
+		CritMultiplier:   disc.DefaultCritMultiplier(),
+		ThreatMultiplier: 1,
+
+		Dot: core.DotConfig{
+			Aura: core.Aura{
+				Label: "Penance-" + disc.Label,
+			},
+			NumberOfTicks:        2,
+			TickLength:           time.Second,
+			AffectedByCastSpeed:  true,
+			HasteReducesDuration: true,
+			BonusCoefficient:     penanceCoeff,
+
+			OnSnapshot: func(sim *core.Simulation, target *core.Unit, dot *core.Dot, _ bool) {
+				dot.Snapshot(target, disc.CalcScalingSpellDmg(penanceScale))
+			},
+			OnTick: func(sim *core.Simulation, target *core.Unit, dot *core.Dot) {
+				dot.CalcAndDealPeriodicSnapshotDamage(sim, target, dot.OutcomeSnapshotCrit)
+			},
+		},
+
+		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			result := spell.CalcOutcome(sim, target, spell.OutcomeMagicHitNoHitCounter)
+			if result.Landed() {
+				dot := spell.Dot(target)
+				dot.Apply(sim)
+				dot.TickOnce(sim)
+			}
+			spell.DealOutcome(sim, result)
+		},
+	})
+}
@@ -0,0 +1,90 @@
+package discipline
+
+import (
+	"math"
+	"time"
+
+	"github.com/wowsims/mop/sim/core"
+	"github.com/wowsims/mop/sim/core/stats"
+	"github.com/wowsims/mop/sim/priest"
+)
+
+const pwsScale = 20.3
+const pwsCoeff = 1.871
+
+func (disc *DisciplinePriest) registerPowerWordShieldSpell() {
+	// Rapture: a fully absorbed shield returns mana, and Power Word: Shield has
+	// no cooldown for Discipline.
+	raptureMetrics := disc.NewManaMetrics(core.ActionID{SpellID: 47755})
+	raptureICD := core.Cooldown{
+		Timer:    disc.NewTimer(),
+		Duration: time.Second * 12,
+	}
+	onDepleted := func(sim *core.Simulation, _ *core.Shield) {
+		if raptureICD.IsReady(sim) {
+			raptureICD.Use(sim)
+			disc.AddMana(sim, disc.GetStat(stats.Spirit)*1.5, raptureMetrics)
+		}
+	}
+
+	// Borrowed Time: the next spell after Power Word: Shield is cast faster.
+	borrowedTime := disc.RegisterAura(core.Aura{
+		Label:    "Borrowed Time",
+		ActionID: core.ActionID{SpellID: 59889},
+		Duration: time.Second * 6,
+		OnCastComplete: func(aura *core.Aura, sim *core.Simulation, spell *core.Spell) {
+			if (spell.DefaultCast.GCD > 0) && !spell.Matches(priest.PriestSpellPowerWordShield) {
+				aura.Deactivate(sim)
+			}
+		},
+	}).AttachMultiplyCastSpeed(1.15)
+
+	disc.WeakenedSouls = disc.NewAllyAuraArray(func(target *core.Unit) *core.Aura {
+		return target.GetOrRegisterAura(core.Aura{
+			Label:    "Weakened Soul",
+			ActionID: core.ActionID{SpellID: 6788},
+			Duration: time.Second * 15,
+		})
+	})
+
+	disc.PowerWordShield = disc.RegisterSpell(core.SpellConfig{
+		ActionID:       core.ActionID{SpellID: 17},
+		SpellSchool:    core.SpellSchoolHoly,
+		ProcMask:       core.ProcMaskSpellHealing,
+		Flags:          core.SpellFlagHelpful | core.SpellFlagAPL,
+		ClassSpellMask: priest.PriestSpellPowerWordShield,
+
+		ManaCost: core.ManaCostOptions{
+			BaseCostPercent: 2.45,
+		},
+		Cast: core.CastConfig{
+			DefaultCast: core.Cast{
+				GCD: core.GCDDefault,
+			},
+		},
+		ExtraCastCondition: func(sim *core.Simulation, target *core.Unit) bool {
+			return !disc.WeakenedSouls.Get(target).IsActive()
+		},
+
+		DamageMultiplier: 1,
+		ThreatMultiplier: 1,
+
+		Shield: core.ShieldConfig{
+			AbsorbDamage: true,
+			OnDepleted:   onDepleted,
+			Aura: core.Aura{
+				Label:     "Power Word: Shield",
+				Duration:  time.Second * 15,
+				MaxStacks: math.MaxInt32,
+			},
+		},
+
+		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			shieldAmount := disc.CalcScalingSpellDmg(pwsScale) + pwsCoeff*spell.HealingPower(target)
+			spell.Shield(target).Apply(sim, shieldAmount)
+
+			disc.WeakenedSouls.Get(target).Activate(sim)
+			borrowedTime.Activate(sim)
+		},
+	})
+}
@@ -0,0 +1,42 @@
+package discipline
+
+import (
+	"time"
+
+	"github.com/wowsims/mop/sim/core"
+	"github.com/wowsims/mop/sim/priest"
+)
+
+const smiteScale = 2.228
+const smiteCoeff = 0.856
+const smiteVariance = 0.115
+
+func (disc *DisciplinePriest) registerSmiteSpell() {
+	disc.Smite = disc.RegisterSpell(core.SpellConfig{
+		ActionID:       core.ActionID{SpellID: 585},
+		SpellSchool:    core.SpellSchoolHoly,
+		ProcMask:       core.ProcMaskSpellDamage,
+		Flags:          core.SpellFlagAPL,
+		ClassSpellMask: priest.PriestSpellSmite,
+
+		ManaCost: core.ManaCostOptions{
+			BaseCostPercent: 1.5,
+		},
+		Cast: core.CastConfig{
+			DefaultCast: core.Cast{
+				GCD:      core.GCDDefault,
+				CastTime: time.Millisecond * 1500,
+			},
+		},
+
+		DamageMultiplier: 1,
+		CritMultiplier:   disc.DefaultCritMultiplier(),
+		ThreatMultiplier: 1,
+		BonusCoefficient: smiteCoeff,
+
+		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			baseDamage := disc.CalcAndRollDamageRange(sim, smiteScale, smiteVariance)
+			spell.CalcAndDealDamage(sim, target, baseDamage, spell.OutcomeMagicHitAndCrit)
+		},
+	})
+}
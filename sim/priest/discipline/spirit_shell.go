@@ -0,0 +1,61 @@
+package discipline
+
+import (
+	"math"
+	"time"
+
+	"github.com/wowsims/mop/sim/core"
+)
+
+// Spirit Shell absorbs stack up to 60% of the priest's maximum health.
+const spiritShellMaxHealthPercent = 0.6
+
+func (disc *DisciplinePriest) registerSpiritShellSpell() {
+	disc.spiritShellAbsorb = disc.RegisterSpell(core.SpellConfig{
+		ActionID:    core.ActionID{SpellID: 114908},
+		SpellSchool: core.SpellSchoolHoly,
+		ProcMask:    core.ProcMaskSpellHealing,
+		Flags:       core.SpellFlagHelpful | core.SpellFlagPassiveSpell | core.SpellFlagNoOnCastComplete,
+
+		DamageMultiplier: 1,
+		ThreatMultiplier: 1,
+
+		Shield: core.ShieldConfig{
+			AbsorbDamage: true,
+			Aura: core.Aura{
+				Label:     "Spirit Shell",
+				Duration:  time.Second * 15,
+				MaxStacks: math.MaxInt32,
+			},
+		},
+	})
+
+	disc.SpiritShell = disc.RegisterAura(core.Aura{
+		Label:    "Spirit Shell",
+		ActionID: core.ActionID{SpellID: 109964},
+		Duration: time.Second * 15,
+	})
+
+	spell := disc.RegisterSpell(core.SpellConfig{
+		ActionID: core.ActionID{SpellID: 109964},
+		Flags:    core.SpellFlagHelpful | core.SpellFlagAPL,
+
+		Cast: core.CastConfig{
+			DefaultCast: core.Cast{
+				NonEmpty: true,
+			},
+			CD: core.Cooldown{
+				Timer:    disc.NewTimer(),
+				Duration: time.Minute,
+			},
+		},
+
+		ApplyEffects: func(sim *core.Simulation, _ *core.Unit, _ *core.Spell) {
+			disc.SpiritShell.Activate(sim)
+		},
+	})
+
+	disc.AddMajorCooldown(core.MajorCooldown{
+		Spell: spell,
+	})
+}
@@ -21,6 +21,20 @@ func RegisterHolyPriest() {
 			player.Spec = playerSpec
 		},
 	)
+	core.RegisterItemFilter(proto.Spec_SpecHolyPriest, ItemFilter)
+	core.RegisterModelingStatus(proto.Spec_SpecHolyPriest, proto.ModelingStatus_ModelingStatusMissing,
+		core.MechanicModelingStatus{Mechanic: "Healing spells", Status: proto.ModelingStatus_ModelingStatusMissing, Notes: "Only Hymn of Hope is implemented."},
+	)
+}
+
+var ItemFilter = core.ItemFilter{
+	WeaponTypes: []proto.WeaponType{
+		proto.WeaponType_WeaponTypeDagger,
+		proto.WeaponType_WeaponTypeMace,
+		proto.WeaponType_WeaponTypeOffHand,
+		proto.WeaponType_WeaponTypeStaff,
+	},
+	ArmorType: proto.ArmorType_ArmorTypeCloth,
 }
 
 func NewHolyPriest(character *core.Character, options *proto.Player) *HolyPriest {
@@ -53,7 +67,7 @@ func (holyPriest *HolyPriest) Initialize() {
 	// holyPriest.RegisterHolyFireSpell()
 	// holyPriest.RegisterSmiteSpell()
 	// holyPriest.RegisterPenanceSpell()
-	// holyPriest.RegisterHymnOfHopeCD()
+	holyPriest.RegisterHymnOfHopeCD()
 }
 
 func (holyPriest *HolyPriest) ApplyTalents() {
@@ -0,0 +1,89 @@
+package priest
+
+import (
+	"slices"
+
+	"github.com/wowsims/mop/sim/core"
+)
+
+const HymnOfHopeNumTargets = 3
+
+// Hymn of Hope is channeled on the raid members lowest on mana, including the
+// priest, restoring mana with each tick and raising their maximum mana.
+func (priest *Priest) RegisterHymnOfHopeCD() {
+	actionID := core.ActionID{SpellID: 64901, Tag: priest.Index}
+
+	var hymnTargets []*core.Character
+	hymnAuras := make(map[*core.Character]*core.Aura)
+	manaMetrics := make(map[*core.Character]*core.ResourceMetrics)
+	for _, unit := range priest.Env.Raid.AllPlayerUnits {
+		if !unit.HasManaBar() {
+			continue
+		}
+		character := priest.Env.Raid.GetPlayerFromUnit(unit).GetCharacter()
+		hymnTargets = append(hymnTargets, character)
+		hymnAuras[character] = core.HymnOfHopeAura(character, actionID.Tag)
+		manaMetrics[character] = character.NewManaMetrics(actionID)
+	}
+
+	var channelTargets []*core.Character
+
+	hymnOfHopeSpell := priest.RegisterSpell(core.SpellConfig{
+		ActionID:       actionID,
+		Flags:          core.SpellFlagHelpful | core.SpellFlagChanneled,
+		ClassSpellMask: PriestSpellHymnOfHope,
+
+		Cast: core.CastConfig{
+			DefaultCast: core.Cast{
+				GCD: core.GCDDefault,
+			},
+			CD: core.Cooldown{
+				Timer:    priest.NewTimer(),
+				Duration: core.HymnOfHopeCD,
+			},
+		},
+
+		Hot: core.DotConfig{
+			Aura: core.Aura{
+				Label: "Hymn of Hope",
+			},
+			NumberOfTicks: core.HymnOfHopeNumTicks,
+			TickLength:    core.HymnOfHopeDuration / core.HymnOfHopeNumTicks,
+
+			OnTick: func(sim *core.Simulation, _ *core.Unit, _ *core.Dot) {
+				for _, character := range channelTargets {
+					character.AddMana(sim, character.MaxMana()*core.HymnOfHopeManaPercentPerTick, manaMetrics[character])
+				}
+			},
+		},
+
+		ApplyEffects: func(sim *core.Simulation, _ *core.Unit, spell *core.Spell) {
+			channelTargets = lowestManaCharacters(hymnTargets, HymnOfHopeNumTargets)
+			for _, character := range channelTargets {
+				hymnAuras[character].Activate(sim)
+			}
+			spell.Hot(&priest.Unit).Apply(sim)
+		},
+	})
+
+	priest.AddMajorCooldown(core.MajorCooldown{
+		Spell: hymnOfHopeSpell,
+		Type:  core.CooldownTypeMana,
+		ShouldActivate: func(sim *core.Simulation, character *core.Character) bool {
+			return character.CurrentManaPercent() < 0.5
+		},
+	})
+}
+
+func lowestManaCharacters(characters []*core.Character, n int) []*core.Character {
+	sorted := slices.Clone(characters)
+	slices.SortStableFunc(sorted, func(a, b *core.Character) int {
+		if a.CurrentManaPercent() < b.CurrentManaPercent() {
+			return -1
+		} else if a.CurrentManaPercent() > b.CurrentManaPercent() {
+			return 1
+		}
+		return 0
+	})
+	return sorted[:min(n, len(sorted))]
+}
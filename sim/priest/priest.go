@@ -96,6 +96,8 @@ func (priest *Priest) Initialize() {
 	// priest.registerDispersionSpell()
 
 	priest.registerPowerInfusionSpell()
+	priest.registerVampiricEmbraceSpell()
+	priest.registerVoidShiftSpell()
 	priest.registerMindSearSpell()
 
 	priest.ApplyGlyphs()
@@ -217,6 +219,7 @@ const (
 	PriestSpellSmite
 	PriestSpellVampiricEmbrace
 	PriestSpellVampiricTouch
+	PriestSpellVoidShift
 
 	PriestSpellLast
 	PriestSpellsAll    = PriestSpellLast<<1 - 1
@@ -239,7 +242,8 @@ const (
 		PriestSpellRenew |
 		PriestSpellShadowWordDeath |
 		PriestSpellShadowWordPain |
-		PriestSpellVampiricEmbrace
+		PriestSpellVampiricEmbrace |
+		PriestSpellVoidShift
 	PriestShadowSpells = PriestSpellImprovedDevouringPlague |
 		PriestSpellDevouringPlague |
 		PriestSpellShadowWordDeath |
@@ -0,0 +1,61 @@
+package shadow
+
+import (
+	"time"
+
+	"github.com/wowsims/mop/sim/core"
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func (shadow *ShadowPriest) NewAPLValue(rot *core.APLRotation, config *proto.APLValue) core.APLValue {
+	switch config.Value.(type) {
+	case *proto.APLValue_ShadowPriestTimeToNextOrb:
+		return shadow.newValueTimeToNextOrb(config.GetShadowPriestTimeToNextOrb(), config.Uuid)
+	default:
+		return nil
+	}
+}
+
+type APLValueShadowPriestTimeToNextOrb struct {
+	core.DefaultAPLValueImpl
+	shadow *ShadowPriest
+}
+
+func (shadow *ShadowPriest) newValueTimeToNextOrb(_ *proto.APLValueShadowPriestTimeToNextOrb, _ *proto.UUID) core.APLValue {
+	return &APLValueShadowPriestTimeToNextOrb{
+		shadow: shadow,
+	}
+}
+
+func (value *APLValueShadowPriestTimeToNextOrb) Type() proto.APLValueType {
+	return proto.APLValueType_ValueTypeDuration
+}
+
+// Assumes Mind Blast is cast as soon as both it and the GCD are ready, and
+// Shadow Word: Death is used on cooldown during execute.
+func (value *APLValueShadowPriestTimeToNextOrb) GetDuration(sim *core.Simulation) time.Duration {
+	shadow := value.shadow
+	gcdReady := max(0, shadow.NextGCDAt()-sim.CurrentTime)
+	timeToNextOrb := core.NeverExpires
+
+	if mb := shadow.MindBlast; mb != nil {
+		// Divine Insight resets the cooldown and makes the cast instant.
+		castTime := core.TernaryDuration(shadow.DivineInsight.IsActive(), 0, mb.CastTime())
+		timeToNextOrb = max(mb.TimeToReady(sim), gcdReady) + castTime
+	}
+
+	if swd := shadow.ShadowWordDeath; (swd != nil) && sim.IsExecutePhase20() {
+		swdReady := max(swd.TimeToReady(sim), gcdReady)
+		if lockout := shadow.shadowWordDeathOrbLockout; lockout.IsActive() {
+			// The next cast won't generate an orb unless the lockout has expired by then.
+			swdReady = max(swdReady, lockout.RemainingDuration(sim))
+		}
+		timeToNextOrb = min(timeToNextOrb, swdReady)
+	}
+
+	return timeToNextOrb
+}
+
+func (value *APLValueShadowPriestTimeToNextOrb) String() string {
+	return "Time to Next Shadow Orb"
+}
@@ -65,7 +65,7 @@ func (shadow *ShadowPriest) registerCascade() {
 		BonusCoefficient: cascadeCoeff,
 		ThreatMultiplier: 1,
 		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
-			damageMod := 0.4 // assume minimal distance for now
+			damageMod := calcCascadeMod(0) // bounces stay within the pack, so assume minimal distance
 			cascadeHandler(damageMod, spell, target, sim)
 		},
 	})
@@ -93,11 +93,16 @@ func (shadow *ShadowPriest) registerCascade() {
 		CritMultiplier:   shadow.DefaultCritMultiplier(),
 		BonusCoefficient: cascadeCoeff,
 		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
-			damageMod := math.Min(0.4+0.6*(1-(30-shadow.DistanceFromTarget)/30), 1)
 			targets = []*core.Unit{target}
 			spell.WaitTravelTime(sim, func(s *core.Simulation) {
+				damageMod := calcCascadeMod(shadow.distanceAtImpact(sim))
 				cascadeHandler(damageMod, bounceSpell, target, sim)
 			})
 		},
 	})
 }
+
+// Scales from 40% damage at point blank to full damage at 30 yards.
+func calcCascadeMod(distance float64) float64 {
+	return math.Min(0.4+0.6*distance/30, 1)
+}
@@ -10,6 +10,10 @@ const divineStarScale = 4.495
 const divineStarCoeff = 0.455
 const divineStarVariance = 0.5
 
+// Yards travelled before the star turns around, and its speed in yards per second.
+const divineStarRange = 24
+const divineStarSpeed = 24
+
 func (shadow *ShadowPriest) registerDivineStar() {
 	if !shadow.Talents.DivineStar {
 		return
@@ -38,28 +42,24 @@ func (shadow *ShadowPriest) registerDivineStar() {
 			},
 		},
 		ApplyEffects: func(sim *core.Simulation, _ *core.Unit, spell *core.Spell) {
-			hit1 := shadow.DistanceFromTarget / 24
+			// The star passes through the pack on its way out and again on its
+			// way back, but only reaches targets within its travel distance.
+			hit1 := shadow.DistanceFromTarget / divineStarSpeed
 			hit2 := 2.5 - hit1
 
-			// first hit
-			pa1 := sim.GetConsumedPendingActionFromPool()
-			pa1.NextActionAt = sim.CurrentTime + time.Second*time.Duration(hit1)
-
-			pa1.OnAction = func(sim *core.Simulation) {
-				spell.CalcAndDealAoeDamageWithVariance(sim, spell.OutcomeMagicHitAndCrit, shadow.rollDivineStarDamage)
-			}
+			for _, hitAt := range []float64{hit1, hit2} {
+				pa := sim.GetConsumedPendingActionFromPool()
+				pa.NextActionAt = sim.CurrentTime + core.DurationFromSeconds(hitAt)
 
-			sim.AddPendingAction(pa1)
+				pa.OnAction = func(sim *core.Simulation) {
+					if shadow.distanceAtImpact(sim) > divineStarRange {
+						return
+					}
+					spell.CalcAndDealAoeDamageWithVariance(sim, spell.OutcomeMagicHitAndCrit, shadow.rollDivineStarDamage)
+				}
 
-			// second hit
-			pa2 := sim.GetConsumedPendingActionFromPool()
-			pa2.NextActionAt = sim.CurrentTime + time.Second*time.Duration(hit2)
-
-			pa2.OnAction = func(sim *core.Simulation) {
-				spell.CalcAndDealAoeDamageWithVariance(sim, spell.OutcomeMagicHitAndCrit, shadow.rollDivineStarDamage)
+				sim.AddPendingAction(pa)
 			}
-
-			sim.AddPendingAction(pa2)
 		},
 	})
 }
@@ -43,7 +43,7 @@ func (shadow *ShadowPriest) registerHalo() {
 		ApplyEffects: func(sim *core.Simulation, _ *core.Unit, spell *core.Spell) {
 			spell.WaitTravelTime(sim, func(s *core.Simulation) {
 				baseDamage := shadow.CalcAndRollDamageRange(sim, haloScale, haloVariance)
-				distMod := calcHaloMod(shadow.DistanceFromTarget)
+				distMod := calcHaloMod(shadow.distanceAtImpact(sim))
 				spell.DamageMultiplier *= distMod
 				spell.CalcAndDealAoeDamage(sim, baseDamage, spell.OutcomeMagicHitAndCrit)
 				spell.DamageMultiplier /= distMod
@@ -52,6 +52,13 @@ func (shadow *ShadowPriest) registerHalo() {
 	})
 }
 
+// Targets are modeled as a single pack, so every target is at the priest's
+// current distance when the ring reaches them.
+func (shadow *ShadowPriest) distanceAtImpact(sim *core.Simulation) float64 {
+	shadow.UpdatePosition(sim)
+	return shadow.DistanceFromTarget
+}
+
 // https://web.archive.org/web/20120626065654/http://us.battle.net/wow/en/forum/topic/5889309137?page=5#97
 func calcHaloMod(distance float64) float64 {
 	return 0.5*math.Pow(1.01, -1*math.Pow(((distance-25)/2), 4)) + 0.1 + 0.015*distance
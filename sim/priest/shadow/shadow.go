@@ -22,6 +22,17 @@ func RegisterShadowPriest() {
 			player.Spec = playerSpec
 		},
 	)
+	core.RegisterItemFilter(proto.Spec_SpecShadowPriest, ItemFilter)
+}
+
+var ItemFilter = core.ItemFilter{
+	WeaponTypes: []proto.WeaponType{
+		proto.WeaponType_WeaponTypeDagger,
+		proto.WeaponType_WeaponTypeMace,
+		proto.WeaponType_WeaponTypeOffHand,
+		proto.WeaponType_WeaponTypeStaff,
+	},
+	ArmorType: proto.ArmorType_ArmorTypeCloth,
 }
 
 const MaxShadowOrbs = 3
@@ -60,7 +71,12 @@ type ShadowPriest struct {
 	DevouringPlague *core.Spell
 	MindSpike       *core.Spell
 	MindBlast       *core.Spell
+	ShadowWordDeath *core.Spell
 	SurgeOfDarkness *core.Aura // Required for dummy effect
+	DivineInsight   *core.Aura
+
+	// Active after an orb generating Shadow Word: Death, while the next cast won't generate an orb
+	shadowWordDeathOrbLockout *core.Aura
 }
 
 func (spriest *ShadowPriest) GetPriest() *priest.Priest {
@@ -32,14 +32,12 @@ func TestShadow(t *testing.T) {
 
 			Rotation: core.GetAplRotation("../../../ui/priest/shadow/apls", "t15"),
 
-			ItemFilter: core.ItemFilter{
-				WeaponTypes: []proto.WeaponType{
-					proto.WeaponType_WeaponTypeDagger,
-					proto.WeaponType_WeaponTypeMace,
-					proto.WeaponType_WeaponTypeOffHand,
-					proto.WeaponType_WeaponTypeStaff,
-				},
-				ArmorType: proto.ArmorType_ArmorTypeCloth,
+			ItemFilter: ItemFilter,
+
+			APLCoverageExemptions: []core.ActionID{
+				{SpellID: 48045},  // Mind Sear
+				{SpellID: 15286},  // Vampiric Embrace
+				{SpellID: 108968}, // Void Shift
 			},
 		},
 	}))
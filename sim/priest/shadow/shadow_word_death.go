@@ -12,13 +12,13 @@ const swdCoeff = 2.157
 
 func (shadow *ShadowPriest) registerShadowWordDeathSpell() {
 	actionId := core.ActionID{SpellID: 32379}
-	swdAura := shadow.RegisterAura(core.Aura{
+	shadow.shadowWordDeathOrbLockout = shadow.RegisterAura(core.Aura{
 		Label:    "Shadow Word: Death",
 		ActionID: actionId.WithTag(1),
 		Duration: 9 * time.Second,
 	})
 
-	shadow.RegisterSpell(core.SpellConfig{
+	shadow.ShadowWordDeath = shadow.RegisterSpell(core.SpellConfig{
 		ActionID:       actionId,
 		SpellSchool:    core.SpellSchoolShadow,
 		ProcMask:       core.ProcMaskSpellDamage,
@@ -45,14 +45,18 @@ func (shadow *ShadowPriest) registerShadowWordDeathSpell() {
 			},
 		},
 		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
-			spell.CalcAndDealDamage(sim, target, shadow.CalcScalingSpellDmg(swdScale), spell.OutcomeMagicHitAndCrit)
-			if swdAura.IsActive() {
-				swdAura.Deactivate(sim)
+			result := spell.CalcAndDealDamage(sim, target, shadow.CalcScalingSpellDmg(swdScale), spell.OutcomeMagicHitAndCrit)
+			if shadow.shadowWordDeathOrbLockout.IsActive() {
+				shadow.shadowWordDeathOrbLockout.Deactivate(sim)
 				return
 			}
 
-			shadow.ShadowOrbs.Gain(sim, 1, actionId)
-			swdAura.Activate(sim)
+			// Like Mind Blast, a miss doesn't generate an orb, but the cooldown is still reset.
+			// The lockout only starts once an orb was actually gained.
+			if result.Landed() {
+				shadow.ShadowOrbs.Gain(sim, 1, actionId)
+				shadow.shadowWordDeathOrbLockout.Activate(sim)
+			}
 			spell.CD.Reset()
 		},
 		ExtraCastCondition: func(sim *core.Simulation, target *core.Unit) bool {
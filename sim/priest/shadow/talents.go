@@ -187,7 +187,7 @@ func (shadow *ShadowPriest) registerDivineInsight() {
 		FloatValue: -2,
 	})
 
-	procAura := core.BlockPrepull(shadow.RegisterAura(core.Aura{
+	shadow.DivineInsight = core.BlockPrepull(shadow.RegisterAura(core.Aura{
 		Label:    "Divine Insight",
 		Duration: time.Second * 12,
 		ActionID: core.ActionID{SpellID: 124430},
@@ -219,7 +219,7 @@ func (shadow *ShadowPriest) registerDivineInsight() {
 			}
 
 			if sim.Proc(0.05, "Divine Insight (Proc)") {
-				procAura.Activate(sim)
+				shadow.DivineInsight.Activate(sim)
 			}
 		},
 	}))
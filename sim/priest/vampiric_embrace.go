@@ -0,0 +1,82 @@
+package priest
+
+import (
+	"time"
+
+	"github.com/wowsims/mop/sim/core"
+)
+
+const VampiricEmbraceDuration = time.Second * 15
+const VampiricEmbraceCD = time.Minute * 3
+
+// Single-target Shadow spells which feed Vampiric Embrace.
+const vampiricEmbraceSpells = PriestShadowSpells &^ PriestSpellMindSear
+
+const vampiricEmbraceMaxTargets = 15
+
+// Heals the raid for a share of the priest's single-target Shadow damage, so
+// the utility healing shows up in the healing metrics of DPS sims too.
+func (priest *Priest) registerVampiricEmbraceSpell() {
+	var healTargets []*core.Unit
+	healAmount := 0.0
+
+	healSpell := priest.RegisterSpell(core.SpellConfig{
+		ActionID:    core.ActionID{SpellID: 15290},
+		SpellSchool: core.SpellSchoolShadow,
+		ProcMask:    core.ProcMaskEmpty,
+		Flags:       core.SpellFlagHelpful | core.SpellFlagNoOnCastComplete | core.SpellFlagPassiveSpell,
+
+		DamageMultiplier: 1,
+		ThreatMultiplier: 1,
+
+		ApplyEffects: func(sim *core.Simulation, _ *core.Unit, spell *core.Spell) {
+			// The healing is divided evenly among everyone it reaches.
+			amount := healAmount / float64(len(healTargets))
+			for _, target := range healTargets {
+				spell.CalcAndDealHealing(sim, target, amount, spell.OutcomeHealing)
+			}
+		},
+	})
+
+	vampiricEmbraceAura := priest.RegisterAura(core.Aura{
+		Label:    "Vampiric Embrace",
+		ActionID: core.ActionID{SpellID: 15286},
+		Duration: VampiricEmbraceDuration,
+		OnInit: func(aura *core.Aura, sim *core.Simulation) {
+			healTargets = priest.Env.Raid.AllPlayerUnits[:min(len(priest.Env.Raid.AllPlayerUnits), vampiricEmbraceMaxTargets)]
+		},
+		OnSpellHitDealt: func(aura *core.Aura, sim *core.Simulation, spell *core.Spell, result *core.SpellResult) {
+			if result.Landed() && (result.Damage > 0) && spell.Matches(vampiricEmbraceSpells) {
+				healAmount = result.Damage * 0.75
+				healSpell.Cast(sim, &priest.Unit)
+			}
+		},
+		OnPeriodicDamageDealt: func(aura *core.Aura, sim *core.Simulation, spell *core.Spell, result *core.SpellResult) {
+			if (result.Damage > 0) && spell.Matches(vampiricEmbraceSpells) {
+				healAmount = result.Damage * 0.75
+				healSpell.Cast(sim, &priest.Unit)
+			}
+		},
+	})
+
+	// Only cast through the APL, since its healing is only worth a GCD in some
+	// raid compositions.
+	priest.RegisterSpell(core.SpellConfig{
+		ActionID:       core.ActionID{SpellID: 15286},
+		Flags:          core.SpellFlagHelpful | core.SpellFlagAPL,
+		ClassSpellMask: PriestSpellVampiricEmbrace,
+		Cast: core.CastConfig{
+			CD: core.Cooldown{
+				Timer:    priest.NewTimer(),
+				Duration: VampiricEmbraceCD,
+			},
+			DefaultCast: core.Cast{
+				NonEmpty: true,
+			},
+		},
+
+		ApplyEffects: func(sim *core.Simulation, _ *core.Unit, _ *core.Spell) {
+			vampiricEmbraceAura.Activate(sim)
+		},
+	})
+}
@@ -0,0 +1,63 @@
+package priest
+
+import (
+	"time"
+
+	"github.com/wowsims/mop/sim/core"
+)
+
+const VoidShiftCD = time.Minute * 5
+
+// Neither side of a Void Shift drops below this share of their health.
+const voidShiftMinHealthPercent = 0.25
+
+// Swaps health percentages with a friendly target. Health the target gains is
+// reported as healing, so it counts towards the priest's raid contribution.
+func (priest *Priest) registerVoidShiftSpell() {
+	actionID := core.ActionID{SpellID: 108968}
+	healthMetrics := priest.NewHealthMetrics(actionID)
+
+	priest.RegisterSpell(core.SpellConfig{
+		ActionID:       actionID,
+		SpellSchool:    core.SpellSchoolShadow,
+		ProcMask:       core.ProcMaskEmpty,
+		Flags:          core.SpellFlagHelpful | core.SpellFlagAPL,
+		ClassSpellMask: PriestSpellVoidShift,
+
+		Cast: core.CastConfig{
+			DefaultCast: core.Cast{
+				GCD: core.GCDDefault,
+			},
+			CD: core.Cooldown{
+				Timer:    priest.NewTimer(),
+				Duration: VoidShiftCD,
+			},
+		},
+
+		DamageMultiplier: 1,
+		ThreatMultiplier: 1,
+
+		ExtraCastCondition: func(_ *core.Simulation, target *core.Unit) bool {
+			return (target != &priest.Unit) && target.HasHealthBar()
+		},
+
+		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			priestPercent := max(target.CurrentHealthPercent(), voidShiftMinHealthPercent)
+			targetPercent := max(priest.CurrentHealthPercent(), voidShiftMinHealthPercent)
+
+			if delta := (priestPercent - priest.CurrentHealthPercent()) * priest.MaxHealth(); delta > 0 {
+				priest.GainHealth(sim, delta, healthMetrics)
+			} else if delta < 0 {
+				priest.RemoveHealth(sim, -delta)
+			}
+
+			if delta := (targetPercent - target.CurrentHealthPercent()) * target.MaxHealth(); delta > 0 {
+				result := spell.CalcHealing(sim, target, delta, spell.OutcomeHealing)
+				result.Damage = delta
+				spell.DealHealing(sim, result)
+			} else if delta < 0 {
+				target.RemoveHealth(sim, -delta)
+			}
+		},
+	})
+}
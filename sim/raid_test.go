@@ -81,6 +81,111 @@ func TestBasicRaid(t *testing.T) {
 	core.RaidSimTest("P1 ST", t, rsr, 6323.79)
 }
 
+func TestComputeCharacterSheet(t *testing.T) {
+	player := core.WithSpec(&proto.Player{
+		Name:      "Mage",
+		Race:      proto.Race_RaceTroll,
+		Class:     proto.Class_ClassMage,
+		Equipment: &proto.EquipmentSpec{},
+	}, &proto.Player_ArcaneMage{
+		ArcaneMage: &proto.ArcaneMage{
+			Options: &proto.ArcaneMage_Options{ClassOptions: &proto.MageOptions{}},
+		},
+	})
+
+	result := core.ComputeCharacterSheet(&proto.ComputeCharacterSheetRequest{
+		Raid: &proto.Raid{
+			Parties:       []*proto.Party{{Players: []*proto.Player{{}, player}}},
+			TargetDummies: 1,
+		},
+		Encounter: STEncounter,
+	})
+
+	if len(result.Players) != 1 {
+		t.Fatalf("Expected a single character sheet, found %d", len(result.Players))
+	}
+
+	sheet := result.Players[0]
+	if sheet.Name != "Mage" || sheet.PlayerIndex != 1 {
+		t.Fatalf("Unexpected player %s at index %d", sheet.Name, sheet.PlayerIndex)
+	}
+
+	finalStats := stats.FromProtoArray(sheet.FinalStats.Stats)
+	if sheet.Resources[0].Type != proto.ResourceType_ResourceTypeHealth || sheet.Resources[0].Value != finalStats[stats.Health] {
+		t.Fatalf("Expected health maximum of %f, found %v", finalStats[stats.Health], sheet.Resources[0])
+	}
+	if len(sheet.Resources) != 2 || sheet.Resources[1].Type != proto.ResourceType_ResourceTypeMana || sheet.Resources[1].Value <= 0 {
+		t.Fatalf("Expected a mana maximum, found %v", sheet.Resources)
+	}
+	if sheet.SpellHastePercent != sheet.FinalStats.PseudoStats[proto.PseudoStat_PseudoStatSpellHastePercent] {
+		t.Fatalf("Spell haste does not match final pseudo stats")
+	}
+}
+
+// Keeps the MoP combat table, but without glancing blows.
+type noGlanceOutcomeModel struct {
+	core.MopOutcomeModel
+}
+
+func (model noGlanceOutcomeModel) InitAttackTable(table *core.AttackTable) {
+	model.MopOutcomeModel.InitAttackTable(table)
+	table.BaseGlanceChance = 0
+}
+
+func TestComputeOutcomeTables(t *testing.T) {
+	core.RegisterOutcomeModel("no_glance", noGlanceOutcomeModel{})
+
+	player := core.WithSpec(&proto.Player{
+		Name:      "Warrior",
+		Race:      proto.Race_RaceHuman,
+		Class:     proto.Class_ClassWarrior,
+		Equipment: &proto.EquipmentSpec{},
+	}, &proto.Player_FuryWarrior{
+		FuryWarrior: &proto.FuryWarrior{
+			Options: &proto.FuryWarrior_Options{ClassOptions: &proto.WarriorOptions{}},
+		},
+	})
+
+	computeTables := func(outcomeModel string) *proto.ComputeOutcomeTablesResult {
+		return core.ComputeOutcomeTables(&proto.ComputeOutcomeTablesRequest{
+			Raid: &proto.Raid{
+				Parties: []*proto.Party{{Players: []*proto.Player{player}}},
+			},
+			Encounter: &proto.Encounter{
+				Duration:     300,
+				Targets:      []*proto.Target{StandardTarget},
+				OutcomeModel: outcomeModel,
+			},
+		})
+	}
+
+	result := computeTables("")
+	if result.OutcomeModel != core.DefaultOutcomeModelName || len(result.Tables) != 1 {
+		t.Fatalf("Expected a single table from the default model, found %d from %s", len(result.Tables), result.OutcomeModel)
+	}
+
+	table := result.Tables[0]
+	if table.BaseGlanceChance != 0.24 || table.MeleeCritSuppression != 0.03 {
+		t.Fatalf("Unexpected raid boss glance chance %f and crit suppression %f", table.BaseGlanceChance, table.MeleeCritSuppression)
+	}
+	if table.MainHand == nil || table.OffHand != nil {
+		t.Fatalf("Expected only a main hand table, found %v and %v", table.MainHand, table.OffHand)
+	}
+
+	mh := table.MainHand
+	if total := mh.Miss + mh.Dodge + mh.Parry + mh.Glance + mh.Crit + mh.Hit; total < 0.9999 || total > 1.0001 {
+		t.Fatalf("Expected the white hit table to cover the whole roll, found %f", total)
+	}
+	if mh.Glance != 0.24 || mh.Parry != 0 || mh.Block != 0 {
+		t.Fatalf("Unexpected white hit table from behind the target: %v", mh)
+	}
+
+	mh = computeTables("no_glance").Tables[0].MainHand
+	if mh.Glance != 0 {
+		t.Fatalf("Expected no glancing blows from the alternate model, found %f", mh.Glance)
+	}
+}
+
 // To quickly debug raid sim issues, uncomment this test and copy in a request string.
 /*
 func testRaidString(t *testing.T, raidString string) {
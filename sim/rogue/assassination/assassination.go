@@ -28,6 +28,15 @@ func RegisterAssassinationRogue() {
 			player.Spec = playerSpec
 		},
 	)
+	core.RegisterItemFilter(proto.Spec_SpecAssassinationRogue, ItemFilter)
+}
+
+var ItemFilter = core.ItemFilter{
+	ArmorType: proto.ArmorType_ArmorTypeLeather,
+
+	WeaponTypes: []proto.WeaponType{
+		proto.WeaponType_WeaponTypeDagger,
+	},
 }
 
 func (sinRogue *AssassinationRogue) Initialize() {
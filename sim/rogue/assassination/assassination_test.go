@@ -33,18 +33,24 @@ func TestAssassination(t *testing.T) {
 			Rotation:       core.GetAplRotation("../../../ui/rogue/assassination/apls", "assassination"),
 			OtherRotations: []core.RotationCombo{},
 
-			ItemFilter: core.ItemFilter{
-				ArmorType: proto.ArmorType_ArmorTypeLeather,
-
-				WeaponTypes: []proto.WeaponType{
-					proto.WeaponType_WeaponTypeDagger,
-				},
-			},
+			ItemFilter: ItemFilter,
 
 			// General practice is to not include stat weights in test suite configs to speed up test execution, but at least one spec should
 			// include them so the core functionality is tested. Assassination Rogue was chosen because it was
 			StatsToWeigh:    []proto.Stat{proto.Stat_StatCritRating},
 			EPReferenceStat: proto.Stat_StatAgility,
+
+			APLCoverageExemptions: []core.ActionID{
+				{SpellID: 8676},   // Ambush
+				{SpellID: 1943},   // Rupture
+				{SpellID: 5171},   // Slice and Dice
+				{SpellID: 2098},   // Eviscerate
+				{SpellID: 8647},   // Expose Armor
+				{SpellID: 51723},  // Fan of Knives
+				{SpellID: 57934},  // Tricks of the Trade
+				{SpellID: 121411}, // Crimson Tempest
+				{SpellID: 32645},  // Envenom
+			},
 		},
 	}))
 }
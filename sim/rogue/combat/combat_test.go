@@ -30,20 +30,18 @@ func TestCombat(t *testing.T) {
 
 			Rotation:       core.GetAplRotation("../../../ui/rogue/combat/apls", "combat"),
 			OtherRotations: []core.RotationCombo{},
-			ItemFilter: core.ItemFilter{
-				ArmorType: proto.ArmorType_ArmorTypeLeather,
-				WeaponTypes: []proto.WeaponType{
-					proto.WeaponType_WeaponTypeDagger,
-					proto.WeaponType_WeaponTypeFist,
-					proto.WeaponType_WeaponTypeAxe,
-					proto.WeaponType_WeaponTypeMace,
-					proto.WeaponType_WeaponTypeSword,
-				},
-				HandTypes: []proto.HandType{
-					proto.HandType_HandTypeMainHand,
-					proto.HandType_HandTypeOffHand,
-					proto.HandType_HandTypeOneHand,
-				},
+			ItemFilter:     ItemFilter,
+
+			APLCoverageExemptions: []core.ActionID{
+				{SpellID: 703},    // Garrote
+				{SpellID: 1943},   // Rupture
+				{SpellID: 5171},   // Slice and Dice
+				{SpellID: 2098},   // Eviscerate
+				{SpellID: 8647},   // Expose Armor
+				{SpellID: 51723},  // Fan of Knives
+				{SpellID: 57934},  // Tricks of the Trade
+				{SpellID: 121411}, // Crimson Tempest
+				{SpellID: 13877},  // Blade Flurry
 			},
 		},
 	}))
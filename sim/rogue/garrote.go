@@ -62,5 +62,17 @@ func (rogue *Rogue) registerGarrote() {
 			}
 			spell.DealOutcome(sim, result)
 		},
+
+		ExpectedTickDamage: func(sim *core.Simulation, target *core.Unit, spell *core.Spell, useSnapshot bool) *core.SpellResult {
+			if useSnapshot {
+				dot := spell.Dot(target)
+				return dot.CalcSnapshotDamage(sim, target, dot.OutcomeExpectedSnapshotCrit)
+			}
+
+			result := spell.CalcPeriodicDamage(sim, target, baseDamage+spell.MeleeAttackPower()*0.078, spell.OutcomeExpectedMagicAlwaysHit)
+			attackTable := spell.Unit.AttackTables[target.UnitIndex]
+			result.Damage *= 1 + spell.PhysicalCritChance(attackTable)*(spell.CritMultiplier-1)
+			return result
+		},
 	})
 }
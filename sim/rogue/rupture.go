@@ -81,6 +81,19 @@ func (rogue *Rogue) registerRupture() {
 			}
 
 		},
+
+		ExpectedTickDamage: func(sim *core.Simulation, target *core.Unit, spell *core.Spell, useSnapshot bool) *core.SpellResult {
+			if useSnapshot {
+				dot := spell.Dot(target)
+				return dot.CalcSnapshotDamage(sim, target, dot.OutcomeExpectedSnapshotCrit)
+			}
+
+			// Always compare against a 5 combo point Rupture, so the snapshot strength can be checked before spending them.
+			result := spell.CalcPeriodicDamage(sim, target, rogue.ruptureDamage(5, baseDamage, damagePerComboPoint), spell.OutcomeExpectedMagicAlwaysHit)
+			attackTable := spell.Unit.AttackTables[target.UnitIndex]
+			result.Damage *= 1 + spell.PhysicalCritChance(attackTable)*(spell.CritMultiplier-1)
+			return result
+		},
 	})
 }
 
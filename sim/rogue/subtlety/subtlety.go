@@ -29,6 +29,14 @@ func RegisterSubtletyRogue() {
 			player.Spec = playerSpec
 		},
 	)
+	core.RegisterItemFilter(proto.Spec_SpecSubtletyRogue, ItemFilter)
+}
+
+var ItemFilter = core.ItemFilter{
+	ArmorType: proto.ArmorType_ArmorTypeLeather,
+	WeaponTypes: []proto.WeaponType{
+		proto.WeaponType_WeaponTypeDagger,
+	},
 }
 
 func (subRogue *SubtletyRogue) Initialize() {
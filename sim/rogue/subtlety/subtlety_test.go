@@ -29,11 +29,17 @@ func TestSubtlety(t *testing.T) {
 			SpecOptions:    core.SpecOptionsCombo{Label: "Subtlety", SpecOptions: PlayerOptions},
 			Rotation:       core.GetAplRotation("../../../ui/rogue/subtlety/apls", "subtlety"),
 			OtherRotations: []core.RotationCombo{},
-			ItemFilter: core.ItemFilter{
-				ArmorType: proto.ArmorType_ArmorTypeLeather,
-				WeaponTypes: []proto.WeaponType{
-					proto.WeaponType_WeaponTypeDagger,
-				},
+			ItemFilter:     ItemFilter,
+
+			APLCoverageExemptions: []core.ActionID{
+				{SpellID: 703},    // Garrote
+				{SpellID: 1943},   // Rupture
+				{SpellID: 5171},   // Slice and Dice
+				{SpellID: 2098},   // Eviscerate
+				{SpellID: 8647},   // Expose Armor
+				{SpellID: 51723},  // Fan of Knives
+				{SpellID: 57934},  // Tricks of the Trade
+				{SpellID: 121411}, // Crimson Tempest
 			},
 		},
 	}))
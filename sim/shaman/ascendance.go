@@ -65,7 +65,7 @@ func (shaman *Shaman) registerAscendanceSpell() {
 				originalOHSpell = shaman.AutoAttacks.OHAuto()
 				shaman.AutoAttacks.SetMHSpell(windLashMH)
 				shaman.AutoAttacks.SetOHSpell(windLashOH)
-			} else {
+			} else if shaman.LavaBurst != nil {
 				shaman.LavaBurst.CD.Reset()
 			}
 
@@ -10,6 +10,8 @@ const (
 	// This could be value or bitflag if we ended up needing multiple flags at the same time.
 	//1 to 5 are used by MaelstromWeapon Stacks
 	CastTagLightningOverload int32 = 6
+	CastTagEcho              int32 = 7
+	CastTagEchoOverload      int32 = 8
 )
 
 type ShamSpellConfig struct {
@@ -22,6 +22,21 @@ func RegisterElementalShaman() {
 			player.Spec = playerSpec
 		},
 	)
+	core.RegisterItemFilter(proto.Spec_SpecElementalShaman, ItemFilter)
+}
+
+var ItemFilter = core.ItemFilter{
+	WeaponTypes: []proto.WeaponType{
+		proto.WeaponType_WeaponTypeAxe,
+		proto.WeaponType_WeaponTypeDagger,
+		proto.WeaponType_WeaponTypeFist,
+		proto.WeaponType_WeaponTypeMace,
+		proto.WeaponType_WeaponTypeOffHand,
+		proto.WeaponType_WeaponTypeShield,
+		proto.WeaponType_WeaponTypeStaff,
+	},
+	ArmorType:         proto.ArmorType_ArmorTypeMail,
+	RangedWeaponTypes: []proto.RangedWeaponType{},
 }
 
 func NewElementalShaman(character *core.Character, options *proto.Player) *ElementalShaman {
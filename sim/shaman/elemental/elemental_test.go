@@ -42,20 +42,22 @@ func TestElemental(t *testing.T) {
 				core.GetAplRotation("../../../ui/shaman/elemental/apls", "aoe"),
 			},
 
-			ItemFilter: core.ItemFilter{
-				WeaponTypes: []proto.WeaponType{
-					proto.WeaponType_WeaponTypeAxe,
-					proto.WeaponType_WeaponTypeDagger,
-					proto.WeaponType_WeaponTypeFist,
-					proto.WeaponType_WeaponTypeMace,
-					proto.WeaponType_WeaponTypeOffHand,
-					proto.WeaponType_WeaponTypeShield,
-					proto.WeaponType_WeaponTypeStaff,
-				},
-				ArmorType:         proto.ArmorType_ArmorTypeMail,
-				RangedWeaponTypes: []proto.RangedWeaponType{},
-			},
+			ItemFilter:       ItemFilter,
 			StartingDistance: 20,
+
+			APLCoverageExemptions: []core.ActionID{
+				{SpellID: 421},    // Chain Lightning
+				{SpellID: 324},    // Lightning Shield
+				{SpellID: 79206},  // Spiritwalker's Grace
+				{SpellID: 8190},   // Magma Totem
+				{SpellID: 8056},   // Frost Shock
+				{SpellID: 73680},  // Unleash Elements
+				{SpellID: 30823},  // Shamanistic Rage
+				{SpellID: 2825},   // Bloodlust
+				{SpellID: 51490},  // Thunderstorm
+				{SpellID: 77478},  // Earthquake
+				{SpellID: 114074}, // Lava Beam
+			},
 		},
 	}))
 }
@@ -24,6 +24,21 @@ func RegisterEnhancementShaman() {
 			player.Spec = playerSpec
 		},
 	)
+	core.RegisterItemFilter(proto.Spec_SpecEnhancementShaman, ItemFilter)
+}
+
+var ItemFilter = core.ItemFilter{
+	WeaponTypes: []proto.WeaponType{
+		proto.WeaponType_WeaponTypeAxe,
+		proto.WeaponType_WeaponTypeDagger,
+		proto.WeaponType_WeaponTypeFist,
+		proto.WeaponType_WeaponTypeMace,
+		proto.WeaponType_WeaponTypeOffHand,
+		proto.WeaponType_WeaponTypeShield,
+		proto.WeaponType_WeaponTypeStaff,
+	},
+	ArmorType:         proto.ArmorType_ArmorTypeMail,
+	RangedWeaponTypes: []proto.RangedWeaponType{},
 }
 
 func NewEnhancementShaman(character *core.Character, options *proto.Player) *EnhancementShaman {
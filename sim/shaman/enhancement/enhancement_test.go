@@ -40,18 +40,19 @@ func TestEnhancement(t *testing.T) {
 			SpecOptions: core.SpecOptionsCombo{Label: "Standard", SpecOptions: PlayerOptionsStandard},
 			Rotation:    core.GetAplRotation("../../../ui/shaman/enhancement/apls", "default"),
 
-			ItemFilter: core.ItemFilter{
-				WeaponTypes: []proto.WeaponType{
-					proto.WeaponType_WeaponTypeAxe,
-					proto.WeaponType_WeaponTypeDagger,
-					proto.WeaponType_WeaponTypeFist,
-					proto.WeaponType_WeaponTypeMace,
-					proto.WeaponType_WeaponTypeOffHand,
-					proto.WeaponType_WeaponTypeShield,
-					proto.WeaponType_WeaponTypeStaff,
-				},
-				ArmorType:         proto.ArmorType_ArmorTypeMail,
-				RangedWeaponTypes: []proto.RangedWeaponType{},
+			ItemFilter: ItemFilter,
+
+			APLCoverageExemptions: []core.ActionID{
+				{SpellID: 117014}, // Elemental Blast
+				{SpellID: 421},    // Chain Lightning
+				{SpellID: 403},    // Lightning Bolt
+				{SpellID: 324},    // Lightning Shield
+				{SpellID: 79206},  // Spiritwalker's Grace
+				{SpellID: 8190},   // Magma Totem
+				{SpellID: 8056},   // Frost Shock
+				{SpellID: 30823},  // Shamanistic Rage
+				{SpellID: 2825},   // Bloodlust
+				{SpellID: 1535},   // Fire Nova
 			},
 		},
 	}))
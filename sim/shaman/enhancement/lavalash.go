@@ -1,6 +1,7 @@
 package enhancement
 
 import (
+	"cmp"
 	"slices"
 	"time"
 
@@ -54,38 +55,7 @@ func (enh *EnhancementShaman) registerLavaLashSpell() {
 			}
 
 			if !enh.HasMinorGlyph(proto.ShamanMinorGlyph_GlyphOfLavaLash) {
-				flameShockDot := enh.FlameShock.Dot(target)
-
-				if flameShockDot != nil && flameShockDot.IsActive() {
-					numberSpread := 0
-					maxTargets := min(4, len(sim.Encounter.ActiveTargetUnits))
-					sortedTargets := make([]*core.Unit, len(sim.Encounter.ActiveTargetUnits))
-					copy(sortedTargets, sim.Encounter.ActiveTargetUnits)
-					slices.SortFunc(sortedTargets, func(a *core.Unit, b *core.Unit) int {
-						aDot := enh.FlameShock.Dot(a)
-						if aDot == nil || !aDot.IsActive() {
-							return -1
-						}
-						bDot := enh.FlameShock.Dot(b)
-						if bDot == nil || !bDot.IsActive() {
-							return 1
-						}
-						return int(aDot.RemainingDuration(sim) - bDot.RemainingDuration(sim))
-					})
-
-					for _, otherTarget := range sortedTargets {
-						if otherTarget == target {
-							return
-						}
-
-						enh.FlameShock.RelatedDotSpell.Dot(otherTarget).CopyDotAndApply(sim, flameShockDot)
-						numberSpread++
-
-						if numberSpread >= maxTargets {
-							return
-						}
-					}
-				}
+				enh.spreadFlameShock(sim, target)
 			}
 		},
 		ExtraCastCondition: func(sim *core.Simulation, target *core.Unit) bool {
@@ -94,6 +64,33 @@ func (enh *EnhancementShaman) registerLavaLashSpell() {
 	})
 }
 
+// Lava Lash spreads Flame Shock from its target to up to 4 other enemies,
+// starting with those without Flame Shock and then those whose Flame Shock
+// expires soonest. Longer Flame Shocks are not overwritten.
+func (enh *EnhancementShaman) spreadFlameShock(sim *core.Simulation, target *core.Unit) {
+	sourceDot := enh.FlameShock.Dot(target)
+	if sourceDot == nil || !sourceDot.IsActive() {
+		return
+	}
+
+	remainingDuration := func(unit *core.Unit) time.Duration {
+		if dot := enh.FlameShock.Dot(unit); dot.IsActive() {
+			return dot.RemainingDuration(sim)
+		}
+		return 0
+	}
+	otherTargets := core.FilterSlice(sim.Encounter.ActiveTargetUnits, func(unit *core.Unit) bool {
+		return unit != target && remainingDuration(unit) < sourceDot.RemainingDuration(sim)
+	})
+	slices.SortStableFunc(otherTargets, func(a *core.Unit, b *core.Unit) int {
+		return cmp.Compare(remainingDuration(a), remainingDuration(b))
+	})
+
+	for _, otherTarget := range otherTargets[:min(4, len(otherTargets))] {
+		enh.FlameShock.Dot(otherTarget).CopyDotAndApply(sim, sourceDot)
+	}
+}
+
 func (enh *EnhancementShaman) IsLavaLashCastable(sim *core.Simulation) bool {
 	return enh.LavaLash.IsReady(sim)
 }
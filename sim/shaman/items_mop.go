@@ -194,8 +194,7 @@ var ItemSetBattlegearOfTheWitchDoctor = core.NewItemSet(core.ItemSet{
 					if !shaman.StormstrikeCastResult.Landed() || (spell.Matches(SpellMaskStormstrikeDamage) && !spell.ProcMask.Matches(core.ProcMaskMeleeOHSpecial)) {
 						return
 					}
-					shaman.MaelstromWeaponAura.Activate(sim)
-					shaman.MaelstromWeaponAura.SetStacks(sim, shaman.MaelstromWeaponAura.GetStacks()+2)
+					shaman.AddMaelstromWeaponStacks(sim, 2, core.ActionID{SpellID: 138136})
 				},
 			})
 		},
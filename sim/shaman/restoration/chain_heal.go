@@ -0,0 +1,69 @@
+package restoration
+
+import (
+	"time"
+
+	"github.com/wowsims/mop/sim/core"
+	"github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/shaman"
+)
+
+const (
+	ChainHealCoeff      = 4.9
+	ChainHealVariance   = 0.133
+	ChainHealBonusCoeff = 0.6875
+	ChainHealNumTargets = 4
+
+	// Chain Heal's first heal is stronger on a target with Riptide.
+	ChainHealRiptideBonus = 1.25
+)
+
+func (resto *RestorationShaman) registerChainHealSpell() {
+	resto.ChainHeal = resto.RegisterSpell(core.SpellConfig{
+		ActionID:       core.ActionID{SpellID: 1064},
+		SpellSchool:    core.SpellSchoolNature,
+		ProcMask:       core.ProcMaskSpellHealing,
+		ClassSpellMask: shaman.SpellMaskChainHeal,
+		Flags:          core.SpellFlagHelpful | core.SpellFlagAPL,
+
+		ManaCost: core.ManaCostOptions{
+			BaseCostPercent: 26.7,
+		},
+		Cast: core.CastConfig{
+			DefaultCast: core.Cast{
+				GCD:      core.GCDDefault,
+				CastTime: time.Millisecond * 2500,
+			},
+		},
+
+		DamageMultiplier: 1,
+		CritMultiplier:   resto.DefaultCritMultiplier(),
+		ThreatMultiplier: 1,
+		BonusCoefficient: ChainHealBonusCoeff,
+
+		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			if target.IsOpponent(&resto.Unit) {
+				target = &resto.Unit
+			}
+
+			// Jumps go to the most injured allies in range.
+			targets := []*core.Unit{target}
+			for _, ally := range resto.SmartHealTargets(sim, proto.HealTargetStrategy_HealTargetLowestHealth, ChainHealNumTargets) {
+				if (ally != target) && (len(targets) < ChainHealNumTargets) {
+					targets = append(targets, ally)
+				}
+			}
+
+			for i, ally := range targets {
+				baseHealing := resto.CalcAndRollDamageRange(sim, ChainHealCoeff, ChainHealVariance)
+				result := spell.CalcHealing(sim, ally, baseHealing, spell.OutcomeHealingCrit)
+				if (i == 0) && resto.Riptide.Hot(ally).IsActive() {
+					result.Damage *= ChainHealRiptideBonus
+				}
+				resto.dealDeepHealing(sim, spell, result, false)
+			}
+
+			resto.grantTidalWaves(sim)
+		},
+	})
+}
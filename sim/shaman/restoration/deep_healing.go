@@ -0,0 +1,31 @@
+package restoration
+
+import (
+	"github.com/wowsims/mop/sim/core"
+)
+
+const (
+	DeepHealingBaseBonus     = 0.24 // 8 base mastery points
+	DeepHealingBonusPerPoint = 0.03
+)
+
+// Deep Healing increases healing by up to the mastery bonus, in proportion to
+// how injured the target is. Units without a health bar count as uninjured.
+func (resto *RestorationShaman) deepHealingMultiplier(target *core.Unit) float64 {
+	if !target.HasHealthBar() {
+		return 1
+	}
+	bonus := DeepHealingBaseBonus + DeepHealingBonusPerPoint*resto.GetMasteryPoints()
+	return 1 + bonus*(1-target.CurrentHealthPercent())
+}
+
+// Deep Healing depends on the target's health when the heal lands, so it is
+// applied to the result instead of being snapshot like other multipliers.
+func (resto *RestorationShaman) dealDeepHealing(sim *core.Simulation, spell *core.Spell, result *core.SpellResult, isPeriodic bool) {
+	result.Damage *= resto.deepHealingMultiplier(result.Target)
+	if isPeriodic {
+		spell.DealPeriodicHealing(sim, result)
+	} else {
+		spell.DealHealing(sim, result)
+	}
+}
@@ -0,0 +1,52 @@
+package restoration
+
+import (
+	"time"
+
+	"github.com/wowsims/mop/sim/core"
+	"github.com/wowsims/mop/sim/shaman"
+)
+
+const (
+	GreaterHealingWaveCoeff      = 16.2
+	GreaterHealingWaveVariance   = 0.133
+	GreaterHealingWaveBonusCoeff = 1.377
+)
+
+func (resto *RestorationShaman) registerGreaterHealingWaveSpell() {
+	resto.GreaterHealingWave = resto.RegisterSpell(core.SpellConfig{
+		ActionID:       core.ActionID{SpellID: 77472},
+		SpellSchool:    core.SpellSchoolNature,
+		ProcMask:       core.ProcMaskSpellHealing,
+		ClassSpellMask: shaman.SpellMaskGreaterHealingWave,
+		Flags:          core.SpellFlagHelpful | core.SpellFlagAPL,
+
+		ManaCost: core.ManaCostOptions{
+			BaseCostPercent: 26,
+		},
+		Cast: core.CastConfig{
+			DefaultCast: core.Cast{
+				GCD:      core.GCDDefault,
+				CastTime: time.Millisecond * 2500,
+			},
+		},
+
+		DamageMultiplier: 1,
+		CritMultiplier:   resto.DefaultCritMultiplier(),
+		ThreatMultiplier: 1,
+		BonusCoefficient: GreaterHealingWaveBonusCoeff,
+
+		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			if target.IsOpponent(&resto.Unit) {
+				target = &resto.Unit
+			}
+
+			baseHealing := resto.CalcAndRollDamageRange(sim, GreaterHealingWaveCoeff, GreaterHealingWaveVariance)
+			resto.dealDeepHealing(sim, spell, spell.CalcHealing(sim, target, baseHealing, spell.OutcomeHealingCrit), false)
+
+			if resto.TidalWaves.IsActive() {
+				resto.TidalWaves.RemoveStack(sim)
+			}
+		},
+	})
+}
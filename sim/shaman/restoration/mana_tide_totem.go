@@ -0,0 +1,56 @@
+package restoration
+
+import (
+	"github.com/wowsims/mop/sim/core"
+	"github.com/wowsims/mop/sim/shaman"
+)
+
+// Mana Tide Totem raises the Spirit of every raid member with a mana bar,
+// including other healers in raid sims.
+func (resto *RestorationShaman) registerManaTideTotemCD() {
+	actionID := core.ManaTideTotemActionID.WithTag(resto.Index)
+
+	mttAuras := []*core.Aura{}
+	for _, party := range resto.Env.Raid.Parties {
+		for _, partyMember := range party.Players {
+			character := partyMember.GetCharacter()
+			if character.HasManaBar() {
+				mttAuras = append(mttAuras, core.ManaTideTotemAura(character, actionID.Tag))
+			}
+		}
+	}
+
+	spell := resto.RegisterSpell(core.SpellConfig{
+		ActionID:       actionID,
+		Flags:          core.SpellFlagAPL | core.SpellFlagHelpful,
+		ClassSpellMask: shaman.SpellMaskManaTideTotem,
+
+		Cast: core.CastConfig{
+			DefaultCast: core.Cast{
+				GCD: core.GCDMin,
+			},
+			CD: core.Cooldown{
+				Timer:    resto.NewTimer(),
+				Duration: core.ManaTideTotemCD,
+			},
+		},
+		ExtraCastCondition: func(_ *core.Simulation, _ *core.Unit) bool {
+			return !resto.HasActiveAuraWithTag(core.ManaTideTotemAuraTag)
+		},
+
+		ApplyEffects: func(sim *core.Simulation, _ *core.Unit, _ *core.Spell) {
+			resto.TotemExpirations[shaman.WaterTotem] = sim.CurrentTime + core.ManaTideTotemDuration
+			for _, mttAura := range mttAuras {
+				mttAura.Activate(sim)
+			}
+		},
+	})
+
+	resto.AddMajorCooldown(core.MajorCooldown{
+		Spell: spell,
+		Type:  core.CooldownTypeMana,
+		ShouldActivate: func(_ *core.Simulation, character *core.Character) bool {
+			return character.CurrentManaPercent() < 0.8
+		},
+	})
+}
@@ -22,6 +22,21 @@ func RegisterRestorationShaman() {
 			player.Spec = playerSpec
 		},
 	)
+	core.RegisterItemFilter(proto.Spec_SpecRestorationShaman, ItemFilter)
+}
+
+var ItemFilter = core.ItemFilter{
+	WeaponTypes: []proto.WeaponType{
+		proto.WeaponType_WeaponTypeAxe,
+		proto.WeaponType_WeaponTypeDagger,
+		proto.WeaponType_WeaponTypeFist,
+		proto.WeaponType_WeaponTypeMace,
+		proto.WeaponType_WeaponTypeOffHand,
+		proto.WeaponType_WeaponTypeShield,
+		proto.WeaponType_WeaponTypeStaff,
+	},
+	ArmorType:         proto.ArmorType_ArmorTypeMail,
+	RangedWeaponTypes: []proto.RangedWeaponType{},
 }
 
 func NewRestorationShaman(character *core.Character, options *proto.Player) *RestorationShaman {
@@ -44,6 +59,8 @@ func NewRestorationShaman(character *core.Character, options *proto.Player) *Res
 
 type RestorationShaman struct {
 	*shaman.Shaman
+
+	TidalWaves *core.Aura
 }
 
 func (resto *RestorationShaman) GetShaman() *shaman.Shaman {
@@ -78,9 +95,24 @@ func (resto *RestorationShaman) Initialize() {
 
 	resto.Shaman.Initialize()
 	resto.Shaman.RegisterHealingSpells()
+
+	resto.registerTidalWaves()
+	resto.registerRiptideSpell()
+	resto.registerGreaterHealingWaveSpell()
+	resto.registerChainHealSpell()
+	resto.registerManaTideTotemCD()
 }
 
 func (resto *RestorationShaman) ApplyTalents() {
 	resto.Shaman.ApplyTalents()
 	resto.ApplyArmorSpecializationEffect(stats.Intellect, proto.ArmorType_ArmorTypeMail, 86529)
+
+	// Purification
+	resto.PseudoStats.HealingDealtMultiplier *= 1.25
+
+	// Meditation
+	resto.PseudoStats.SpiritRegenRateCombat = 0.5
+
+	// Spiritual Insight
+	resto.MultiplyStat(stats.Mana, 5)
 }
@@ -1,104 +1,74 @@
 package restoration
 
 import (
-	_ "github.com/wowsims/mop/sim/common"
+	"testing"
+
+	"github.com/wowsims/mop/sim/common" // imported to get caster sets included.
+	"github.com/wowsims/mop/sim/core"
+	"github.com/wowsims/mop/sim/core/proto"
 )
 
 func init() {
 	RegisterRestorationShaman()
+	common.RegisterAllEffects()
 }
 
-// func TestRestoration(t *testing.T) {
-// 	core.RunTestSuite(t, t.Name(), core.FullCharacterTestSuiteGenerator([]core.CharacterSuiteConfig{
-// 		Class: proto.Class_ClassShaman,
-// 		Race:  proto.Race_RaceTroll,
-
-// 		GearSet:     core.GetGearSet("../../../ui/restoration_shaman/gear_sets", "p1"),
-// 		Talents:     StandardTalents,
-// 		Glyphs:      StandardGlyphs,
-// 		Consumes:    FullConsumes,
-// 		SpecOptions: core.SpecOptionsCombo{Label: "Standard", SpecOptions: PlayerOptionsStandard},
-// 		Rotation:    core.RotationCombo{Label: "Default", Rotation: DefaultRotation},
-
-// 		ItemFilter: core.ItemFilter{
-// 			WeaponTypes: []proto.WeaponType{
-// 				proto.WeaponType_WeaponTypeAxe,
-// 				proto.WeaponType_WeaponTypeDagger,
-// 				proto.WeaponType_WeaponTypeFist,
-// 				proto.WeaponType_WeaponTypeMace,
-// 				proto.WeaponType_WeaponTypeOffHand,
-// 				proto.WeaponType_WeaponTypeShield,
-// 				proto.WeaponType_WeaponTypeStaff,
-// 			},
-// 			ArmorType: proto.ArmorType_ArmorTypeMail,
-// 			RangedWeaponTypes: []proto.RangedWeaponType{},
-// 		},
-// 	}))
-// }
+func TestRestoration(t *testing.T) {
+	core.RunTestSuite(t, t.Name(), core.FullCharacterTestSuiteGenerator([]core.CharacterSuiteConfig{
+		{
+			Class:    proto.Class_ClassShaman,
+			Race:     proto.Race_RaceTroll,
+			IsHealer: true,
 
-// func BenchmarkSimulate(b *testing.B) {
-// 	rsr := &proto.RaidSimRequest{
-// 		Raid: core.SinglePlayerRaidProto(
-// 			&proto.Player{
-// 				Race:          proto.Race_RaceOrc,
-// 				Class:         proto.Class_ClassShaman,
-// 				Equipment:     core.GetGearSet("../../../ui/restoration_shaman/gear_sets", "p1").GearSet,
-// 				Consumes:      FullConsumes,
-// 				Spec:          PlayerOptionsStandard,
-// 				Buffs:         core.FullIndividualBuffs,
-// 				TalentsString: StandardTalents,
-// 				Glyphs:        StandardGlyphs,
-// 			},
-// 			core.FullPartyBuffs,
-// 			core.FullRaidBuffs,
-// 			core.FullDebuffs),
-// 		Encounter: &proto.Encounter{
-// 			Duration: 300,
-// 			Targets: []*proto.Target{
-// 				core.NewDefaultTarget(),
-// 			},
-// 		},
-// 		SimOptions: core.AverageDefaultSimTestOptions,
-// 	}
+			GearSet:     core.GetGearSet("../../../ui/shaman/restoration/gear_sets", "p1"),
+			Talents:     StandardTalents,
+			Glyphs:      &proto.Glyphs{},
+			Consumables: FullConsumesSpec,
+			SpecOptions: core.SpecOptionsCombo{Label: "Standard", SpecOptions: PlayerOptionsStandard},
+			Rotation:    core.GetAplRotation("../../../ui/shaman/restoration/apls", "default"),
 
-// 	core.RaidBenchmark(b, rsr)
-// }
+			ItemFilter: ItemFilter,
 
-// var StandardTalents = "-3020503-50005331335310501122331251"
-// var StandardGlyphs = &proto.Glyphs{
-// 	Major1: int32(proto.ShamanMajorGlyph_GlyphOfChainHeal),
-// 	Major2: int32(proto.ShamanMajorGlyph_GlyphOfEarthShield),
-// 	Major3: int32(proto.ShamanMajorGlyph_GlyphOfEarthlivingWeapon),
-// }
+			APLCoverageExemptions: []core.ActionID{
+				{SpellID: 403},   // Lightning Bolt
+				{SpellID: 421},   // Chain Lightning
+				{SpellID: 3599},  // Searing Totem
+				{SpellID: 8042},  // Earth Shock
+				{SpellID: 8050},  // Flame Shock
+				{SpellID: 8056},  // Frost Shock
+				{SpellID: 8190},  // Magma Totem
+				{SpellID: 73680}, // Unleash Elements
+				{SpellID: 79206}, // Spiritwalker's Grace
+			},
 
-// var BasicTotems = &proto.ShamanTotems{
-// 	Earth: proto.EarthTotem_TremorTotem,
-// 	Air:   proto.AirTotem_WrathOfAirTotem,
-// 	Water: proto.WaterTotem_ManaSpringTotem,
-// 	Fire:  proto.FireTotem_FlametongueTotem,
-// }
+			EPReferenceStat: proto.Stat_StatSpellPower,
+			StatsToWeigh: []proto.Stat{
+				proto.Stat_StatIntellect,
+				proto.Stat_StatSpirit,
+				proto.Stat_StatSpellPower,
+				proto.Stat_StatHasteRating,
+				proto.Stat_StatCritRating,
+				proto.Stat_StatMasteryRating,
+			},
+		},
+	}))
+}
 
-// var restoShamOptions = &proto.RestorationShaman_Options{
-// 	Shield: proto.ShamanShield_WaterShield,
-// 	Totems: BasicTotems,
-// }
-// var PlayerOptionsStandard = &proto.Player_RestorationShaman{
-// 	RestorationShaman: &proto.RestorationShaman{
-// 		Options: restoShamOptions,
-// 	},
-// }
+var StandardTalents = "313131"
 
-// var FullConsumes = &proto.Consumes{
-// 	Flask:           proto.Flask_FlaskOfBlindingLight,
-// 	Food:            proto.Food_FoodBlackenedBasilisk,
-// 	DefaultPotion:   proto.Potions_SuperManaPotion,
-// 	PrepopPotion:    proto.Potions_DestructionPotion,
-// 	DefaultConjured: proto.Conjured_ConjuredDarkRune,
-// }
+var FullConsumesSpec = &proto.ConsumesSpec{
+	FlaskId:  76085, // Flask of the Warm Sun
+	FoodId:   74650, // Mogu Fish Stew
+	PotId:    76093, // Potion of the Jade Serpent
+	PrepotId: 76093, // Potion of the Jade Serpent
+}
 
-// var DefaultRotation = core.APLRotationFromJsonString(`{
-// 	"type": "TypeAPL",
-// 	"priorityList": [
-// 		{"action":{"autocastOtherCooldowns":{}}}
-// 	]
-// }`)
+var PlayerOptionsStandard = &proto.Player_RestorationShaman{
+	RestorationShaman: &proto.RestorationShaman{
+		Options: &proto.RestorationShaman_Options{
+			ClassOptions: &proto.ShamanOptions{
+				Shield: proto.ShamanShield_WaterShield,
+			},
+		},
+	},
+}
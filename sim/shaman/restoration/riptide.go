@@ -0,0 +1,72 @@
+package restoration
+
+import (
+	"time"
+
+	"github.com/wowsims/mop/sim/core"
+	"github.com/wowsims/mop/sim/shaman"
+)
+
+const (
+	RiptideCoeff          = 2.06
+	RiptideBonusCoeff     = 0.2
+	RiptideHotCoeff       = 1.02
+	RiptideHotBonusCoeff  = 0.096
+	RiptideHotNumberTicks = 6
+)
+
+func (resto *RestorationShaman) registerRiptideSpell() {
+	resto.Riptide = resto.RegisterSpell(core.SpellConfig{
+		ActionID:       core.ActionID{SpellID: 61295},
+		SpellSchool:    core.SpellSchoolNature,
+		ProcMask:       core.ProcMaskSpellHealing,
+		ClassSpellMask: shaman.SpellMaskRiptide,
+		Flags:          core.SpellFlagHelpful | core.SpellFlagAPL,
+
+		ManaCost: core.ManaCostOptions{
+			BaseCostPercent: 16,
+		},
+		Cast: core.CastConfig{
+			DefaultCast: core.Cast{
+				GCD: core.GCDDefault,
+			},
+			CD: core.Cooldown{
+				Timer:    resto.NewTimer(),
+				Duration: time.Second * 6,
+			},
+		},
+
+		DamageMultiplier: 1,
+		CritMultiplier:   resto.DefaultCritMultiplier(),
+		ThreatMultiplier: 1,
+		BonusCoefficient: RiptideBonusCoeff,
+
+		Hot: core.DotConfig{
+			Aura: core.Aura{
+				Label: "Riptide",
+			},
+			NumberOfTicks:       RiptideHotNumberTicks,
+			TickLength:          time.Second * 3,
+			AffectedByCastSpeed: true,
+			BonusCoefficient:    RiptideHotBonusCoeff,
+
+			OnSnapshot: func(_ *core.Simulation, target *core.Unit, dot *core.Dot, _ bool) {
+				dot.SnapshotHeal(target, resto.CalcScalingSpellDmg(RiptideHotCoeff))
+			},
+			OnTick: func(sim *core.Simulation, target *core.Unit, dot *core.Dot) {
+				resto.dealDeepHealing(sim, dot.Spell, dot.CalcSnapshotHealing(sim, target, dot.OutcomeSnapshotCrit), true)
+			},
+		},
+
+		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
+			if target.IsOpponent(&resto.Unit) {
+				target = &resto.Unit
+			}
+
+			baseHealing := resto.CalcScalingSpellDmg(RiptideCoeff)
+			resto.dealDeepHealing(sim, spell, spell.CalcHealing(sim, target, baseHealing, spell.OutcomeHealingCrit), false)
+			spell.Hot(target).Apply(sim)
+			resto.grantTidalWaves(sim)
+		},
+	})
+}
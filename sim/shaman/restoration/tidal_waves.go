@@ -0,0 +1,36 @@
+package restoration
+
+import (
+	"time"
+
+	"github.com/wowsims/mop/sim/core"
+	"github.com/wowsims/mop/sim/shaman"
+)
+
+// Riptide and Chain Heal grant two charges of Tidal Waves, each reducing the
+// cast time of the next Greater Healing Wave by 30%.
+func (resto *RestorationShaman) registerTidalWaves() {
+	castTimeMod := resto.AddDynamicMod(core.SpellModConfig{
+		ClassMask:  shaman.SpellMaskGreaterHealingWave,
+		Kind:       core.SpellMod_CastTime_Pct,
+		FloatValue: -0.3,
+	})
+
+	resto.TidalWaves = resto.RegisterAura(core.Aura{
+		Label:     "Tidal Waves",
+		ActionID:  core.ActionID{SpellID: 53390},
+		Duration:  time.Second * 15,
+		MaxStacks: 2,
+		OnGain: func(_ *core.Aura, _ *core.Simulation) {
+			castTimeMod.Activate()
+		},
+		OnExpire: func(_ *core.Aura, _ *core.Simulation) {
+			castTimeMod.Deactivate()
+		},
+	})
+}
+
+func (resto *RestorationShaman) grantTidalWaves(sim *core.Simulation) {
+	resto.TidalWaves.Activate(sim)
+	resto.TidalWaves.SetStacks(sim, resto.TidalWaves.MaxStacks)
+}
@@ -196,6 +196,7 @@ type Shaman struct {
 	EarthShield        *core.Spell
 
 	waterShieldManaMetrics *core.ResourceMetrics
+	maelstromWeaponMetrics map[core.ActionID]*core.ResourceMetrics
 
 	// Item sets
 	T14Ele4pc *core.Aura
@@ -352,6 +353,10 @@ const (
 	SpellMaskElementalBlastOverload
 	SpellMaskStormlashTotem
 	SpellMaskBloodlust
+	SpellMaskRiptide
+	SpellMaskGreaterHealingWave
+	SpellMaskChainHeal
+	SpellMaskManaTideTotem
 
 	SpellMaskStormstrike  = SpellMaskStormstrikeCast | SpellMaskStormstrikeDamage
 	SpellMaskStormblast   = SpellMaskStormblastCast | SpellMaskStormblastDamage
@@ -142,55 +142,30 @@ func (shaman *Shaman) ApplyEchoOfTheElements() {
 		return
 	}
 
-	var copySpells = map[*core.Spell]*core.Spell{}
-	var alreadyProcced = map[*core.Spell]bool{}
-	var lastTimestamp time.Duration
-
 	const cantProc int64 = SpellMaskTotem | SpellMaskLightningShield | SpellMaskImbue | SpellMaskFulmination | SpellMaskFlameShockDot
 
-	core.MakePermanent(shaman.GetOrRegisterAura(core.Aura{
-		Label: "Echo of The Elements Dummy",
-		OnSpellHitDealt: func(aura *core.Aura, sim *core.Simulation, spell *core.Spell, result *core.SpellResult) {
-			if !result.Landed() || spell.Flags.Matches(SpellFlagIsEcho) || !spell.Flags.Matches(SpellFlagShamanSpell) || spell.Matches(cantProc) {
-				return
-			}
-			if sim.CurrentTime == lastTimestamp && alreadyProcced[spell] {
-				return
-			} else if sim.CurrentTime != lastTimestamp {
-				lastTimestamp = sim.CurrentTime
-				alreadyProcced = map[*core.Spell]bool{}
+	shaman.RegisterSpellEcho(core.SpellEchoConfig{
+		Label: "Echo of The Elements",
+		Flag:  SpellFlagIsEcho,
+		Tag: func(spell *core.Spell) int32 {
+			return core.TernaryInt32(spell.Tag == CastTagLightningOverload, CastTagEchoOverload, CastTagEcho)
+		},
+		ProcChance: func(spell *core.Spell) float64 {
+			if !spell.Flags.Matches(SpellFlagShamanSpell) || spell.Matches(cantProc) {
+				return 0
 			}
-			procChance := core.TernaryFloat64(shaman.Spec == proto.Spec_SpecElementalShaman, 0.06, 0.3)
 			if spell.Matches(SpellMaskElementalBlast | SpellMaskElementalBlastOverload) {
-				procChance = 0.06
+				return 0.06
 			}
-			if !sim.Proc(procChance, "Echo of The Elements") {
-				return
-			}
-			alreadyProcced[spell] = true
-			if copySpells[spell] == nil {
-				copySpells[spell] = spell.Unit.RegisterSpell(core.SpellConfig{
-					ActionID:                 core.ActionID{SpellID: spell.SpellID, Tag: core.TernaryInt32(spell.Tag == CastTagLightningOverload, 8, 7)},
-					SpellSchool:              spell.SpellSchool,
-					ProcMask:                 core.ProcMaskSpellProc,
-					ApplyEffects:             spell.ApplyEffects,
-					ManaCost:                 core.ManaCostOptions{},
-					CritMultiplier:           shaman.DefaultCritMultiplier(),
-					BonusCritPercent:         spell.BonusCritPercent,
-					DamageMultiplier:         core.TernaryFloat64(spell.Tag == CastTagLightningOverload, 0.75, 1),
-					DamageMultiplierAdditive: 1,
-					MissileSpeed:             spell.MissileSpeed,
-					ClassSpellMask:           spell.ClassSpellMask,
-					BonusCoefficient:         spell.BonusCoefficient,
-					Flags:                    spell.Flags & ^core.SpellFlagAPL | SpellFlagIsEcho,
-					RelatedDotSpell:          spell.RelatedDotSpell,
-				})
-			}
-			copySpell := copySpells[spell]
-			copySpell.SpellMetrics[result.Target.UnitIndex].Casts--
-			copySpell.Cast(sim, result.Target)
+			return core.TernaryFloat64(shaman.Spec == proto.Spec_SpecElementalShaman, 0.06, 0.3)
 		},
-	}))
+		ModifyEcho: func(spell *core.Spell, config *core.SpellConfig) {
+			config.CritMultiplier = shaman.DefaultCritMultiplier()
+			config.DamageMultiplier = core.TernaryFloat64(spell.Tag == CastTagLightningOverload, 0.75, 1)
+			// Echoes have never generated threat for shamans.
+			config.ThreatMultiplier = 0
+		},
+	})
 }
 
 func (shaman *Shaman) ApplyUnleashedFury() {
@@ -188,9 +188,10 @@ func (shaman *Shaman) ApplyEnhancementTalents() {
 		},
 	}))
 
-	ppm := core.TernaryFloat64(shaman.S12Enh2pc.IsActive(), 12.0, 10.0)
-
-	dpm := shaman.NewLegacyPPMManager(ppm, core.ProcMaskMeleeOrMeleeProc)
+	// The S12 2P can be swapped in and out, so the proc rate is picked on
+	// each hit instead of once here.
+	dpm := shaman.NewLegacyPPMManager(10, core.ProcMaskMeleeOrMeleeProc)
+	s12Dpm := shaman.NewLegacyPPMManager(12, core.ProcMaskMeleeOrMeleeProc)
 
 	// This aura is hidden, just applies stacks of the proc aura.
 	shaman.MakeProcTriggerAura(core.ProcTrigger{
@@ -198,12 +199,31 @@ func (shaman *Shaman) ApplyEnhancementTalents() {
 		Outcome:            core.OutcomeLanded,
 		Callback:           core.CallbackOnSpellHitDealt,
 		RequireDamageDealt: true,
-		DPM:                dpm,
 		TriggerImmediately: true,
+		ExtraCondition: func(sim *core.Simulation, spell *core.Spell, _ *core.SpellResult) bool {
+			return core.Ternary(shaman.S12Enh2pc.IsActive(), s12Dpm, dpm).Proc(sim, spell.ProcMask, "Maelstrom Weapon")
+		},
 
 		Handler: func(sim *core.Simulation, spell *core.Spell, result *core.SpellResult) {
-			shaman.MaelstromWeaponAura.Activate(sim)
-			shaman.MaelstromWeaponAura.AddStack(sim)
+			shaman.AddMaelstromWeaponStacks(sim, 1, spell.ActionID)
 		},
 	})
 }
+
+// Adds Maelstrom Weapon stacks, recording the gain against the source in the
+// resource metrics.
+func (shaman *Shaman) AddMaelstromWeaponStacks(sim *core.Simulation, stacks int32, source core.ActionID) {
+	metrics, ok := shaman.maelstromWeaponMetrics[source]
+	if !ok {
+		if shaman.maelstromWeaponMetrics == nil {
+			shaman.maelstromWeaponMetrics = make(map[core.ActionID]*core.ResourceMetrics)
+		}
+		metrics = shaman.Metrics.NewResourceMetrics(source, proto.ResourceType_ResourceTypeMaelstromWeapon)
+		shaman.maelstromWeaponMetrics[source] = metrics
+	}
+
+	oldStacks := shaman.MaelstromWeaponAura.GetStacks()
+	shaman.MaelstromWeaponAura.Activate(sim)
+	shaman.MaelstromWeaponAura.AddStacks(sim, stacks)
+	metrics.AddEvent(float64(stacks), float64(shaman.MaelstromWeaponAura.GetStacks()-oldStacks))
+}
@@ -24,6 +24,22 @@ func RegisterAfflictionWarlock() {
 			player.Spec = playerSpec
 		},
 	)
+	core.RegisterItemFilter(proto.Spec_SpecAfflictionWarlock, ItemFilter)
+}
+
+var ItemFilter = core.ItemFilter{
+	WeaponTypes: []proto.WeaponType{
+		proto.WeaponType_WeaponTypeSword,
+		proto.WeaponType_WeaponTypeDagger,
+		proto.WeaponType_WeaponTypeStaff,
+	},
+	HandTypes: []proto.HandType{
+		proto.HandType_HandTypeOffHand,
+	},
+	ArmorType: proto.ArmorType_ArmorTypeCloth,
+	RangedWeaponTypes: []proto.RangedWeaponType{
+		proto.RangedWeaponType_RangedWeaponTypeWand,
+	},
 }
 
 func NewAfflictionWarlock(character *core.Character, options *proto.Player) *AfflictionWarlock {
@@ -44,6 +60,7 @@ type AfflictionWarlock struct {
 	*warlock.Warlock
 
 	SoulShards         core.SecondaryResourceBar
+	Haunt              *core.Spell
 	Agony              *core.Spell
 	UnstableAffliction *core.Spell
 
@@ -114,15 +131,22 @@ func (affliction *AfflictionWarlock) Reset(sim *core.Simulation) {
 
 func (affliction *AfflictionWarlock) OnEncounterStart(sim *core.Simulation) {
 	defaultShards := MaxSoulShards
+	if affliction.Options.CustomStartingResources {
+		defaultShards = min(max(float64(affliction.Options.StartingSoulShards), 0), MaxSoulShards)
+	}
+
+	if affliction.Options.PrepullSoulburn && (defaultShards >= 1) {
+		affliction.SoulBurnAura.Activate(sim)
+	}
+
 	if affliction.SoulBurnAura.IsActive() {
 		defaultShards -= 1
 	}
 
-	haunt := affliction.GetSpell(core.ActionID{SpellID: HauntSpellID})
-	count := float64(affliction.SpellsInFlight[haunt])
+	count := float64(affliction.SpellsInFlight[affliction.Haunt])
 	defaultShards -= count
 
-	affliction.SoulShards.ResetBarTo(sim, defaultShards)
+	affliction.SoulShards.ResetBarTo(sim, max(defaultShards, 0))
 	affliction.Warlock.OnEncounterStart(sim)
 }
 
@@ -27,18 +27,16 @@ func TestAffliction(t *testing.T) {
 		},
 	}
 
-	var itemFilter = core.ItemFilter{
-		WeaponTypes: []proto.WeaponType{
-			proto.WeaponType_WeaponTypeSword,
-			proto.WeaponType_WeaponTypeDagger,
-			proto.WeaponType_WeaponTypeStaff,
-		},
-		HandTypes: []proto.HandType{
-			proto.HandType_HandTypeOffHand,
-		},
-		ArmorType: proto.ArmorType_ArmorTypeCloth,
-		RangedWeaponTypes: []proto.RangedWeaponType{
-			proto.RangedWeaponType_RangedWeaponTypeWand,
+	var customResourcesAfflictionWarlock = &proto.Player_AfflictionWarlock{
+		AfflictionWarlock: &proto.AfflictionWarlock{
+			Options: &proto.AfflictionWarlock_Options{
+				ClassOptions: &proto.WarlockOptions{
+					Summon:                  proto.WarlockOptions_Felhunter,
+					CustomStartingResources: true,
+					StartingSoulShards:      3,
+					PrepullSoulburn:         true,
+				},
+			},
 		},
 	}
 
@@ -63,15 +61,26 @@ func TestAffliction(t *testing.T) {
 				Major1: int32(proto.WarlockMajorGlyph_GlyphOfSiphonLife),
 				Major2: int32(proto.WarlockMajorGlyph_GlyphOfUnstableAffliction),
 			},
-			Consumables:      fullConsumesSpec,
-			SpecOptions:      core.SpecOptionsCombo{Label: "Affliction Warlock", SpecOptions: defaultAfflictionWarlock},
-			OtherSpecOptions: []core.SpecOptionsCombo{},
-			Rotation:         core.GetAplRotation("../../../ui/warlock/affliction/apls", "default"),
+			Consumables: fullConsumesSpec,
+			SpecOptions: core.SpecOptionsCombo{Label: "Affliction Warlock", SpecOptions: defaultAfflictionWarlock},
+			OtherSpecOptions: []core.SpecOptionsCombo{
+				{Label: "Prepull Soulburn", SpecOptions: customResourcesAfflictionWarlock},
+			},
+			Rotation: core.GetAplRotation("../../../ui/warlock/affliction/apls", "default"),
 			OtherRotations: []core.RotationCombo{
 				core.GetAplRotation("../../../ui/warlock/affliction/apls", "multitarget"),
 			},
-			ItemFilter:       itemFilter,
+			ItemFilter:       ItemFilter,
 			StartingDistance: 25,
+
+			APLCoverageExemptions: []core.ActionID{
+				{SpellID: 1490},   // Curse of the Elements
+				{SpellID: 1122},   // Summon Infernal
+				{SpellID: 27243},  // Seed of Corruption
+				{SpellID: 86213},  // Soul Swap
+				{SpellID: 86121},  // Soul Swap
+				{SpellID: 111400}, // Burning Rush
+			},
 		},
 	}))
 }
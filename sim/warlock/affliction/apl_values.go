@@ -20,6 +20,8 @@ func (warlock *AfflictionWarlock) NewAPLValue(rot *core.APLRotation, config *pro
 		return warlock.newAfflictionCurrentSnapshot(rot, config.GetAfflictionCurrentSnapshot(), config.Uuid)
 	case *proto.APLValue_AfflictionExhaleWindow:
 		return warlock.newValueExhaleWindow(config.GetAfflictionExhaleWindow(), config.Uuid)
+	case *proto.APLValue_AfflictionHauntShardCoverage:
+		return warlock.newValueHauntShardCoverage(rot, config.GetAfflictionHauntShardCoverage(), config.Uuid)
 	default:
 		return warlock.Warlock.NewAPLValue(rot, config)
 	}
@@ -200,3 +202,31 @@ func (value *APLValueExhaleWindow) GetDuration(sim *core.Simulation) time.Durati
 func (value *APLValueExhaleWindow) String() string {
 	return "Exhale Window()"
 }
+
+// Haunt remaining on the target, plus the Haunt duration still to come from
+// Haunts in flight and from spending every available Soul Shard on Haunt.
+type APLValueHauntShardCoverage struct {
+	core.DefaultAPLValueImpl
+	warlock   *AfflictionWarlock
+	haunt     *core.Spell
+	targetRef core.UnitReference
+}
+
+func (warlock *AfflictionWarlock) newValueHauntShardCoverage(rot *core.APLRotation, config *proto.APLValueAfflictionHauntShardCoverage, _ *proto.UUID) core.APLValue {
+	return &APLValueHauntShardCoverage{
+		warlock:   warlock,
+		haunt:     warlock.Haunt,
+		targetRef: rot.GetTargetUnit(config.TargetUnit),
+	}
+}
+func (value *APLValueHauntShardCoverage) Type() proto.APLValueType {
+	return proto.APLValueType_ValueTypeDuration
+}
+func (value *APLValueHauntShardCoverage) GetDuration(sim *core.Simulation) time.Duration {
+	dot := value.haunt.Dot(value.targetRef.Get())
+	pendingHaunts := float64(value.warlock.SpellsInFlight[value.haunt]) + value.warlock.SoulShards.Value()
+	return dot.RemainingDuration(sim) + time.Duration(pendingHaunts*float64(dot.BaseDuration()))
+}
+func (value *APLValueHauntShardCoverage) String() string {
+	return fmt.Sprintf("Haunt Shard Coverage(%s)", value.targetRef.String())
+}
@@ -21,7 +21,7 @@ const hauntCoeff = 2.625
 func (affliction *AfflictionWarlock) registerHaunt() {
 	actionID := core.ActionID{SpellID: HauntSpellID}
 
-	affliction.RegisterSpell(core.SpellConfig{
+	affliction.Haunt = affliction.RegisterSpell(core.SpellConfig{
 		ActionID:       actionID,
 		SpellSchool:    core.SpellSchoolShadow,
 		ProcMask:       core.ProcMaskSpellDamage,
@@ -9,6 +9,12 @@ func (warlock *DemonologyWarlock) NewAPLValue(rot *core.APLRotation, config *pro
 	switch config.Value.(type) {
 	case *proto.APLValue_WarlockHandOfGuldanInFlight:
 		return warlock.newValueWarlockHandOfGuldanInFlight(rot, config.GetWarlockHandOfGuldanInFlight())
+	case *proto.APLValue_DemonologyDemonicFury:
+		return warlock.newValueDemonicFury(config.GetDemonologyDemonicFury())
+	case *proto.APLValue_DemonologyInMetamorphosis:
+		return warlock.newValueInMetamorphosis(config.GetDemonologyInMetamorphosis())
+	case *proto.APLValue_DemonologyFuryDrainRate:
+		return warlock.newValueFuryDrainRate(config.GetDemonologyFuryDrainRate())
 	default:
 		return warlock.Warlock.NewAPLValue(rot, config)
 	}
@@ -34,3 +40,63 @@ func (value *APLValueWarlockHandOfGuldanInFlight) GetBool(sim *core.Simulation)
 func (value *APLValueWarlockHandOfGuldanInFlight) String() string {
 	return "Warlock Hand of Guldan in Flight()"
 }
+
+type APLValueDemonicFury struct {
+	core.DefaultAPLValueImpl
+	warlock *DemonologyWarlock
+}
+
+func (warlock *DemonologyWarlock) newValueDemonicFury(_ *proto.APLValueDemonologyDemonicFury) core.APLValue {
+	return &APLValueDemonicFury{
+		warlock: warlock,
+	}
+}
+func (value *APLValueDemonicFury) Type() proto.APLValueType {
+	return proto.APLValueType_ValueTypeFloat
+}
+func (value *APLValueDemonicFury) GetFloat(sim *core.Simulation) float64 {
+	return value.warlock.DemonicFury.Value()
+}
+func (value *APLValueDemonicFury) String() string {
+	return "Demonic Fury()"
+}
+
+type APLValueInMetamorphosis struct {
+	core.DefaultAPLValueImpl
+	warlock *DemonologyWarlock
+}
+
+func (warlock *DemonologyWarlock) newValueInMetamorphosis(_ *proto.APLValueDemonologyInMetamorphosis) core.APLValue {
+	return &APLValueInMetamorphosis{
+		warlock: warlock,
+	}
+}
+func (value *APLValueInMetamorphosis) Type() proto.APLValueType {
+	return proto.APLValueType_ValueTypeBool
+}
+func (value *APLValueInMetamorphosis) GetBool(sim *core.Simulation) bool {
+	return value.warlock.IsInMeta()
+}
+func (value *APLValueInMetamorphosis) String() string {
+	return "In Metamorphosis()"
+}
+
+type APLValueFuryDrainRate struct {
+	core.DefaultAPLValueImpl
+	warlock *DemonologyWarlock
+}
+
+func (warlock *DemonologyWarlock) newValueFuryDrainRate(_ *proto.APLValueDemonologyFuryDrainRate) core.APLValue {
+	return &APLValueFuryDrainRate{
+		warlock: warlock,
+	}
+}
+func (value *APLValueFuryDrainRate) Type() proto.APLValueType {
+	return proto.APLValueType_ValueTypeFloat
+}
+func (value *APLValueFuryDrainRate) GetFloat(sim *core.Simulation) float64 {
+	return value.warlock.DemonicFuryDrainRate()
+}
+func (value *APLValueFuryDrainRate) String() string {
+	return "Demonic Fury Drain Rate()"
+}
@@ -23,6 +23,19 @@ func RegisterDemonologyWarlock() {
 			player.Spec = playerSpec
 		},
 	)
+	core.RegisterItemFilter(proto.Spec_SpecDemonologyWarlock, ItemFilter)
+}
+
+var ItemFilter = core.ItemFilter{
+	WeaponTypes: []proto.WeaponType{
+		proto.WeaponType_WeaponTypeSword,
+		proto.WeaponType_WeaponTypeDagger,
+		proto.WeaponType_WeaponTypeStaff,
+	},
+	HandTypes: []proto.HandType{
+		proto.HandType_HandTypeOffHand,
+	},
+	ArmorType: proto.ArmorType_ArmorTypeCloth,
 }
 
 func NewDemonologyWarlock(character *core.Character, options *proto.Player) *DemonologyWarlock {
@@ -41,10 +54,11 @@ func NewDemonologyWarlock(character *core.Character, options *proto.Player) *Dem
 type DemonologyWarlock struct {
 	*warlock.Warlock
 
-	DemonicFury   core.SecondaryResourceBar
-	Metamorphosis *core.Spell
-	HandOfGuldan  *core.Spell
-	ChaosWave     *core.Spell
+	DemonicFury    core.SecondaryResourceBar
+	Metamorphosis  *core.Spell
+	HandOfGuldan   *core.Spell
+	ChaosWave      *core.Spell
+	ImmolationAura *core.Spell
 
 	MoltenCore *core.Aura
 
@@ -59,13 +73,14 @@ func (demonology *DemonologyWarlock) GetWarlock() *warlock.Warlock {
 }
 
 const DefaultDemonicFury = 200
+const MaxDemonicFury = 1000
 
 func (demonology *DemonologyWarlock) Initialize() {
 	demonology.Warlock.Initialize()
 
 	demonology.DemonicFury = demonology.RegisterNewDefaultSecondaryResourceBar(core.SecondaryResourceConfig{
 		Type:    proto.SecondaryResourceType_SecondaryResourceTypeDemonicFury,
-		Max:     1000, // Multiplied by 10 to avoid having to refactor to float
+		Max:     MaxDemonicFury, // Multiplied by 10 to avoid having to refactor to float
 		Default: DefaultDemonicFury,
 	})
 
@@ -87,6 +102,7 @@ func (demonology *DemonologyWarlock) Initialize() {
 	demonology.registerVoidRay()
 	demonology.registerDarksoulKnowledge()
 	demonology.registerImpSwarm()
+	demonology.registerMetamorphosisSubstitutions()
 
 	demonology.registerHotfixes()
 }
@@ -106,7 +122,12 @@ func (demonology *DemonologyWarlock) Reset(sim *core.Simulation) {
 }
 
 func (demonology *DemonologyWarlock) OnEncounterStart(sim *core.Simulation) {
-	demonology.DemonicFury.ResetBarTo(sim, DefaultDemonicFury)
+	startingFury := float64(DefaultDemonicFury)
+	if demonology.Options.CustomStartingResources {
+		startingFury = min(max(float64(demonology.Options.StartingDemonicFury), 0), MaxDemonicFury)
+	}
+
+	demonology.DemonicFury.ResetBarTo(sim, startingFury)
 	demonology.Warlock.OnEncounterStart(sim)
 }
 
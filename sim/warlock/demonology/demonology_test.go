@@ -24,18 +24,6 @@ func TestDemonology(t *testing.T) {
 		},
 	}
 
-	var itemFilter = core.ItemFilter{
-		WeaponTypes: []proto.WeaponType{
-			proto.WeaponType_WeaponTypeSword,
-			proto.WeaponType_WeaponTypeDagger,
-			proto.WeaponType_WeaponTypeStaff,
-		},
-		HandTypes: []proto.HandType{
-			proto.HandType_HandTypeOffHand,
-		},
-		ArmorType: proto.ArmorType_ArmorTypeCloth,
-	}
-
 	var fullConsumesSpec = &proto.ConsumesSpec{
 		FlaskId:  76085, // Flask of the Warm Sun
 		FoodId:   74650, // Mogu Fish Stew
@@ -59,8 +47,24 @@ func TestDemonology(t *testing.T) {
 			SpecOptions:      core.SpecOptionsCombo{Label: "Demonology Warlock", SpecOptions: defaultDemonologyWarlock},
 			OtherSpecOptions: []core.SpecOptionsCombo{},
 			Rotation:         core.GetAplRotation("../../../ui/warlock/demonology/apls", "uvls"),
-			ItemFilter:       itemFilter,
+			ItemFilter:       ItemFilter,
 			StartingDistance: 25,
+
+			APLCoverageExemptions: []core.ActionID{
+				{SpellID: 111859}, // Grimoire: Imp
+				{SpellID: 111895}, // Grimoire: Voidwalker
+				{SpellID: 111896}, // Grimoire: Succubus
+				{SpellID: 111897}, // Grimoire: Felhunter
+				{SpellID: 1490},   // Curse of the Elements
+				{SpellID: 1122},   // Summon Infernal
+				{SpellID: 77799},  // Fel Flame
+				{SpellID: 689},    // Drain Life
+				{SpellID: 1949},   // Hellfire
+				{SpellID: 103967}, // Carrion Swarm
+				{SpellID: 124916}, // Chaos Wave
+				{SpellID: 115422}, // Void Ray
+				{SpellID: 111400}, // Burning Rush
+			},
 		},
 	}))
 }
@@ -2,11 +2,13 @@ package demonology
 
 import "github.com/wowsims/mop/sim/core"
 
+const drainLifeMetaFuryPerTick = 30
+
 func (demo *DemonologyWarlock) registerDrainLife() {
 	demo.RegisterDrainLife(func(_ core.SpellResultSlice, spell *core.Spell, sim *core.Simulation) {
 		if demo.IsInMeta() {
-			if demo.CanSpendDemonicFury(30) {
-				demo.SpendDemonicFury(sim, 30, spell.ActionID)
+			if demo.CanSpendDemonicFury(drainLifeMetaFuryPerTick) {
+				demo.SpendDemonicFury(sim, drainLifeMetaFuryPerTick, spell.ActionID)
 			} else {
 				demo.ChanneledDot.Deactivate(sim)
 			}
@@ -9,11 +9,12 @@ import (
 
 const immolationAuraScale = 0.17499999702
 const immolationAuraCoeff = 0.17499999702
+const immolationAuraFuryPerTick = 25
 
 func (demonology *DemonologyWarlock) registerImmolationAura() {
 	var baseDamage = demonology.CalcScalingSpellDmg(immolationAuraScale)
 
-	immolationAura := demonology.RegisterSpell(core.SpellConfig{
+	demonology.ImmolationAura = demonology.RegisterSpell(core.SpellConfig{
 		ActionID:       core.ActionID{SpellID: 104025},
 		SpellSchool:    core.SpellSchoolFire,
 		ProcMask:       core.ProcMaskSpellDamage,
@@ -42,18 +43,18 @@ func (demonology *DemonologyWarlock) registerImmolationAura() {
 			IsAOE:                true,
 
 			OnTick: func(sim *core.Simulation, _ *core.Unit, dot *core.Dot) {
-				if !demonology.CanSpendDemonicFury(25) {
+				if !demonology.CanSpendDemonicFury(immolationAuraFuryPerTick) {
 					dot.Deactivate(sim)
 					return
 				}
 
-				demonology.SpendDemonicFury(sim, 25, dot.Spell.ActionID)
+				demonology.SpendDemonicFury(sim, immolationAuraFuryPerTick, dot.Spell.ActionID)
 				dot.Spell.CalcAndDealPeriodicAoeDamage(sim, baseDamage, dot.OutcomeTick)
 			},
 		},
 
 		ExtraCastCondition: func(sim *core.Simulation, target *core.Unit) bool {
-			return demonology.IsInMeta() && demonology.CanSpendDemonicFury(25)
+			return demonology.IsInMeta() && demonology.CanSpendDemonicFury(immolationAuraFuryPerTick)
 		},
 		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
 			spell.AOEDot().Apply(sim)
@@ -61,6 +62,6 @@ func (demonology *DemonologyWarlock) registerImmolationAura() {
 	})
 
 	demonology.Metamorphosis.RelatedSelfBuff.ApplyOnExpire(func(aura *core.Aura, sim *core.Simulation) {
-		immolationAura.AOEDot().Deactivate(sim)
+		demonology.ImmolationAura.AOEDot().Deactivate(sim)
 	})
 }
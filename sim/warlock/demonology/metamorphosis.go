@@ -7,6 +7,8 @@ import (
 	"github.com/wowsims/mop/sim/warlock"
 )
 
+const metamorphosisFuryPerSecond = 6
+
 func (demo *DemonologyWarlock) registerMetamorphosis() {
 	metaActionId := core.ActionID{SpellID: 103958}
 
@@ -47,7 +49,7 @@ func (demo *DemonologyWarlock) registerMetamorphosis() {
 					return
 				}
 
-				demo.SpendUpToDemonicFury(sim, 6, metaActionId)
+				demo.SpendUpToDemonicFury(sim, metamorphosisFuryPerSecond, metaActionId)
 				if demo.DemonicFury.Value() < 50 {
 					metaAura.Deactivate(sim)
 					return
@@ -93,6 +95,8 @@ func (demo *DemonologyWarlock) registerMetamorphosis() {
 		RelatedSelfBuff: metaAura,
 	})
 
+	demo.DemonicFury.TrackAura(metaAura)
+
 	demo.RegisterSpell(core.SpellConfig{
 		ActionID:    metaActionId.WithTag(1),
 		Flags:       core.SpellFlagAPL | core.SpellFlagNoOnCastComplete,
@@ -115,3 +119,50 @@ func (demo *DemonologyWarlock) registerMetamorphosis() {
 		},
 	})
 }
+
+// While in Metamorphosis the caster spells are replaced by their demon form
+// counterparts, so APLs can keep casting e.g. Shadow Bolt in both forms.
+var metamorphosisSubstitutions = []struct {
+	caster core.ActionID
+	meta   core.ActionID
+}{
+	{caster: core.ActionID{SpellID: 686}, meta: core.ActionID{SpellID: 103964}},    // Shadow Bolt -> Touch of Chaos
+	{caster: core.ActionID{SpellID: 105174}, meta: core.ActionID{SpellID: 124916}}, // Hand of Gul'dan -> Chaos Wave
+	{caster: core.ActionID{SpellID: 172}, meta: core.ActionID{SpellID: 603}},       // Corruption -> Doom
+	{caster: core.ActionID{SpellID: 77799}, meta: core.ActionID{SpellID: 115422}},  // Fel Flame -> Void Ray
+	{caster: core.ActionID{SpellID: 1949}, meta: core.ActionID{SpellID: 104025}},   // Hellfire -> Immolation Aura
+	{caster: core.ActionID{SpellID: 6353}, meta: core.ActionID{SpellID: 104027}},   // Soul Fire -> Soul Fire (Metamorphosis)
+}
+
+func (demo *DemonologyWarlock) registerMetamorphosisSubstitutions() {
+	for _, substitution := range metamorphosisSubstitutions {
+		caster := demo.GetSpell(substitution.caster)
+		meta := demo.GetSpell(substitution.meta)
+		if caster == nil || meta == nil {
+			continue
+		}
+
+		caster.RegisterSubstitution(demo.Metamorphosis.RelatedSelfBuff, meta)
+	}
+}
+
+// Demonic Fury spent per second right now, from Metamorphosis itself and
+// from Immolation Aura or Drain Life ticking while in demon form.
+func (demo *DemonologyWarlock) DemonicFuryDrainRate() float64 {
+	if !demo.IsInMeta() {
+		return 0
+	}
+
+	drain := float64(metamorphosisFuryPerSecond)
+	if dot := demo.ImmolationAura.AOEDot(); dot.IsActive() {
+		drain += immolationAuraFuryPerTick / dot.TickPeriod().Seconds()
+	}
+	if dot := demo.ChanneledDot; (dot != nil) && (dot.Spell == demo.DrainLife) && dot.IsActive() {
+		drain += drainLifeMetaFuryPerTick / dot.TickPeriod().Seconds()
+	}
+
+	if demo.T15_2pc.IsActive() {
+		drain *= 0.7
+	}
+	return drain
+}
@@ -21,11 +21,28 @@ func RegisterDestructionWarlock() {
 			player.Spec = playerSpec
 		},
 	)
+	core.RegisterItemFilter(proto.Spec_SpecDestructionWarlock, ItemFilter)
+}
+
+var ItemFilter = core.ItemFilter{
+	WeaponTypes: []proto.WeaponType{
+		proto.WeaponType_WeaponTypeSword,
+		proto.WeaponType_WeaponTypeDagger,
+		proto.WeaponType_WeaponTypeStaff,
+	},
+	HandTypes: []proto.HandType{
+		proto.HandType_HandTypeOffHand,
+	},
+	ArmorType: proto.ArmorType_ArmorTypeCloth,
+	RangedWeaponTypes: []proto.RangedWeaponType{
+		proto.RangedWeaponType_RangedWeaponTypeWand,
+	},
 }
 
 const SpellFlagDestructionHavoc = core.SpellFlagAgentReserved1
 
 const DefaultBurningEmbers = 10
+const MaxBurningEmbers = 40
 
 func NewDestructionWarlock(character *core.Character, options *proto.Player) *DestructionWarlock {
 	destroOptions := options.GetDestructionWarlock().Options
@@ -35,7 +52,7 @@ func NewDestructionWarlock(character *core.Character, options *proto.Player) *De
 
 	destruction.BurningEmbers = destruction.RegisterNewDefaultSecondaryResourceBar(core.SecondaryResourceConfig{
 		Type:    proto.SecondaryResourceType_SecondaryResourceTypeBurningEmbers,
-		Max:     40,
+		Max:     MaxBurningEmbers,
 		Default: DefaultBurningEmbers,
 	})
 
@@ -95,7 +112,13 @@ func (destruction *DestructionWarlock) Reset(sim *core.Simulation) {
 }
 
 func (destruction *DestructionWarlock) OnEncounterStart(sim *core.Simulation) {
-	destruction.BurningEmbers.ResetBarTo(sim, DefaultBurningEmbers)
+	startingEmbers := float64(DefaultBurningEmbers)
+	if destruction.Options.CustomStartingResources {
+		// Embers are tracked in tenths on the bar
+		startingEmbers = min(max(destruction.Options.StartingBurningEmbers*10, 0), MaxBurningEmbers)
+	}
+
+	destruction.BurningEmbers.ResetBarTo(sim, startingEmbers)
 	destruction.Warlock.OnEncounterStart(sim)
 }
 
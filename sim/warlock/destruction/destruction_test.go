@@ -25,21 +25,6 @@ func TestDestruction(t *testing.T) {
 		},
 	}
 
-	var itemFilter = core.ItemFilter{
-		WeaponTypes: []proto.WeaponType{
-			proto.WeaponType_WeaponTypeSword,
-			proto.WeaponType_WeaponTypeDagger,
-			proto.WeaponType_WeaponTypeStaff,
-		},
-		HandTypes: []proto.HandType{
-			proto.HandType_HandTypeOffHand,
-		},
-		ArmorType: proto.ArmorType_ArmorTypeCloth,
-		RangedWeaponTypes: []proto.RangedWeaponType{
-			proto.RangedWeaponType_RangedWeaponTypeWand,
-		},
-	}
-
 	var fullConsumesSpec = &proto.ConsumesSpec{
 		FlaskId:  76085, // Flask of the Warm Sun
 		FoodId:   74650, // Mogu Fish Stew
@@ -61,8 +46,22 @@ func TestDestruction(t *testing.T) {
 			SpecOptions:      core.SpecOptionsCombo{Label: "Destruction Warlock", SpecOptions: defaultDestructionWarlock},
 			OtherSpecOptions: []core.SpecOptionsCombo{},
 			Rotation:         core.GetAplRotation("../../../ui/warlock/destruction/apls", "default"),
-			ItemFilter:       itemFilter,
+			ItemFilter:       ItemFilter,
 			StartingDistance: 25,
+
+			APLCoverageExemptions: []core.ActionID{
+				{SpellID: 1490},   // Curse of the Elements
+				{SpellID: 1122},   // Summon Infernal
+				{SpellID: 1454},   // Life Tap
+				{SpellID: 77799},  // Fel Flame
+				{SpellID: 108683}, // Fire and Brimstone
+				{SpellID: 108685}, // Conflagrate (Fire and Brimstone)
+				{SpellID: 108686}, // Immolate (Fire and Brimstone)
+				{SpellID: 114654}, // Incinerate (Fire and Brimstone)
+				{SpellID: 80240},  // Havoc
+				{SpellID: 689},    // Drain Life
+				{SpellID: 111400}, // Burning Rush
+			},
 		},
 	}))
 }
@@ -9,11 +9,12 @@ import (
 
 func (destruction *DestructionWarlock) registerFireAndBrimstoneConflagrate() {
 	destruction.FABConflagrate = destruction.RegisterSpell(core.SpellConfig{
-		ActionID:       core.ActionID{SpellID: 108685},
-		SpellSchool:    core.SpellSchoolFire,
-		ProcMask:       core.ProcMaskSpellDamage,
-		Flags:          core.SpellFlagAoE | core.SpellFlagAPL,
-		ClassSpellMask: warlock.WarlockSpellFaBConflagrate,
+		ActionID:        core.ActionID{SpellID: 108685},
+		MetricsActionID: core.ActionID{SpellID: 17962},
+		SpellSchool:     core.SpellSchoolFire,
+		ProcMask:        core.ProcMaskSpellDamage,
+		Flags:           core.SpellFlagAoE | core.SpellFlagAPL,
+		ClassSpellMask:  warlock.WarlockSpellFaBConflagrate,
 
 		Cast: core.CastConfig{
 			DefaultCast: core.Cast{
@@ -9,11 +9,12 @@ import (
 
 func (destruction *DestructionWarlock) registerFireAndBrimstoneImmolate() {
 	fabImmolate := destruction.RegisterSpell(core.SpellConfig{
-		ActionID:       core.ActionID{SpellID: 108686},
-		SpellSchool:    core.SpellSchoolFire,
-		ProcMask:       core.ProcMaskSpellDamage,
-		Flags:          core.SpellFlagAPL,
-		ClassSpellMask: warlock.WarlockSpellImmolate,
+		ActionID:        core.ActionID{SpellID: 108686},
+		MetricsActionID: core.ActionID{SpellID: 348},
+		SpellSchool:     core.SpellSchoolFire,
+		ProcMask:        core.ProcMaskSpellDamage,
+		Flags:           core.SpellFlagAPL,
+		ClassSpellMask:  warlock.WarlockSpellImmolate,
 
 		ManaCost: core.ManaCostOptions{BaseCostPercent: 3},
 		Cast: core.CastConfig{
@@ -60,11 +61,12 @@ func (destruction *DestructionWarlock) registerFireAndBrimstoneImmolate() {
 	})
 
 	fabImmolate.RelatedDotSpell = destruction.RegisterSpell(core.SpellConfig{
-		ActionID:       core.ActionID{SpellID: 108686}.WithTag(1),
-		SpellSchool:    core.SpellSchoolFire,
-		ProcMask:       core.ProcMaskSpellDamage,
-		ClassSpellMask: warlock.WarlockSpellImmolateDot,
-		Flags:          core.SpellFlagPassiveSpell,
+		ActionID:        core.ActionID{SpellID: 108686}.WithTag(1),
+		MetricsActionID: core.ActionID{SpellID: 348}.WithTag(1),
+		SpellSchool:     core.SpellSchoolFire,
+		ProcMask:        core.ProcMaskSpellDamage,
+		ClassSpellMask:  warlock.WarlockSpellImmolateDot,
+		Flags:           core.SpellFlagPassiveSpell,
 
 		DamageMultiplier: 1,
 		CritMultiplier:   destruction.DefaultCritMultiplier(),
@@ -12,12 +12,13 @@ var bafIncinerateCoeff = 1.568
 
 func (destruction *DestructionWarlock) registerFireAndBrimstoneIncinerate() {
 	destruction.RegisterSpell(core.SpellConfig{
-		ActionID:       core.ActionID{SpellID: 114654},
-		SpellSchool:    core.SpellSchoolFire,
-		ProcMask:       core.ProcMaskSpellDamage,
-		Flags:          core.SpellFlagAoE | core.SpellFlagAPL,
-		MissileSpeed:   24,
-		ClassSpellMask: warlock.WarlockSpellFaBIncinerate,
+		ActionID:        core.ActionID{SpellID: 114654},
+		MetricsActionID: core.ActionID{SpellID: 29722},
+		SpellSchool:     core.SpellSchoolFire,
+		ProcMask:        core.ProcMaskSpellDamage,
+		Flags:           core.SpellFlagAoE | core.SpellFlagAPL,
+		MissileSpeed:    24,
+		ClassSpellMask:  warlock.WarlockSpellFaBIncinerate,
 
 		ManaCost: core.ManaCostOptions{BaseCostPercent: 5},
 		Cast: core.CastConfig{
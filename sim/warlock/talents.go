@@ -289,3 +289,60 @@ func (warlock *Warlock) registerGrimoireOfSacrifice() {
 	applyPetHook(warlock.Felhunter)
 	applyPetHook(warlock.Voidwalker)
 }
+
+// Burning Rush trades 4% of maximum health per second for 50% movement speed
+// until it is cast again, so the rotation decides which movements it is used
+// for. It is cancelled rather than draining the warlock's last health.
+func (warlock *Warlock) registerBurningRush() {
+	if !warlock.Talents.BurningRush {
+		return
+	}
+
+	actionID := core.ActionID{SpellID: 111400}
+
+	var healthDrain *core.PendingAction
+	aura := warlock.RegisterAura(core.Aura{
+		Label:    "Burning Rush",
+		ActionID: actionID,
+		Duration: core.NeverExpires,
+
+		OnGain: func(aura *core.Aura, sim *core.Simulation) {
+			healthDrain = core.StartPeriodicAction(sim, core.PeriodicActionOptions{
+				Period: time.Second,
+				OnAction: func(sim *core.Simulation) {
+					drain := warlock.MaxHealth() * 0.04
+					if warlock.CurrentHealth() <= drain {
+						aura.Deactivate(sim)
+						return
+					}
+					warlock.RemoveHealth(sim, drain)
+				},
+			})
+		},
+		OnExpire: func(aura *core.Aura, sim *core.Simulation) {
+			healthDrain.Cancel(sim)
+		},
+	})
+	aura.NewActiveMovementSpeedEffect(0.5)
+
+	warlock.RegisterSpell(core.SpellConfig{
+		ActionID:    actionID,
+		SpellSchool: core.SpellSchoolFire,
+		Flags:       core.SpellFlagAPL,
+		ProcMask:    core.ProcMaskEmpty,
+
+		Cast: core.CastConfig{
+			DefaultCast: core.Cast{
+				GCD: core.GCDDefault,
+			},
+		},
+
+		ApplyEffects: func(sim *core.Simulation, _ *core.Unit, _ *core.Spell) {
+			if aura.IsActive() {
+				aura.Deactivate(sim)
+			} else {
+				aura.Activate(sim)
+			}
+		},
+	})
+}
@@ -59,6 +59,7 @@ func (warlock *Warlock) ApplyTalents() {
 	warlock.registerMannarothsFury()
 	warlock.registerGrimoireOfSupremacy()
 	warlock.registerGrimoireOfSacrifice()
+	warlock.registerBurningRush()
 }
 
 func (warlock *Warlock) Initialize() {
@@ -263,6 +264,7 @@ func (s *SecondaryResourceCost) CostFailureReason(_ *core.Simulation, spell *cor
 func (s *SecondaryResourceCost) IssueRefund(sim *core.Simulation, spell *core.Spell) {
 	curCost := spell.Cost.PercentModifier * float64(s.SecondaryCost)
 	spell.Unit.GetSecondaryResourceBar().Gain(sim, curCost, spell.ActionID)
+	spell.RecordRefund(curCost)
 }
 
 // MeetsRequirement implements core.ResourceCostImpl.
@@ -31,6 +31,23 @@ func TestArms(t *testing.T) {
 			Rotation:    core.GetAplRotation("../../../ui/warrior/arms/apls", "arms"),
 
 			ItemFilter: ItemFilter,
+
+			APLCoverageExemptions: []core.ActionID{
+				{SpellID: 71},      // Defensive Stance
+				{SpellID: 2458},    // Berserker Stance
+				{SpellID: 469},     // Commanding Shout
+				{SpellID: 55694},   // Enraged Regeneration
+				{SpellID: 97462},   // Rallying Cry
+				{SpellID: 845},     // Cleave
+				{SpellID: 57755},   // Heroic Throw
+				{SpellID: 34428},   // Victory Rush
+				{SpellID: 871},     // Shield Wall
+				{SpellID: 7386},    // Sunder Armor
+				{SpellID: 1715},    // Hamstring
+				{SpellID: 6343},    // Thunder Clap
+				{SpellID: 1680},    // Whirlwind
+				{SpellID: 1250616}, // Sweeping Strikes
+			},
 		},
 	}))
 }
@@ -56,13 +73,3 @@ var FullConsumesSpec = &proto.ConsumesSpec{
 	PotId:    76095, // Potion of Mogu Power
 	PrepotId: 76095, // Potion of Mogu Power
 }
-
-var ItemFilter = core.ItemFilter{
-	ArmorType: proto.ArmorType_ArmorTypePlate,
-
-	WeaponTypes: []proto.WeaponType{
-		proto.WeaponType_WeaponTypeAxe,
-		proto.WeaponType_WeaponTypeSword,
-		proto.WeaponType_WeaponTypeMace,
-	},
-}
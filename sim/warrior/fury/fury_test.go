@@ -40,23 +40,25 @@ func TestFury(t *testing.T) {
 			StartingDistance: 25,
 
 			ItemFilter: ItemFilter,
+
+			APLCoverageExemptions: []core.ActionID{
+				{SpellID: 103840}, // Impending Victory
+				{SpellID: 71},     // Defensive Stance
+				{SpellID: 2458},   // Berserker Stance
+				{SpellID: 469},    // Commanding Shout
+				{SpellID: 97462},  // Rallying Cry
+				{SpellID: 845},    // Cleave
+				{SpellID: 871},    // Shield Wall
+				{SpellID: 7386},   // Sunder Armor
+				{SpellID: 1715},   // Hamstring
+				{SpellID: 6343},   // Thunder Clap
+				{SpellID: 1680},   // Whirlwind
+				{SpellID: 6552},   // Pummel
+			},
 		},
 	}))
 }
 
-var ItemFilter = core.ItemFilter{
-	ArmorType: proto.ArmorType_ArmorTypePlate,
-
-	WeaponTypes: []proto.WeaponType{
-		proto.WeaponType_WeaponTypeAxe,
-		proto.WeaponType_WeaponTypeSword,
-		proto.WeaponType_WeaponTypeMace,
-		proto.WeaponType_WeaponTypePolearm,
-		proto.WeaponType_WeaponTypeDagger,
-		proto.WeaponType_WeaponTypeFist,
-	},
-}
-
 var SMFTalents = "133333"
 var TGTalents = "133133"
 var FuryGlyphs = &proto.Glyphs{
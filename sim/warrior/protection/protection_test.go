@@ -44,28 +44,24 @@ func TestProtectionWarrior(t *testing.T) {
 			InFrontOfTarget: true,
 
 			ItemFilter: ItemFilter,
+
+			APLCoverageExemptions: []core.ActionID{
+				{SpellID: 2457},  // Battle Stance
+				{SpellID: 2458},  // Berserker Stance
+				{SpellID: 469},   // Commanding Shout
+				{SpellID: 18499}, // Berserker Rage
+				{SpellID: 5308},  // Execute
+				{SpellID: 845},   // Cleave
+				{SpellID: 57755}, // Heroic Throw
+				{SpellID: 34428}, // Victory Rush
+				{SpellID: 1715},  // Hamstring
+				{SpellID: 6343},  // Thunder Clap
+				{SpellID: 6552},  // Pummel
+			},
 		},
 	}))
 }
 
-var ItemFilter = core.ItemFilter{
-	ArmorType: proto.ArmorType_ArmorTypePlate,
-
-	HandTypes: []proto.HandType{
-		proto.HandType_HandTypeMainHand,
-		proto.HandType_HandTypeOneHand,
-	},
-
-	WeaponTypes: []proto.WeaponType{
-		proto.WeaponType_WeaponTypeAxe,
-		proto.WeaponType_WeaponTypeSword,
-		proto.WeaponType_WeaponTypeMace,
-		proto.WeaponType_WeaponTypeDagger,
-		proto.WeaponType_WeaponTypeFist,
-		proto.WeaponType_WeaponTypeShield,
-	},
-}
-
 var DefaultTalents = "213332"
 var DefaultGlyphs = &proto.Glyphs{
 	Major1: int32(proto.WarriorMajorGlyph_GlyphOfHeavyRepercussions),
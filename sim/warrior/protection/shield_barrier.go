@@ -31,6 +31,7 @@ func (war *ProtectionWarrior) registerShieldBarrier() {
 			return newAbsorb
 		},
 	})
+	core.ActiveMitigation(war.ShieldBarrierAura.Aura)
 
 	war.RegisterSpell(core.SpellConfig{
 		ActionID:       actionID,
@@ -15,7 +15,7 @@ func (war *ProtectionWarrior) registerShieldBlock() {
 	atkTable := core.NewAttackTable(atkTableAttacker, &war.Unit)
 
 	extraAvoidance := 0.0
-	war.ShieldBlockAura = core.BlockPrepull(war.RegisterAura(core.Aura{
+	war.ShieldBlockAura = core.ActiveMitigation(core.BlockPrepull(war.RegisterAura(core.Aura{
 		Label:    "Shield Block",
 		ActionID: actionId,
 		Duration: time.Second * 6,
@@ -37,7 +37,7 @@ func (war *ProtectionWarrior) registerShieldBlock() {
 				war.CriticalBlockChance[1] -= extraAvoidance
 			}
 		},
-	}))
+	})))
 
 	war.RegisterSpell(core.SpellConfig{
 		ActionID:       actionId,
@@ -25,7 +25,10 @@ func (war *Warrior) registerPummel() {
 		CritMultiplier: war.DefaultCritMultiplier(),
 
 		ApplyEffects: func(sim *core.Simulation, target *core.Unit, spell *core.Spell) {
-			spell.CalcAndDealOutcome(sim, target, spell.OutcomeMeleeSpecialHit)
+			result := spell.CalcAndDealOutcome(sim, target, spell.OutcomeMeleeSpecialHit)
+			if result.Landed() {
+				target.InterruptCast(sim, time.Second*4)
+			}
 		},
 	})
 }
@@ -199,7 +199,7 @@ func (war *Warrior) registerBladestorm() {
 		Kind:      core.SpellMod_AllowCastWhileChanneling,
 	})
 
-	flags := core.SpellFlagChanneled | core.SpellFlagMeleeMetrics | core.SpellFlagAPL | core.SpellFlagCastWhileChanneling
+	flags := core.SpellFlagChanneled | core.SpellFlagMeleeMetrics | core.SpellFlagAPL | core.SpellFlagCastWhileChanneling | core.SpellFlagCanCastWhileMoving
 	if war.Spec != proto.Spec_SpecProtectionWarrior {
 		flags |= core.SpellFlagReadinessTrinket
 	}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/wowsims/mop/sim/core"
+	proto "github.com/wowsims/mop/sim/core/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+// Set when the server is started with an archive file.
+var archive *resultArchive
+
+// Append-only archive of sim results, stored as one JSON object per line so
+// the file stays readable when the server is killed mid-write.
+type resultArchive struct {
+	mu   sync.Mutex
+	path string
+}
+
+type archivedResult struct {
+	TimestampMs   int64           `json:"timestampMs"`
+	CharacterName string          `json:"characterName"`
+	Spec          string          `json:"spec"`
+	Dps           float64         `json:"dps"`
+	DpsStdev      float64         `json:"dpsStdev"`
+	Iterations    int32           `json:"iterations"`
+	Equipment     json.RawMessage `json:"equipment"` // EquipmentSpec in protojson format
+}
+
+func newResultArchive(path string) *resultArchive {
+	return &resultArchive{path: path}
+}
+
+// Appends the results of all players in the request to the archive.
+func (ra *resultArchive) record(request *proto.RaidSimRequest, result *proto.RaidSimResult) error {
+	if result.Error != nil {
+		return nil
+	}
+
+	var lines []byte
+	timestamp := time.Now().UnixMilli()
+	for partyIdx, party := range request.GetRaid().GetParties() {
+		for playerIdx, player := range party.Players {
+			if player.GetClass() == proto.Class_ClassUnknown || player.GetEquipment() == nil {
+				continue
+			}
+			if partyIdx >= len(result.RaidMetrics.Parties) || playerIdx >= len(result.RaidMetrics.Parties[partyIdx].Players) {
+				continue
+			}
+			metrics := result.RaidMetrics.Parties[partyIdx].Players[playerIdx]
+
+			equipment, err := protojson.Marshal(player.Equipment)
+			if err != nil {
+				return err
+			}
+			line, err := json.Marshal(archivedResult{
+				TimestampMs:   timestamp,
+				CharacterName: player.Name,
+				Spec:          core.PlayerProtoToSpec(player).String(),
+				Dps:           metrics.Dps.Avg,
+				DpsStdev:      metrics.Dps.Stdev,
+				Iterations:    result.IterationsDone,
+				Equipment:     equipment,
+			})
+			if err != nil {
+				return err
+			}
+			lines = append(append(lines, line...), '\n')
+		}
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+
+	ra.mu.Lock()
+	defer ra.mu.Unlock()
+
+	file, err := os.OpenFile(ra.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0666)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.Write(lines)
+	return err
+}
+
+// Returns the archived DPS of a character over time, with one point per gear change.
+func (ra *resultArchive) history(request *proto.ResultHistoryRequest) (*proto.ResultHistoryResult, error) {
+	ra.mu.Lock()
+	defer ra.mu.Unlock()
+
+	result := &proto.ResultHistoryResult{}
+
+	file, err := os.Open(ra.path)
+	if os.IsNotExist(err) {
+		return result, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var lastPoint *proto.ResultHistoryPoint
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		var entry archivedResult
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, fmt.Errorf("invalid archive entry: %w", err)
+		}
+		if entry.CharacterName != request.CharacterName || entry.Spec != request.Spec.String() {
+			continue
+		}
+
+		equipment := &proto.EquipmentSpec{}
+		if err := protojson.Unmarshal(entry.Equipment, equipment); err != nil {
+			return nil, fmt.Errorf("invalid archived equipment: %w", err)
+		}
+
+		if lastPoint != nil && googleProto.Equal(lastPoint.Equipment, equipment) {
+			lastPoint.LastTimestampMs = entry.TimestampMs
+			lastPoint.NumResults++
+			lastPoint.Dps = entry.Dps
+			lastPoint.DpsStdev = entry.DpsStdev
+			continue
+		}
+
+		point := &proto.ResultHistoryPoint{
+			FirstTimestampMs: entry.TimestampMs,
+			LastTimestampMs:  entry.TimestampMs,
+			NumResults:       1,
+			Dps:              entry.Dps,
+			DpsStdev:         entry.DpsStdev,
+			Equipment:        equipment,
+		}
+		if lastPoint != nil {
+			point.GearChanges = gearChanges(lastPoint.Equipment, equipment)
+		}
+		result.Points = append(result.Points, point)
+		lastPoint = point
+	}
+
+	return result, scanner.Err()
+}
+
+func gearChanges(oldEquipment *proto.EquipmentSpec, newEquipment *proto.EquipmentSpec) []*proto.GearChange {
+	var changes []*proto.GearChange
+	for slot := range max(len(oldEquipment.Items), len(newEquipment.Items)) {
+		oldItem := itemInSlot(oldEquipment, slot)
+		newItem := itemInSlot(newEquipment, slot)
+		if !googleProto.Equal(oldItem, newItem) {
+			changes = append(changes, &proto.GearChange{
+				Slot:    proto.ItemSlot(slot),
+				OldItem: oldItem,
+				NewItem: newItem,
+			})
+		}
+	}
+	return changes
+}
+
+func itemInSlot(equipment *proto.EquipmentSpec, slot int) *proto.ItemSpec {
+	if slot < len(equipment.Items) && equipment.Items[slot] != nil {
+		return equipment.Items[slot]
+	}
+	return &proto.ItemSpec{}
+}
+
+func archiveResult(request *proto.RaidSimRequest, result *proto.RaidSimResult) {
+	if archive == nil {
+		return
+	}
+	if err := archive.record(request, result); err != nil {
+		log.Printf("[ERROR] Failed to archive result: %s", err.Error())
+	}
+}
+
+func resultHistory(request *proto.ResultHistoryRequest) *proto.ResultHistoryResult {
+	if archive == nil {
+		return &proto.ResultHistoryResult{Error: &proto.ErrorOutcome{Message: "Result archive is disabled, start the server with -archive to enable it"}}
+	}
+	result, err := archive.history(request)
+	if err != nil {
+		return &proto.ResultHistoryResult{Error: &proto.ErrorOutcome{Message: err.Error()}}
+	}
+	return result
+}
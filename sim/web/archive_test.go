@@ -0,0 +1,56 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+func TestResultArchiveHistory(t *testing.T) {
+	ra := newResultArchive(filepath.Join(t.TempDir(), "results.jsonl"))
+
+	record := func(name string, equipment *proto.EquipmentSpec, dps float64) {
+		request := &proto.RaidSimRequest{
+			Raid: &proto.Raid{Parties: []*proto.Party{{Players: []*proto.Player{{
+				Name:      name,
+				Class:     proto.Class_ClassShaman,
+				Spec:      basicSpec,
+				Equipment: equipment,
+			}}}}},
+		}
+		result := &proto.RaidSimResult{
+			RaidMetrics: &proto.RaidMetrics{Parties: []*proto.PartyMetrics{{Players: []*proto.UnitMetrics{{
+				Dps: &proto.DistributionMetrics{Avg: dps},
+			}}}}},
+		}
+		if err := ra.record(request, result); err != nil {
+			t.Fatalf("Failed to record result: %s", err)
+		}
+	}
+
+	upgradedEquip := googleProto.Clone(p1Equip).(*proto.EquipmentSpec)
+	upgradedEquip.Items[proto.ItemSlot_ItemSlotTrinket1].Id = 45518
+
+	record("Player", p1Equip, 100)
+	record("Other Player", upgradedEquip, 500)
+	record("Player", p1Equip, 110)
+	record("Player", upgradedEquip, 120)
+
+	history, err := ra.history(&proto.ResultHistoryRequest{CharacterName: "Player", Spec: proto.Spec_SpecElementalShaman})
+	if err != nil {
+		t.Fatalf("Failed to read history: %s", err)
+	}
+	if len(history.Points) != 2 {
+		t.Fatalf("Expected a point per gear set, got %d", len(history.Points))
+	}
+
+	first, second := history.Points[0], history.Points[1]
+	if first.NumResults != 2 || first.Dps != 110 || len(first.GearChanges) != 0 {
+		t.Fatalf("Expected the latest result of the first gear set, got %v", first)
+	}
+	if second.Dps != 120 || len(second.GearChanges) != 1 || second.GearChanges[0].Slot != proto.ItemSlot_ItemSlotTrinket1 || second.GearChanges[0].NewItem.Id != 45518 {
+		t.Fatalf("Expected the trinket change, got %v", second)
+	}
+}
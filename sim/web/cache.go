@@ -0,0 +1,104 @@
+package main
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"log"
+	"sync"
+
+	proto "github.com/wowsims/mop/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+const defaultResultCacheSize = 100
+
+// Caches raid sim results for the lifetime of the server, so repeating an
+// identical sim (e.g. when toggling UI panels) returns instantly.
+var raidSimCache = newResultCache(defaultResultCacheSize)
+
+type resultCacheKey [sha256.Size]byte
+
+type resultCacheEntry struct {
+	key    resultCacheKey
+	result *proto.RaidSimResult
+}
+
+// Least recently used cache of raid sim results, keyed by request hash.
+type resultCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	order      *list.List
+	entries    map[resultCacheKey]*list.Element
+}
+
+func newResultCache(maxEntries int) *resultCache {
+	return &resultCache{
+		maxEntries: maxEntries,
+		order:      list.New(),
+		entries:    make(map[resultCacheKey]*list.Element),
+	}
+}
+
+// Hashes the canonical form of the request. Requests without a seed aren't
+// reproducible, so they can't be cached.
+func raidSimCacheKey(request *proto.RaidSimRequest) (resultCacheKey, bool) {
+	if request.GetSimOptions().GetRandomSeed() == 0 {
+		return resultCacheKey{}, false
+	}
+
+	// The request ID is unique per request and doesn't affect the result.
+	canonical := googleProto.Clone(request).(*proto.RaidSimRequest)
+	canonical.RequestId = ""
+
+	bytes, err := googleProto.MarshalOptions{Deterministic: true}.Marshal(canonical)
+	if err != nil {
+		log.Printf("[ERROR] Failed to hash request: %s", err.Error())
+		return resultCacheKey{}, false
+	}
+	return sha256.Sum256(bytes), true
+}
+
+func (rc *resultCache) get(request *proto.RaidSimRequest) (*proto.RaidSimResult, bool) {
+	if rc.maxEntries <= 0 {
+		return nil, false
+	}
+	key, ok := raidSimCacheKey(request)
+	if !ok {
+		return nil, false
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	element, ok := rc.entries[key]
+	if !ok {
+		return nil, false
+	}
+	rc.order.MoveToFront(element)
+	return element.Value.(*resultCacheEntry).result, true
+}
+
+// Stores the result of the request. Failed or aborted sims aren't cached.
+func (rc *resultCache) put(request *proto.RaidSimRequest, result *proto.RaidSimResult) {
+	if rc.maxEntries <= 0 || result == nil || result.Error != nil {
+		return
+	}
+	key, ok := raidSimCacheKey(request)
+	if !ok {
+		return
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if element, ok := rc.entries[key]; ok {
+		element.Value.(*resultCacheEntry).result = result
+		rc.order.MoveToFront(element)
+		return
+	}
+
+	rc.entries[key] = rc.order.PushFront(&resultCacheEntry{key: key, result: result})
+	for rc.order.Len() > rc.maxEntries {
+		oldest := rc.order.Back()
+		rc.order.Remove(oldest)
+		delete(rc.entries, oldest.Value.(*resultCacheEntry).key)
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/wowsims/mop/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+func TestResultCache(t *testing.T) {
+	rc := newResultCache(2)
+
+	newRequest := func(seed int64) *proto.RaidSimRequest {
+		return &proto.RaidSimRequest{
+			RequestId:  "raidSimAsync-1",
+			Encounter:  &proto.Encounter{Duration: 120},
+			SimOptions: &proto.SimOptions{Iterations: 100, RandomSeed: seed},
+		}
+	}
+	newResult := func(dps float64) *proto.RaidSimResult {
+		return &proto.RaidSimResult{RaidMetrics: &proto.RaidMetrics{Dps: &proto.DistributionMetrics{Avg: dps}}}
+	}
+
+	rc.put(newRequest(1), newResult(100))
+
+	sameRequest := newRequest(1)
+	sameRequest.RequestId = "raidSimAsync-2"
+	if result, ok := rc.get(sameRequest); !ok || result.RaidMetrics.Dps.Avg != 100 {
+		t.Fatalf("Expected a hit for an identical request with a different request ID, got %v", result)
+	}
+
+	changedRequest := googleProto.Clone(sameRequest).(*proto.RaidSimRequest)
+	changedRequest.Encounter.Duration = 180
+	if _, ok := rc.get(changedRequest); ok {
+		t.Fatalf("Expected a miss for a different encounter")
+	}
+
+	rc.put(newRequest(0), newResult(200))
+	if _, ok := rc.get(newRequest(0)); ok {
+		t.Fatalf("Expected requests without a seed not to be cached")
+	}
+
+	rc.put(newRequest(2), &proto.RaidSimResult{Error: &proto.ErrorOutcome{Message: "aborted"}})
+	if _, ok := rc.get(newRequest(2)); ok {
+		t.Fatalf("Expected failed sims not to be cached")
+	}
+
+	// Seed 1 was used more recently than seed 2, so seed 2 is evicted.
+	rc.put(newRequest(2), newResult(300))
+	rc.get(newRequest(1))
+	rc.put(newRequest(3), newResult(400))
+	if _, ok := rc.get(newRequest(2)); ok {
+		t.Fatalf("Expected the least recently used result to be evicted")
+	}
+	if _, ok := rc.get(newRequest(1)); !ok {
+		t.Fatalf("Expected the recently used result to stay cached")
+	}
+}
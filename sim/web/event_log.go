@@ -0,0 +1,57 @@
+package main
+
+import (
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/wowsims/mop/sim/core"
+	proto "github.com/wowsims/mop/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+// Pushes each write to the client right away, so the event log is streamed
+// while the sim runs instead of being buffered by the server.
+type flushWriter struct {
+	w       io.Writer
+	flusher http.Flusher
+}
+
+func (fw flushWriter) Write(p []byte) (int, error) {
+	n, err := fw.w.Write(p)
+	if fw.flusher != nil {
+		fw.flusher.Flush()
+	}
+	return n, err
+}
+
+// Runs the raid sim and streams the logs of its first iteration as a compact
+// event log download, see core.EventLogWriter for the format.
+func handleRaidSimLogs(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return
+	}
+
+	request := &proto.RaidSimRequest{}
+	if err := googleProto.Unmarshal(body, request); err != nil {
+		log.Printf("Failed to parse request: %s", err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if request.Raid == nil || request.Encounter == nil || request.SimOptions == nil {
+		log.Printf("Request is empty")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/octet-stream")
+	w.Header().Add("Content-Disposition", `attachment; filename="sim_logs.bin"`)
+
+	flusher, _ := w.(http.Flusher)
+	result := core.StreamRaidSimLogs(request, flushWriter{w: w, flusher: flusher})
+	if result.Error != nil {
+		// The headers are already sent, so the client only sees a truncated log.
+		log.Printf("[ERROR] Failed to stream sim logs: %s", result.Error.Message)
+	}
+}
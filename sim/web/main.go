@@ -24,6 +24,7 @@ import (
 	"github.com/wowsims/mop/sim"
 	"github.com/wowsims/mop/sim/core"
 	proto "github.com/wowsims/mop/sim/core/proto"
+	"github.com/wowsims/mop/sim/core/simc"
 	"github.com/wowsims/mop/sim/core/simsignals"
 
 	googleProto "google.golang.org/protobuf/proto"
@@ -48,9 +49,21 @@ func main() {
 	var host = flag.String("host", "localhost:3333", "URL to host the interface on.")
 	var launch = flag.Bool("launch", true, "auto launch browser")
 	var skipVersionCheck = flag.Bool("nvc", false, "set true to skip version check")
+	var archivePath = flag.String("archive", "", "File to append sim results to. Enables the /resultHistory endpoint.")
+	var cacheSize = flag.Int("cache", defaultResultCacheSize, "Number of raid sim results to cache for identical requests. Set to 0 to disable.")
+	var workers = flag.String("workers", "", "Comma separated URLs of other instances of this binary to split raid sim iterations with (ex: 192.168.1.20:3333,192.168.1.21:3333).")
 
 	flag.Parse()
 
+	if *archivePath != "" {
+		archive = newResultArchive(*archivePath)
+	}
+	raidSimCache = newResultCache(*cacheSize)
+	shardWorkers = parseShardWorkers(*workers)
+	if len(shardWorkers) > 0 {
+		log.Printf("Splitting raid sims with %d worker(s): %s", len(shardWorkers), strings.Join(shardWorkers, ", "))
+	}
+
 	fmt.Printf("Version: %s\n", Version)
 	if !*skipVersionCheck && Version != "development" {
 		go func() {
@@ -92,7 +105,22 @@ func main() {
 // Handlers to decode and handle each proto function
 var handlers = map[string]apiHandler{
 	"/raidSim": {msg: func() googleProto.Message { return &proto.RaidSimRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
-		return core.RunRaidSim(msg.(*proto.RaidSimRequest))
+		request := msg.(*proto.RaidSimRequest)
+		if result, ok := raidSimCache.get(request); ok {
+			return result
+		}
+		var result *proto.RaidSimResult
+		if len(shardWorkers) > 0 {
+			result = runShardedRaidSim(request, shardWorkers)
+		} else {
+			result = core.RunRaidSim(request)
+		}
+		raidSimCache.put(request, result)
+		archiveResult(request, result)
+		return result
+	}},
+	raidSimShardPath: {msg: func() googleProto.Message { return &proto.RaidSimRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
+		return core.RunRaidSimConcurrent(msg.(*proto.RaidSimRequest))
 	}},
 	"/statWeights": {msg: func() googleProto.Message { return &proto.StatWeightsRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
 		return core.StatWeights(msg.(*proto.StatWeightsRequest))
@@ -106,6 +134,45 @@ var handlers = map[string]apiHandler{
 	"/computeStats": {msg: func() googleProto.Message { return &proto.ComputeStatsRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
 		return core.ComputeStats(msg.(*proto.ComputeStatsRequest))
 	}},
+	"/computeCharacterSheet": {msg: func() googleProto.Message { return &proto.ComputeCharacterSheetRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
+		return core.ComputeCharacterSheet(msg.(*proto.ComputeCharacterSheetRequest))
+	}},
+	"/computeOutcomeTables": {msg: func() googleProto.Message { return &proto.ComputeOutcomeTablesRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
+		return core.ComputeOutcomeTables(msg.(*proto.ComputeOutcomeTablesRequest))
+	}},
+	"/computeGearDelta": {msg: func() googleProto.Message { return &proto.GearDeltaRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
+		return core.ComputeGearDelta(msg.(*proto.GearDeltaRequest))
+	}},
+	"/computeUpgradeAdvice": {msg: func() googleProto.Message { return &proto.UpgradeAdvisorRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
+		return core.ComputeUpgradeAdvice(msg.(*proto.UpgradeAdvisorRequest))
+	}},
+	"/compareConsumables": {msg: func() googleProto.Message { return &proto.ConsumableComparisonRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
+		return core.CompareConsumables(msg.(*proto.ConsumableComparisonRequest))
+	}},
+	"/raidBuffContributions": {msg: func() googleProto.Message { return &proto.RaidBuffContributionRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
+		return core.ComputeRaidBuffContributions(msg.(*proto.RaidBuffContributionRequest))
+	}},
+	"/compareRotations": {msg: func() googleProto.Message { return &proto.RotationComparisonRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
+		return core.CompareRotations(msg.(*proto.RotationComparisonRequest))
+	}},
+	"/statScaling": {msg: func() googleProto.Message { return &proto.StatScalingRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
+		return core.ComputeStatScaling(msg.(*proto.StatScalingRequest))
+	}},
+	"/importSimcActionList": {msg: func() googleProto.Message { return &proto.SimcImportRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
+		return simc.ImportActionList(msg.(*proto.SimcImportRequest))
+	}},
+	"/listPresets": {msg: func() googleProto.Message { return &proto.ListPresetsRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
+		return core.ListPresets(msg.(*proto.ListPresetsRequest))
+	}},
+	"/getItemFilter": {msg: func() googleProto.Message { return &proto.ItemFilterRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
+		return core.GetItemFilterRules(msg.(*proto.ItemFilterRequest))
+	}},
+	"/decodeTalents": {msg: func() googleProto.Message { return &proto.DecodeTalentsRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
+		return core.DecodeTalents(msg.(*proto.DecodeTalentsRequest))
+	}},
+	"/resultHistory": {msg: func() googleProto.Message { return &proto.ResultHistoryRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
+		return resultHistory(msg.(*proto.ResultHistoryRequest))
+	}},
 	"/abortById": {msg: func() googleProto.Message { return &proto.AbortRequest{} }, handle: func(msg googleProto.Message) googleProto.Message {
 		requestId := msg.(*proto.AbortRequest).RequestId
 		triggered := simsignals.AbortById(requestId)
@@ -115,7 +182,23 @@ var handlers = map[string]apiHandler{
 
 var asyncAPIHandlers = map[string]asyncAPIHandler{
 	"/raidSimAsync": {msg: func() googleProto.Message { return &proto.RaidSimRequest{} }, handle: func(msg googleProto.Message, reporter chan *proto.ProgressMetrics, requestId string) {
-		core.RunRaidSimConcurrentAsync(msg.(*proto.RaidSimRequest), reporter, requestId)
+		request := msg.(*proto.RaidSimRequest)
+		if len(shardWorkers) == 0 {
+			core.RunRaidSimConcurrentAsync(request, reporter, requestId)
+			return
+		}
+		// Workers don't report progress, so only the final result is reported.
+		go func() {
+			result := runShardedRaidSim(request, shardWorkers)
+			progress := &proto.ProgressMetrics{FinalRaidResult: result}
+			if result.Error == nil {
+				progress.TotalIterations = request.SimOptions.Iterations
+				progress.CompletedIterations = result.IterationsDone
+				progress.Dps = result.RaidMetrics.Dps.Avg
+				progress.Hps = result.RaidMetrics.Hps.Avg
+			}
+			reporter <- progress
+		}()
 	}},
 	"/statWeightsAsync": {msg: func() googleProto.Message { return &proto.StatWeightsRequest{} }, handle: func(msg googleProto.Message, reporter chan *proto.ProgressMetrics, requestId string) {
 		core.StatWeightsAsync(msg.(*proto.StatWeightsRequest), reporter, requestId)
@@ -179,7 +262,22 @@ func (s *server) handleAsyncAPI(w http.ResponseWriter, r *http.Request) {
 	//  as the simulation advances it will push changes to the channel
 	//  these changes will be consumed by the goroutine below so the asyncProgress endpoint can fetch the results.
 	reporter := make(chan *proto.ProgressMetrics, 100)
-	handler.handle(msg, reporter, r.URL.Query().Get("requestId"))
+	request, isRaidSim := msg.(*proto.RaidSimRequest)
+	var cachedResult *proto.RaidSimResult
+	if isRaidSim {
+		cachedResult, _ = raidSimCache.get(request)
+	}
+	if cachedResult != nil {
+		// Identical sim was already run, so report its result as finished right away.
+		reporter <- &proto.ProgressMetrics{
+			TotalIterations:     request.SimOptions.Iterations,
+			CompletedIterations: request.SimOptions.Iterations,
+			Dps:                 cachedResult.RaidMetrics.Dps.Avg,
+			FinalRaidResult:     cachedResult,
+		}
+	} else {
+		handler.handle(msg, reporter, r.URL.Query().Get("requestId"))
+	}
 
 	// Generate a new async simulation
 	simProgress := s.addNewSim()
@@ -200,6 +298,10 @@ func (s *server) handleAsyncAPI(w http.ResponseWriter, r *http.Request) {
 					return
 				}
 				simProgress.latestProgress.Store(progMetric)
+				if progMetric.FinalRaidResult != nil && isRaidSim && cachedResult == nil {
+					raidSimCache.put(request, progMetric.FinalRaidResult)
+					archiveResult(request, progMetric.FinalRaidResult)
+				}
 				if progMetric.FinalRaidResult != nil || progMetric.FinalWeightResult != nil {
 					return
 				}
@@ -294,6 +396,7 @@ func (s *server) runServer(useFS bool, host string, launchBrowser bool, simName
 	for route := range handlers {
 		http.Handle(route, corsMiddleware(http.HandlerFunc(handleAPI)))
 	}
+	http.Handle("/raidSimLogs", corsMiddleware(http.HandlerFunc(handleRaidSimLogs)))
 
 	http.HandleFunc("/version", func(resp http.ResponseWriter, req *http.Request) {
 		msg := fmt.Sprintf(`{"version": "%s", "outdated": %d}`, Version, outdated)
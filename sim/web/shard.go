@@ -0,0 +1,103 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/wowsims/mop/sim/core"
+	proto "github.com/wowsims/mop/sim/core/proto"
+	googleProto "google.golang.org/protobuf/proto"
+)
+
+// Set when the server is started with workers, which must be instances of the
+// same binary so their results can be combined with ours.
+var shardWorkers []string
+
+// Endpoint workers run their share of the iterations on.
+const raidSimShardPath = "/raidSimShard"
+
+func parseShardWorkers(workers string) []string {
+	var urls []string
+	for _, worker := range strings.Split(workers, ",") {
+		worker = strings.TrimSuffix(strings.TrimSpace(worker), "/")
+		if worker == "" {
+			continue
+		}
+		if !strings.Contains(worker, "://") {
+			worker = "http://" + worker
+		}
+		urls = append(urls, worker)
+	}
+	return urls
+}
+
+// Splits the iterations of a raid sim into one seed range for this server and
+// one for each worker, and combines the results. Iteration i always uses seed
+// RandomSeed+i, so the result is the same no matter how the work is spread.
+func runShardedRaidSim(request *proto.RaidSimRequest, workers []string) *proto.RaidSimResult {
+	splitRes := core.SplitSimRequestForConcurrency(request, int32(len(workers)+1))
+	if splitRes.ErrorResult != "" {
+		return &proto.RaidSimResult{Error: &proto.ErrorOutcome{Message: splitRes.ErrorResult}}
+	}
+
+	// The first shard keeps the debug logs, so it always runs locally.
+	results := make([]*proto.RaidSimResult, splitRes.SplitsDone)
+	var wg sync.WaitGroup
+	for i, shard := range splitRes.Requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if i == 0 {
+				results[i] = core.RunRaidSimConcurrent(shard)
+				return
+			}
+
+			worker := workers[i-1]
+			result, err := postRaidSimShard(worker, shard)
+			if err != nil {
+				log.Printf("Worker %s failed, running its %d iterations locally: %s", worker, shard.SimOptions.Iterations, err.Error())
+				result = core.RunRaidSimConcurrent(shard)
+			}
+			results[i] = result
+		}()
+	}
+	wg.Wait()
+
+	for _, result := range results {
+		if result.Error != nil {
+			return result
+		}
+	}
+	return core.CombineConcurrentSimResults(results, request.SimOptions.Debug)
+}
+
+func postRaidSimShard(worker string, shard *proto.RaidSimRequest) (*proto.RaidSimResult, error) {
+	msgBytes, err := googleProto.Marshal(shard)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.Post(worker+raidSimShardPath, "application/x-protobuf", bytes.NewReader(msgBytes))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	result := &proto.RaidSimResult{}
+	if err := googleProto.Unmarshal(body, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/wowsims/mop/sim/core"
+	"github.com/wowsims/mop/sim/core/proto"
+)
+
+func TestShardedRaidSim(t *testing.T) {
+	worker := httptest.NewServer(http.HandlerFunc(handleAPI))
+	defer worker.Close()
+
+	newRequest := func() *proto.RaidSimRequest {
+		return &proto.RaidSimRequest{
+			Raid: core.SinglePlayerRaidProto(
+				&proto.Player{
+					Race:      proto.Race_RaceTroll,
+					Class:     proto.Class_ClassShaman,
+					Equipment: &proto.EquipmentSpec{},
+					Spec:      basicSpec,
+					Rotation:  &proto.APLRotation{Type: proto.APLRotation_TypeAuto},
+				},
+				&proto.PartyBuffs{},
+				&proto.RaidBuffs{},
+				&proto.Debuffs{}),
+			Encounter: &proto.Encounter{
+				Duration: 120,
+				Targets: []*proto.Target{
+					{},
+				},
+			},
+			SimOptions: &proto.SimOptions{
+				Iterations: 301,
+				RandomSeed: 1,
+			},
+		}
+	}
+
+	expected := core.RunRaidSimConcurrent(newRequest())
+	if expected.Error != nil {
+		t.Fatalf("Local sim failed: %s", expected.Error.Message)
+	}
+	if expected.RaidMetrics.Dps.Avg == 0 {
+		t.Fatalf("Expected the local sim to deal damage")
+	}
+
+	// The unreachable worker's shard falls back to running locally.
+	workers := parseShardWorkers(worker.URL + "/, 127.0.0.1:1")
+	if len(workers) != 2 || workers[1] != "http://127.0.0.1:1" {
+		t.Fatalf("Unexpected workers: %v", workers)
+	}
+
+	result := runShardedRaidSim(newRequest(), workers)
+	if result.Error != nil {
+		t.Fatalf("Sharded sim failed: %s", result.Error.Message)
+	}
+	if result.IterationsDone != 301 {
+		t.Fatalf("Expected 301 iterations, got %d", result.IterationsDone)
+	}
+	// Every iteration keeps its seed, so only float rounding differs.
+	if math.Abs(result.RaidMetrics.Dps.Avg-expected.RaidMetrics.Dps.Avg) > 1e-6 {
+		t.Fatalf("Expected %0.3f DPS, got %0.3f", expected.RaidMetrics.Dps.Avg, result.RaidMetrics.Dps.Avg)
+	}
+}
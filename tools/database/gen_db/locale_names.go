@@ -0,0 +1,79 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wowsims/mop/sim/core"
+	"github.com/wowsims/mop/tools"
+)
+
+type talentTree struct {
+	Talents []struct {
+		FieldName string `json:"fieldName"`
+		SpellID   int32  `json:"spellId"`
+	} `json:"talents"`
+}
+
+// Builds the translated spell names used in sim results from the UI talent
+// translations, and writes them to <outDir>/<locale>.json. English names are
+// read from the database instead, so English is skipped.
+func writeLocaleNames(localesDir string, outDir string) {
+	talentSpellIDs := map[string]map[string]int32{}
+	treeFiles, err := filepath.Glob("ui/core/talents/trees/*.json")
+	if err != nil {
+		log.Fatalf("Failed to list talent trees: %s", err)
+	}
+	for _, treeFile := range treeFiles {
+		var tree talentTree
+		if err := json.Unmarshal([]byte(tools.ReadFile(treeFile)), &tree); err != nil {
+			log.Fatalf("Failed to parse %s: %s", treeFile, err)
+		}
+		spellIDs := map[string]int32{}
+		for _, talent := range tree.Talents {
+			spellIDs[talent.FieldName] = talent.SpellID
+		}
+		talentSpellIDs[strings.TrimSuffix(filepath.Base(treeFile), ".json")] = spellIDs
+	}
+
+	localeDirs, err := os.ReadDir(localesDir)
+	if err != nil {
+		log.Fatalf("Failed to list locales: %s", err)
+	}
+	if err := os.MkdirAll(outDir, os.ModePerm); err != nil {
+		log.Fatalf("Failed to create %s: %s", outDir, err)
+	}
+	for _, localeDir := range localeDirs {
+		locale := localeDir.Name()
+		if !localeDir.IsDir() || locale == core.DefaultLocale {
+			continue
+		}
+
+		var talentNames map[string]map[string]string
+		if err := json.Unmarshal([]byte(tools.ReadFile(filepath.Join(localesDir, locale, "talents.json"))), &talentNames); err != nil {
+			log.Fatalf("Failed to parse %s talents: %s", locale, err)
+		}
+
+		names := core.LocalizedNames{Spells: map[int32]string{}, Items: map[int32]string{}}
+		for class, classNames := range talentNames {
+			for fieldName, name := range classNames {
+				if spellID := talentSpellIDs[class][fieldName]; spellID != 0 && name != "" {
+					names.Spells[spellID] = name
+				}
+			}
+		}
+
+		data, err := json.MarshalIndent(names, "", "\t")
+		if err != nil {
+			log.Fatalf("Failed to marshal %s names: %s", locale, err)
+		}
+		outFile := filepath.Join(outDir, fmt.Sprintf("%s.json", locale))
+		if err := os.WriteFile(outFile, append(data, '\n'), 0666); err != nil {
+			log.Fatalf("Failed to write %s: %s", outFile, err)
+		}
+	}
+}
@@ -23,9 +23,10 @@ import (
 // To do a full re-scrape, delete the previous output file first.
 // go run ./tools/database/gen_db -outDir=assets -gen=atlasloot
 // go run ./tools/database/gen_db -outDir=assets -gen=db
+// go run ./tools/database/gen_db -outDir=assets -gen=locale-names
 
 var outDir = flag.String("outDir", "assets", "Path to output directory for writing generated .go files.")
-var genAsset = flag.String("gen", "", "Asset to generate. Valid values are 'db', 'atlasloot', 'wowhead-items', 'wowhead-spells', 'wowhead-itemdb', 'mop-items', and 'wago-db2-items'")
+var genAsset = flag.String("gen", "", "Asset to generate. Valid values are 'db', 'locale-names', 'atlasloot', 'wowhead-items', 'wowhead-spells', 'wowhead-itemdb', 'mop-items', and 'wago-db2-items'")
 var dbPath = flag.String("dbPath", "./tools/database/wowsims.db", "Location of wowsims.db file from the DB2ToSqliteTool")
 
 func main() {
@@ -54,6 +55,9 @@ func main() {
 		//Todo: fill this when we have information from wowhead @ Neteyes - Gehennas
 		// For now, the version we have was taken from https://web.archive.org/web/20120201045249js_/http://www.wowhead.com/data=item-scaling
 		return
+	} else if *genAsset == "locale-names" {
+		writeLocaleNames(fmt.Sprintf("%s/locales", *outDir), fmt.Sprintf("%s/locales", dbDir))
+		return
 	} else if *genAsset != "db" {
 		panic("Invalid gen value")
 	}
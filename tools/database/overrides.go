@@ -1242,12 +1242,29 @@ var EnchantOverrides = []*proto.UIEnchant{
 	{EffectId: 4445, SpellId: 104040}, // Enchant Weapon - Colossus
 	{EffectId: 4446, SpellId: 104442}, // Enchant Weapon - River's Song
 
-	{EffectId: 4892, SpellId: 125481}, // Lightweave Embroidery (Rank 3)
-	{EffectId: 4893, SpellId: 125482}, // Darkglow Embroidery (Rank 3)
-	{EffectId: 4894, SpellId: 125483}, // Swordguard Embroidery (Rank 3)
-	{EffectId: 4895, SpellId: 125496}, // Master's Spellthread (Rank 3)
-	{EffectId: 4896, SpellId: 125497}, // Sanctified Spellthread (Rank 3)
+	{EffectId: 4434, SpellId: 104445, EnchantType: proto.EnchantType_EnchantTypeOffHand}, // Enchant Off-Hand - Major Intellect
+	{EffectId: 4993, SpellId: 130758, EnchantType: proto.EnchantType_EnchantTypeShield},  // Enchant Shield - Greater Parry
+
+	// Profession-only enchants. The required profession is pinned here so the
+	// UI can warn about them even if the DBC data stops carrying it.
+	{EffectId: 4359, SpellId: 103461, RequiredProfession: proto.Profession_Enchanting},     // Enchant Ring - Greater Agility
+	{EffectId: 4360, SpellId: 103462, RequiredProfession: proto.Profession_Enchanting},     // Enchant Ring - Greater Intellect
+	{EffectId: 4361, SpellId: 103463, RequiredProfession: proto.Profession_Enchanting},     // Enchant Ring - Greater Stamina
+	{EffectId: 4807, SpellId: 103465, RequiredProfession: proto.Profession_Enchanting},     // Enchant Ring - Greater Strength
+	{EffectId: 4875, SpellId: 124551, RequiredProfession: proto.Profession_Leatherworking}, // Fur Lining - Agility (Rank 3)
+	{EffectId: 4877, SpellId: 124552, RequiredProfession: proto.Profession_Leatherworking}, // Fur Lining - Intellect (Rank 3)
+	{EffectId: 4878, SpellId: 124553, RequiredProfession: proto.Profession_Leatherworking}, // Fur Lining - Stamina (Rank 3)
+	{EffectId: 4879, SpellId: 124554, RequiredProfession: proto.Profession_Leatherworking}, // Fur Lining - Strength (Rank 3)
+	{EffectId: 4892, SpellId: 125481, RequiredProfession: proto.Profession_Tailoring},      // Lightweave Embroidery (Rank 3)
+	{EffectId: 4893, SpellId: 125482, RequiredProfession: proto.Profession_Tailoring},      // Darkglow Embroidery (Rank 3)
+	{EffectId: 4894, SpellId: 125483, RequiredProfession: proto.Profession_Tailoring},      // Swordguard Embroidery (Rank 3)
+	{EffectId: 4895, SpellId: 125496, RequiredProfession: proto.Profession_Tailoring},      // Master's Spellthread (Rank 3)
+	{EffectId: 4896, SpellId: 125497, RequiredProfession: proto.Profession_Tailoring},      // Sanctified Spellthread (Rank 3)
 	{EffectId: 4898, SpellId: 126731}, // Synapse Springs (Mark II)
+	{EffectId: 4912, SpellId: 113048, RequiredProfession: proto.Profession_Inscription}, // Secret Ox Horn Inscription
+	{EffectId: 4913, SpellId: 113047, RequiredProfession: proto.Profession_Inscription}, // Secret Tiger Fang Inscription
+	{EffectId: 4914, SpellId: 113046, RequiredProfession: proto.Profession_Inscription}, // Secret Tiger Claw Inscription
+	{EffectId: 4915, SpellId: 113045, RequiredProfession: proto.Profession_Inscription}, // Secret Crane Wing Inscription
 
 	{EffectId: 5124, SpellId: 142469}, // Enchant Weapon - Spirit of Conquest
 	{EffectId: 5125, SpellId: 142468}, // Enchant Weapon - Bloody Dancing Steel
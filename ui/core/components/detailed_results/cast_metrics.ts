@@ -25,6 +25,28 @@ export class CastMetricsTable extends MetricsTable<ActionMetrics> {
 				getValue: (metric: ActionMetrics) => metric.castsPerMinute,
 				getDisplayString: (metric: ActionMetrics) => metric.castsPerMinute.toFixed(1),
 			},
+			{
+				name: i18n.t('results_tab.details.columns.interrupted'),
+				getValue: (metric: ActionMetrics) => metric.interrupts,
+				getDisplayString: (metric: ActionMetrics) => metric.interrupts.toFixed(1),
+			},
+			{
+				name: i18n.t('results_tab.details.columns.avg_cost'),
+				tooltip: i18n.t('results_tab.details.tooltips.avg_cost_tooltip'),
+				getValue: (metric: ActionMetrics) => metric.avgCost,
+				getDisplayString: (metric: ActionMetrics) => metric.avgCost.toFixed(1),
+			},
+			{
+				name: i18n.t('results_tab.details.columns.refunded'),
+				getValue: (metric: ActionMetrics) => metric.resourceRefunded,
+				getDisplayString: (metric: ActionMetrics) => metric.resourceRefunded.toFixed(1),
+			},
+			{
+				name: i18n.t('results_tab.details.columns.damage_per_resource'),
+				tooltip: i18n.t('results_tab.details.tooltips.damage_per_resource_tooltip'),
+				getValue: (metric: ActionMetrics) => metric.damagePerResource,
+				getDisplayString: (metric: ActionMetrics) => metric.damagePerResource.toFixed(1),
+			},
 		]);
 	}
 
@@ -36,9 +58,9 @@ export class CastMetricsTable extends MetricsTable<ActionMetrics> {
 		}
 		const player = players[0];
 
-		const actions = player.actions.filter(action => action.casts != 0).map(action => action.forTarget(resultData.filter));
+		const actions = player.actions.filter(action => action.casts != 0 || action.interrupts != 0).map(action => action.forTarget(resultData.filter));
 		const actionGroups = ActionMetrics.groupById(actions);
-		const petGroups = player.pets.map(pet => pet.actions.filter(action => action.casts != 0).map(action => action.forTarget(resultData.filter)));
+		const petGroups = player.pets.map(pet => pet.actions.filter(action => action.casts != 0 || action.interrupts != 0).map(action => action.forTarget(resultData.filter)));
 
 		return actionGroups.concat(petGroups);
 	}
@@ -250,6 +250,11 @@ export class HealingMetricsTable extends MetricsTable<ActionMetrics> {
 				getValue: (metric: ActionMetrics) => metric.critPercent || metric.critTickPercent,
 				getDisplayString: (metric: ActionMetrics) => formatToPercent(metric.critPercent || metric.critTickPercent, { fallbackString: '-' }),
 			},
+			{
+				name: i18n.t('results_tab.details.columns.overheal_percent'),
+				getValue: (metric: ActionMetrics) => metric.overhealPercent,
+				getDisplayString: (metric: ActionMetrics) => formatToPercent(metric.overhealPercent, { fallbackString: '-' }),
+			},
 			{
 				name: i18n.t('results_tab.details.columns.hpet'),
 				getValue: (metric: ActionMetrics) => metric.healingThroughput,
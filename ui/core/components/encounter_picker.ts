@@ -1,6 +1,7 @@
 import i18n from '../../i18n/config.js';
 import { translateSpellSchool, translateStat, translateTargetInputLabel, translateTargetInputTooltip, translateMobType } from '../../i18n/localization.js';
 import { TrackEventProps, trackEvent } from '../../tracking/utils';
+import { targetArmorForLevel } from '../constants/mechanics.js';
 import { Encounter } from '../encounter.js';
 import { IndividualSimUI } from '../individual_sim_ui.js';
 import { InputType, MobType, Spec, SpellSchool, Stat, Target, Target as TargetProto, TargetInput } from '../proto/common.js';
@@ -139,6 +140,30 @@ export class EncounterPicker extends Component {
 						return shouldEnable;
 					},
 				});
+
+				// Incoming damage for the allies, so healers have missing health to heal.
+				(
+					[
+						['dummyHealth', 'dummy_health'],
+						['raidDps', 'raid_dps'],
+						['tankDps', 'tank_dps'],
+					] as const
+				).forEach(([field, key]) => {
+					new NumberPicker(this.rootElem, simUI.sim.raid, {
+						id: `encounter-${key.replaceAll('_', '-')}`,
+						label: i18n.t(`settings_tab.encounter.${key}.label`),
+						labelTooltip: i18n.t(`settings_tab.encounter.${key}.tooltip`),
+						positive: true,
+						changedEvent: (raid: Raid) => TypedEvent.onAny([raid.targetDummiesChangeEmitter, raid.damageProfileChangeEmitter]),
+						getValue: (raid: Raid) => raid.getDamageProfile()[field],
+						setValue: (eventID: EventID, raid: Raid, newValue: number) => {
+							const damageProfile = raid.getDamageProfile();
+							damageProfile[field] = newValue;
+							raid.setDamageProfile(eventID, damageProfile);
+						},
+						showWhen: (raid: Raid) => raid.getTargetDummies() > 0,
+					});
+				});
 			}
 
 			if (simUI.isIndividualSim() && (simUI as IndividualSimUI<any>).player.getPlayerSpec().isTankSpec) {
@@ -275,12 +300,15 @@ class TargetPicker extends Input<Encounter, TargetProto> {
 	private readonly levelPicker: Input<null, number>;
 	private readonly mobTypePicker: Input<null, number>;
 	private readonly tankIndexPicker: Input<null, number>;
+	private readonly linkedHealthGroupPicker: Input<null, number>;
+	private readonly distanceFromPrimaryPicker: Input<null, number>;
 	private readonly statPickers: Array<Input<null, number>>;
 	private readonly swingSpeedPicker: Input<null, number>;
 	private readonly minBaseDamagePicker: Input<null, number>;
 	private readonly dualWieldPicker: Input<null, boolean>;
 	private readonly dwMissPenaltyPicker: Input<null, boolean>;
 	private readonly parryHastePicker: Input<null, boolean>;
+	private readonly playerLikePicker: Input<null, boolean>;
 	private readonly spellSchoolPicker: Input<null, number>;
 	private readonly damageSpreadPicker: Input<null, number>;
 	private readonly targetInputPickers: ListPicker<Encounter, TargetInput>;
@@ -385,7 +413,12 @@ class TargetPicker extends Input<Encounter, TargetProto> {
 					label: 'level',
 					value: newValue,
 				});
-				this.getTarget().level = newValue;
+				const target = this.getTarget();
+				// Keep the armor in line with the level, unless it was customized.
+				if (target.stats[Stat.StatArmor] == targetArmorForLevel(target.level)) {
+					target.stats[Stat.StatArmor] = targetArmorForLevel(newValue);
+				}
+				target.level = newValue;
 				encounter.targetsChangeEmitter.emit(eventID);
 			},
 		});
@@ -431,6 +464,42 @@ class TargetPicker extends Input<Encounter, TargetProto> {
 				encounter.targetsChangeEmitter.emit(eventID);
 			},
 		});
+		this.linkedHealthGroupPicker = new NumberPicker(section1, null, {
+			id: `target-${this.targetIndex}-picker-linked-health-group`,
+			label: i18n.t('settings_tab.encounter.linked_health_group.label'),
+			labelTooltip: i18n.t('settings_tab.encounter.linked_health_group.tooltip'),
+			changedEvent: () => encounter.targetsChangeEmitter,
+			getValue: () => this.getTarget().linkedHealthGroup,
+			setValue: (eventID: EventID, _: null, newValue: number) => {
+				trackEvent({
+					action: 'settings',
+					category: 'targets',
+					label: 'linked_health_group',
+					value: newValue,
+				});
+				this.getTarget().linkedHealthGroup = newValue;
+				encounter.targetsChangeEmitter.emit(eventID);
+			},
+		});
+		this.distanceFromPrimaryPicker = new NumberPicker(section1, null, {
+			id: `target-${this.targetIndex}-picker-distance-from-primary`,
+			label: i18n.t('settings_tab.encounter.distance_from_primary.label'),
+			labelTooltip: i18n.t('settings_tab.encounter.distance_from_primary.tooltip'),
+			float: true,
+			positive: true,
+			changedEvent: () => encounter.targetsChangeEmitter,
+			getValue: () => this.getTarget().distanceFromPrimary,
+			setValue: (eventID: EventID, _: null, newValue: number) => {
+				trackEvent({
+					action: 'settings',
+					category: 'targets',
+					label: 'distance_from_primary',
+					value: newValue,
+				});
+				this.getTarget().distanceFromPrimary = newValue;
+				encounter.targetsChangeEmitter.emit(eventID);
+			},
+		});
 
 		this.targetInputPickers = makeTargetInputsPicker(section1, encounter, this.targetIndex);
 
@@ -562,6 +631,25 @@ class TargetPicker extends Input<Encounter, TargetProto> {
 				encounter.targetsChangeEmitter.emit(eventID);
 			},
 		});
+		this.playerLikePicker = new BooleanPicker(section3, null, {
+			id: `target-${this.targetIndex}-picker-player-like`,
+			label: i18n.t('settings_tab.encounter.player_like.label'),
+			labelTooltip: i18n.t('settings_tab.encounter.player_like.tooltip'),
+			inline: true,
+			reverse: true,
+			changedEvent: () => encounter.targetsChangeEmitter,
+			getValue: () => this.getTarget().playerLike,
+			setValue: (eventID: EventID, _: null, newValue: boolean) => {
+				trackEvent({
+					action: 'settings',
+					category: 'targets',
+					label: 'player_like',
+					value: newValue,
+				});
+				this.getTarget().playerLike = newValue;
+				encounter.targetsChangeEmitter.emit(eventID);
+			},
+		});
 		this.spellSchoolPicker = new EnumPicker<null>(section3, null, {
 			id: `target-${this.targetIndex}-picker-spell-school`,
 			label: i18n.t('settings_tab.encounter.spell_school.label'),
@@ -601,11 +689,14 @@ class TargetPicker extends Input<Encounter, TargetProto> {
 			level: this.levelPicker.getInputValue(),
 			mobType: this.mobTypePicker.getInputValue(),
 			tankIndex: this.tankIndexPicker.getInputValue(),
+			linkedHealthGroup: this.linkedHealthGroupPicker.getInputValue(),
+			distanceFromPrimary: this.distanceFromPrimaryPicker.getInputValue(),
 			swingSpeed: this.swingSpeedPicker.getInputValue(),
 			minBaseDamage: this.minBaseDamagePicker.getInputValue(),
 			dualWield: this.dualWieldPicker.getInputValue(),
 			dualWieldPenalty: this.dwMissPenaltyPicker.getInputValue(),
 			parryHaste: this.parryHastePicker.getInputValue(),
+			playerLike: this.playerLikePicker.getInputValue(),
 			spellSchool: this.spellSchoolPicker.getInputValue(),
 			damageSpread: this.damageSpreadPicker.getInputValue(),
 			stats: this.statPickers
@@ -624,11 +715,14 @@ class TargetPicker extends Input<Encounter, TargetProto> {
 		this.levelPicker.setInputValue(newValue.level);
 		this.mobTypePicker.setInputValue(newValue.mobType);
 		this.tankIndexPicker.setInputValue(newValue.tankIndex);
+		this.linkedHealthGroupPicker.setInputValue(newValue.linkedHealthGroup);
+		this.distanceFromPrimaryPicker.setInputValue(newValue.distanceFromPrimary);
 		this.swingSpeedPicker.setInputValue(newValue.swingSpeed);
 		this.minBaseDamagePicker.setInputValue(newValue.minBaseDamage);
 		this.dualWieldPicker.setInputValue(newValue.dualWield);
 		this.dwMissPenaltyPicker.setInputValue(newValue.dualWieldPenalty);
 		this.parryHastePicker.setInputValue(newValue.parryHaste);
+		this.playerLikePicker.setInputValue(newValue.playerLike);
 		this.spellSchoolPicker.setInputValue(newValue.spellSchool);
 		this.damageSpreadPicker.setInputValue(newValue.damageSpread);
 		ALL_TARGET_STATS.forEach((statData, i) => this.statPickers[i].setInputValue(newValue.stats[statData.stat]));
@@ -960,6 +1054,7 @@ const ALL_TARGET_STATS: Array<{ stat: Stat; tooltip: string; extraCssClasses: Ar
 	{ stat: Stat.StatHealth, tooltip: '', extraCssClasses: [] },
 	{ stat: Stat.StatArmor, tooltip: '', extraCssClasses: [] },
 	{ stat: Stat.StatAttackPower, tooltip: '', extraCssClasses: ['threat-metrics'] },
+	{ stat: Stat.StatPvpResilienceRating, tooltip: i18n.t('settings_tab.encounter.resilience_tooltip'), extraCssClasses: [] },
 ];
 
 const mobTypeEnumValues = [
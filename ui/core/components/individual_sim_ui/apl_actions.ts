@@ -23,19 +23,21 @@ import {
 	APLActionMoveDuration,
 	APLActionMultidot,
 	APLActionMultishield,
+	APLActionPoolResource,
 	APLActionResetSequence,
 	APLActionSchedule,
 	APLActionSequence,
 	APLActionStrictMultidot,
 	APLActionStrictSequence,
 	APLActionTriggerICD,
+	APLActionUseItemInSlot,
 	APLActionWait,
 	APLActionWaitUntil,
 	APLValue,
 	APLActionWarlockNextExhaleTarget,
 	APLActionCancelSpellCast,
 } from '../../proto/apl.js';
-import { Spec } from '../../proto/common.js';
+import { Class, Spec } from '../../proto/common.js';
 import { FeralDruid_Rotation_AplType } from '../../proto/druid.js';
 import { EventID } from '../../typed_event.js';
 import { randomUUID } from '../../utils';
@@ -340,6 +342,13 @@ const actionKindFactories: { [f in NonNullable<APLActionKind>]: ActionKindConfig
 		newValue: APLActionCastSpell.create,
 		fields: [AplHelpers.actionIdFieldConfig('spellId', 'castable_spells', ''), AplHelpers.unitFieldConfig('target', 'targets')],
 	}),
+	['useItemInSlot']: inputBuilder({
+		label: i18n.t('rotation_tab.apl.actions.use_item_in_slot.label'),
+		submenu: ['casting'],
+		shortDescription: i18n.t('rotation_tab.apl.actions.use_item_in_slot.tooltip'),
+		newValue: APLActionUseItemInSlot.create,
+		fields: [AplHelpers.itemSlotFieldConfig('itemSlot'), AplHelpers.unitFieldConfig('target', 'targets')],
+	}),
 	['cancelSpellCast']: inputBuilder({
 		label: i18n.t('rotation_tab.apl.actions.cancel_cast.label'),
 		submenu: ['casting'],
@@ -351,7 +360,11 @@ const actionKindFactories: { [f in NonNullable<APLActionKind>]: ActionKindConfig
 		label: i18n.t('rotation_tab.apl.actions.cast_at_player.label'),
 		shortDescription: i18n.t('rotation_tab.apl.actions.cast_at_player.tooltip'),
 		newValue: APLActionCastFriendlySpell.create,
-		fields: [AplHelpers.actionIdFieldConfig('spellId', 'friendly_spells', ''), AplHelpers.unitFieldConfig('target', 'players')],
+		fields: [
+			AplHelpers.actionIdFieldConfig('spellId', 'friendly_spells', ''),
+			AplHelpers.unitFieldConfig('target', 'players'),
+			AplHelpers.healTargetStrategyFieldConfig('smartTarget'),
+		],
 		includeIf: (player: Player<any>, _isPrepull: boolean) => player.getRaid()!.size() > 1 || player.shouldEnableTargetDummies(),
 	}),
 	['multidot']: inputBuilder({
@@ -515,6 +528,35 @@ const actionKindFactories: { [f in NonNullable<APLActionKind>]: ActionKindConfig
 		newValue: () => APLActionWaitUntil.create(),
 		fields: [AplValues.valueFieldConfig('condition')],
 	}),
+	['poolResource']: inputBuilder({
+		label: i18n.t('rotation_tab.apl.actions.pool_resource.label'),
+		submenu: ['timing'],
+		shortDescription: i18n.t('rotation_tab.apl.actions.pool_resource.tooltip'),
+		includeIf(player: Player<any>, isPrepull: boolean) {
+			const clss = player.getClass();
+			const spec = player.getSpec();
+			return (
+				!isPrepull &&
+				(spec === Spec.SpecFeralDruid ||
+					spec === Spec.SpecGuardianDruid ||
+					clss === Class.ClassRogue ||
+					clss === Class.ClassMonk ||
+					clss === Class.ClassHunter ||
+					clss === Class.ClassWarrior)
+			);
+		},
+		newValue: () => APLActionPoolResource.create(),
+		fields: [
+			AplValues.valueFieldConfig('targetAmount', {
+				label: i18n.t('rotation_tab.apl.actions.pool_resource.target.label'),
+				labelTooltip: i18n.t('rotation_tab.apl.actions.pool_resource.target.tooltip'),
+			}),
+			AplValues.valueFieldConfig('capMargin', {
+				label: i18n.t('rotation_tab.apl.actions.pool_resource.cap_margin.label'),
+				labelTooltip: i18n.t('rotation_tab.apl.actions.pool_resource.cap_margin.tooltip'),
+			}),
+		],
+	}),
 	['schedule']: inputBuilder({
 		label: i18n.t('rotation_tab.apl.actions.scheduled_action.label'),
 		submenu: ['timing'],
@@ -560,7 +602,18 @@ const actionKindFactories: { [f in NonNullable<APLActionKind>]: ActionKindConfig
 		fullDescription: i18n.t('rotation_tab.apl.actions.strict_sequence.full'),
 		includeIf: (_, isPrepull: boolean) => !isPrepull,
 		newValue: APLActionStrictSequence.create,
-		fields: [actionListFieldConfig('actions')],
+		fields: [
+			AplHelpers.stringFieldConfig('name'),
+			actionListFieldConfig('actions'),
+			AplValues.valueFieldConfig('resetCondition', {
+				label: i18n.t('rotation_tab.apl.actions.strict_sequence.reset_condition.label'),
+				labelTooltip: i18n.t('rotation_tab.apl.actions.strict_sequence.reset_condition.tooltip'),
+			}),
+			AplValues.valueFieldConfig('resetTimer', {
+				label: i18n.t('rotation_tab.apl.actions.strict_sequence.reset_timer.label'),
+				labelTooltip: i18n.t('rotation_tab.apl.actions.strict_sequence.reset_timer.tooltip'),
+			}),
+		],
 	}),
 	['changeTarget']: inputBuilder({
 		label: i18n.t('rotation_tab.apl.actions.change_target.label'),
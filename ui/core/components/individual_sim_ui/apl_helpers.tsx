@@ -10,11 +10,11 @@ import {
 	APLValueRuneSlot,
 	APLValueRuneType,
 } from '../../proto/apl.js';
-import { ActionID, OtherAction, Stat, UnitReference, UnitReference_Type as UnitType } from '../../proto/common.js';
+import { ActionID, HealTargetStrategy, ItemSlot, OtherAction, Stat, UnitReference, UnitReference_Type as UnitType } from '../../proto/common.js';
 import { FeralDruid_Rotation_AplType } from '../../proto/druid.js';
 import { ActionId, defaultTargetIcon, getPetIconFromName } from '../../proto_utils/action_id.js';
 import { getStatName } from '../../proto_utils/names.js';
-import { translateStat } from '../../../i18n/localization.js';
+import { translateSlotName, translateStat } from '../../../i18n/localization.js';
 import { EventID } from '../../typed_event.js';
 import { bucket, getEnumValues, randomUUID } from '../../utils.js';
 import { Input, InputConfig } from '../input.jsx';
@@ -29,6 +29,7 @@ export type ACTION_ID_SET =
 	| 'auras'
 	| 'stackable_auras'
 	| 'icd_auras'
+	| 'rppm_auras'
 	| 'exclusive_effect_auras'
 	| 'spells'
 	| 'castable_spells'
@@ -39,6 +40,7 @@ export type ACTION_ID_SET =
 	| 'non_instant_spells'
 	| 'friendly_spells'
 	| 'expected_dot_spells'
+	| 'expected_damage_spells'
 	| 'spells_with_travelTime';
 
 const actionIdSets: Record<
@@ -84,6 +86,19 @@ const actionIdSets: Record<
 				});
 		},
 	},
+	rppm_auras: {
+		defaultLabel: i18n.t('rotation_tab.apl.helpers.action_id_sets.rppm_auras'),
+		getActionIDs: async metadata => {
+			return metadata
+				.getAuras()
+				.filter(aura => aura.data.hasRppm)
+				.map(actionId => {
+					return {
+						value: actionId.id,
+					};
+				});
+		},
+	},
 	exclusive_effect_auras: {
 		defaultLabel: i18n.t('rotation_tab.apl.helpers.action_id_sets.exclusive_effect_auras'),
 		getActionIDs: async metadata => {
@@ -261,6 +276,23 @@ const actionIdSets: Record<
 			);
 		},
 	},
+	expected_damage_spells: {
+		defaultLabel: i18n.t('rotation_tab.apl.helpers.action_id_sets.expected_damage_spells'),
+		getActionIDs: async metadata => {
+			return (
+				metadata
+					.getSpells()
+					.filter(spell => spell.data.hasExpectedDamage)
+					// filter duplicate dot entries from RelatedDotSpell
+					.filter((value, index, self) => self.findIndex(v => v.id.anyId() === value.id.anyId()) === index)
+					.map(actionId => {
+						return {
+							value: actionId.id,
+						};
+					})
+			);
+		},
+	},
 	shield_spells: {
 		defaultLabel: i18n.t('rotation_tab.apl.helpers.action_id_sets.shield_spells'),
 		getActionIDs: async metadata => {
@@ -1187,6 +1219,30 @@ export function hotwStrategyFieldConfig(field: string): APLPickerBuilderFieldCon
 	};
 }
 
+export function healTargetStrategyFieldConfig(field: string): APLPickerBuilderFieldConfig<any, any> {
+	const values = [
+		{ value: HealTargetStrategy.HealTargetNone, label: i18n.t('rotation_tab.apl.helpers.heal_target_strategies.none') },
+		{ value: HealTargetStrategy.HealTargetLowestHealth, label: i18n.t('rotation_tab.apl.helpers.heal_target_strategies.lowest_health') },
+		{ value: HealTargetStrategy.HealTargetTankPriority, label: i18n.t('rotation_tab.apl.helpers.heal_target_strategies.tank_priority') },
+		{ value: HealTargetStrategy.HealTargetRandomInjured, label: i18n.t('rotation_tab.apl.helpers.heal_target_strategies.random_injured') },
+	];
+
+	return {
+		field: field,
+		label: i18n.t('rotation_tab.apl.helpers.field_configs.smart_target'),
+		labelTooltip: i18n.t('rotation_tab.apl.helpers.field_configs.smart_target_tooltip'),
+		newValue: () => HealTargetStrategy.HealTargetNone,
+		factory: (parent, player, config) =>
+			new TextDropdownPicker(parent, player, {
+				id: randomUUID(),
+				...config,
+				defaultLabel: i18n.t('rotation_tab.apl.helpers.heal_target_strategies.none'),
+				equals: (a, b) => a == b,
+				values: values,
+			}),
+	};
+}
+
 export function statTypeFieldConfig(field: string): APLPickerBuilderFieldConfig<any, any> {
 	const allStats = getEnumValues(Stat) as Array<Stat>;
 	const values = [{ value: -1, label: i18n.t('common.none') }].concat(
@@ -1246,6 +1302,23 @@ export function useRuneRegenBaseValueCheckbox(): APLPickerBuilderFieldConfig<any
 	});
 }
 
+export function itemSlotFieldConfig(field: string): APLPickerBuilderFieldConfig<any, any> {
+	// Slots which can hold an on-use item or tinker.
+	const slots = [ItemSlot.ItemSlotTrinket1, ItemSlot.ItemSlotTrinket2, ItemSlot.ItemSlotHands, ItemSlot.ItemSlotWaist, ItemSlot.ItemSlotBack];
+	return {
+		field: field,
+		newValue: () => ItemSlot.ItemSlotTrinket1,
+		factory: (parent, player, config) =>
+			new TextDropdownPicker(parent, player, {
+				id: randomUUID(),
+				...config,
+				defaultLabel: i18n.t('common.none'),
+				equals: (a, b) => a == b,
+				values: slots.map(slot => ({ value: slot, label: translateSlotName(slot) })),
+			}),
+	};
+}
+
 export function itemSwapSetFieldConfig(field: string): APLPickerBuilderFieldConfig<any, any> {
 	return {
 		field: field,
@@ -12,6 +12,8 @@ import {
 	APLValueAuraIsActive,
 	APLValueAuraIsKnown,
 	APLValueAuraNumStacks,
+	APLValueAuraStackAge,
+	APLValueAuraChargesToDischarge,
 	APLValueAuraRemainingTime,
 	APLValueAuraShouldRefresh,
 	APLValueAutoTimeToNext,
@@ -44,10 +46,12 @@ import {
 	APLValueCurrentTimePercent,
 	APLValueDotIsActive,
 	APLValueDotIsActiveOnAllTargets,
+	APLValueDotNumActiveTargets,
 	APLValueDotLowestRemainingTime,
 	APLValueDotPercentIncrease,
 	APLValueDotRemainingTime,
 	APLValueDotTickFrequency,
+	APLValueDotHasteRatingToNextTick,
 	APLValueAfflictionCurrentSnapshot,
 	APLValueEnergyRegenPerSecond,
 	APLValueEnergyTimeToTarget,
@@ -76,17 +80,21 @@ import {
 	APLValueFullRuneCooldown,
 	APLValueNot,
 	APLValueNumberTargets,
+	APLValueNumberTargetsInRange,
 	APLValueNumEquippedStatProcTrinkets,
 	APLValueNumStatBuffCooldowns,
 	APLValueOr,
 	APLValueProtectionPaladinDamageTakenLastGlobal,
+	APLValueRandomChance,
 	APLValueRemainingTime,
 	APLValueRemainingTimePercent,
 	APLValueRuneCooldown,
 	APLValueRuneSlotCooldown,
 	APLValueSequenceIsComplete,
 	APLValueSequenceIsReady,
+	APLValueSequencePosition,
 	APLValueSequenceTimeToReady,
+	APLValueShadowPriestTimeToNextOrb,
 	APLValueShamanFireElementalDuration,
 	APLValueSpellCanCast,
 	APLValueSpellCastTime,
@@ -100,6 +108,7 @@ import {
 	APLValueSpellTimeToCharge,
 	APLValueSpellTimeToReady,
 	APLValueSpellTravelTime,
+	APLValueTimeUntilEncounterEvent,
 	APLValueTotemRemainingTime,
 	APLValueTrinketProcsMaxRemainingICD,
 	APLValueTrinketProcsMinRemainingTime,
@@ -107,19 +116,32 @@ import {
 	APLValueUnitIsMoving,
 	APLValueVariablePlaceholder,
 	APLValueWarlockHandOfGuldanInFlight,
+	APLValueDemonologyDemonicFury,
+	APLValueDemonologyInMetamorphosis,
+	APLValueDemonologyFuryDrainRate,
 	APLValueWarlockHauntInFlight,
 	APLValueAfflictionExhaleWindow,
+	APLValueAfflictionHauntShardCoverage,
 	APLValueAuraIsInactive,
 	APLValueAuraICDIsReady,
+	APLValueAuraExpectedTimeToProc,
 	APLValueActiveItemSwapSet,
 	APLValueDotBaseDuration,
 	APLValueSpellGCDHastedDuration,
 	APLValueSpellFullCooldown,
 	APLValueDotTimeToNextTick,
+	APLValueDotInPandemicWindow,
+	APLValueDotRefreshThreshold,
 	APLValueSpellInFlight,
 	APLValueBossCurrentTarget,
+	APLValueBossDamageReduced,
+	APLValueBossDamageReducedRemainingTime,
+	APLValueBossSpellRemainingCastTime,
 	APLValueSpellIsCasting,
 	APLValueRemainingCastTime,
+	APLValueSpellCastsInWindow,
+	APLValuePreviousGcdSpellIs,
+	APLValueSpellExpectedDamage,
 } from '../../proto/apl.js';
 import { Class, Spec } from '../../proto/common.js';
 import { ShamanTotems_TotemType as TotemType } from '../../proto/shaman.js';
@@ -605,6 +627,23 @@ const valueKindFactories: { [f in ValidAPLValueKind]: ValueKindConfig<APLValueIm
 		fields: [valueFieldConfig('val')],
 	}),
 
+	randomChance: inputBuilder({
+		label: i18n.t('rotation_tab.apl.values.random_chance.label'),
+		submenu: ['logic'],
+		shortDescription: i18n.t('rotation_tab.apl.values.random_chance.tooltip'),
+		newValue: () => APLValueRandomChance.create({ chance: 0.85 }),
+		includeIf: (_: Player<any>, isPrepull: boolean) => !isPrepull,
+		fields: [
+			AplHelpers.numberFieldConfig('chance', true, {
+				label: i18n.t('rotation_tab.apl.values.random_chance.chance.label'),
+				labelTooltip: i18n.t('rotation_tab.apl.values.random_chance.chance.tooltip'),
+			}),
+			AplHelpers.booleanFieldConfig('rollOncePerFight', i18n.t('rotation_tab.apl.values.random_chance.roll_once_per_fight.label'), {
+				labelTooltip: i18n.t('rotation_tab.apl.values.random_chance.roll_once_per_fight.tooltip'),
+			}),
+		],
+	}),
+
 	// Encounter
 	currentTime: inputBuilder({
 		label: i18n.t('rotation_tab.apl.values.current_time.label'),
@@ -646,6 +685,19 @@ const valueKindFactories: { [f in ValidAPLValueKind]: ValueKindConfig<APLValueIm
 		includeIf: (_: Player<any>, isPrepull: boolean) => !isPrepull,
 		fields: [executePhaseThresholdFieldConfig('threshold')],
 	}),
+	timeUntilEncounterEvent: inputBuilder({
+		label: i18n.t('rotation_tab.apl.values.time_until_encounter_event.label'),
+		submenu: ['encounter'],
+		shortDescription: i18n.t('rotation_tab.apl.values.time_until_encounter_event.tooltip'),
+		newValue: () => APLValueTimeUntilEncounterEvent.create({ eventName: 'Add Spawn' }),
+		includeIf: (_: Player<any>, isPrepull: boolean) => !isPrepull,
+		fields: [
+			AplHelpers.stringFieldConfig('eventName', {
+				label: i18n.t('rotation_tab.apl.values.time_until_encounter_event.event_name.label'),
+				labelTooltip: i18n.t('rotation_tab.apl.values.time_until_encounter_event.event_name.tooltip'),
+			}),
+		],
+	}),
 	numberTargets: inputBuilder({
 		label: i18n.t('rotation_tab.apl.values.num_targets.label'),
 		submenu: ['encounter'],
@@ -654,6 +706,29 @@ const valueKindFactories: { [f in ValidAPLValueKind]: ValueKindConfig<APLValueIm
 		includeIf: (_: Player<any>, isPrepull: boolean) => !isPrepull,
 		fields: [],
 	}),
+	numberTargetsInRange: inputBuilder({
+		label: i18n.t('rotation_tab.apl.values.num_targets_in_range.label'),
+		submenu: ['encounter'],
+		shortDescription: i18n.t('rotation_tab.apl.values.num_targets_in_range.tooltip'),
+		newValue: () =>
+			APLValueNumberTargetsInRange.create({
+				maxRange: {
+					value: {
+						oneofKind: 'const',
+						const: {
+							val: '8',
+						},
+					},
+				},
+			}),
+		includeIf: (_: Player<any>, isPrepull: boolean) => !isPrepull,
+		fields: [
+			valueFieldConfig('maxRange', {
+				label: i18n.t('rotation_tab.apl.values.num_targets_in_range.max_range.label'),
+				labelTooltip: i18n.t('rotation_tab.apl.values.num_targets_in_range.max_range.tooltip'),
+			}),
+		],
+	}),
 	frontOfTarget: inputBuilder({
 		label: i18n.t('rotation_tab.apl.values.in_front_of_target.label'),
 		submenu: ['encounter'],
@@ -673,6 +748,23 @@ const valueKindFactories: { [f in ValidAPLValueKind]: ValueKindConfig<APLValueIm
 		fields: [
 			AplHelpers.unitFieldConfig('targetUnit', 'targets'),
 			AplHelpers.actionIdFieldConfig('spellId', 'non_instant_spells', 'targetUnit', 'currentTarget'),
+			AplHelpers.booleanFieldConfig('anyTarget', i18n.t('rotation_tab.apl.values.boss_spell_any_target.label'), {
+				labelTooltip: i18n.t('rotation_tab.apl.values.boss_spell_any_target.tooltip'),
+			}),
+		],
+	}),
+	bossSpellRemainingCastTime: inputBuilder({
+		label: i18n.t('rotation_tab.apl.values.boss_spell_remaining_cast_time.label'),
+		submenu: ['boss'],
+		shortDescription: i18n.t('rotation_tab.apl.values.boss_spell_remaining_cast_time.tooltip'),
+		newValue: APLValueBossSpellRemainingCastTime.create,
+		includeIf: (_: Player<any>, isPrepull: boolean) => !isPrepull,
+		fields: [
+			AplHelpers.unitFieldConfig('targetUnit', 'targets'),
+			AplHelpers.actionIdFieldConfig('spellId', 'non_instant_spells', 'targetUnit', 'currentTarget'),
+			AplHelpers.booleanFieldConfig('anyTarget', i18n.t('rotation_tab.apl.values.boss_spell_any_target.label'), {
+				labelTooltip: i18n.t('rotation_tab.apl.values.boss_spell_any_target.tooltip'),
+			}),
 		],
 	}),
 	bossSpellTimeToReady: inputBuilder({
@@ -691,6 +783,22 @@ const valueKindFactories: { [f in ValidAPLValueKind]: ValueKindConfig<APLValueIm
 		includeIf: (_: Player<any>, isPrepull: boolean) => !isPrepull,
 		fields: [AplHelpers.unitFieldConfig('targetUnit', 'targets')],
 	}),
+	bossDamageReduced: inputBuilder({
+		label: i18n.t('rotation_tab.apl.values.boss_damage_reduced.label'),
+		submenu: ['boss'],
+		shortDescription: i18n.t('rotation_tab.apl.values.boss_damage_reduced.tooltip'),
+		newValue: APLValueBossDamageReduced.create,
+		includeIf: (_: Player<any>, isPrepull: boolean) => !isPrepull,
+		fields: [AplHelpers.unitFieldConfig('targetUnit', 'targets')],
+	}),
+	bossDamageReducedRemainingTime: inputBuilder({
+		label: i18n.t('rotation_tab.apl.values.boss_damage_reduced_remaining_time.label'),
+		submenu: ['boss'],
+		shortDescription: i18n.t('rotation_tab.apl.values.boss_damage_reduced_remaining_time.tooltip'),
+		newValue: APLValueBossDamageReducedRemainingTime.create,
+		includeIf: (_: Player<any>, isPrepull: boolean) => !isPrepull,
+		fields: [AplHelpers.unitFieldConfig('targetUnit', 'targets')],
+	}),
 
 	// Unit
 	unitIsMoving: inputBuilder({
@@ -1209,6 +1317,46 @@ const valueKindFactories: { [f in ValidAPLValueKind]: ValueKindConfig<APLValueIm
 		includeIf: (_: Player<any>, isPrepull: boolean) => !isPrepull,
 		fields: [AplHelpers.actionIdFieldConfig('spellId', 'spells_with_travelTime', '')],
 	}),
+	spellCastsInWindow: inputBuilder({
+		label: i18n.t('rotation_tab.apl.values.spell_casts_in_window.label'),
+		submenu: ['spell'],
+		shortDescription: i18n.t('rotation_tab.apl.values.spell_casts_in_window.tooltip'),
+		newValue: () =>
+			APLValueSpellCastsInWindow.create({
+				window: {
+					value: {
+						oneofKind: 'const',
+						const: {
+							val: '10s',
+						},
+					},
+				},
+			}),
+		includeIf: (_: Player<any>, isPrepull: boolean) => !isPrepull,
+		fields: [
+			AplHelpers.actionIdFieldConfig('spellId', 'castable_spells', ''),
+			valueFieldConfig('window', {
+				label: i18n.t('rotation_tab.apl.values.spell_casts_in_window.window.label'),
+				labelTooltip: i18n.t('rotation_tab.apl.values.spell_casts_in_window.window.tooltip'),
+			}),
+		],
+	}),
+	previousGcdSpellIs: inputBuilder({
+		label: i18n.t('rotation_tab.apl.values.previous_gcd_spell_is.label'),
+		submenu: ['spell'],
+		shortDescription: i18n.t('rotation_tab.apl.values.previous_gcd_spell_is.tooltip'),
+		newValue: APLValuePreviousGcdSpellIs.create,
+		includeIf: (_: Player<any>, isPrepull: boolean) => !isPrepull,
+		fields: [AplHelpers.actionIdFieldConfig('spellId', 'castable_spells', '')],
+	}),
+	spellExpectedDamage: inputBuilder({
+		label: i18n.t('rotation_tab.apl.values.spell_expected_damage.label'),
+		submenu: ['spell'],
+		shortDescription: i18n.t('rotation_tab.apl.values.spell_expected_damage.tooltip'),
+		newValue: APLValueSpellExpectedDamage.create,
+		includeIf: (_: Player<any>, isPrepull: boolean) => !isPrepull,
+		fields: [AplHelpers.unitFieldConfig('targetUnit', 'targets'), AplHelpers.actionIdFieldConfig('spellId', 'expected_damage_spells', '')],
+	}),
 
 	// Auras
 	auraIsKnown: inputBuilder({
@@ -1278,6 +1426,22 @@ const valueKindFactories: { [f in ValidAPLValueKind]: ValueKindConfig<APLValueIm
 			AplHelpers.reactionTimeCheckbox(),
 		],
 	}),
+	auraStackAge: inputBuilder({
+		label: i18n.t('rotation_tab.apl.values.aura_stack_age.label'),
+		submenu: ['aura'],
+		shortDescription: i18n.t('rotation_tab.apl.values.aura_stack_age.tooltip'),
+		newValue: APLValueAuraStackAge.create,
+		includeIf: (_: Player<any>, isPrepull: boolean) => !isPrepull,
+		fields: [AplHelpers.unitFieldConfig('sourceUnit', 'aura_sources'), AplHelpers.actionIdFieldConfig('auraId', 'stackable_auras', 'sourceUnit')],
+	}),
+	auraChargesToDischarge: inputBuilder({
+		label: i18n.t('rotation_tab.apl.values.aura_charges_to_discharge.label'),
+		submenu: ['aura'],
+		shortDescription: i18n.t('rotation_tab.apl.values.aura_charges_to_discharge.tooltip'),
+		newValue: APLValueAuraChargesToDischarge.create,
+		includeIf: (_: Player<any>, isPrepull: boolean) => !isPrepull,
+		fields: [AplHelpers.unitFieldConfig('sourceUnit', 'aura_sources'), AplHelpers.actionIdFieldConfig('auraId', 'stackable_auras', 'sourceUnit')],
+	}),
 	auraInternalCooldown: inputBuilder({
 		label: i18n.t('rotation_tab.apl.values.aura_remaining_icd.label'),
 		submenu: ['aura'],
@@ -1306,6 +1470,15 @@ const valueKindFactories: { [f in ValidAPLValueKind]: ValueKindConfig<APLValueIm
 		includeIf: (_: Player<any>, isPrepull: boolean) => !isPrepull,
 		fields: [AplHelpers.unitFieldConfig('sourceUnit', 'aura_sources'), AplHelpers.actionIdFieldConfig('auraId', 'icd_auras', 'sourceUnit')],
 	}),
+	auraExpectedTimeToProc: inputBuilder({
+		label: i18n.t('rotation_tab.apl.values.aura_expected_time_to_proc.label'),
+		submenu: ['aura'],
+		shortDescription: i18n.t('rotation_tab.apl.values.aura_expected_time_to_proc.tooltip'),
+		fullDescription: i18n.t('rotation_tab.apl.values.aura_expected_time_to_proc.full_description'),
+		newValue: APLValueAuraExpectedTimeToProc.create,
+		includeIf: (_: Player<any>, isPrepull: boolean) => !isPrepull,
+		fields: [AplHelpers.unitFieldConfig('sourceUnit', 'aura_sources'), AplHelpers.actionIdFieldConfig('auraId', 'rppm_auras', 'sourceUnit')],
+	}),
 	auraShouldRefresh: inputBuilder({
 		label: i18n.t('rotation_tab.apl.values.aura_should_refresh.label'),
 		submenu: ['aura'],
@@ -1505,6 +1678,14 @@ const valueKindFactories: { [f in ValidAPLValueKind]: ValueKindConfig<APLValueIm
 		includeIf: (_: Player<any>, isPrepull: boolean) => !isPrepull,
 		fields: [AplHelpers.actionIdFieldConfig('spellId', 'dot_spells')],
 	}),
+	dotNumActiveTargets: inputBuilder({
+		label: i18n.t('rotation_tab.apl.values.dot_num_active_targets.label'),
+		submenu: ['dot'],
+		shortDescription: i18n.t('rotation_tab.apl.values.dot_num_active_targets.tooltip'),
+		newValue: APLValueDotNumActiveTargets.create,
+		includeIf: (_: Player<any>, isPrepull: boolean) => !isPrepull,
+		fields: [AplHelpers.actionIdFieldConfig('spellId', 'dot_spells')],
+	}),
 	dotRemainingTime: inputBuilder({
 		label: i18n.t('rotation_tab.apl.values.dot_remaining_time.label'),
 		submenu: ['dot'],
@@ -1529,6 +1710,14 @@ const valueKindFactories: { [f in ValidAPLValueKind]: ValueKindConfig<APLValueIm
 		includeIf: (_: Player<any>, isPrepull: boolean) => !isPrepull,
 		fields: [AplHelpers.unitFieldConfig('targetUnit', 'targets'), AplHelpers.actionIdFieldConfig('spellId', 'dot_spells', '')],
 	}),
+	dotHasteRatingToNextTick: inputBuilder({
+		label: i18n.t('rotation_tab.apl.values.dot_haste_rating_to_next_tick.label'),
+		submenu: ['dot'],
+		shortDescription: i18n.t('rotation_tab.apl.values.dot_haste_rating_to_next_tick.tooltip'),
+		newValue: APLValueDotHasteRatingToNextTick.create,
+		includeIf: (_: Player<any>, isPrepull: boolean) => !isPrepull,
+		fields: [AplHelpers.unitFieldConfig('targetUnit', 'targets'), AplHelpers.actionIdFieldConfig('spellId', 'dot_spells', '')],
+	}),
 	dotTimeToNextTick: inputBuilder({
 		label: i18n.t('rotation_tab.apl.values.dot_time_to_next_tick.label'),
 		submenu: ['dot'],
@@ -1537,6 +1726,22 @@ const valueKindFactories: { [f in ValidAPLValueKind]: ValueKindConfig<APLValueIm
 		includeIf: (_: Player<any>, isPrepull: boolean) => !isPrepull,
 		fields: [AplHelpers.unitFieldConfig('targetUnit', 'targets'), AplHelpers.actionIdFieldConfig('spellId', 'dot_spells', '')],
 	}),
+	dotInPandemicWindow: inputBuilder({
+		label: i18n.t('rotation_tab.apl.values.dot_in_pandemic_window.label'),
+		submenu: ['dot'],
+		shortDescription: i18n.t('rotation_tab.apl.values.dot_in_pandemic_window.tooltip'),
+		newValue: APLValueDotInPandemicWindow.create,
+		includeIf: (_: Player<any>, isPrepull: boolean) => !isPrepull,
+		fields: [AplHelpers.unitFieldConfig('targetUnit', 'targets'), AplHelpers.actionIdFieldConfig('spellId', 'dot_spells', '')],
+	}),
+	dotRefreshThreshold: inputBuilder({
+		label: i18n.t('rotation_tab.apl.values.dot_refresh_threshold.label'),
+		submenu: ['dot'],
+		shortDescription: i18n.t('rotation_tab.apl.values.dot_refresh_threshold.tooltip'),
+		newValue: APLValueDotRefreshThreshold.create,
+		includeIf: (_: Player<any>, isPrepull: boolean) => !isPrepull,
+		fields: [AplHelpers.unitFieldConfig('targetUnit', 'targets'), AplHelpers.actionIdFieldConfig('spellId', 'dot_spells', '')],
+	}),
 	dotBaseDuration: inputBuilder({
 		label: 'Dot Base Duration',
 		submenu: ['dot'],
@@ -1557,6 +1762,18 @@ const valueKindFactories: { [f in ValidAPLValueKind]: ValueKindConfig<APLValueIm
 			AplHelpers.useDotBaseValueCheckbox(),
 		],
 	}),
+	dotDamageIncrease: inputBuilder({
+		label: i18n.t('rotation_tab.apl.values.dot_damage_increase.label'),
+		submenu: ['dot'],
+		shortDescription: i18n.t('rotation_tab.apl.values.dot_damage_increase.tooltip'),
+		newValue: APLValueDotPercentIncrease.create,
+		includeIf: (_: Player<any>, isPrepull: boolean) => !isPrepull,
+		fields: [
+			AplHelpers.unitFieldConfig('targetUnit', 'targets'),
+			AplHelpers.actionIdFieldConfig('spellId', 'expected_dot_spells', ''),
+			AplHelpers.useDotBaseValueCheckbox(),
+		],
+	}),
 	dotCritPercentIncrease: inputBuilder({
 		label: 'Dot Crit Chance Increase %',
 		submenu: ['dot'],
@@ -1605,6 +1822,14 @@ const valueKindFactories: { [f in ValidAPLValueKind]: ValueKindConfig<APLValueIm
 		includeIf: (_: Player<any>, isPrepull: boolean) => !isPrepull,
 		fields: [AplHelpers.stringFieldConfig('sequenceName')],
 	}),
+	sequencePosition: inputBuilder({
+		label: i18n.t('rotation_tab.apl.values.sequence_position.label'),
+		submenu: ['sequence'],
+		shortDescription: i18n.t('rotation_tab.apl.values.sequence_position.tooltip'),
+		newValue: APLValueSequencePosition.create,
+		includeIf: (_: Player<any>, isPrepull: boolean) => !isPrepull,
+		fields: [AplHelpers.stringFieldConfig('sequenceName')],
+	}),
 
 	// Class/spec specific values
 	totemRemainingTime: inputBuilder({
@@ -1647,6 +1872,30 @@ const valueKindFactories: { [f in ValidAPLValueKind]: ValueKindConfig<APLValueIm
 		includeIf: (player: Player<any>, isPrepull: boolean) => !isPrepull && player.getSpec() == Spec.SpecDemonologyWarlock,
 		fields: [],
 	}),
+	demonologyDemonicFury: inputBuilder({
+		label: i18n.t('rotation_tab.apl.values.demonology_demonic_fury.label'),
+		submenu: ['warlock'],
+		shortDescription: i18n.t('rotation_tab.apl.values.demonology_demonic_fury.tooltip'),
+		newValue: APLValueDemonologyDemonicFury.create,
+		includeIf: (player: Player<any>, isPrepull: boolean) => !isPrepull && player.getSpec() == Spec.SpecDemonologyWarlock,
+		fields: [],
+	}),
+	demonologyInMetamorphosis: inputBuilder({
+		label: i18n.t('rotation_tab.apl.values.demonology_in_metamorphosis.label'),
+		submenu: ['warlock'],
+		shortDescription: i18n.t('rotation_tab.apl.values.demonology_in_metamorphosis.tooltip'),
+		newValue: APLValueDemonologyInMetamorphosis.create,
+		includeIf: (player: Player<any>, isPrepull: boolean) => !isPrepull && player.getSpec() == Spec.SpecDemonologyWarlock,
+		fields: [],
+	}),
+	demonologyFuryDrainRate: inputBuilder({
+		label: i18n.t('rotation_tab.apl.values.demonology_fury_drain_rate.label'),
+		submenu: ['warlock'],
+		shortDescription: i18n.t('rotation_tab.apl.values.demonology_fury_drain_rate.tooltip'),
+		newValue: APLValueDemonologyFuryDrainRate.create,
+		includeIf: (player: Player<any>, isPrepull: boolean) => !isPrepull && player.getSpec() == Spec.SpecDemonologyWarlock,
+		fields: [],
+	}),
 	warlockHauntInFlight: inputBuilder({
 		label: i18n.t('rotation_tab.apl.values.warlock_haunt_in_flight.label'),
 		submenu: ['warlock'],
@@ -1663,6 +1912,14 @@ const valueKindFactories: { [f in ValidAPLValueKind]: ValueKindConfig<APLValueIm
 		includeIf: (player: Player<any>, isPrepull: boolean) => !isPrepull && player.getSpec() == Spec.SpecAfflictionWarlock,
 		fields: [],
 	}),
+	afflictionHauntShardCoverage: inputBuilder({
+		label: i18n.t('rotation_tab.apl.values.affliction_haunt_shard_coverage.label'),
+		submenu: ['warlock'],
+		shortDescription: i18n.t('rotation_tab.apl.values.affliction_haunt_shard_coverage.tooltip'),
+		newValue: APLValueAfflictionHauntShardCoverage.create,
+		includeIf: (player: Player<any>, isPrepull: boolean) => !isPrepull && player.getSpec() == Spec.SpecAfflictionWarlock,
+		fields: [AplHelpers.unitFieldConfig('targetUnit', 'targets')],
+	}),
 	afflictionCurrentSnapshot: inputBuilder({
 		label: i18n.t('rotation_tab.apl.values.affliction_current_snapshot.label'),
 		submenu: ['warlock'],
@@ -1679,6 +1936,14 @@ const valueKindFactories: { [f in ValidAPLValueKind]: ValueKindConfig<APLValueIm
 		includeIf: (player: Player<any>, isPrepull: boolean) => !isPrepull && player.getSpec() == Spec.SpecFireMage,
 		fields: [],
 	}),
+	shadowPriestTimeToNextOrb: inputBuilder({
+		label: i18n.t('rotation_tab.apl.values.shadow_priest_time_to_next_orb.label'),
+		submenu: ['priest'],
+		shortDescription: i18n.t('rotation_tab.apl.values.shadow_priest_time_to_next_orb.tooltip'),
+		newValue: APLValueShadowPriestTimeToNextOrb.create,
+		includeIf: (player: Player<any>, isPrepull: boolean) => !isPrepull && player.getSpec() == Spec.SpecShadowPriest,
+		fields: [],
+	}),
 	brewmasterMonkCurrentStaggerPercent: inputBuilder({
 		label: i18n.t('rotation_tab.apl.values.brewmaster_monk_current_stagger_percent.label'),
 		submenu: ['tank'],
@@ -99,3 +99,25 @@ export function getAllPairs<T>(arr: T[]): [T, T][] {
   }
   return pairs;
 }
+
+// Fraction of the JS heap limit above which a batch is stopped early.
+const MAX_HEAP_USAGE = 0.85;
+
+// Whether the page is close to running out of memory. Only Chromium based
+// browsers expose heap usage, elsewhere this is always false.
+export const isMemoryPressureHigh = (): boolean => {
+	const memory = (performance as Performance & { memory?: { usedJSHeapSize: number; jsHeapSizeLimit: number } }).memory;
+	return !!memory && memory.usedJSHeapSize > memory.jsHeapSizeLimit * MAX_HEAP_USAGE;
+};
+
+// MoP melee weapon enchants that can be compared as a bulk sim dimension, by effect ID.
+export const bulkSimWeaponEnchantEffectIds = [
+	4441, // Windsong
+	4442, // Jade Spirit
+	4443, // Elemental Force
+	4444, // Dancing Steel
+	4445, // Colossus
+	4446, // River's Song
+	5124, // Spirit of Conquest
+	5125, // Bloody Dancing Steel
+];
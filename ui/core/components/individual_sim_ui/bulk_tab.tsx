@@ -14,7 +14,7 @@ import { ActionId } from '../../proto_utils/action_id';
 import { EquippedItem } from '../../proto_utils/equipped_item';
 import { Gear } from '../../proto_utils/gear';
 import { getEmptyGemSocketIconUrl } from '../../proto_utils/gems';
-import { canEquipItem, getEligibleItemSlots, isSecondaryItemSlot } from '../../proto_utils/utils';
+import { canEquipItem, enchantAppliesToItem, getEligibleItemSlots, isSecondaryItemSlot } from '../../proto_utils/utils';
 import { RequestTypes } from '../../sim_signal_manager';
 import { RelativeStatCap } from '../suggest_reforges_action';
 import { TypedEvent } from '../../typed_event';
@@ -33,8 +33,10 @@ import {
 	BulkSimItemSlot,
 	bulkSimItemSlotToSingleItemSlot,
 	bulkSimItemSlotToItemSlotPairs,
+	bulkSimWeaponEnchantEffectIds,
 	getAllPairs,
 	getBulkItemSlotFromSlot,
+	isMemoryPressureHigh,
 } from './bulk/utils';
 import { BulkGearJsonImporter } from './importers';
 import { BooleanPicker } from '../pickers/boolean_picker';
@@ -64,6 +66,7 @@ export class BulkTab extends SimTab {
 	private readonly combinationsElem: HTMLElement;
 	private readonly bulkSimButton: HTMLButtonElement;
 	private readonly settingsContainer: HTMLElement;
+	private weaponEnchantsContainer: HTMLElement | null = null;
 
 	private pendingDiv: HTMLDivElement;
 
@@ -87,6 +90,8 @@ export class BulkTab extends SimTab {
 	]);
 	defaultGems: SimGem[];
 	gemIconElements: HTMLImageElement[];
+	// Effect IDs of the weapon enchants to compare, empty to keep the equipped ones.
+	weaponEnchants: number[] = [];
 
 	protected topGearResults: TopGearResult[] | null = null;
 	protected originalGear: Gear | null = null;
@@ -195,6 +200,7 @@ export class BulkTab extends SimTab {
 		this.buildTabContent();
 
 		this.simUI.sim.waitForInit().then(() => {
+			this.buildWeaponEnchantPickers();
 			this.loadSettings();
 			const loadEquippedItems = () => {
 				if (this.isRunning) {
@@ -248,6 +254,7 @@ export class BulkTab extends SimTab {
 
 			this.addItems(settings.items, true);
 			this.setInheritUpgrades(settings.inheritUpgrades);
+			this.setWeaponEnchants(settings.weaponEnchants);
 			this.defaultGems = new Array<SimGem>(
 				SimGem.create({ id: settings.defaultRedGem }),
 				SimGem.create({ id: settings.defaultYellowGem }),
@@ -284,6 +291,7 @@ export class BulkTab extends SimTab {
 			defaultBlueGem: this.defaultGems[2].id,
 			defaultMetaGem: this.defaultGems[3].id,
 			defaultPrismaticGem: this.defaultGems[4].id,
+			weaponEnchants: this.weaponEnchants,
 			iterationsPerCombo: this.getDefaultIterationsCount(),
 		});
 	}
@@ -603,9 +611,22 @@ export class BulkTab extends SimTab {
 		return itemsForCombo;
 	}
 
+	// Resolves the weapon enchant to apply for a combination, and the index of
+	// the item combination it is applied to.
+	protected getWeaponEnchantForCombo(comboIdx: number): [UIEnchant | null, number] {
+		const numWeaponEnchants = this.weaponEnchants.length;
+		if (!numWeaponEnchants) {
+			return [null, comboIdx];
+		}
+
+		const effectId = this.weaponEnchants[comboIdx % numWeaponEnchants];
+		const enchant = this.simUI.sim.db.getEnchants(ItemSlot.ItemSlotMainHand).find(enchant => enchant.effectId === effectId) || null;
+		return [enchant, Math.floor(comboIdx / numWeaponEnchants)];
+	}
+
 	protected async calculateBulkCombinations() {
 		try {
-			let numCombinations: number = this.getAllWeaponCombos().length;
+			let numCombinations: number = this.getAllWeaponCombos().length * Math.max(this.weaponEnchants.length, 1);
 
 			for (const [bulkItemSlot, pickerGroup] of this.pickerGroups.entries()) {
 				if ([BulkSimItemSlot.ItemSlotMainHand, BulkSimItemSlot.ItemSlotOffHand, BulkSimItemSlot.ItemSlotHandWeapon].includes(bulkItemSlot)) {
@@ -745,6 +766,10 @@ export class BulkTab extends SimTab {
 			this.isPending = true;
 			let waitAbort = false;
 			let isAborted = false;
+			let isLowOnMemory = false;
+			let isFinished = false;
+			let referenceDpsMetrics: DistributionMetrics | null = null;
+			const topGearResults: TopGearResult[] = [];
 			this.topGearResults = null;
 			this.originalGearResults = null;
 			const playerPhase = this.simUI.sim.getPhase() >= 2;
@@ -756,7 +781,6 @@ export class BulkTab extends SimTab {
 
 				this.originalGear = this.simUI.player.getGear();
 				let updatedGear: Gear = this.originalGear;
-				let topGearResults: TopGearResult[] = [];
 
 				this.pendingResults.addAbortButton(async () => {
 					if (waitAbort) return;
@@ -781,13 +805,7 @@ export class BulkTab extends SimTab {
 					const msSinceStart = new Date().getTime() - simStart;
 					this.setSimProgress(progressMetrics, msSinceStart / 1000, 0, this.combinations);
 				});
-				const referenceDpsMetrics = this.simUI.raidSimResultsManager!.currentData!.simResult!.getFirstPlayer()!.dps;
-
-				const allItemCombos: Map<ItemSlot, EquippedItem>[] = [];
-
-				for (let comboIdx = 0; comboIdx < this.combinations; comboIdx++) {
-					allItemCombos.push(this.getItemsForCombo(comboIdx));
-				}
+				referenceDpsMetrics = this.simUI.raidSimResultsManager!.currentData!.simResult!.getFirstPlayer()!.dps;
 
 				const defaultGemsByColor = new Map<GemColor, UIGem | null>();
 
@@ -805,10 +823,17 @@ export class BulkTab extends SimTab {
 					if (isAborted) {
 						throw new Error('Bulk Sim Aborted');
 					}
+					// Stop early and keep the results so far, rather than crashing the page.
+					if (isMemoryPressureHigh()) {
+						isLowOnMemory = true;
+						break;
+					}
 
 					updatedGear = this.originalGear;
+					const [weaponEnchant, itemComboIdx] = this.getWeaponEnchantForCombo(comboIdx);
 
-					for (const [itemSlot, equippedItem] of allItemCombos[comboIdx].entries()) {
+					// Combos are built one at a time, as large batches have too many to keep in memory.
+					for (const [itemSlot, equippedItem] of this.getItemsForCombo(itemComboIdx).entries()) {
 						const equippedItemInSlot = this.originalGear.getEquippedItem(itemSlot);
 						let updatedItem = equippedItemInSlot
 							? equippedItemInSlot.withItem(equippedItem.item)
@@ -830,6 +855,16 @@ export class BulkTab extends SimTab {
 						}
 					}
 
+					// Each hand procs its own enchant, so dual wielders get it on both weapons.
+					if (weaponEnchant) {
+						for (const weaponSlot of [ItemSlot.ItemSlotMainHand, ItemSlot.ItemSlotOffHand]) {
+							const weapon = updatedGear.getEquippedItem(weaponSlot);
+							if (weapon && enchantAppliesToItem(weaponEnchant, weapon.item)) {
+								updatedGear = updatedGear.withEquippedItem(weaponSlot, weapon.withEnchant(weaponEnchant), this.playerIsFuryWarrior);
+							}
+						}
+					}
+
 					await this.simUI.player.setGearAsync(TypedEvent.nextEventID(), updatedGear);
 
 					if (this.simUI.reforger) {
@@ -870,7 +905,7 @@ export class BulkTab extends SimTab {
 							const msSinceStart = new Date().getTime() - simStart;
 							this.setSimProgress(progressMetrics, msSinceStart / 1000, comboIdx + 1, this.combinations);
 						},
-						{ silent: true },
+						{ silent: true, skipLogs: true },
 					);
 
 					if (result && 'type' in result) {
@@ -888,24 +923,25 @@ export class BulkTab extends SimTab {
 					topGearResults.sort((a, b) => b.dpsMetrics.avg - a.dpsMetrics.avg);
 					if (topGearResults.length > 5) topGearResults.pop();
 				}
-
-				await this.simUI.player.setGearAsync(TypedEvent.nextEventID(), this.originalGear);
-
-				this.topGearResults = topGearResults;
-				this.originalGearResults = {
-					gear: this.originalGear,
-					dpsMetrics: referenceDpsMetrics,
-				};
-
-				this.topGearResults.push(this.originalGearResults);
-				this.topGearResults.sort((a, b) => b.dpsMetrics.avg - a.dpsMetrics.avg);
-
-				this.simUI.resultsViewer.hideAll();
-				this.buildResultsTabContent();
+				isFinished = true;
 			} catch (error) {
 				console.error(error);
-				await this.simUI.player.setGearAsync(TypedEvent.nextEventID(), this.originalGear!);
 			} finally {
+				await this.simUI.player.setGearAsync(TypedEvent.nextEventID(), this.originalGear!);
+
+				// Also show the results of an aborted or stopped batch, as far as it got.
+				if (referenceDpsMetrics && (isFinished || topGearResults.length)) {
+					this.originalGearResults = {
+						gear: this.originalGear!,
+						dpsMetrics: referenceDpsMetrics,
+					};
+					this.topGearResults = [...topGearResults, this.originalGearResults];
+					this.topGearResults.sort((a, b) => b.dpsMetrics.avg - a.dpsMetrics.avg);
+
+					this.simUI.resultsViewer.hideAll();
+					this.buildResultsTabContent();
+				}
+
 				this.isRunning = false;
 				if (!waitAbort) this.bulkSimButton.disabled = false;
 				if (isAborted) {
@@ -913,6 +949,11 @@ export class BulkTab extends SimTab {
 						variant: 'error',
 						body: i18n.t('bulk_tab.notifications.bulk_sim_cancelled'),
 					});
+				} else if (isLowOnMemory) {
+					new Toast({
+						variant: 'warning',
+						body: i18n.t('bulk_tab.notifications.bulk_sim_low_memory'),
+					});
 				}
 				this.simUI.resultsViewer.hideAll();
 				this.isPending = false;
@@ -923,6 +964,7 @@ export class BulkTab extends SimTab {
 		const inheritUpgradesDiv = ref<HTMLDivElement>();
 		const frozenRingDiv = ref<HTMLDivElement>();
 		const frozenTrinketDiv = ref<HTMLDivElement>();
+		const weaponEnchantsDiv = ref<HTMLDivElement>();
 
 		this.settingsContainer.appendChild(
 			<>
@@ -933,8 +975,10 @@ export class BulkTab extends SimTab {
 				<div ref={inheritUpgradesDiv} className="inherit-upgrades-container"></div>
 				<div ref={frozenRingDiv}></div>
 				<div ref={frozenTrinketDiv}></div>
+				<div ref={weaponEnchantsDiv} className="weapon-enchants-container"></div>
 			</>,
 		);
+		this.weaponEnchantsContainer = weaponEnchantsDiv.value ?? null;
 
 		if (inheritUpgradesDiv.value)
 			new BooleanPicker<BulkTab>(inheritUpgradesDiv.value, this, {
@@ -1088,6 +1132,39 @@ export class BulkTab extends SimTab {
 		);
 	}
 
+	// Needs the database, so this is built once the sim has loaded.
+	private buildWeaponEnchantPickers() {
+		if (!this.weaponEnchantsContainer) return;
+
+		const weaponEnchants = bulkSimWeaponEnchantEffectIds
+			.map(effectId => this.simUI.sim.db.getEnchants(ItemSlot.ItemSlotMainHand).find(enchant => enchant.effectId === effectId))
+			.filter((enchant): enchant is UIEnchant => !!enchant);
+		if (!weaponEnchants.length) return;
+
+		const headerRef = ref<HTMLHeadingElement>();
+		this.weaponEnchantsContainer.appendChild(<h6 ref={headerRef}>{i18n.t('bulk_tab.settings.weapon_enchants.label')}</h6>);
+		tippy(headerRef.value!, {
+			content: i18n.t('bulk_tab.settings.weapon_enchants.tooltip'),
+		});
+
+		for (const enchant of weaponEnchants) {
+			new BooleanPicker<BulkTab>(this.weaponEnchantsContainer, this, {
+				id: `bulk-weapon-enchant-${enchant.effectId}`,
+				label: enchant.name,
+				inline: true,
+				changedEvent: _modObj => this.settingsChangedEmitter,
+				getValue: _modObj => this.weaponEnchants.includes(enchant.effectId),
+				setValue: (_, _modObj, newValue: boolean) => {
+					const weaponEnchants = this.weaponEnchants.filter(effectId => effectId !== enchant.effectId);
+					if (newValue) {
+						weaponEnchants.push(enchant.effectId);
+					}
+					this.setWeaponEnchants(weaponEnchants);
+				},
+			});
+		}
+	}
+
 	private async getCombinationsCount(): Promise<Element> {
 		await this.calculateBulkCombinations();
 		this.bulkSimButton.disabled = this.combinations > 50000;
@@ -1160,4 +1237,9 @@ export class BulkTab extends SimTab {
 		this.inheritUpgrades = newValue;
 		this.settingsChangedEmitter.emit(TypedEvent.nextEventID());
 	}
+
+	private setWeaponEnchants(newValue: number[]) {
+		this.weaponEnchants = newValue;
+		this.settingsChangedEmitter.emit(TypedEvent.nextEventID());
+	}
 }
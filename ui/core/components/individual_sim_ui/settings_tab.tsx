@@ -12,6 +12,7 @@ import { EncounterPicker } from '../encounter_picker.js';
 import * as IconInputs from '../icon_inputs.js';
 import { Input } from '../input.jsx';
 import * as BuffDebuffInputs from '../inputs/buffs_debuffs.js';
+import * as OtherInputs from '../inputs/other_inputs.js';
 import { relevantStatOptions } from '../inputs/stat_options.js';
 import { ItemSwapPicker } from '../item_swap_picker.jsx';
 import { BooleanPicker } from '../pickers/boolean_picker.js';
@@ -179,23 +180,22 @@ export class SettingsTab extends SimTab {
 		const settings = this.simUI.individualConfig.otherInputs?.inputs.filter(inputs => !inputs.extraCssClasses?.includes('within-raid-sim-hide') || true);
 
 		const swapSlots = this.simUI.individualConfig.itemSwapSlots || [];
-		if (settings.length > 0 || swapSlots.length > 0) {
-			const contentBlock = new ContentBlock(this.column2, 'other-settings', {
+		const contentBlock = new ContentBlock(this.column2, 'other-settings', {
 			header: { title: i18n.t('settings_tab.other.title') },
 		});
 
-			if (settings.length > 0) {
-				this.configureInputSection(contentBlock.bodyElement, this.simUI.individualConfig.otherInputs);
-				contentBlock.bodyElement.querySelectorAll('.input-root').forEach(elem => {
-					elem.classList.add('input-inline');
-				});
-			}
+		// Every spec can set its spell queue window and choose when its trinkets are used automatically.
+		this.configureInputSection(contentBlock.bodyElement, {
+			inputs: [...(settings || []), OtherInputs.SpellQueueWindow, OtherInputs.Trinket1Policy, OtherInputs.Trinket2Policy],
+		});
+		contentBlock.bodyElement.querySelectorAll('.input-root').forEach(elem => {
+			elem.classList.add('input-inline');
+		});
 
-			if (swapSlots.length > 0) {
-				const _itemSwapPicker = new ItemSwapPicker(contentBlock.bodyElement, this.simUI, this.simUI.player, {
-					itemSlots: swapSlots,
-				});
-			}
+		if (swapSlots.length > 0) {
+			const _itemSwapPicker = new ItemSwapPicker(contentBlock.bodyElement, this.simUI, this.simUI.player, {
+				itemSlots: swapSlots,
+			});
 		}
 	}
 
@@ -335,6 +335,7 @@ export class SettingsTab extends SimTab {
 					);
 					simUI.player.setReactionTime(eventID, newSettings.reactionTimeMs);
 					simUI.player.setChannelClipDelay(eventID, newSettings.channelClipDelayMs);
+					simUI.player.setSpellQueueWindow(eventID, newSettings.spellQueueWindowMs);
 					simUI.player.setInFrontOfTarget(eventID, newSettings.inFrontOfTarget);
 					simUI.player.setDistanceFromTarget(eventID, newSettings.distanceFromTarget);
 					simUI.player.setHealingModel(eventID, newSettings.healingModel || HealingModel.create());
@@ -407,6 +408,7 @@ export class SettingsTab extends SimTab {
 			itemSwap: this.simUI.player.itemSwapSettings.toProto(),
 			reactionTimeMs: this.simUI.player.getReactionTime(),
 			channelClipDelayMs: this.simUI.player.getChannelClipDelay(),
+			spellQueueWindowMs: this.simUI.player.getSpellQueueWindow(),
 			inFrontOfTarget: this.simUI.player.getInFrontOfTarget(),
 			distanceFromTarget: this.simUI.player.getDistanceFromTarget(),
 			healingModel: this.simUI.player.getHealingModel(),
@@ -101,6 +101,8 @@ export const StaminaBuff = InputHelpers.makeMultiIconInput(
 
 // Misc Buffs
 export const ManaTideTotem = makeMultistateRaidBuffInput({ actionId: ActionId.fromSpellId(16190), numStates: 5, fieldName: 'manaTideTotemCount' });
+export const Innervate = makeMultistateIndividualBuffInput({ actionId: ActionId.fromSpellId(29166), numStates: 5, fieldName: 'innervateCount' });
+export const HymnOfHope = makeMultistateIndividualBuffInput({ actionId: ActionId.fromSpellId(64901), numStates: 5, fieldName: 'hymnOfHopeCount' });
 
 // External Damage Cooldowns
 export const MajorHasteBuff = makeBooleanRaidBuffInput({ actionId: ActionId.fromSpellId(2825), fieldName: 'bloodlust', label: i18n.t('settings_tab.external_damage_cooldowns.bloodlust') });
@@ -251,6 +253,16 @@ export const RAID_BUFFS_MISC_CONFIG = [
 		picker: IconPicker,
 		stats: [Stat.StatSpirit],
 	},
+	{
+		config: Innervate,
+		picker: IconPicker,
+		stats: [Stat.StatSpirit],
+	},
+	{
+		config: HymnOfHope,
+		picker: IconPicker,
+		stats: [Stat.StatSpirit],
+	},
 ] as IconPickerStatOption[];
 
 export const RAID_BUFFS_EXTERNAL_DAMAGE_COOLDOWN = [
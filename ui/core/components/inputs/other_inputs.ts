@@ -1,5 +1,5 @@
 import { Player } from '../../player.js';
-import { UnitReference } from '../../proto/common.js';
+import { CooldownPolicy, UnitReference } from '../../proto/common.js';
 import { emptyUnitReference } from '../../proto_utils/utils.js';
 import { Sim } from '../../sim.js';
 import { EventID } from '../../typed_event.js';
@@ -126,6 +126,18 @@ export const ChannelClipDelay = {
 	},
 };
 
+export const SpellQueueWindow = {
+	id: 'spell-queue-window',
+	type: 'number' as const,
+	label: i18n.t('settings_tab.other.spell_queue_window.label'),
+	labelTooltip: i18n.t('settings_tab.other.spell_queue_window.tooltip'),
+	changedEvent: (player: Player<any>) => player.miscOptionsChangeEmitter,
+	getValue: (player: Player<any>) => player.getSpellQueueWindow(),
+	setValue: (eventID: EventID, player: Player<any>, newValue: number) => {
+		player.setSpellQueueWindow(eventID, newValue);
+	},
+};
+
 export const InFrontOfTarget = {
 	id: 'in-front-of-target',
 	type: 'boolean' as const,
@@ -269,3 +281,33 @@ export const HpPercentForDefensives = {
 		player.setSimpleCooldowns(eventID, cooldowns);
 	},
 };
+
+const makeTrinketPolicyInput = (trinketNumber: 1 | 2) => ({
+	id: `trinket-${trinketNumber}-policy`,
+	type: 'enum' as const,
+	label: i18n.t(`settings_tab.other.trinket_policy.label_${trinketNumber}`),
+	labelTooltip: i18n.t('settings_tab.other.trinket_policy.tooltip'),
+	values: [
+		{ name: i18n.t('settings_tab.other.trinket_policy.values.on_cooldown'), value: CooldownPolicy.CooldownPolicyOnCooldown },
+		{ name: i18n.t('settings_tab.other.trinket_policy.values.sync_with_major_cooldown'), value: CooldownPolicy.CooldownPolicySyncWithMajorCooldown },
+		{ name: i18n.t('settings_tab.other.trinket_policy.values.hold_for_execute'), value: CooldownPolicy.CooldownPolicyHoldForExecute },
+		{ name: i18n.t('settings_tab.other.trinket_policy.values.hold_for_bloodlust'), value: CooldownPolicy.CooldownPolicyHoldForBloodlust },
+	],
+	changedEvent: (player: Player<any>) => player.rotationChangeEmitter,
+	getValue: (player: Player<any>) => {
+		const cooldowns = player.getSimpleCooldowns();
+		return trinketNumber == 1 ? cooldowns.trinket1Policy : cooldowns.trinket2Policy;
+	},
+	setValue: (eventID: EventID, player: Player<any>, newValue: number) => {
+		const cooldowns = player.getSimpleCooldowns();
+		if (trinketNumber == 1) {
+			cooldowns.trinket1Policy = newValue;
+		} else {
+			cooldowns.trinket2Policy = newValue;
+		}
+		player.setSimpleCooldowns(eventID, cooldowns);
+	},
+});
+
+export const Trinket1Policy = makeTrinketPolicyInput(1);
+export const Trinket2Policy = makeTrinketPolicyInput(2);
@@ -69,6 +69,7 @@ export type ReferenceData = {
 };
 
 export interface ResultMetrics {
+	amu: string;
 	cod: string;
 	dps: string;
 	dtps: string;
@@ -100,11 +101,13 @@ export class RaidSimResultsManager {
 		dtps: 'threat',
 		tmi: 'threat',
 		cod: 'threat',
+		amu: 'threat',
 		tto: 'healing',
 		hps: 'healing',
 	};
 
 	static resultMetricClasses: { [ResultMetrics: string]: string } = {
+		amu: 'results-sim-amu',
 		cod: 'results-sim-cod',
 		dps: 'results-sim-dps',
 		dtps: 'results-sim-dtps',
@@ -233,6 +236,7 @@ export class RaidSimResultsManager {
 				<p>{i18n.t('sidebar.results.metrics.cod.tooltip.note')}</p>
 			</>,
 		);
+		setResultTooltip(`.${RaidSimResultsManager.resultMetricClasses['amu']}`, i18n.t('sidebar.results.metrics.amu.tooltip'));
 
 		if (!this.simUI.isIndividualSim()) {
 			[...this.simUI.resultsViewer.contentElem.querySelectorAll(`.${RaidSimResultsManager.resultMetricClasses['tto']}`)].forEach(e => e.remove());
@@ -241,6 +245,7 @@ export class RaidSimResultsManager {
 			[...this.simUI.resultsViewer.contentElem.querySelectorAll(`.${RaidSimResultsManager.resultMetricClasses['dtps']}`)].forEach(e => e.remove());
 			[...this.simUI.resultsViewer.contentElem.querySelectorAll(`.${RaidSimResultsManager.resultMetricClasses['tmi']}`)].forEach(e => e.remove());
 			[...this.simUI.resultsViewer.contentElem.querySelectorAll(`.${RaidSimResultsManager.resultMetricClasses['cod']}`)].forEach(e => e.remove());
+			[...this.simUI.resultsViewer.contentElem.querySelectorAll(`.${RaidSimResultsManager.resultMetricClasses['amu']}`)].forEach(e => e.remove());
 		}
 
 		const simReferenceSetButton = this.simUI.resultsViewer.contentElem.querySelector<HTMLSpanElement>('.results-sim-set-reference');
@@ -340,6 +345,11 @@ export class RaidSimResultsManager {
 				true,
 				true,
 			);
+			this.formatToplineResult(
+				`.${RaidSimResultsManager.resultMetricClasses['amu']} .results-reference-diff`,
+				res => res.getFirstPlayer()!.activeMitigationUptime,
+				2,
+			);
 		} else {
 			this.formatToplineResult(
 				`.${RaidSimResultsManager.resultMetricClasses['dtps']} .results-reference-diff`,
@@ -516,6 +526,15 @@ export class RaidSimResultsManager {
 					classes: this.getResultsLineClasses('cod'),
 					unit: 'percentage',
 				});
+
+				if (playerMetrics.hasActiveMitigation) {
+					resultColumns.push({
+						name: i18n.t('sidebar.results.metrics.amu.label'),
+						average: playerMetrics.activeMitigationUptime,
+						classes: this.getResultsLineClasses('amu'),
+						unit: 'percentage',
+					});
+				}
 			} else {
 				const actions = simResult.getRaidIndexedActionMetrics(filter);
 				if (!!actions.length) {
@@ -27,6 +27,7 @@ export class StatWeightActionSettings {
 
 	_excludedStats: Stat[] = [];
 	_excludedPseudoStats: PseudoStat[] = [];
+	_hybrid = false;
 
 	constructor(simUI: SimUI) {
 		this.storageKey = simUI.getStorageKey('__statweight_settings__');
@@ -50,6 +51,13 @@ export class StatWeightActionSettings {
 		return this._excludedPseudoStats.slice();
 	}
 
+	set hybrid(value: boolean) {
+		this._hybrid = value;
+	}
+	get hybrid(): boolean {
+		return this._hybrid;
+	}
+
 	static updateProtoVersion(_: SavedStatWeightSettings) {
 		// No-op, as there are no proto version migrations currently
 	}
@@ -57,6 +65,7 @@ export class StatWeightActionSettings {
 	applyDefaults(eventID: EventID) {
 		this.excludedStats = [];
 		this.excludedPseudoStats = [];
+		this.hybrid = false;
 		this.changeEmitter.emit(eventID);
 	}
 
@@ -66,9 +75,10 @@ export class StatWeightActionSettings {
 			const settingsProto = SavedStatWeightSettings.fromJsonString(storageValue, { ignoreUnknownFields: true });
 			StatWeightActionSettings.updateProtoVersion(settingsProto);
 
-			const { excludedStats, excludedPseudoStats } = settingsProto;
+			const { excludedStats, excludedPseudoStats, hybrid } = settingsProto;
 			this.excludedStats = excludedStats || [];
 			this.excludedPseudoStats = excludedPseudoStats || [];
+			this.hybrid = hybrid;
 			this.changeEmitter.emit(eventID);
 		}
 	}
@@ -78,6 +88,7 @@ export class StatWeightActionSettings {
 			apiVersion: CURRENT_API_VERSION,
 			excludedStats: this.excludedStats,
 			excludedPseudoStats: this.excludedPseudoStats,
+			hybrid: this.hybrid,
 		});
 	}
 
@@ -202,6 +213,7 @@ export class EpWeightsMenu extends BaseModal {
 		const computeEpRef = ref<HTMLButtonElement>();
 		const calcWeightsButtonRef = ref<HTMLButtonElement>();
 		const allStatsContainerRef = ref<HTMLDivElement>();
+		const hybridContainerRef = ref<HTMLDivElement>();
 
 		const getNameFromStat = (stat: Stat | undefined) => (stat !== undefined ? translateStat(stat) : '??');
 		const getStatFromName = (value: string) => Object.values(this.epStats).find(stat => getNameFromStat(stat) === value);
@@ -224,6 +236,7 @@ export class EpWeightsMenu extends BaseModal {
 							</select>
 						</div>
 						<div ref={allStatsContainerRef} className="show-all-stats-container col col-sm-3"></div>
+						<div ref={hybridContainerRef} className="hybrid-sim-container col col-sm-3"></div>
 					</div>
 					<div className="ep-reference-options row">
 						<div className="col col-sm-4 damage-metrics">
@@ -410,6 +423,7 @@ export class EpWeightsMenu extends BaseModal {
 				epStatsToCalc,
 				epPseudoStatsToCalc,
 				this.epReferenceStat,
+				this.settings.hybrid,
 				progress => {
 					this.setSimProgress(progress);
 				},
@@ -474,6 +488,19 @@ export class EpWeightsMenu extends BaseModal {
 			},
 		});
 
+		new BooleanPicker(hybridContainerRef.value!, this.settings, {
+			id: 'ep-hybrid-sim',
+			label: i18n.t('sidebar.buttons.stat_weights.modal.hybrid_sim'),
+			labelTooltip: i18n.t('sidebar.buttons.stat_weights.modal.tooltips.hybrid_sim'),
+			inline: true,
+			changedEvent: settings => settings.changeEmitter,
+			getValue: settings => settings.hybrid,
+			setValue: (eventID: EventID, settings: StatWeightActionSettings, newValue: boolean) => {
+				settings.hybrid = newValue;
+				settings.changeEmitter.emit(eventID);
+			},
+		});
+
 		this.updateTable();
 
 		const makeEpRatioCell = (cell: HTMLElement, idx: number) => {
@@ -598,18 +625,19 @@ export class EpWeightsMenu extends BaseModal {
 		const epRatios = this.simUI.player.getEpRatios();
 
 		const rowTotalEp = scaledEpValue(stat, epRatios, result);
+		const statCap = result?.caps ? stat.getProtoValue(result.caps) : 0;
 		const currentEpRef = ref<HTMLTableCellElement>();
 		const includeToggleRef = ref<HTMLTableCellElement>();
 		const row = (
 			<tr>
 				<td>{stat.getFullName(this.simUI.player.getClass())}</td>
 				<td ref={includeToggleRef} className="swcalc-include-toggle"></td>
-				{this.makeTableRowCells(stat, result?.dps, 'damage-metrics', rowTotalEp, epRatios[0])}
-				{this.makeTableRowCells(stat, result?.hps, 'healing-metrics', rowTotalEp, epRatios[1])}
-				{this.makeTableRowCells(stat, result?.tps, 'threat-metrics', rowTotalEp, epRatios[2])}
-				{this.makeTableRowCells(stat, result?.dtps, 'threat-metrics', rowTotalEp, epRatios[3])}
-				{this.makeTableRowCells(stat, result?.tmi, 'threat-metrics', rowTotalEp, epRatios[4])}
-				{this.makeTableRowCells(stat, result?.pDeath, 'threat-metrics', rowTotalEp, epRatios[5])}
+				{this.makeTableRowCells(stat, result?.dps, 'damage-metrics', rowTotalEp, epRatios[0], statCap)}
+				{this.makeTableRowCells(stat, result?.hps, 'healing-metrics', rowTotalEp, epRatios[1], statCap)}
+				{this.makeTableRowCells(stat, result?.tps, 'threat-metrics', rowTotalEp, epRatios[2], statCap)}
+				{this.makeTableRowCells(stat, result?.dtps, 'threat-metrics', rowTotalEp, epRatios[3], statCap)}
+				{this.makeTableRowCells(stat, result?.tmi, 'threat-metrics', rowTotalEp, epRatios[4], statCap)}
+				{this.makeTableRowCells(stat, result?.pDeath, 'threat-metrics', rowTotalEp, epRatios[5], statCap)}
 				<td ref={currentEpRef} className="current-ep"></td>
 			</tr>
 		) as HTMLElement;
@@ -640,7 +668,14 @@ export class EpWeightsMenu extends BaseModal {
 		return row;
 	}
 
-	private makeTableRowCells(stat: UnitStat, statWeights: StatWeightValues | undefined, className: string, epTotal: number, epRatio: number) {
+	private makeTableRowCells(
+		stat: UnitStat,
+		statWeights: StatWeightValues | undefined,
+		className: string,
+		epTotal: number,
+		epRatio: number,
+		statCap: number,
+	) {
 		let weightCell: Element | null = null;
 		let epCell: Element | null = null;
 
@@ -672,6 +707,16 @@ export class EpWeightsMenu extends BaseModal {
 			</>
 		);
 
+		if (statWeights && statCap) {
+			tippy(weightRef.value!, {
+				content: i18n.t('sidebar.buttons.stat_weights.modal.tooltips.stat_cap', {
+					cap: statCap.toFixed(0),
+					below: stat.getProtoValue(statWeights.weightsBelowCap!).toFixed(2),
+					above: stat.getProtoValue(statWeights.weightsAboveCap!).toFixed(2),
+				}),
+			});
+		}
+
 		if (!statWeights || isZeroEpRatio) return row;
 
 		const epCurrent = this.simUI.player.getEpWeights().getUnitStat(stat);
@@ -4,6 +4,20 @@ export const CHARACTER_LEVEL = 90;
 export const BOSS_LEVEL = CHARACTER_LEVEL + 3;
 export const MAX_CHALLENGE_MODE_ILVL = 463;
 
+// Mirrors TargetArmorForLevel in sim/core/target.go.
+const TARGET_ARMOR_BY_LEVEL: Record<number, number> = {
+	[BOSS_LEVEL]: 24835,
+	[BOSS_LEVEL - 1]: 23115,
+};
+export const targetArmorForLevel = (level: number): number => {
+	if (TARGET_ARMOR_BY_LEVEL[level] !== undefined) {
+		return TARGET_ARMOR_BY_LEVEL[level];
+	}
+	const plus2Armor = TARGET_ARMOR_BY_LEVEL[BOSS_LEVEL - 1];
+	const growthPerLevel = TARGET_ARMOR_BY_LEVEL[BOSS_LEVEL] / plus2Armor;
+	return Math.round(plus2Armor * Math.pow(growthPerLevel, level - (BOSS_LEVEL - 1)));
+};
+
 export const HASTE_RATING_PER_HASTE_PERCENT = 425.0;
 export const EXPERTISE_PER_QUARTER_PERCENT_REDUCTION = 85.0;
 export const CRIT_RATING_PER_CRIT_PERCENT = 600.0;
@@ -37,7 +37,7 @@ import { simLaunchStatuses } from './launched_sims';
 import { Player, PlayerConfig, registerSpecConfig as registerPlayerConfig } from './player';
 import { PlayerSpecs } from './player_specs';
 import { PresetBuild, PresetEpWeights, PresetGear, PresetItemSwap, PresetRotation, PresetSettings } from './preset_utils';
-import { StatWeightsResult } from './proto/api';
+import { RaidDamageProfile, StatWeightsResult } from './proto/api';
 import { APLRotation, APLRotation_Type as APLRotationType } from './proto/apl';
 import {
 	ConsumesSpec,
@@ -652,6 +652,7 @@ export abstract class IndividualSimUI<SpecType extends Spec> extends SimUI {
 				raidBuffs: this.sim.raid.getBuffs(),
 				debuffs: this.sim.raid.getDebuffs(),
 				targetDummies: this.sim.raid.getTargetDummies(),
+				damageProfile: this.sim.raid.getDamageProfile(),
 			});
 		}
 		if (exportCategory(SimSettingCategories.UISettings)) {
@@ -699,6 +700,7 @@ export abstract class IndividualSimUI<SpecType extends Spec> extends SimUI {
 					party.setBuffs(eventID, settings.partyBuffs || PartyBuffs.create());
 				}
 				this.sim.raid.setTargetDummies(eventID, settings.targetDummies);
+				this.sim.raid.setDamageProfile(eventID, settings.damageProfile || RaidDamageProfile.create());
 			}
 			if (loadCategory(SimSettingCategories.Encounter)) {
 				this.sim.encounter.fromProto(eventID, settings.encounter || EncounterProto.create());
@@ -256,6 +256,7 @@ export class Player<SpecType extends Spec> {
 	private specOptions: SpecOptions<SpecType>;
 	private reactionTime = 0;
 	private channelClipDelay = 0;
+	private spellQueueWindow = 0;
 	private inFrontOfTarget = false;
 	private distanceFromTarget = 0;
 	private healingModel: HealingModel = HealingModel.create();
@@ -563,10 +564,11 @@ export class Player<SpecType extends Spec> {
 		epStats: Array<Stat>,
 		epPseudoStats: Array<PseudoStat>,
 		epReferenceStat: Stat,
+		hybrid: boolean,
 		onProgress: WorkerProgressCallback,
 	): Promise<StatWeightsResult | null> {
 		try {
-			const result = await this.sim.statWeights(this, epStats, epPseudoStats, epReferenceStat, onProgress);
+			const result = await this.sim.statWeights(this, epStats, epPseudoStats, epReferenceStat, hybrid, onProgress);
 			if (result.error) {
 				if (result.error.type == ErrorOutcomeType.ErrorOutcomeAborted) {
 					new Toast({
@@ -981,6 +983,17 @@ export class Player<SpecType extends Spec> {
 		this.miscOptionsChangeEmitter.emit(eventID);
 	}
 
+	getSpellQueueWindow(): number {
+		return this.spellQueueWindow;
+	}
+
+	setSpellQueueWindow(eventID: EventID, newSpellQueueWindow: number) {
+		if (newSpellQueueWindow == this.spellQueueWindow) return;
+
+		this.spellQueueWindow = newSpellQueueWindow;
+		this.miscOptionsChangeEmitter.emit(eventID);
+	}
+
 	getChallengeModeEnabled(): boolean {
 		return this.challengeModeEnabled;
 	}
@@ -1486,6 +1499,8 @@ export class Player<SpecType extends Spec> {
 			PlayerProto.mergePartial(player, {
 				cooldowns: Cooldowns.create({
 					hpPercentForDefensives: this.getSimpleCooldowns().hpPercentForDefensives,
+					trinket1Policy: this.getSimpleCooldowns().trinket1Policy,
+					trinket2Policy: this.getSimpleCooldowns().trinket2Policy,
 				}),
 				rotation: aplRotation,
 			});
@@ -1503,6 +1518,7 @@ export class Player<SpecType extends Spec> {
 				profession2: this.getProfession2(),
 				reactionTimeMs: this.getReactionTime(),
 				channelClipDelayMs: this.getChannelClipDelay(),
+				spellQueueWindowMs: this.getSpellQueueWindow(),
 				inFrontOfTarget: this.getInFrontOfTarget(),
 				distanceFromTarget: this.getDistanceFromTarget(),
 				healingModel: this.getHealingModel(),
@@ -1559,6 +1575,7 @@ export class Player<SpecType extends Spec> {
 				this.setProfession2(eventID, proto.profession2);
 				this.setReactionTime(eventID, proto.reactionTimeMs);
 				this.setChannelClipDelay(eventID, proto.channelClipDelayMs);
+				this.setSpellQueueWindow(eventID, proto.spellQueueWindowMs);
 				this.setInFrontOfTarget(eventID, proto.inFrontOfTarget);
 				this.setDistanceFromTarget(eventID, proto.distanceFromTarget);
 				this.setHealingModel(eventID, proto.healingModel || HealingModel.create());
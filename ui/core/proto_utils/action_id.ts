@@ -149,6 +149,10 @@ export class ActionId {
 				baseName = 'Encounter Start';
 				iconUrl = 'https://wow.zamimg.com/images/wow/icons/medium/achievement_faction_elders.jpg';
 				break;
+			case OtherAction.OtherActionDamagePhase:
+				baseName = 'Damage Phase';
+				iconUrl = 'https://wow.zamimg.com/images/wow/icons/medium/spell_holy_divineprotection.jpg';
+				break;
 		}
 		this.baseName = baseName ?? '';
 		this.name = (name || baseName) ?? '';
@@ -1355,6 +1359,7 @@ export const resourceTypeToIcon: Record<ResourceType, string> = {
 	[ResourceType.ResourceTypeSolarEnergy]: 'https://wow.zamimg.com/images/wow/icons/large/ability_druid_eclipseorange.jpg',
 	[ResourceType.ResourceTypeLunarEnergy]: 'https://wow.zamimg.com/images/wow/icons/large/ability_druid_eclipse.jpg',
 	[ResourceType.ResourceTypeGenericResource]: 'https://wow.zamimg.com/images/wow/icons/medium/spell_holy_holybolt.jpg',
+	[ResourceType.ResourceTypeMaelstromWeapon]: 'https://wow.zamimg.com/images/wow/icons/medium/spell_shaman_maelstromweapon.jpg',
 };
 
 // Use this to connect a buff row to a cast row in the timeline view
@@ -149,6 +149,7 @@ export const resourceNames: Map<ResourceType, string> = new Map([
 	[ResourceType.ResourceTypeSolarEnergy, 'Solar Energy'],
 	[ResourceType.ResourceTypeLunarEnergy, 'Lunar Energy'],
 	[ResourceType.ResourceTypeGenericResource, 'Generic Resource'],
+	[ResourceType.ResourceTypeMaelstromWeapon, 'Maelstrom Weapon'],
 ]);
 
 export const resourceColors: Map<ResourceType, string> = new Map([
@@ -168,6 +169,7 @@ export const resourceColors: Map<ResourceType, string> = new Map([
 	[ResourceType.ResourceTypeSolarEnergy, '#d2952b'],
 	[ResourceType.ResourceTypeLunarEnergy, '#2c4f8f'],
 	[ResourceType.ResourceTypeGenericResource, '#ffffff'],
+	[ResourceType.ResourceTypeMaelstromWeapon, '#0070de'],
 ]);
 
 export function stringToResourceType(str: string): [ResourceType, SecondaryResourceType | undefined] {
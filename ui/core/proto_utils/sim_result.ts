@@ -463,6 +463,15 @@ export class UnitMetrics {
 		});
 	}
 
+	get hasActiveMitigation(): boolean {
+		return !!this.metrics.activeMitigation;
+	}
+
+	// Percentage of the encounter with any active mitigation up.
+	get activeMitigationUptime(): number {
+		return (this.metrics.activeMitigation?.uptimeAvg || 0) * 100;
+	}
+
 	get maxThreat() {
 		return this.threatLogs[this.threatLogs.length - 1]?.threatAfter || 0;
 	}
@@ -945,6 +954,23 @@ export class ActionMetrics {
 		return this.combinedMetrics.castsPerMinute;
 	}
 
+	get interrupts() {
+		return this.combinedMetrics.interrupts;
+	}
+
+	get avgCost() {
+		if (this.isPassiveAction) return 0;
+		return this.combinedMetrics.avgCost;
+	}
+
+	get resourceRefunded() {
+		return this.combinedMetrics.resourceRefunded;
+	}
+
+	get damagePerResource() {
+		return this.combinedMetrics.damagePerResource;
+	}
+
 	get avgCastTimeMs() {
 		if (this.isPassiveAction) return 0;
 		return this.combinedMetrics.avgCastTimeMs;
@@ -1125,6 +1151,10 @@ export class ActionMetrics {
 		return this.combinedMetrics.healingCritPercent;
 	}
 
+	get overhealPercent() {
+		return this.combinedMetrics.overhealPercent;
+	}
+
 	get damageDone() {
 		const normalHitAvgDamage =
 			this.avgDamage -
@@ -1390,6 +1420,30 @@ export class TargetedActionMetrics {
 		return this.casts / (this.duration / 60);
 	}
 
+	// Casts and channels lost to interrupts.
+	get interrupts() {
+		return this.data.interrupts / this.iterations;
+	}
+
+	// Resources spent per iteration, net of refunds.
+	get netResourceCost() {
+		return (this.data.resourceCost - this.data.resourceRefunded) / this.iterations;
+	}
+
+	get resourceRefunded() {
+		return this.data.resourceRefunded / this.iterations;
+	}
+
+	get avgCost() {
+		if (!this.casts) return 0;
+		return this.netResourceCost / this.casts;
+	}
+
+	get damagePerResource() {
+		if (this.netResourceCost <= 0) return 0;
+		return this.avgDamage / this.netResourceCost;
+	}
+
 	get avgCastTimeMs() {
 		return this.data.castTimeMs / this.iterations / this.casts;
 	}
@@ -1558,6 +1612,11 @@ export class TargetedActionMetrics {
 		return (this.data.critHealing / this.healing) * 100;
 	}
 
+	// Only counts direct healing, as shields can't overheal.
+	get overhealPercent() {
+		return (this.data.overhealing / this.data.healing) * 100;
+	}
+
 	// Merges an array of metrics into a single metric.
 	static merge(actions: Array<TargetedActionMetrics>): TargetedActionMetrics {
 		const { iterations = 1, duration = 1 } = actions[0];
@@ -1565,6 +1624,9 @@ export class TargetedActionMetrics {
 		return new TargetedActionMetrics(
 			TargetedActionMetricsProto.create({
 				casts: sum(actions.map(a => a.data.casts)),
+				interrupts: sum(actions.map(a => a.data.interrupts)),
+				resourceCost: sum(actions.map(a => a.data.resourceCost)),
+				resourceRefunded: sum(actions.map(a => a.data.resourceRefunded)),
 				hits: sum(actions.map(a => a.data.hits)),
 				crits: sum(actions.map(a => a.data.crits)),
 				ticks: sum(actions.map(a => a.data.ticks)),
@@ -1587,6 +1649,7 @@ export class TargetedActionMetrics {
 				threat: sum(actions.map(a => a.data.threat)),
 				healing: sum(actions.map(a => a.data.healing)),
 				critHealing: sum(actions.map(a => a.data.critHealing)),
+				overhealing: sum(actions.map(a => a.data.overhealing)),
 				shielding: sum(actions.map(a => a.data.shielding)),
 				castTimeMs: sum(actions.map(a => a.data.castTimeMs)),
 			}),
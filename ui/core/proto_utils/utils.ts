@@ -2048,6 +2048,7 @@ export const orderedResourceTypes: Array<ResourceType> = [
 	ResourceType.ResourceTypeLunarEnergy,
 	ResourceType.ResourceTypeSolarEnergy,
 	ResourceType.ResourceTypeGenericResource,
+	ResourceType.ResourceTypeMaelstromWeapon,
 ];
 
 export const AL_CATEGORY_HARD_MODE = 'Hard Mode';
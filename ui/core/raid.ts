@@ -1,6 +1,6 @@
 import { MAX_PARTY_SIZE,Party } from './party.js';
 import { Player } from './player.js';
-import { Raid as RaidProto } from './proto/api.js';
+import { ExternalCooldownAssignment, Raid as RaidProto, RaidDamageProfile } from './proto/api.js';
 import {
 	Class,
 	Debuffs,
@@ -20,6 +20,8 @@ export class Raid {
 	private debuffs: Debuffs = Debuffs.create();
 	private tanks: Array<UnitReference> = [];
 	private targetDummies = 0;
+	private damageProfile: RaidDamageProfile = RaidDamageProfile.create();
+	private externalCooldowns: Array<ExternalCooldownAssignment> = [];
 	private numActiveParties = 5;
 
 	// Emits when a raid member is added/removed/moved.
@@ -29,6 +31,8 @@ export class Raid {
 	readonly debuffsChangeEmitter = new TypedEvent<void>();
 	readonly tanksChangeEmitter = new TypedEvent<void>();
 	readonly targetDummiesChangeEmitter = new TypedEvent<void>();
+	readonly damageProfileChangeEmitter = new TypedEvent<void>();
+	readonly externalCooldownsChangeEmitter = new TypedEvent<void>();
 	readonly numActivePartiesChangeEmitter = new TypedEvent<void>();
 
 	// Emits when anything in the raid changes.
@@ -61,6 +65,8 @@ export class Raid {
 			this.debuffsChangeEmitter,
 			this.tanksChangeEmitter,
 			this.targetDummiesChangeEmitter,
+			this.damageProfileChangeEmitter,
+			this.externalCooldownsChangeEmitter,
 		], 'RaidChange');
 
 		this.changeEmitter.on(() => {
@@ -171,6 +177,37 @@ export class Raid {
 		this.targetDummiesChangeEmitter.emit(eventID);
 	}
 
+	getDamageProfile(): RaidDamageProfile {
+		// Make a defensive copy
+		return RaidDamageProfile.clone(this.damageProfile);
+	}
+
+	setDamageProfile(eventID: EventID, newDamageProfile: RaidDamageProfile) {
+		if (RaidDamageProfile.equals(this.damageProfile, newDamageProfile))
+			return;
+
+		// Make a defensive copy
+		this.damageProfile = RaidDamageProfile.clone(newDamageProfile);
+		this.damageProfileChangeEmitter.emit(eventID);
+	}
+
+	getExternalCooldowns(): Array<ExternalCooldownAssignment> {
+		// Make a defensive copy
+		return this.externalCooldowns.map(assignment => ExternalCooldownAssignment.clone(assignment));
+	}
+
+	setExternalCooldowns(eventID: EventID, newExternalCooldowns: Array<ExternalCooldownAssignment>) {
+		if (
+			this.externalCooldowns.length == newExternalCooldowns.length &&
+			this.externalCooldowns.every((assignment, i) => ExternalCooldownAssignment.equals(assignment, newExternalCooldowns[i]))
+		)
+			return;
+
+		// Make a defensive copy
+		this.externalCooldowns = newExternalCooldowns.map(assignment => ExternalCooldownAssignment.clone(assignment));
+		this.externalCooldownsChangeEmitter.emit(eventID);
+	}
+
 	getNumActiveParties(): number {
 		return this.numActiveParties;
 	}
@@ -198,6 +235,8 @@ export class Raid {
 			debuffs: this.getDebuffs(),
 			tanks: this.getTanks(),
 			targetDummies: this.getTargetDummies(),
+			damageProfile: this.getDamageProfile(),
+			externalCooldowns: this.getExternalCooldowns(),
 			numActiveParties: this.getNumActiveParties(),
 		});
 	}
@@ -208,6 +247,8 @@ export class Raid {
 			this.setDebuffs(eventID, proto.debuffs || Debuffs.create());
 			this.setTanks(eventID, proto.tanks);
 			this.setTargetDummies(eventID, proto.targetDummies);
+			this.setDamageProfile(eventID, proto.damageProfile || RaidDamageProfile.create());
+			this.setExternalCooldowns(eventID, proto.externalCooldowns);
 			this.setNumActiveParties(eventID, proto.numActiveParties || 5);
 
 			for (let i = 0; i < MAX_NUM_PARTIES; i++) {
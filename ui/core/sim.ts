@@ -57,6 +57,7 @@ interface SimProps {
 
 export type RunSimOptions = {
 	silent?: boolean; // If true, don't emit the simResultEmitter event.
+	skipLogs?: boolean; // If true, don't record the combat log of the first iteration.
 };
 
 const WASM_CONCURRENCY_STORAGE_KEY = `${LOCAL_STORAGE_PREFIX}_wasmconcurrency`;
@@ -269,7 +270,7 @@ export class Sim {
 		return raidProto;
 	}
 
-	makeRaidSimRequest(debug: boolean): RaidSimRequest {
+	makeRaidSimRequest(debug: boolean, skipLogs = false): RaidSimRequest {
 		const raid = this.getModifiedRaidProto();
 		const encounter = this.encounter.toProto();
 
@@ -283,8 +284,9 @@ export class Sim {
 			simOptions: SimOptions.create({
 				iterations: debug ? 1 : this.getIterations(),
 				randomSeed: BigInt(this.nextRngSeed()),
-				debugFirstIteration: true,
+				debugFirstIteration: !skipLogs,
 			}),
+			locale: getLang(),
 		});
 	}
 
@@ -299,7 +301,7 @@ export class Sim {
 		try {
 			await this.waitForInit();
 
-			const request = this.makeRaidSimRequest(false);
+			const request = this.makeRaidSimRequest(false, options.skipLogs);
 
 			let result;
 			// Only use worker base concurrency when running wasm. Local sim has native threading.
@@ -410,6 +412,7 @@ export class Sim {
 		epStats: Array<Stat>,
 		epPseudoStats: Array<PseudoStat>,
 		epReferenceStat: Stat,
+		hybrid: boolean,
 		onProgress: WorkerProgressCallback,
 	): Promise<StatWeightsResult> {
 		if (this.raid.isEmpty()) {
@@ -446,6 +449,7 @@ export class Sim {
 				statsToWeigh: epStats,
 				pseudoStatsToWeigh: epPseudoStats,
 				epReferenceStat: epReferenceStat,
+				hybrid: hybrid,
 			});
 
 			const signals = this.signalManager.registerRunning(RequestTypes.StatWeights);
@@ -271,6 +271,7 @@ export const runConcurrentStatWeights = async (
 		baseResult: baseLine,
 		epReferenceStat: manualResponse.epReferenceStat,
 		statSimResults: [],
+		analyticalStats: manualResponse.analyticalStats,
 	});
 
 	for (const statReqData of manualResponse.statSimRequests) {
@@ -18,6 +18,9 @@ import { defaultRaidBuffMajorDamageCooldowns } from '../../core/proto_utils/util
 import P4Gear from './gear_sets/p4.gear.json';
 export const P4_PRESET = PresetUtils.makePresetGear('P4 Preset', P4Gear);
 
+import DefaultApl from './apls/default.apl.json';
+export const ROTATION_PRESET_DEFAULT = PresetUtils.makePresetAPLRotation('Default', DefaultApl);
+
 export const P1_EP_PRESET = PresetUtils.makePresetEpWeights(
 	'P1',
 	Stats.fromMap({
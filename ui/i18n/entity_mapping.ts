@@ -128,6 +128,7 @@ export const resourceTypeI18nKeys: Record<ResourceType, string> = {
 	[ResourceType.ResourceTypeSolarEnergy]: 'solar_energy',
 	[ResourceType.ResourceTypeLunarEnergy]: 'lunar_energy',
 	[ResourceType.ResourceTypeGenericResource]: 'generic_resource',
+	[ResourceType.ResourceTypeMaelstromWeapon]: 'maelstrom',
 };
 
 // standardize keys regardless they are from backend or frontend
@@ -1,6 +1,7 @@
 import { Component } from '../core/components/component';
 import { UnitReferencePicker } from '../core/components/pickers/raid_target_picker';
 import { Player } from '../core/player';
+import { ExternalCooldown, ExternalCooldownAssignment } from '../core/proto/api';
 import { Class, Spec, UnitReference } from '../core/proto/common';
 import { DeathKnightTalents } from '../core/proto/death_knight';
 import { PriestTalents } from '../core/proto/priest';
@@ -15,6 +16,7 @@ export class AssignmentsPicker extends Component {
 	private readonly innervatesPicker: InnervatesPicker;
 	private readonly tricksOfTheTradesPicker: TricksOfTheTradesPicker;
 	private readonly unholyFrenzyPicker: UnholyFrenzyPicker;
+	private readonly externalCooldownPickers: Array<ExternalCooldownPicker>;
 
 	constructor(parentElem: HTMLElement, raidSimUI: RaidSimUI) {
 		super(parentElem, 'assignments-picker-root');
@@ -23,6 +25,12 @@ export class AssignmentsPicker extends Component {
 		this.innervatesPicker = new InnervatesPicker(this.rootElem, raidSimUI);
 		this.tricksOfTheTradesPicker = new TricksOfTheTradesPicker(this.rootElem, raidSimUI);
 		this.unholyFrenzyPicker = new UnholyFrenzyPicker(this.rootElem, raidSimUI);
+		this.externalCooldownPickers = [
+			new PainSuppressionPicker(this.rootElem, raidSimUI),
+			new HandOfSacrificePicker(this.rootElem, raidSimUI),
+			new VigilancePicker(this.rootElem, raidSimUI),
+			new IronbarkPicker(this.rootElem, raidSimUI),
+		];
 	}
 }
 
@@ -81,7 +89,7 @@ abstract class AssignedBuffPicker extends Component {
 				noTargetLabel: 'Unassigned',
 				compChangeEmitter: this.raidSimUI.sim.raid.compChangeEmitter,
 
-				changedEvent: (player: Player<any>) => player.specOptionsChangeEmitter,
+				changedEvent: (player: Player<any>) => this.getChangedEvent(player),
 				getValue: (player: Player<any>) => this.getPlayerValue(player),
 				setValue: (eventID: EventID, player: Player<any>, newValue: UnitReference) => this.setPlayerValue(eventID, player, newValue),
 			});
@@ -105,6 +113,10 @@ abstract class AssignedBuffPicker extends Component {
 
 	abstract getPlayerValue(player: Player<any>): UnitReference;
 	abstract setPlayerValue(eventID: EventID, player: Player<any>, newValue: UnitReference): void;
+
+	getChangedEvent(player: Player<any>): TypedEvent<any> {
+		return player.specOptionsChangeEmitter;
+	}
 }
 
 class InnervatesPicker extends AssignedBuffPicker {
@@ -167,3 +179,94 @@ class UnholyFrenzyPicker extends AssignedBuffPicker {
 	}
 }
 
+
+// External cooldowns are assigned on the raid rather than the caster's spec
+// options, and are used whenever they are ready.
+abstract class ExternalCooldownPicker extends AssignedBuffPicker {
+	abstract getCooldown(): ExternalCooldown;
+
+	getChangedEvent(_player: Player<any>): TypedEvent<any> {
+		return this.raidSimUI.sim.raid.externalCooldownsChangeEmitter;
+	}
+
+	getPlayerValue(player: Player<any>): UnitReference {
+		const sourceRef = player.makeUnitReference();
+		const assignment = this.raidSimUI.sim.raid
+			.getExternalCooldowns()
+			.find(assignment => assignment.cooldown == this.getCooldown() && UnitReference.equals(assignment.source!, sourceRef));
+		return assignment?.target || emptyUnitReference();
+	}
+
+	setPlayerValue(eventID: EventID, player: Player<any>, newValue: UnitReference) {
+		const sourceRef = player.makeUnitReference();
+		const assignments = this.raidSimUI.sim.raid
+			.getExternalCooldowns()
+			.filter(assignment => assignment.cooldown != this.getCooldown() || !UnitReference.equals(assignment.source!, sourceRef));
+		if (!UnitReference.equals(newValue, emptyUnitReference())) {
+			assignments.push(
+				ExternalCooldownAssignment.create({
+					cooldown: this.getCooldown(),
+					source: sourceRef,
+					target: newValue,
+				}),
+			);
+		}
+		this.raidSimUI.sim.raid.setExternalCooldowns(eventID, assignments);
+	}
+}
+
+class PainSuppressionPicker extends ExternalCooldownPicker {
+	getTitle(): string {
+		return 'Pain Suppression';
+	}
+
+	getCooldown(): ExternalCooldown {
+		return ExternalCooldown.ExternalCooldownPainSuppression;
+	}
+
+	getSourcePlayers(): Array<Player<any>> {
+		return this.raidSimUI.getActivePlayers().filter(player => player.isSpec(Spec.SpecDisciplinePriest));
+	}
+}
+
+class HandOfSacrificePicker extends ExternalCooldownPicker {
+	getTitle(): string {
+		return 'Hand of Sacrifice';
+	}
+
+	getCooldown(): ExternalCooldown {
+		return ExternalCooldown.ExternalCooldownHandOfSacrifice;
+	}
+
+	getSourcePlayers(): Array<Player<any>> {
+		return this.raidSimUI.getActivePlayers().filter(player => player.isClass(Class.ClassPaladin));
+	}
+}
+
+class VigilancePicker extends ExternalCooldownPicker {
+	getTitle(): string {
+		return 'Vigilance';
+	}
+
+	getCooldown(): ExternalCooldown {
+		return ExternalCooldown.ExternalCooldownVigilance;
+	}
+
+	getSourcePlayers(): Array<Player<any>> {
+		return this.raidSimUI.getActivePlayers().filter(player => player.isClass(Class.ClassWarrior));
+	}
+}
+
+class IronbarkPicker extends ExternalCooldownPicker {
+	getTitle(): string {
+		return 'Ironbark';
+	}
+
+	getCooldown(): ExternalCooldown {
+		return ExternalCooldown.ExternalCooldownIronbark;
+	}
+
+	getSourcePlayers(): Array<Player<any>> {
+		return this.raidSimUI.getActivePlayers().filter(player => player.isSpec(Spec.SpecRestorationDruid));
+	}
+}
@@ -135,6 +135,9 @@ const SPEC_CONFIG = registerSpecConfig(Spec.SpecAfflictionWarlock, {
 	otherInputs: {
 		inputs: [
 			AffInputs.ExhaleWindow,
+			WarlockInputs.CustomStartingResources(),
+			WarlockInputs.StartingSoulShards(),
+			WarlockInputs.PrepullSoulburn(),
 			OtherInputs.InputDelay,
 			OtherInputs.DistanceFromTarget,
 			OtherInputs.TankAssignment,
@@ -27,3 +27,52 @@ export const PetInput = <SpecType extends WarlockSpecs>() =>
 		changeEmitter: (player: Player<SpecType>) => player.changeEmitter,
 	});
 
+export const CustomStartingResources = <SpecType extends WarlockSpecs>() =>
+	InputHelpers.makeClassOptionsBooleanInput<SpecType>({
+		fieldName: 'customStartingResources',
+		label: i18n.t('settings_tab.other.custom_starting_resources.label'),
+		labelTooltip: i18n.t('settings_tab.other.custom_starting_resources.tooltip'),
+	});
+
+export const StartingSoulShards = <SpecType extends WarlockSpecs>() =>
+	InputHelpers.makeClassOptionsNumberInput<SpecType>({
+		fieldName: 'startingSoulShards',
+		label: i18n.t('settings_tab.other.starting_soul_shards.label'),
+		labelTooltip: i18n.t('settings_tab.other.starting_soul_shards.tooltip'),
+		positive: true,
+		max: 4,
+		showWhen: (player: Player<SpecType>) =>
+			player.getSpec() == Spec.SpecAfflictionWarlock && player.getClassOptions().customStartingResources,
+	});
+
+export const StartingDemonicFury = <SpecType extends WarlockSpecs>() =>
+	InputHelpers.makeClassOptionsNumberInput<SpecType>({
+		fieldName: 'startingDemonicFury',
+		label: i18n.t('settings_tab.other.starting_demonic_fury.label'),
+		labelTooltip: i18n.t('settings_tab.other.starting_demonic_fury.tooltip'),
+		positive: true,
+		max: 1000,
+		showWhen: (player: Player<SpecType>) =>
+			player.getSpec() == Spec.SpecDemonologyWarlock && player.getClassOptions().customStartingResources,
+	});
+
+export const StartingBurningEmbers = <SpecType extends WarlockSpecs>() =>
+	InputHelpers.makeClassOptionsNumberInput<SpecType>({
+		fieldName: 'startingBurningEmbers',
+		label: i18n.t('settings_tab.other.starting_burning_embers.label'),
+		labelTooltip: i18n.t('settings_tab.other.starting_burning_embers.tooltip'),
+		float: true,
+		maxDecimalDigits: 1,
+		positive: true,
+		max: 4,
+		showWhen: (player: Player<SpecType>) =>
+			player.getSpec() == Spec.SpecDestructionWarlock && player.getClassOptions().customStartingResources,
+	});
+
+export const PrepullSoulburn = <SpecType extends WarlockSpecs>() =>
+	InputHelpers.makeClassOptionsBooleanInput<SpecType>({
+		fieldName: 'prepullSoulburn',
+		label: i18n.t('settings_tab.other.prepull_soulburn.label'),
+		labelTooltip: i18n.t('settings_tab.other.prepull_soulburn.tooltip'),
+		showWhen: (player: Player<SpecType>) => player.getSpec() == Spec.SpecAfflictionWarlock,
+	});